@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/matsuokashuhei/morrow-backend/internal/config"
+)
+
+const configUsage = `Usage: server config <command>
+
+Commands:
+  print     print the effective configuration with secrets redacted and the source of each value
+  validate  exit with 0 when the configuration is valid for GO_ENV
+`
+
+// runConfig prints or validates the configuration read from CONFIG_FILE and the environment
+func runConfig(args []string) int {
+	if len(args) != 1 || (args[0] != "print" && args[0] != "validate") {
+		fmt.Fprint(os.Stderr, configUsage)
+		return 2
+	}
+
+	cfg, err := config.Load(os.Getenv("CONFIG_FILE"))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	if args[0] == "print" {
+		if err := cfg.WriteRedacted(os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+	}
+	// 表示した設定が起動時に拒否されるかどうかも知らせる
+	if err := cfg.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "invalid configuration:\n%s\n", err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/internal/config"
+)
+
+// runHealthcheck asks the server running in this container for its health,
+// so images without curl or wget can use it as a container probe
+func runHealthcheck(args []string) int {
+	flags := flag.NewFlagSet("healthcheck", flag.ContinueOnError)
+	timeout := flags.Duration("timeout", 3*time.Second, "how long to wait for the server")
+	probe := flags.String("probe", "readyz", "probe to query: livez, readyz or startupz")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	switch *probe {
+	case "livez", "readyz", "startupz":
+	default:
+		fmt.Fprintf(os.Stderr, "unknown probe %q\n", *probe)
+		return 2
+	}
+
+	// プローブはデータベースの設定を必要としないので検証はしない
+	cfg := config.New()
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://127.0.0.1:"+cfg.Port+"/"+*probe, nil)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		fmt.Fprintf(os.Stderr, "unhealthy: %s\n", resp.Status)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+	"github.com/matsuokashuhei/morrow-backend/internal/calendar"
+)
+
+// runImportCalendar creates a user's events from an .ics file
+func runImportCalendar(args []string) int {
+	flags := flag.NewFlagSet("import-calendar", flag.ContinueOnError)
+	email := flags.String("user", "", "email of the user who owns the imported events")
+	path := flags.String("file", "", "path of the .ics file to import")
+	visibility := flags.String("visibility", string(event.VisibilityPrivate), "visibility of created events (private, shared, public)")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *email == "" || *path == "" {
+		flags.Usage()
+		return 2
+	}
+	if err := event.VisibilityValidator(event.Visibility(*visibility)); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+
+	ctx := context.Background()
+	cfg, logger := setup(os.Stderr)
+	client, closeDB := openDatabase(cfg, logger)
+	defer closeDB()
+
+	owner, err := client.User.Query().Where(user.EmailEQ(*email)).Only(ctx)
+	if err != nil {
+		logger.WithError(err).WithField("email", *email).Error("Failed to find user")
+		return 1
+	}
+
+	f, err := os.Open(*path)
+	if err != nil {
+		logger.WithError(err).Error("Failed to open calendar file")
+		return 1
+	}
+	defer f.Close()
+
+	items, err := calendar.Import(ctx, client.Client, f, calendar.ImportOptions{
+		CreatorID:  owner.ID,
+		Visibility: event.Visibility(*visibility),
+	})
+	if err != nil {
+		logger.WithError(err).Error("Failed to import calendar")
+		return 1
+	}
+
+	// インポート結果の一覧を表示
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "STATUS\tEVENT\tUID\tSUMMARY\tREASON")
+	for _, item := range items {
+		eventID := "-"
+		if item.EventID != 0 {
+			eventID = fmt.Sprint(item.EventID)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", item.Status, eventID, item.UID, item.Summary, item.Reason)
+	}
+	_ = w.Flush()
+
+	created, updated, skipped := calendar.Counts(items)
+	fmt.Printf("\n✅ Imported %d events: %d created, %d updated, %d skipped\n", len(items), created, updated, skipped)
+	return 0
+}
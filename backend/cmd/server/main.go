@@ -1,102 +1,93 @@
+// Command server is the Morrow backend: the API server and the operational
+// commands that share its configuration and logging.
+//
+//	server [serve] [-require-migrations]
+//	server migrate <status|up|down|baseline|verify> [-to VERSION] [-dry-run]
+//	server seed -file fixtures/dev.yaml
+//	server user <create|promote|disable|enable> -email alice@example.com
+//	server import-calendar -user alice@example.com -file calendar.ics
+//	server healthcheck
+//	server config <print|validate>
 package main
 
 import (
-	"context"
-	"net/http"
+	"fmt"
+	"io"
 	"os"
-	"os/signal"
-	"syscall"
-	"time"
+	"strings"
 
 	"github.com/matsuokashuhei/morrow-backend/internal/config"
 	"github.com/matsuokashuhei/morrow-backend/internal/database"
 	"github.com/matsuokashuhei/morrow-backend/internal/middleware"
-	"github.com/matsuokashuhei/morrow-backend/internal/routes"
+	"github.com/sirupsen/logrus"
 )
 
+const usage = `Usage: server [command] [flags]
+
+Commands:
+  serve            run the API server (default)
+  migrate          apply or revert the versioned database migrations
+  seed             load users and events from a YAML or JSON fixture file
+  user             create users and change their admin flag or sign-in access
+  import-calendar  create a user's events from an .ics file
+  healthcheck      exit with 0 when the server running on PORT is ready (-probe livez, readyz or startupz)
+  config           print or validate the configuration
+
+Run "server <command> -h" for the flags of a command.
+`
+
+// commands maps subcommand names to functions returning the exit code
+var commands = map[string]func(args []string) int{
+	"serve":           runServe,
+	"migrate":         runMigrate,
+	"seed":            runSeed,
+	"user":            runUser,
+	"import-calendar": runImportCalendar,
+	"healthcheck":     runHealthcheck,
+	"config":          runConfig,
+}
+
 func main() {
-	// Initialize structured logging
-	logger := middleware.InitLogger()
+	args := os.Args[1:]
+	// 引数なし、またはフラグだけの場合はサーバーを起動する
+	command := "serve"
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		command, args = args[0], args[1:]
+	}
+	if command == "help" {
+		fmt.Print(usage)
+		return
+	}
+	run, ok := commands[command]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", command, usage)
+		os.Exit(2)
+	}
+	os.Exit(run(args))
+}
 
-	// Set Gin mode based on environment
-	middleware.SetGinMode()
+// setup initializes logging to out and loads the validated configuration.
+// Commands that print results log to stderr so their output stays clean.
+func setup(out io.Writer) (*config.Config, *logrus.Logger) {
+	logger := middleware.InitLogger()
+	logger.SetOutput(out)
 
-	// Load configuration
 	cfg := config.New()
-
-	// Validate configuration
 	if err := cfg.Validate(); err != nil {
 		logger.WithError(err).Fatal("Configuration validation failed")
 	}
+	return cfg, logger
+}
 
-	// Initialize database connection
+// openDatabase connects to the database and returns a function closing the connection
+func openDatabase(cfg *config.Config, logger *logrus.Logger) (*database.Client, func()) {
 	dbClient, err := database.NewClient(cfg, logger)
 	if err != nil {
 		logger.WithError(err).Fatal("Failed to connect to database")
 	}
-	defer func() {
+	return dbClient, func() {
 		if err := dbClient.Close(); err != nil {
 			logger.WithError(err).Error("Failed to close database connection")
 		}
-	}()
-
-	// Run database migration
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-
-	if err := dbClient.AutoMigrate(ctx); err != nil {
-		logger.WithError(err).Fatal("Database migration failed")
-	}
-
-	// Perform initial health check
-	if err := dbClient.HealthCheck(ctx); err != nil {
-		logger.WithError(err).Fatal("Database health check failed")
-	}
-
-	// Create router with database client
-	router := routes.SetupRoutes(cfg, logger, dbClient)
-
-	// Configure HTTP server
-	srv := &http.Server{
-		Addr:         ":" + cfg.Port,
-		Handler:      router,
-		ReadTimeout:  30 * time.Second,
-		WriteTimeout: 30 * time.Second,
-		IdleTimeout:  120 * time.Second,
-	}
-
-	// Log server start information
-	logger.WithField("port", cfg.Port).
-		WithField("environment", cfg.Env).
-		WithField("database_host", cfg.DatabaseHost()).
-		WithField("database_port", cfg.DatabasePort()).
-		WithField("database_name", cfg.DatabaseName()).
-		Info("Starting Morrow API server")
-
-	// Start server in a goroutine
-	go func() {
-		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			logger.WithError(err).Fatal("Failed to start server")
-		}
-	}()
-
-	// Wait for interrupt signal to gracefully shutdown the server
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
-
-	logger.Info("Shutting down server...")
-
-	// Graceful shutdown with timeout
-	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer shutdownCancel()
-
-	// Shutdown HTTP server
-	if err := srv.Shutdown(shutdownCtx); err != nil {
-		logger.WithError(err).Error("Server forced to shutdown")
-	} else {
-		logger.Info("Server gracefully stopped")
 	}
-
-	logger.Info("Server shutdown complete")
 }
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent/migrate/migrations"
+	"github.com/matsuokashuhei/morrow-backend/internal/middleware"
+	"github.com/matsuokashuhei/morrow-backend/internal/migration"
+)
+
+const migrateUsage = `Usage: server migrate <command> [flags]
+
+Commands:
+  status    show applied, pending and edited migrations
+  up        apply pending migrations (-to VERSION stops after that version)
+  down      revert the latest migration (-to VERSION reverts until VERSION is the latest, -to 0 reverts all)
+  baseline  record migrations up to -to VERSION (default: all) as applied without running them,
+            for databases created before migrations were tracked
+  verify    check the migration directory against atlas.sum without connecting to the database
+
+Flags:
+`
+
+// runMigrate runs the migrate subcommand and returns the exit code
+func runMigrate(args []string) int {
+	logger := middleware.InitLogger()
+	// 標準出力は結果の表示に使うのでログは標準エラー出力に出す
+	logger.SetOutput(os.Stderr)
+
+	flags := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	to := flags.String("to", "", "target version")
+	dryRun := flags.Bool("dry-run", false, "print the statements instead of running them")
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), migrateUsage)
+		flags.PrintDefaults()
+	}
+	if len(args) == 0 {
+		flags.Usage()
+		return 2
+	}
+	command := args[0]
+	if err := flags.Parse(args[1:]); err != nil {
+		return 2
+	}
+
+	dir, err := migration.Load(migrations.FS)
+	if err != nil {
+		logger.WithError(err).Error("Invalid migration directory")
+		return 1
+	}
+	switch command {
+	case "verify":
+		fmt.Printf("%d migrations match atlas.sum, latest version %s\n", len(dir.Migrations), dir.Latest())
+		return 0
+	case "status", "up", "down", "baseline":
+	default:
+		flags.Usage()
+		return 2
+	}
+
+	cfg, logger := setup(os.Stderr)
+	dbClient, closeDB := openDatabase(cfg, logger)
+	defer closeDB()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	migrator := migration.New(dbClient.DB(), dir)
+
+	var (
+		done []*migration.Migration
+		verb string
+	)
+	switch command {
+	case "status":
+		status, err := migrator.Status(ctx)
+		if err != nil {
+			logger.WithError(err).Error("Failed to get migration status")
+			return 1
+		}
+		printStatus(os.Stdout, status, dir)
+		return 0
+	case "up":
+		done, err = migrator.Up(ctx, *to, *dryRun)
+		verb = "Applied"
+	case "down":
+		done, err = migrator.Down(ctx, *to, *dryRun)
+		verb = "Reverted"
+	case "baseline":
+		if *dryRun {
+			logger.Error("baseline does not support -dry-run")
+			return 2
+		}
+		target := *to
+		if target == "" {
+			target = dir.Latest()
+		}
+		done, err = migrator.Baseline(ctx, target)
+		verb = "Baselined"
+	}
+
+	if *dryRun {
+		if printErr := printPlan(os.Stdout, done, command == "down"); printErr != nil && err == nil {
+			err = printErr
+		}
+	} else {
+		for _, m := range done {
+			logger.WithField("version", m.Version).WithField("description", m.Description).Info(verb + " migration")
+		}
+	}
+	if err != nil {
+		if errors.Is(err, migration.ErrDrift) {
+			logger.Error("An applied migration was edited; restore it and add a new migration instead")
+		}
+		logger.WithError(err).Error("Migration failed")
+		return 1
+	}
+	if len(done) == 0 {
+		logger.Info("Nothing to migrate")
+	}
+	return 0
+}
+
+// printStatus writes the migration status as a table
+func printStatus(w io.Writer, status *migration.Status, dir *migration.Dir) {
+	fmt.Fprintf(w, "Current version: %s\nLatest version:  %s\n\n", status.Current, dir.Latest())
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "VERSION\tDESCRIPTION\tSTATE\tAPPLIED AT\tTOOK")
+	for _, r := range status.Applied {
+		fmt.Fprintf(tw, "%s\t%s\tapplied\t%s\t%s\n", r.Version, r.Description, r.AppliedAt.Format(time.RFC3339), r.ExecutionTime)
+	}
+	for _, m := range status.Pending {
+		fmt.Fprintf(tw, "%s\t%s\tpending\t\t\n", m.Version, m.Description)
+	}
+	_ = tw.Flush()
+
+	for _, name := range status.Drifted {
+		fmt.Fprintf(w, "\nEdited after it was applied: %s", name)
+	}
+	for _, version := range status.Unknown {
+		fmt.Fprintf(w, "\nApplied but not in this build: %s", version)
+	}
+	if len(status.Drifted) > 0 || len(status.Unknown) > 0 {
+		fmt.Fprintln(w)
+	}
+}
+
+// printPlan writes the statements a dry run would execute
+func printPlan(w io.Writer, plan []*migration.Migration, down bool) error {
+	for _, m := range plan {
+		stmts, err := m.Statements()
+		if down {
+			stmts, err = m.DownStatements()
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "-- %s\n", m.Name)
+		for _, stmt := range stmts {
+			fmt.Fprintln(w, stmt)
+		}
+		fmt.Fprintln(w)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/matsuokashuhei/morrow-backend/internal/config"
+	"github.com/matsuokashuhei/morrow-backend/internal/database"
+	"github.com/matsuokashuhei/morrow-backend/internal/health"
+	"github.com/matsuokashuhei/morrow-backend/internal/invitation"
+	"github.com/matsuokashuhei/morrow-backend/internal/leader"
+	"github.com/matsuokashuhei/morrow-backend/internal/migration"
+	"github.com/matsuokashuhei/morrow-backend/internal/storage"
+)
+
+// maxMailBacklog is the number of undelivered invitation emails above which
+// the mail check fails, which points at an unreachable SMTP server
+const maxMailBacklog = 100
+
+// newProbes registers the dependency checks of the probes. Migrations only
+// fail the probes when the server requires them to be up to date.
+// elector is nil when the server runs no background jobs.
+func newProbes(cfg *config.Config, dbClient *database.Client, migrator *migration.Migrator, requireMigrations bool, store storage.Storage, invitations *invitation.Sender, elector *leader.Elector) *health.Probes {
+	probes := health.New(cfg.HealthCheckTimeout)
+
+	databaseCheck := health.Check{Name: "database", Run: dbClient.HealthCheck}
+	migrationsCheck := health.Check{Name: "migrations", Run: migrator.Check, Optional: !requireMigrations}
+	probes.AddStartup(databaseCheck)
+	probes.AddStartup(migrationsCheck)
+	probes.AddReadiness(databaseCheck)
+	probes.AddReadiness(migrationsCheck)
+
+	// アップロード以外の API は使えるので、ストレージとメールは必須にしない
+	if store != nil {
+		probes.AddReadiness(health.Check{
+			Name:     "storage",
+			Run:      func(ctx context.Context) error { return storage.Ping(ctx, store) },
+			Optional: true,
+		})
+	}
+	if invitations != nil {
+		probes.AddReadiness(health.Check{
+			Name: "mail_backlog",
+			Run: func(context.Context) error {
+				if n := invitations.Backlog(); n > maxMailBacklog {
+					return fmt.Errorf("%d invitation emails are waiting to be sent", n)
+				}
+				return nil
+			},
+			Optional: true,
+		})
+	}
+	// フォロワーも正常とし、リーダーの選出に参加できない場合だけ失敗する
+	if elector != nil {
+		probes.AddReadiness(health.Check{
+			Name:     "scheduler",
+			Run:      elector.Check,
+			Optional: true,
+		})
+	}
+	return probes
+}
+
+// runJobs runs the background jobs until ctx is canceled and waits for them to stop
+func runJobs(ctx context.Context, jobs []func(context.Context)) {
+	var wg sync.WaitGroup
+	for _, job := range jobs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			job(ctx)
+		}()
+	}
+	wg.Wait()
+}
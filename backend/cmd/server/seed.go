@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/matsuokashuhei/morrow-backend/internal/seed"
+)
+
+// runSeed loads a fixture set into the database
+func runSeed(args []string) int {
+	flags := flag.NewFlagSet("seed", flag.ContinueOnError)
+	path := flags.String("file", "", "path of the YAML or JSON fixture file")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *path == "" {
+		flags.Usage()
+		return 2
+	}
+
+	// 接続する前にファイルを検証する
+	f, err := os.Open(*path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	fixtures, err := seed.Parse(f)
+	_ = f.Close()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	ctx := context.Background()
+	cfg, logger := setup(os.Stderr)
+	client, closeDB := openDatabase(cfg, logger)
+	defer closeDB()
+
+	result, err := seed.Apply(ctx, client.Client, fixtures)
+	if err != nil {
+		logger.WithError(err).Error("Failed to load fixtures")
+		return 1
+	}
+	fmt.Printf("✅ Users: %d created, %d existing. Events: %d created, %d existing. %d participants and %d messages added\n",
+		result.UsersCreated, result.UsersExisting, result.EventsCreated, result.EventsExisting, result.Participants, result.Messages)
+	return 0
+}
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent/migrate/migrations"
+	"github.com/matsuokashuhei/morrow-backend/internal/account"
+	"github.com/matsuokashuhei/morrow-backend/internal/billing"
+	"github.com/matsuokashuhei/morrow-backend/internal/dataexport"
+	"github.com/matsuokashuhei/morrow-backend/internal/entitlement"
+	"github.com/matsuokashuhei/morrow-backend/internal/invitation"
+	"github.com/matsuokashuhei/morrow-backend/internal/leader"
+	"github.com/matsuokashuhei/morrow-backend/internal/mail"
+	"github.com/matsuokashuhei/morrow-backend/internal/metrics"
+	"github.com/matsuokashuhei/morrow-backend/internal/middleware"
+	"github.com/matsuokashuhei/morrow-backend/internal/migration"
+	"github.com/matsuokashuhei/morrow-backend/internal/routes"
+	"github.com/matsuokashuhei/morrow-backend/internal/softdelete"
+	"github.com/matsuokashuhei/morrow-backend/internal/storage"
+	"github.com/matsuokashuhei/morrow-backend/internal/tracing"
+)
+
+// runServe runs the API server until it receives SIGINT or SIGTERM
+func runServe(args []string) int {
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+	requireMigrations := flags.Bool("require-migrations", false, "refuse to start when the database schema is behind the migration directory")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	// Set Gin mode based on environment
+	middleware.SetGinMode()
+
+	// Initialize structured logging and load configuration
+	cfg, logger := setup(os.Stdout)
+
+	// Export traces and link log entries to them
+	shutdownTracing, err := tracing.Setup(context.Background(), tracing.Options{
+		Exporter:    cfg.TracingExporter,
+		Endpoint:    cfg.TracingEndpoint,
+		ServiceName: cfg.TracingServiceName,
+		SampleRatio: cfg.TracingSampleRatio,
+	})
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to initialize tracing")
+	}
+	logger.AddHook(tracing.LogHook{})
+
+	// Initialize database connection
+	dbClient, closeDB := openDatabase(cfg, logger)
+	defer closeDB()
+
+	// Check the schema against the versioned migrations (applied with "server migrate up")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	dir, err := migration.Load(migrations.FS)
+	if err != nil {
+		logger.WithError(err).Fatal("Invalid migration directory")
+	}
+	migrator := migration.New(dbClient.DB(), dir)
+	err = migrator.Check(ctx)
+	switch {
+	case err == nil:
+		if err := entitlement.EnsurePlans(ctx, dbClient.Client); err != nil {
+			logger.WithError(err).Fatal("Failed to create default plans")
+		}
+	case *requireMigrations:
+		logger.WithError(err).Fatal("Database schema is not up to date, run \"server migrate up\"")
+	default:
+		logger.WithError(err).Warn("Database schema is not up to date, run \"server migrate up\"")
+	}
+
+	// Perform initial health check
+	if err := dbClient.HealthCheck(ctx); err != nil {
+		logger.WithError(err).Fatal("Database health check failed")
+	}
+
+	// Initialize storage for uploaded files
+	store, err := storage.New(cfg)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to initialize storage")
+	}
+
+	// Initialize calendar invitation emails
+	mailer, err := mail.New(cfg, logger)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to initialize mailer")
+	}
+	invitations, err := invitation.NewSender(dbClient.Client, mailer, cfg.MailFrom, logger)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to initialize invitations")
+	}
+
+	// Initialize subscription billing
+	billingService, err := billing.New(cfg, dbClient.Client)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to initialize billing")
+	}
+
+	// Purge expired trash, build data exports and close accounts whose grace period
+	// has passed in the background, on the one server elected to lead the jobs
+	purgeCtx, stopPurge := context.WithCancel(context.Background())
+	defer stopPurge()
+	var jobs []func(context.Context)
+	if cfg.TrashPurgeInterval > 0 {
+		jobs = append(jobs, softdelete.NewPurger(dbClient.Client, cfg.TrashRetention, cfg.TrashPurgeInterval, logger).Run)
+	}
+	if cfg.JobInterval > 0 {
+		if store != nil {
+			jobs = append(jobs, dataexport.NewWorker(dbClient.Client, store, cfg.DataExportRetention, cfg.JobInterval, logger).Run)
+		}
+		jobs = append(jobs, account.NewCloser(dbClient.Client, store, invitations, cfg.JobInterval, logger).Run)
+	}
+	var elector *leader.Elector
+	jobsDone := make(chan struct{})
+	if len(jobs) > 0 {
+		elector = leader.New(leader.NewPostgresLock(dbClient.DB(), leader.JobsLockKey), leader.DefaultInterval, logger)
+		go func() {
+			defer close(jobsDone)
+			elector.Run(purgeCtx, func(ctx context.Context) { runJobs(ctx, jobs) })
+		}()
+	} else {
+		close(jobsDone)
+	}
+
+	// Create router with database client
+	probes := newProbes(cfg, dbClient, migrator, *requireMigrations, store, invitations, elector)
+	router := routes.SetupRoutes(cfg, logger, dbClient, store, invitations, billingService, probes)
+
+	// Serve metrics on their own port, which is not exposed publicly
+	var metricsSrv *http.Server
+	if cfg.MetricsEnabled {
+		if err := metrics.RegisterDBStats(dbClient.DB()); err != nil {
+			logger.WithError(err).Fatal("Failed to register database metrics")
+		}
+		if cfg.MetricsPort != "" {
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", metrics.Handler(cfg.MetricsToken))
+			metricsSrv = &http.Server{
+				Addr:              ":" + cfg.MetricsPort,
+				Handler:           mux,
+				ReadHeaderTimeout: 10 * time.Second,
+			}
+			go func() {
+				if err := metricsSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+					logger.WithError(err).Fatal("Failed to start metrics server")
+				}
+			}()
+			logger.WithField("port", cfg.MetricsPort).Info("Serving metrics")
+		}
+	}
+
+	// Configure HTTP server
+	srv := &http.Server{
+		Addr:         ":" + cfg.Port,
+		Handler:      router,
+		ReadTimeout:  cfg.ReadTimeout,
+		WriteTimeout: cfg.WriteTimeout,
+		IdleTimeout:  cfg.IdleTimeout,
+	}
+
+	// Log server start information
+	logger.WithField("port", cfg.Port).
+		WithField("environment", cfg.Env).
+		WithField("database_host", cfg.DatabaseHost()).
+		WithField("database_port", cfg.DatabasePort()).
+		WithField("database_name", cfg.DatabaseName()).
+		Info("Starting Morrow API server")
+
+	// Start server in a goroutine
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.WithError(err).Fatal("Failed to start server")
+		}
+	}()
+	probes.MarkStarted()
+
+	// Wait for interrupt signal to gracefully shutdown the server
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	logger.Info("Shutting down server...")
+
+	// Fail readiness first so that load balancers stop sending new requests
+	probes.Drain()
+	if cfg.DrainDelay > 0 {
+		logger.WithField("delay", cfg.DrainDelay).Info("Draining connections")
+		time.Sleep(cfg.DrainDelay)
+	}
+
+	// Graceful shutdown with timeout
+	shutdownCtx, shutdownCancel := context.WithCancel(context.Background())
+	if cfg.ShutdownTimeout > 0 {
+		shutdownCtx, shutdownCancel = context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	}
+	defer shutdownCancel()
+
+	// Shutdown HTTP server
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		logger.WithError(err).Error("Server forced to shutdown")
+	} else {
+		logger.Info("Server gracefully stopped")
+	}
+	if metricsSrv != nil {
+		if err := metricsSrv.Shutdown(shutdownCtx); err != nil {
+			logger.WithError(err).Error("Metrics server forced to shutdown")
+		}
+	}
+	// データベースを閉じる前にジョブを止め、処理中のトランザクションとリーダーのロックを片付けさせる
+	stopPurge()
+	select {
+	case <-jobsDone:
+	case <-shutdownCtx.Done():
+		logger.Error("Background jobs did not stop before the shutdown timeout")
+	}
+	// 残っているスパンを送信してから終了する
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		logger.WithError(err).Error("Failed to flush traces")
+	}
+
+	logger.Info("Server shutdown complete")
+	return 0
+}
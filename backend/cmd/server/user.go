@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+)
+
+const userUsage = `Usage: server user <command> -email EMAIL [flags]
+
+Commands:
+  create   create a user (-name is required)
+  promote  make a user an administrator (-revoke removes the flag)
+  disable  stop a user from signing in
+  enable   allow a disabled user to sign in again
+
+Flags:
+`
+
+// runUser manages users from the command line
+func runUser(args []string) int {
+	flags := flag.NewFlagSet("user", flag.ContinueOnError)
+	email := flags.String("email", "", "email of the user")
+	name := flags.String("name", "", "display name (create)")
+	cognitoID := flags.String("cognito-id", "", "identity provider subject the user signs in with (create)")
+	admin := flags.Bool("admin", false, "create the user as an administrator (create)")
+	revoke := flags.Bool("revoke", false, "remove the administrator flag instead (promote)")
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), userUsage)
+		flags.PrintDefaults()
+	}
+	if len(args) == 0 {
+		flags.Usage()
+		return 2
+	}
+	command := args[0]
+	if err := flags.Parse(args[1:]); err != nil {
+		return 2
+	}
+	switch {
+	case command != "create" && command != "promote" && command != "disable" && command != "enable":
+		flags.Usage()
+		return 2
+	case *email == "", command == "create" && *name == "":
+		flags.Usage()
+		return 2
+	}
+
+	ctx := context.Background()
+	cfg, logger := setup(os.Stderr)
+	client, closeDB := openDatabase(cfg, logger)
+	defer closeDB()
+
+	var (
+		u   *ent.User
+		err error
+	)
+	if command == "create" {
+		create := client.User.Create().
+			SetEmail(*email).
+			SetName(*name).
+			SetIsAdmin(*admin)
+		if *cognitoID != "" {
+			create.SetCognitoID(*cognitoID)
+		}
+		u, err = create.Save(ctx)
+	} else {
+		u, err = client.User.Query().Where(user.EmailEQ(*email)).Only(ctx)
+		if err == nil {
+			update := u.Update()
+			switch command {
+			case "promote":
+				update.SetIsAdmin(!*revoke)
+			case "disable":
+				// 無効にした日時は最初のものを残す
+				if u.DisabledAt == nil {
+					update.SetDisabledAt(time.Now())
+				}
+			case "enable":
+				update.ClearDisabledAt()
+			}
+			u, err = update.Save(ctx)
+		}
+	}
+	if ent.IsNotFound(err) {
+		fmt.Fprintf(os.Stderr, "user %s not found\n", *email)
+		return 1
+	}
+	if err != nil {
+		logger.WithError(err).WithField("email", *email).Error("Failed to " + command + " user")
+		return 1
+	}
+
+	status := "enabled"
+	if u.DisabledAt != nil {
+		status = "disabled since " + u.DisabledAt.Format(time.RFC3339)
+	}
+	fmt.Printf("✅ User %d <%s> %s: admin=%t, %s\n", u.ID, u.Email, u.Name, u.IsAdmin, status)
+	return 0
+}
@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/matsuokashuhei/morrow-backend/ent/attachment"
+	"github.com/matsuokashuhei/morrow-backend/ent/message"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+)
+
+// Attachment is the model entity for the Attachment schema.
+type Attachment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ストレージ上のオブジェクトキー
+	Key string `json:"key,omitempty"`
+	// サムネイルのオブジェクトキー
+	ThumbnailKey string `json:"thumbnail_key,omitempty"`
+	// 内容から判定したMIMEタイプ
+	ContentType string `json:"content_type,omitempty"`
+	// ファイルサイズ (バイト)
+	Size int64 `json:"size,omitempty"`
+	// 画像の幅 (px)
+	Width int `json:"width,omitempty"`
+	// 画像の高さ (px)
+	Height int `json:"height,omitempty"`
+	// アップロード日時
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AttachmentQuery when eager-loading is set.
+	Edges               AttachmentEdges `json:"edges"`
+	message_attachments *int
+	user_attachments    *int
+	selectValues        sql.SelectValues
+}
+
+// AttachmentEdges holds the relations/edges for other nodes in the graph.
+type AttachmentEdges struct {
+	// 添付先のメッセージ
+	Message *Message `json:"message,omitempty"`
+	// アップロードしたユーザー
+	Uploader *User `json:"uploader,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// MessageOrErr returns the Message value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttachmentEdges) MessageOrErr() (*Message, error) {
+	if e.Message != nil {
+		return e.Message, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "message"}
+}
+
+// UploaderOrErr returns the Uploader value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttachmentEdges) UploaderOrErr() (*User, error) {
+	if e.Uploader != nil {
+		return e.Uploader, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "uploader"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Attachment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case attachment.FieldID, attachment.FieldSize, attachment.FieldWidth, attachment.FieldHeight:
+			values[i] = new(sql.NullInt64)
+		case attachment.FieldKey, attachment.FieldThumbnailKey, attachment.FieldContentType:
+			values[i] = new(sql.NullString)
+		case attachment.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case attachment.ForeignKeys[0]: // message_attachments
+			values[i] = new(sql.NullInt64)
+		case attachment.ForeignKeys[1]: // user_attachments
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Attachment fields.
+func (a *Attachment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case attachment.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			a.ID = int(value.Int64)
+		case attachment.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				a.Key = value.String
+			}
+		case attachment.FieldThumbnailKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field thumbnail_key", values[i])
+			} else if value.Valid {
+				a.ThumbnailKey = value.String
+			}
+		case attachment.FieldContentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_type", values[i])
+			} else if value.Valid {
+				a.ContentType = value.String
+			}
+		case attachment.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				a.Size = value.Int64
+			}
+		case attachment.FieldWidth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field width", values[i])
+			} else if value.Valid {
+				a.Width = int(value.Int64)
+			}
+		case attachment.FieldHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field height", values[i])
+			} else if value.Valid {
+				a.Height = int(value.Int64)
+			}
+		case attachment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				a.CreatedAt = value.Time
+			}
+		case attachment.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field message_attachments", value)
+			} else if value.Valid {
+				a.message_attachments = new(int)
+				*a.message_attachments = int(value.Int64)
+			}
+		case attachment.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_attachments", value)
+			} else if value.Valid {
+				a.user_attachments = new(int)
+				*a.user_attachments = int(value.Int64)
+			}
+		default:
+			a.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Attachment.
+// This includes values selected through modifiers, order, etc.
+func (a *Attachment) Value(name string) (ent.Value, error) {
+	return a.selectValues.Get(name)
+}
+
+// QueryMessage queries the "message" edge of the Attachment entity.
+func (a *Attachment) QueryMessage() *MessageQuery {
+	return NewAttachmentClient(a.config).QueryMessage(a)
+}
+
+// QueryUploader queries the "uploader" edge of the Attachment entity.
+func (a *Attachment) QueryUploader() *UserQuery {
+	return NewAttachmentClient(a.config).QueryUploader(a)
+}
+
+// Update returns a builder for updating this Attachment.
+// Note that you need to call Attachment.Unwrap() before calling this method if this Attachment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (a *Attachment) Update() *AttachmentUpdateOne {
+	return NewAttachmentClient(a.config).UpdateOne(a)
+}
+
+// Unwrap unwraps the Attachment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (a *Attachment) Unwrap() *Attachment {
+	_tx, ok := a.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Attachment is not a transactional entity")
+	}
+	a.config.driver = _tx.drv
+	return a
+}
+
+// String implements the fmt.Stringer.
+func (a *Attachment) String() string {
+	var builder strings.Builder
+	builder.WriteString("Attachment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", a.ID))
+	builder.WriteString("key=")
+	builder.WriteString(a.Key)
+	builder.WriteString(", ")
+	builder.WriteString("thumbnail_key=")
+	builder.WriteString(a.ThumbnailKey)
+	builder.WriteString(", ")
+	builder.WriteString("content_type=")
+	builder.WriteString(a.ContentType)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", a.Size))
+	builder.WriteString(", ")
+	builder.WriteString("width=")
+	builder.WriteString(fmt.Sprintf("%v", a.Width))
+	builder.WriteString(", ")
+	builder.WriteString("height=")
+	builder.WriteString(fmt.Sprintf("%v", a.Height))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(a.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Attachments is a parsable slice of Attachment.
+type Attachments []*Attachment
@@ -0,0 +1,217 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/matsuokashuhei/morrow-backend/ent/auditentry"
+	"github.com/matsuokashuhei/morrow-backend/ent/schema"
+)
+
+// AuditEntry is the model entity for the AuditEntry schema.
+type AuditEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 操作したユーザーのID (匿名・システムによる操作はNULL)
+	ActorID *int `json:"actor_id,omitempty"`
+	// 操作した認証主体 (Cognito sub)
+	ActorSubject string `json:"actor_subject,omitempty"`
+	// 対象のエンティティ種別 (例: Event)
+	EntityType string `json:"entity_type,omitempty"`
+	// 対象のエンティティID
+	EntityID int `json:"entity_id,omitempty"`
+	// イベントまたはその参加者に関する記録の場合のイベントID (アクティビティ表示用)
+	EventID *int `json:"event_id,omitempty"`
+	// 操作の種類
+	Operation auditentry.Operation `json:"operation,omitempty"`
+	// 変更されたフィールドと変更前後の値
+	Changes []schema.FieldChange `json:"changes,omitempty"`
+	// 操作を行ったリクエストのID
+	RequestID string `json:"request_id,omitempty"`
+	// 操作元のIPアドレス
+	IP string `json:"ip,omitempty"`
+	// 記録日時
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditentry.FieldChanges:
+			values[i] = new([]byte)
+		case auditentry.FieldID, auditentry.FieldActorID, auditentry.FieldEntityID, auditentry.FieldEventID:
+			values[i] = new(sql.NullInt64)
+		case auditentry.FieldActorSubject, auditentry.FieldEntityType, auditentry.FieldOperation, auditentry.FieldRequestID, auditentry.FieldIP:
+			values[i] = new(sql.NullString)
+		case auditentry.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditEntry fields.
+func (ae *AuditEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditentry.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ae.ID = int(value.Int64)
+		case auditentry.FieldActorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_id", values[i])
+			} else if value.Valid {
+				ae.ActorID = new(int)
+				*ae.ActorID = int(value.Int64)
+			}
+		case auditentry.FieldActorSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_subject", values[i])
+			} else if value.Valid {
+				ae.ActorSubject = value.String
+			}
+		case auditentry.FieldEntityType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_type", values[i])
+			} else if value.Valid {
+				ae.EntityType = value.String
+			}
+		case auditentry.FieldEntityID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_id", values[i])
+			} else if value.Valid {
+				ae.EntityID = int(value.Int64)
+			}
+		case auditentry.FieldEventID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value.Valid {
+				ae.EventID = new(int)
+				*ae.EventID = int(value.Int64)
+			}
+		case auditentry.FieldOperation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operation", values[i])
+			} else if value.Valid {
+				ae.Operation = auditentry.Operation(value.String)
+			}
+		case auditentry.FieldChanges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field changes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ae.Changes); err != nil {
+					return fmt.Errorf("unmarshal field changes: %w", err)
+				}
+			}
+		case auditentry.FieldRequestID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field request_id", values[i])
+			} else if value.Valid {
+				ae.RequestID = value.String
+			}
+		case auditentry.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				ae.IP = value.String
+			}
+		case auditentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ae.CreatedAt = value.Time
+			}
+		default:
+			ae.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuditEntry.
+// This includes values selected through modifiers, order, etc.
+func (ae *AuditEntry) Value(name string) (ent.Value, error) {
+	return ae.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AuditEntry.
+// Note that you need to call AuditEntry.Unwrap() before calling this method if this AuditEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ae *AuditEntry) Update() *AuditEntryUpdateOne {
+	return NewAuditEntryClient(ae.config).UpdateOne(ae)
+}
+
+// Unwrap unwraps the AuditEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ae *AuditEntry) Unwrap() *AuditEntry {
+	_tx, ok := ae.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditEntry is not a transactional entity")
+	}
+	ae.config.driver = _tx.drv
+	return ae
+}
+
+// String implements the fmt.Stringer.
+func (ae *AuditEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ae.ID))
+	if v := ae.ActorID; v != nil {
+		builder.WriteString("actor_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("actor_subject=")
+	builder.WriteString(ae.ActorSubject)
+	builder.WriteString(", ")
+	builder.WriteString("entity_type=")
+	builder.WriteString(ae.EntityType)
+	builder.WriteString(", ")
+	builder.WriteString("entity_id=")
+	builder.WriteString(fmt.Sprintf("%v", ae.EntityID))
+	builder.WriteString(", ")
+	if v := ae.EventID; v != nil {
+		builder.WriteString("event_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("operation=")
+	builder.WriteString(fmt.Sprintf("%v", ae.Operation))
+	builder.WriteString(", ")
+	builder.WriteString("changes=")
+	builder.WriteString(fmt.Sprintf("%v", ae.Changes))
+	builder.WriteString(", ")
+	builder.WriteString("request_id=")
+	builder.WriteString(ae.RequestID)
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(ae.IP)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ae.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditEntries is a parsable slice of AuditEntry.
+type AuditEntries []*AuditEntry
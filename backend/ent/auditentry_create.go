@@ -0,0 +1,355 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/matsuokashuhei/morrow-backend/ent/auditentry"
+	"github.com/matsuokashuhei/morrow-backend/ent/schema"
+)
+
+// AuditEntryCreate is the builder for creating a AuditEntry entity.
+type AuditEntryCreate struct {
+	config
+	mutation *AuditEntryMutation
+	hooks    []Hook
+}
+
+// SetActorID sets the "actor_id" field.
+func (aec *AuditEntryCreate) SetActorID(i int) *AuditEntryCreate {
+	aec.mutation.SetActorID(i)
+	return aec
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (aec *AuditEntryCreate) SetNillableActorID(i *int) *AuditEntryCreate {
+	if i != nil {
+		aec.SetActorID(*i)
+	}
+	return aec
+}
+
+// SetActorSubject sets the "actor_subject" field.
+func (aec *AuditEntryCreate) SetActorSubject(s string) *AuditEntryCreate {
+	aec.mutation.SetActorSubject(s)
+	return aec
+}
+
+// SetNillableActorSubject sets the "actor_subject" field if the given value is not nil.
+func (aec *AuditEntryCreate) SetNillableActorSubject(s *string) *AuditEntryCreate {
+	if s != nil {
+		aec.SetActorSubject(*s)
+	}
+	return aec
+}
+
+// SetEntityType sets the "entity_type" field.
+func (aec *AuditEntryCreate) SetEntityType(s string) *AuditEntryCreate {
+	aec.mutation.SetEntityType(s)
+	return aec
+}
+
+// SetEntityID sets the "entity_id" field.
+func (aec *AuditEntryCreate) SetEntityID(i int) *AuditEntryCreate {
+	aec.mutation.SetEntityID(i)
+	return aec
+}
+
+// SetEventID sets the "event_id" field.
+func (aec *AuditEntryCreate) SetEventID(i int) *AuditEntryCreate {
+	aec.mutation.SetEventID(i)
+	return aec
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (aec *AuditEntryCreate) SetNillableEventID(i *int) *AuditEntryCreate {
+	if i != nil {
+		aec.SetEventID(*i)
+	}
+	return aec
+}
+
+// SetOperation sets the "operation" field.
+func (aec *AuditEntryCreate) SetOperation(a auditentry.Operation) *AuditEntryCreate {
+	aec.mutation.SetOperation(a)
+	return aec
+}
+
+// SetChanges sets the "changes" field.
+func (aec *AuditEntryCreate) SetChanges(sc []schema.FieldChange) *AuditEntryCreate {
+	aec.mutation.SetChanges(sc)
+	return aec
+}
+
+// SetRequestID sets the "request_id" field.
+func (aec *AuditEntryCreate) SetRequestID(s string) *AuditEntryCreate {
+	aec.mutation.SetRequestID(s)
+	return aec
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (aec *AuditEntryCreate) SetNillableRequestID(s *string) *AuditEntryCreate {
+	if s != nil {
+		aec.SetRequestID(*s)
+	}
+	return aec
+}
+
+// SetIP sets the "ip" field.
+func (aec *AuditEntryCreate) SetIP(s string) *AuditEntryCreate {
+	aec.mutation.SetIP(s)
+	return aec
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (aec *AuditEntryCreate) SetNillableIP(s *string) *AuditEntryCreate {
+	if s != nil {
+		aec.SetIP(*s)
+	}
+	return aec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aec *AuditEntryCreate) SetCreatedAt(t time.Time) *AuditEntryCreate {
+	aec.mutation.SetCreatedAt(t)
+	return aec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aec *AuditEntryCreate) SetNillableCreatedAt(t *time.Time) *AuditEntryCreate {
+	if t != nil {
+		aec.SetCreatedAt(*t)
+	}
+	return aec
+}
+
+// Mutation returns the AuditEntryMutation object of the builder.
+func (aec *AuditEntryCreate) Mutation() *AuditEntryMutation {
+	return aec.mutation
+}
+
+// Save creates the AuditEntry in the database.
+func (aec *AuditEntryCreate) Save(ctx context.Context) (*AuditEntry, error) {
+	aec.defaults()
+	return withHooks(ctx, aec.sqlSave, aec.mutation, aec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aec *AuditEntryCreate) SaveX(ctx context.Context) *AuditEntry {
+	v, err := aec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aec *AuditEntryCreate) Exec(ctx context.Context) error {
+	_, err := aec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aec *AuditEntryCreate) ExecX(ctx context.Context) {
+	if err := aec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aec *AuditEntryCreate) defaults() {
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		v := auditentry.DefaultCreatedAt()
+		aec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aec *AuditEntryCreate) check() error {
+	if _, ok := aec.mutation.EntityType(); !ok {
+		return &ValidationError{Name: "entity_type", err: errors.New(`ent: missing required field "AuditEntry.entity_type"`)}
+	}
+	if v, ok := aec.mutation.EntityType(); ok {
+		if err := auditentry.EntityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`ent: validator failed for field "AuditEntry.entity_type": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.EntityID(); !ok {
+		return &ValidationError{Name: "entity_id", err: errors.New(`ent: missing required field "AuditEntry.entity_id"`)}
+	}
+	if _, ok := aec.mutation.Operation(); !ok {
+		return &ValidationError{Name: "operation", err: errors.New(`ent: missing required field "AuditEntry.operation"`)}
+	}
+	if v, ok := aec.mutation.Operation(); ok {
+		if err := auditentry.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "AuditEntry.operation": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.Changes(); !ok {
+		return &ValidationError{Name: "changes", err: errors.New(`ent: missing required field "AuditEntry.changes"`)}
+	}
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuditEntry.created_at"`)}
+	}
+	return nil
+}
+
+func (aec *AuditEntryCreate) sqlSave(ctx context.Context) (*AuditEntry, error) {
+	if err := aec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := aec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	aec.mutation.id = &_node.ID
+	aec.mutation.done = true
+	return _node, nil
+}
+
+func (aec *AuditEntryCreate) createSpec() (*AuditEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditEntry{config: aec.config}
+		_spec = sqlgraph.NewCreateSpec(auditentry.Table, sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeInt))
+	)
+	if value, ok := aec.mutation.ActorID(); ok {
+		_spec.SetField(auditentry.FieldActorID, field.TypeInt, value)
+		_node.ActorID = &value
+	}
+	if value, ok := aec.mutation.ActorSubject(); ok {
+		_spec.SetField(auditentry.FieldActorSubject, field.TypeString, value)
+		_node.ActorSubject = value
+	}
+	if value, ok := aec.mutation.EntityType(); ok {
+		_spec.SetField(auditentry.FieldEntityType, field.TypeString, value)
+		_node.EntityType = value
+	}
+	if value, ok := aec.mutation.EntityID(); ok {
+		_spec.SetField(auditentry.FieldEntityID, field.TypeInt, value)
+		_node.EntityID = value
+	}
+	if value, ok := aec.mutation.EventID(); ok {
+		_spec.SetField(auditentry.FieldEventID, field.TypeInt, value)
+		_node.EventID = &value
+	}
+	if value, ok := aec.mutation.Operation(); ok {
+		_spec.SetField(auditentry.FieldOperation, field.TypeEnum, value)
+		_node.Operation = value
+	}
+	if value, ok := aec.mutation.Changes(); ok {
+		_spec.SetField(auditentry.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	if value, ok := aec.mutation.RequestID(); ok {
+		_spec.SetField(auditentry.FieldRequestID, field.TypeString, value)
+		_node.RequestID = value
+	}
+	if value, ok := aec.mutation.IP(); ok {
+		_spec.SetField(auditentry.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := aec.mutation.CreatedAt(); ok {
+		_spec.SetField(auditentry.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AuditEntryCreateBulk is the builder for creating many AuditEntry entities in bulk.
+type AuditEntryCreateBulk struct {
+	config
+	err      error
+	builders []*AuditEntryCreate
+}
+
+// Save creates the AuditEntry entities in the database.
+func (aecb *AuditEntryCreateBulk) Save(ctx context.Context) ([]*AuditEntry, error) {
+	if aecb.err != nil {
+		return nil, aecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(aecb.builders))
+	nodes := make([]*AuditEntry, len(aecb.builders))
+	mutators := make([]Mutator, len(aecb.builders))
+	for i := range aecb.builders {
+		func(i int, root context.Context) {
+			builder := aecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aecb *AuditEntryCreateBulk) SaveX(ctx context.Context) []*AuditEntry {
+	v, err := aecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aecb *AuditEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := aecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aecb *AuditEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := aecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
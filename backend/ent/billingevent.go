@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/matsuokashuhei/morrow-backend/ent/billingevent"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+)
+
+// BillingEvent is the model entity for the BillingEvent schema.
+type BillingEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 決済プロバイダー名 (例: stripe)
+	Provider string `json:"provider,omitempty"`
+	// プロバイダーのイベントID (Webhookの重複処理防止用)
+	ProviderEventID string `json:"provider_event_id,omitempty"`
+	// イベントの種類
+	Type billingevent.Type `json:"type,omitempty"`
+	// イベント時点のプラン
+	PlanKey string `json:"plan_key,omitempty"`
+	// プロバイダーの契約ID
+	ProviderSubscriptionID string `json:"provider_subscription_id,omitempty"`
+	// 契約期間の終了日時
+	PeriodEnd *time.Time `json:"period_end,omitempty"`
+	// 受信したWebhookの本文
+	Payload string `json:"-"`
+	// プロバイダー側でイベントが発生した日時
+	OccurredAt time.Time `json:"occurred_at,omitempty"`
+	// 記録日時
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BillingEventQuery when eager-loading is set.
+	Edges               BillingEventEdges `json:"edges"`
+	user_billing_events *int
+	selectValues        sql.SelectValues
+}
+
+// BillingEventEdges holds the relations/edges for other nodes in the graph.
+type BillingEventEdges struct {
+	// 対象ユーザー
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BillingEventEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BillingEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case billingevent.FieldID:
+			values[i] = new(sql.NullInt64)
+		case billingevent.FieldProvider, billingevent.FieldProviderEventID, billingevent.FieldType, billingevent.FieldPlanKey, billingevent.FieldProviderSubscriptionID, billingevent.FieldPayload:
+			values[i] = new(sql.NullString)
+		case billingevent.FieldPeriodEnd, billingevent.FieldOccurredAt, billingevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case billingevent.ForeignKeys[0]: // user_billing_events
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BillingEvent fields.
+func (be *BillingEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case billingevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			be.ID = int(value.Int64)
+		case billingevent.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				be.Provider = value.String
+			}
+		case billingevent.FieldProviderEventID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_event_id", values[i])
+			} else if value.Valid {
+				be.ProviderEventID = value.String
+			}
+		case billingevent.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				be.Type = billingevent.Type(value.String)
+			}
+		case billingevent.FieldPlanKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field plan_key", values[i])
+			} else if value.Valid {
+				be.PlanKey = value.String
+			}
+		case billingevent.FieldProviderSubscriptionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_subscription_id", values[i])
+			} else if value.Valid {
+				be.ProviderSubscriptionID = value.String
+			}
+		case billingevent.FieldPeriodEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_end", values[i])
+			} else if value.Valid {
+				be.PeriodEnd = new(time.Time)
+				*be.PeriodEnd = value.Time
+			}
+		case billingevent.FieldPayload:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value.Valid {
+				be.Payload = value.String
+			}
+		case billingevent.FieldOccurredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field occurred_at", values[i])
+			} else if value.Valid {
+				be.OccurredAt = value.Time
+			}
+		case billingevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				be.CreatedAt = value.Time
+			}
+		case billingevent.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_billing_events", value)
+			} else if value.Valid {
+				be.user_billing_events = new(int)
+				*be.user_billing_events = int(value.Int64)
+			}
+		default:
+			be.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BillingEvent.
+// This includes values selected through modifiers, order, etc.
+func (be *BillingEvent) Value(name string) (ent.Value, error) {
+	return be.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the BillingEvent entity.
+func (be *BillingEvent) QueryUser() *UserQuery {
+	return NewBillingEventClient(be.config).QueryUser(be)
+}
+
+// Update returns a builder for updating this BillingEvent.
+// Note that you need to call BillingEvent.Unwrap() before calling this method if this BillingEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (be *BillingEvent) Update() *BillingEventUpdateOne {
+	return NewBillingEventClient(be.config).UpdateOne(be)
+}
+
+// Unwrap unwraps the BillingEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (be *BillingEvent) Unwrap() *BillingEvent {
+	_tx, ok := be.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BillingEvent is not a transactional entity")
+	}
+	be.config.driver = _tx.drv
+	return be
+}
+
+// String implements the fmt.Stringer.
+func (be *BillingEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("BillingEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", be.ID))
+	builder.WriteString("provider=")
+	builder.WriteString(be.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("provider_event_id=")
+	builder.WriteString(be.ProviderEventID)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", be.Type))
+	builder.WriteString(", ")
+	builder.WriteString("plan_key=")
+	builder.WriteString(be.PlanKey)
+	builder.WriteString(", ")
+	builder.WriteString("provider_subscription_id=")
+	builder.WriteString(be.ProviderSubscriptionID)
+	builder.WriteString(", ")
+	if v := be.PeriodEnd; v != nil {
+		builder.WriteString("period_end=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("payload=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("occurred_at=")
+	builder.WriteString(be.OccurredAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(be.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BillingEvents is a parsable slice of BillingEvent.
+type BillingEvents []*BillingEvent
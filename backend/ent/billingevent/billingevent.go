@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package billingevent
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the billingevent type in the database.
+	Label = "billing_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldProviderEventID holds the string denoting the provider_event_id field in the database.
+	FieldProviderEventID = "provider_event_id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldPlanKey holds the string denoting the plan_key field in the database.
+	FieldPlanKey = "plan_key"
+	// FieldProviderSubscriptionID holds the string denoting the provider_subscription_id field in the database.
+	FieldProviderSubscriptionID = "provider_subscription_id"
+	// FieldPeriodEnd holds the string denoting the period_end field in the database.
+	FieldPeriodEnd = "period_end"
+	// FieldPayload holds the string denoting the payload field in the database.
+	FieldPayload = "payload"
+	// FieldOccurredAt holds the string denoting the occurred_at field in the database.
+	FieldOccurredAt = "occurred_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the billingevent in the database.
+	Table = "billing_events"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "billing_events"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_billing_events"
+)
+
+// Columns holds all SQL columns for billingevent fields.
+var Columns = []string{
+	FieldID,
+	FieldProvider,
+	FieldProviderEventID,
+	FieldType,
+	FieldPlanKey,
+	FieldProviderSubscriptionID,
+	FieldPeriodEnd,
+	FieldPayload,
+	FieldOccurredAt,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "billing_events"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_billing_events",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	ProviderValidator func(string) error
+	// ProviderEventIDValidator is a validator for the "provider_event_id" field. It is called by the builders before save.
+	ProviderEventIDValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// Type values.
+const (
+	TypeSubscriptionCreated   Type = "subscription_created"
+	TypeSubscriptionRenewed   Type = "subscription_renewed"
+	TypeSubscriptionCancelled Type = "subscription_cancelled"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeSubscriptionCreated, TypeSubscriptionRenewed, TypeSubscriptionCancelled:
+		return nil
+	default:
+		return fmt.Errorf("billingevent: invalid enum value for type field: %q", _type)
+	}
+}
+
+// OrderOption defines the ordering options for the BillingEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// ByProviderEventID orders the results by the provider_event_id field.
+func ByProviderEventID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProviderEventID, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByPlanKey orders the results by the plan_key field.
+func ByPlanKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlanKey, opts...).ToFunc()
+}
+
+// ByProviderSubscriptionID orders the results by the provider_subscription_id field.
+func ByProviderSubscriptionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProviderSubscriptionID, opts...).ToFunc()
+}
+
+// ByPeriodEnd orders the results by the period_end field.
+func ByPeriodEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodEnd, opts...).ToFunc()
+}
+
+// ByPayload orders the results by the payload field.
+func ByPayload(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayload, opts...).ToFunc()
+}
+
+// ByOccurredAt orders the results by the occurred_at field.
+func ByOccurredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOccurredAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
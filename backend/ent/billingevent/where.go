@@ -0,0 +1,629 @@
+// Code generated by ent, DO NOT EDIT.
+
+package billingevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/matsuokashuhei/morrow-backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldLTE(FieldID, id))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderEventID applies equality check predicate on the "provider_event_id" field. It's identical to ProviderEventIDEQ.
+func ProviderEventID(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldEQ(FieldProviderEventID, v))
+}
+
+// PlanKey applies equality check predicate on the "plan_key" field. It's identical to PlanKeyEQ.
+func PlanKey(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldEQ(FieldPlanKey, v))
+}
+
+// ProviderSubscriptionID applies equality check predicate on the "provider_subscription_id" field. It's identical to ProviderSubscriptionIDEQ.
+func ProviderSubscriptionID(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldEQ(FieldProviderSubscriptionID, v))
+}
+
+// PeriodEnd applies equality check predicate on the "period_end" field. It's identical to PeriodEndEQ.
+func PeriodEnd(v time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// Payload applies equality check predicate on the "payload" field. It's identical to PayloadEQ.
+func Payload(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldEQ(FieldPayload, v))
+}
+
+// OccurredAt applies equality check predicate on the "occurred_at" field. It's identical to OccurredAtEQ.
+func OccurredAt(v time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldEQ(FieldOccurredAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// ProviderEventIDEQ applies the EQ predicate on the "provider_event_id" field.
+func ProviderEventIDEQ(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldEQ(FieldProviderEventID, v))
+}
+
+// ProviderEventIDNEQ applies the NEQ predicate on the "provider_event_id" field.
+func ProviderEventIDNEQ(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldNEQ(FieldProviderEventID, v))
+}
+
+// ProviderEventIDIn applies the In predicate on the "provider_event_id" field.
+func ProviderEventIDIn(vs ...string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldIn(FieldProviderEventID, vs...))
+}
+
+// ProviderEventIDNotIn applies the NotIn predicate on the "provider_event_id" field.
+func ProviderEventIDNotIn(vs ...string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldNotIn(FieldProviderEventID, vs...))
+}
+
+// ProviderEventIDGT applies the GT predicate on the "provider_event_id" field.
+func ProviderEventIDGT(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldGT(FieldProviderEventID, v))
+}
+
+// ProviderEventIDGTE applies the GTE predicate on the "provider_event_id" field.
+func ProviderEventIDGTE(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldGTE(FieldProviderEventID, v))
+}
+
+// ProviderEventIDLT applies the LT predicate on the "provider_event_id" field.
+func ProviderEventIDLT(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldLT(FieldProviderEventID, v))
+}
+
+// ProviderEventIDLTE applies the LTE predicate on the "provider_event_id" field.
+func ProviderEventIDLTE(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldLTE(FieldProviderEventID, v))
+}
+
+// ProviderEventIDContains applies the Contains predicate on the "provider_event_id" field.
+func ProviderEventIDContains(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldContains(FieldProviderEventID, v))
+}
+
+// ProviderEventIDHasPrefix applies the HasPrefix predicate on the "provider_event_id" field.
+func ProviderEventIDHasPrefix(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldHasPrefix(FieldProviderEventID, v))
+}
+
+// ProviderEventIDHasSuffix applies the HasSuffix predicate on the "provider_event_id" field.
+func ProviderEventIDHasSuffix(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldHasSuffix(FieldProviderEventID, v))
+}
+
+// ProviderEventIDEqualFold applies the EqualFold predicate on the "provider_event_id" field.
+func ProviderEventIDEqualFold(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldEqualFold(FieldProviderEventID, v))
+}
+
+// ProviderEventIDContainsFold applies the ContainsFold predicate on the "provider_event_id" field.
+func ProviderEventIDContainsFold(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldContainsFold(FieldProviderEventID, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldNotIn(FieldType, vs...))
+}
+
+// PlanKeyEQ applies the EQ predicate on the "plan_key" field.
+func PlanKeyEQ(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldEQ(FieldPlanKey, v))
+}
+
+// PlanKeyNEQ applies the NEQ predicate on the "plan_key" field.
+func PlanKeyNEQ(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldNEQ(FieldPlanKey, v))
+}
+
+// PlanKeyIn applies the In predicate on the "plan_key" field.
+func PlanKeyIn(vs ...string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldIn(FieldPlanKey, vs...))
+}
+
+// PlanKeyNotIn applies the NotIn predicate on the "plan_key" field.
+func PlanKeyNotIn(vs ...string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldNotIn(FieldPlanKey, vs...))
+}
+
+// PlanKeyGT applies the GT predicate on the "plan_key" field.
+func PlanKeyGT(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldGT(FieldPlanKey, v))
+}
+
+// PlanKeyGTE applies the GTE predicate on the "plan_key" field.
+func PlanKeyGTE(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldGTE(FieldPlanKey, v))
+}
+
+// PlanKeyLT applies the LT predicate on the "plan_key" field.
+func PlanKeyLT(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldLT(FieldPlanKey, v))
+}
+
+// PlanKeyLTE applies the LTE predicate on the "plan_key" field.
+func PlanKeyLTE(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldLTE(FieldPlanKey, v))
+}
+
+// PlanKeyContains applies the Contains predicate on the "plan_key" field.
+func PlanKeyContains(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldContains(FieldPlanKey, v))
+}
+
+// PlanKeyHasPrefix applies the HasPrefix predicate on the "plan_key" field.
+func PlanKeyHasPrefix(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldHasPrefix(FieldPlanKey, v))
+}
+
+// PlanKeyHasSuffix applies the HasSuffix predicate on the "plan_key" field.
+func PlanKeyHasSuffix(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldHasSuffix(FieldPlanKey, v))
+}
+
+// PlanKeyIsNil applies the IsNil predicate on the "plan_key" field.
+func PlanKeyIsNil() predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldIsNull(FieldPlanKey))
+}
+
+// PlanKeyNotNil applies the NotNil predicate on the "plan_key" field.
+func PlanKeyNotNil() predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldNotNull(FieldPlanKey))
+}
+
+// PlanKeyEqualFold applies the EqualFold predicate on the "plan_key" field.
+func PlanKeyEqualFold(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldEqualFold(FieldPlanKey, v))
+}
+
+// PlanKeyContainsFold applies the ContainsFold predicate on the "plan_key" field.
+func PlanKeyContainsFold(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldContainsFold(FieldPlanKey, v))
+}
+
+// ProviderSubscriptionIDEQ applies the EQ predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDEQ(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldEQ(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDNEQ applies the NEQ predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDNEQ(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldNEQ(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDIn applies the In predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDIn(vs ...string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldIn(FieldProviderSubscriptionID, vs...))
+}
+
+// ProviderSubscriptionIDNotIn applies the NotIn predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDNotIn(vs ...string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldNotIn(FieldProviderSubscriptionID, vs...))
+}
+
+// ProviderSubscriptionIDGT applies the GT predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDGT(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldGT(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDGTE applies the GTE predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDGTE(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldGTE(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDLT applies the LT predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDLT(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldLT(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDLTE applies the LTE predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDLTE(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldLTE(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDContains applies the Contains predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDContains(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldContains(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDHasPrefix applies the HasPrefix predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDHasPrefix(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldHasPrefix(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDHasSuffix applies the HasSuffix predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDHasSuffix(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldHasSuffix(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDIsNil applies the IsNil predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDIsNil() predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldIsNull(FieldProviderSubscriptionID))
+}
+
+// ProviderSubscriptionIDNotNil applies the NotNil predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDNotNil() predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldNotNull(FieldProviderSubscriptionID))
+}
+
+// ProviderSubscriptionIDEqualFold applies the EqualFold predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDEqualFold(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldEqualFold(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDContainsFold applies the ContainsFold predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDContainsFold(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldContainsFold(FieldProviderSubscriptionID, v))
+}
+
+// PeriodEndEQ applies the EQ predicate on the "period_end" field.
+func PeriodEndEQ(v time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndNEQ applies the NEQ predicate on the "period_end" field.
+func PeriodEndNEQ(v time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldNEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndIn applies the In predicate on the "period_end" field.
+func PeriodEndIn(vs ...time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndNotIn applies the NotIn predicate on the "period_end" field.
+func PeriodEndNotIn(vs ...time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldNotIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndGT applies the GT predicate on the "period_end" field.
+func PeriodEndGT(v time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldGT(FieldPeriodEnd, v))
+}
+
+// PeriodEndGTE applies the GTE predicate on the "period_end" field.
+func PeriodEndGTE(v time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldGTE(FieldPeriodEnd, v))
+}
+
+// PeriodEndLT applies the LT predicate on the "period_end" field.
+func PeriodEndLT(v time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldLT(FieldPeriodEnd, v))
+}
+
+// PeriodEndLTE applies the LTE predicate on the "period_end" field.
+func PeriodEndLTE(v time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldLTE(FieldPeriodEnd, v))
+}
+
+// PeriodEndIsNil applies the IsNil predicate on the "period_end" field.
+func PeriodEndIsNil() predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldIsNull(FieldPeriodEnd))
+}
+
+// PeriodEndNotNil applies the NotNil predicate on the "period_end" field.
+func PeriodEndNotNil() predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldNotNull(FieldPeriodEnd))
+}
+
+// PayloadEQ applies the EQ predicate on the "payload" field.
+func PayloadEQ(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldEQ(FieldPayload, v))
+}
+
+// PayloadNEQ applies the NEQ predicate on the "payload" field.
+func PayloadNEQ(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldNEQ(FieldPayload, v))
+}
+
+// PayloadIn applies the In predicate on the "payload" field.
+func PayloadIn(vs ...string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldIn(FieldPayload, vs...))
+}
+
+// PayloadNotIn applies the NotIn predicate on the "payload" field.
+func PayloadNotIn(vs ...string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldNotIn(FieldPayload, vs...))
+}
+
+// PayloadGT applies the GT predicate on the "payload" field.
+func PayloadGT(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldGT(FieldPayload, v))
+}
+
+// PayloadGTE applies the GTE predicate on the "payload" field.
+func PayloadGTE(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldGTE(FieldPayload, v))
+}
+
+// PayloadLT applies the LT predicate on the "payload" field.
+func PayloadLT(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldLT(FieldPayload, v))
+}
+
+// PayloadLTE applies the LTE predicate on the "payload" field.
+func PayloadLTE(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldLTE(FieldPayload, v))
+}
+
+// PayloadContains applies the Contains predicate on the "payload" field.
+func PayloadContains(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldContains(FieldPayload, v))
+}
+
+// PayloadHasPrefix applies the HasPrefix predicate on the "payload" field.
+func PayloadHasPrefix(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldHasPrefix(FieldPayload, v))
+}
+
+// PayloadHasSuffix applies the HasSuffix predicate on the "payload" field.
+func PayloadHasSuffix(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldHasSuffix(FieldPayload, v))
+}
+
+// PayloadEqualFold applies the EqualFold predicate on the "payload" field.
+func PayloadEqualFold(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldEqualFold(FieldPayload, v))
+}
+
+// PayloadContainsFold applies the ContainsFold predicate on the "payload" field.
+func PayloadContainsFold(v string) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldContainsFold(FieldPayload, v))
+}
+
+// OccurredAtEQ applies the EQ predicate on the "occurred_at" field.
+func OccurredAtEQ(v time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldEQ(FieldOccurredAt, v))
+}
+
+// OccurredAtNEQ applies the NEQ predicate on the "occurred_at" field.
+func OccurredAtNEQ(v time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldNEQ(FieldOccurredAt, v))
+}
+
+// OccurredAtIn applies the In predicate on the "occurred_at" field.
+func OccurredAtIn(vs ...time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldIn(FieldOccurredAt, vs...))
+}
+
+// OccurredAtNotIn applies the NotIn predicate on the "occurred_at" field.
+func OccurredAtNotIn(vs ...time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldNotIn(FieldOccurredAt, vs...))
+}
+
+// OccurredAtGT applies the GT predicate on the "occurred_at" field.
+func OccurredAtGT(v time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldGT(FieldOccurredAt, v))
+}
+
+// OccurredAtGTE applies the GTE predicate on the "occurred_at" field.
+func OccurredAtGTE(v time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldGTE(FieldOccurredAt, v))
+}
+
+// OccurredAtLT applies the LT predicate on the "occurred_at" field.
+func OccurredAtLT(v time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldLT(FieldOccurredAt, v))
+}
+
+// OccurredAtLTE applies the LTE predicate on the "occurred_at" field.
+func OccurredAtLTE(v time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldLTE(FieldOccurredAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.BillingEvent {
+	return predicate.BillingEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.BillingEvent {
+	return predicate.BillingEvent(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BillingEvent) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BillingEvent) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BillingEvent) predicate.BillingEvent {
+	return predicate.BillingEvent(sql.NotPredicates(p))
+}
@@ -0,0 +1,373 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/matsuokashuhei/morrow-backend/ent/billingevent"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+)
+
+// BillingEventCreate is the builder for creating a BillingEvent entity.
+type BillingEventCreate struct {
+	config
+	mutation *BillingEventMutation
+	hooks    []Hook
+}
+
+// SetProvider sets the "provider" field.
+func (bec *BillingEventCreate) SetProvider(s string) *BillingEventCreate {
+	bec.mutation.SetProvider(s)
+	return bec
+}
+
+// SetProviderEventID sets the "provider_event_id" field.
+func (bec *BillingEventCreate) SetProviderEventID(s string) *BillingEventCreate {
+	bec.mutation.SetProviderEventID(s)
+	return bec
+}
+
+// SetType sets the "type" field.
+func (bec *BillingEventCreate) SetType(b billingevent.Type) *BillingEventCreate {
+	bec.mutation.SetType(b)
+	return bec
+}
+
+// SetPlanKey sets the "plan_key" field.
+func (bec *BillingEventCreate) SetPlanKey(s string) *BillingEventCreate {
+	bec.mutation.SetPlanKey(s)
+	return bec
+}
+
+// SetNillablePlanKey sets the "plan_key" field if the given value is not nil.
+func (bec *BillingEventCreate) SetNillablePlanKey(s *string) *BillingEventCreate {
+	if s != nil {
+		bec.SetPlanKey(*s)
+	}
+	return bec
+}
+
+// SetProviderSubscriptionID sets the "provider_subscription_id" field.
+func (bec *BillingEventCreate) SetProviderSubscriptionID(s string) *BillingEventCreate {
+	bec.mutation.SetProviderSubscriptionID(s)
+	return bec
+}
+
+// SetNillableProviderSubscriptionID sets the "provider_subscription_id" field if the given value is not nil.
+func (bec *BillingEventCreate) SetNillableProviderSubscriptionID(s *string) *BillingEventCreate {
+	if s != nil {
+		bec.SetProviderSubscriptionID(*s)
+	}
+	return bec
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (bec *BillingEventCreate) SetPeriodEnd(t time.Time) *BillingEventCreate {
+	bec.mutation.SetPeriodEnd(t)
+	return bec
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (bec *BillingEventCreate) SetNillablePeriodEnd(t *time.Time) *BillingEventCreate {
+	if t != nil {
+		bec.SetPeriodEnd(*t)
+	}
+	return bec
+}
+
+// SetPayload sets the "payload" field.
+func (bec *BillingEventCreate) SetPayload(s string) *BillingEventCreate {
+	bec.mutation.SetPayload(s)
+	return bec
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (bec *BillingEventCreate) SetOccurredAt(t time.Time) *BillingEventCreate {
+	bec.mutation.SetOccurredAt(t)
+	return bec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bec *BillingEventCreate) SetCreatedAt(t time.Time) *BillingEventCreate {
+	bec.mutation.SetCreatedAt(t)
+	return bec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bec *BillingEventCreate) SetNillableCreatedAt(t *time.Time) *BillingEventCreate {
+	if t != nil {
+		bec.SetCreatedAt(*t)
+	}
+	return bec
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (bec *BillingEventCreate) SetUserID(id int) *BillingEventCreate {
+	bec.mutation.SetUserID(id)
+	return bec
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (bec *BillingEventCreate) SetNillableUserID(id *int) *BillingEventCreate {
+	if id != nil {
+		bec = bec.SetUserID(*id)
+	}
+	return bec
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (bec *BillingEventCreate) SetUser(u *User) *BillingEventCreate {
+	return bec.SetUserID(u.ID)
+}
+
+// Mutation returns the BillingEventMutation object of the builder.
+func (bec *BillingEventCreate) Mutation() *BillingEventMutation {
+	return bec.mutation
+}
+
+// Save creates the BillingEvent in the database.
+func (bec *BillingEventCreate) Save(ctx context.Context) (*BillingEvent, error) {
+	bec.defaults()
+	return withHooks(ctx, bec.sqlSave, bec.mutation, bec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bec *BillingEventCreate) SaveX(ctx context.Context) *BillingEvent {
+	v, err := bec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bec *BillingEventCreate) Exec(ctx context.Context) error {
+	_, err := bec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bec *BillingEventCreate) ExecX(ctx context.Context) {
+	if err := bec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bec *BillingEventCreate) defaults() {
+	if _, ok := bec.mutation.CreatedAt(); !ok {
+		v := billingevent.DefaultCreatedAt()
+		bec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bec *BillingEventCreate) check() error {
+	if _, ok := bec.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "BillingEvent.provider"`)}
+	}
+	if v, ok := bec.mutation.Provider(); ok {
+		if err := billingevent.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "BillingEvent.provider": %w`, err)}
+		}
+	}
+	if _, ok := bec.mutation.ProviderEventID(); !ok {
+		return &ValidationError{Name: "provider_event_id", err: errors.New(`ent: missing required field "BillingEvent.provider_event_id"`)}
+	}
+	if v, ok := bec.mutation.ProviderEventID(); ok {
+		if err := billingevent.ProviderEventIDValidator(v); err != nil {
+			return &ValidationError{Name: "provider_event_id", err: fmt.Errorf(`ent: validator failed for field "BillingEvent.provider_event_id": %w`, err)}
+		}
+	}
+	if _, ok := bec.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "BillingEvent.type"`)}
+	}
+	if v, ok := bec.mutation.GetType(); ok {
+		if err := billingevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "BillingEvent.type": %w`, err)}
+		}
+	}
+	if _, ok := bec.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "BillingEvent.payload"`)}
+	}
+	if _, ok := bec.mutation.OccurredAt(); !ok {
+		return &ValidationError{Name: "occurred_at", err: errors.New(`ent: missing required field "BillingEvent.occurred_at"`)}
+	}
+	if _, ok := bec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BillingEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (bec *BillingEventCreate) sqlSave(ctx context.Context) (*BillingEvent, error) {
+	if err := bec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	bec.mutation.id = &_node.ID
+	bec.mutation.done = true
+	return _node, nil
+}
+
+func (bec *BillingEventCreate) createSpec() (*BillingEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BillingEvent{config: bec.config}
+		_spec = sqlgraph.NewCreateSpec(billingevent.Table, sqlgraph.NewFieldSpec(billingevent.FieldID, field.TypeInt))
+	)
+	if value, ok := bec.mutation.Provider(); ok {
+		_spec.SetField(billingevent.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := bec.mutation.ProviderEventID(); ok {
+		_spec.SetField(billingevent.FieldProviderEventID, field.TypeString, value)
+		_node.ProviderEventID = value
+	}
+	if value, ok := bec.mutation.GetType(); ok {
+		_spec.SetField(billingevent.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := bec.mutation.PlanKey(); ok {
+		_spec.SetField(billingevent.FieldPlanKey, field.TypeString, value)
+		_node.PlanKey = value
+	}
+	if value, ok := bec.mutation.ProviderSubscriptionID(); ok {
+		_spec.SetField(billingevent.FieldProviderSubscriptionID, field.TypeString, value)
+		_node.ProviderSubscriptionID = value
+	}
+	if value, ok := bec.mutation.PeriodEnd(); ok {
+		_spec.SetField(billingevent.FieldPeriodEnd, field.TypeTime, value)
+		_node.PeriodEnd = &value
+	}
+	if value, ok := bec.mutation.Payload(); ok {
+		_spec.SetField(billingevent.FieldPayload, field.TypeString, value)
+		_node.Payload = value
+	}
+	if value, ok := bec.mutation.OccurredAt(); ok {
+		_spec.SetField(billingevent.FieldOccurredAt, field.TypeTime, value)
+		_node.OccurredAt = value
+	}
+	if value, ok := bec.mutation.CreatedAt(); ok {
+		_spec.SetField(billingevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := bec.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   billingevent.UserTable,
+			Columns: []string{billingevent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_billing_events = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BillingEventCreateBulk is the builder for creating many BillingEvent entities in bulk.
+type BillingEventCreateBulk struct {
+	config
+	err      error
+	builders []*BillingEventCreate
+}
+
+// Save creates the BillingEvent entities in the database.
+func (becb *BillingEventCreateBulk) Save(ctx context.Context) ([]*BillingEvent, error) {
+	if becb.err != nil {
+		return nil, becb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(becb.builders))
+	nodes := make([]*BillingEvent, len(becb.builders))
+	mutators := make([]Mutator, len(becb.builders))
+	for i := range becb.builders {
+		func(i int, root context.Context) {
+			builder := becb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BillingEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, becb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, becb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, becb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (becb *BillingEventCreateBulk) SaveX(ctx context.Context) []*BillingEvent {
+	v, err := becb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (becb *BillingEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := becb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (becb *BillingEventCreateBulk) ExecX(ctx context.Context) {
+	if err := becb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/matsuokashuhei/morrow-backend/ent/billingevent"
+	"github.com/matsuokashuhei/morrow-backend/ent/predicate"
+)
+
+// BillingEventDelete is the builder for deleting a BillingEvent entity.
+type BillingEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *BillingEventMutation
+}
+
+// Where appends a list predicates to the BillingEventDelete builder.
+func (bed *BillingEventDelete) Where(ps ...predicate.BillingEvent) *BillingEventDelete {
+	bed.mutation.Where(ps...)
+	return bed
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (bed *BillingEventDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, bed.sqlExec, bed.mutation, bed.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bed *BillingEventDelete) ExecX(ctx context.Context) int {
+	n, err := bed.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (bed *BillingEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(billingevent.Table, sqlgraph.NewFieldSpec(billingevent.FieldID, field.TypeInt))
+	if ps := bed.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, bed.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	bed.mutation.done = true
+	return affected, err
+}
+
+// BillingEventDeleteOne is the builder for deleting a single BillingEvent entity.
+type BillingEventDeleteOne struct {
+	bed *BillingEventDelete
+}
+
+// Where appends a list predicates to the BillingEventDelete builder.
+func (bedo *BillingEventDeleteOne) Where(ps ...predicate.BillingEvent) *BillingEventDeleteOne {
+	bedo.bed.mutation.Where(ps...)
+	return bedo
+}
+
+// Exec executes the deletion query.
+func (bedo *BillingEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := bedo.bed.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{billingevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bedo *BillingEventDeleteOne) ExecX(ctx context.Context) {
+	if err := bedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
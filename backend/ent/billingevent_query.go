@@ -0,0 +1,614 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/matsuokashuhei/morrow-backend/ent/billingevent"
+	"github.com/matsuokashuhei/morrow-backend/ent/predicate"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+)
+
+// BillingEventQuery is the builder for querying BillingEvent entities.
+type BillingEventQuery struct {
+	config
+	ctx        *QueryContext
+	order      []billingevent.OrderOption
+	inters     []Interceptor
+	predicates []predicate.BillingEvent
+	withUser   *UserQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BillingEventQuery builder.
+func (beq *BillingEventQuery) Where(ps ...predicate.BillingEvent) *BillingEventQuery {
+	beq.predicates = append(beq.predicates, ps...)
+	return beq
+}
+
+// Limit the number of records to be returned by this query.
+func (beq *BillingEventQuery) Limit(limit int) *BillingEventQuery {
+	beq.ctx.Limit = &limit
+	return beq
+}
+
+// Offset to start from.
+func (beq *BillingEventQuery) Offset(offset int) *BillingEventQuery {
+	beq.ctx.Offset = &offset
+	return beq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (beq *BillingEventQuery) Unique(unique bool) *BillingEventQuery {
+	beq.ctx.Unique = &unique
+	return beq
+}
+
+// Order specifies how the records should be ordered.
+func (beq *BillingEventQuery) Order(o ...billingevent.OrderOption) *BillingEventQuery {
+	beq.order = append(beq.order, o...)
+	return beq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (beq *BillingEventQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: beq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := beq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := beq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(billingevent.Table, billingevent.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, billingevent.UserTable, billingevent.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(beq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first BillingEvent entity from the query.
+// Returns a *NotFoundError when no BillingEvent was found.
+func (beq *BillingEventQuery) First(ctx context.Context) (*BillingEvent, error) {
+	nodes, err := beq.Limit(1).All(setContextOp(ctx, beq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{billingevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (beq *BillingEventQuery) FirstX(ctx context.Context) *BillingEvent {
+	node, err := beq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BillingEvent ID from the query.
+// Returns a *NotFoundError when no BillingEvent ID was found.
+func (beq *BillingEventQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = beq.Limit(1).IDs(setContextOp(ctx, beq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{billingevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (beq *BillingEventQuery) FirstIDX(ctx context.Context) int {
+	id, err := beq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BillingEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BillingEvent entity is found.
+// Returns a *NotFoundError when no BillingEvent entities are found.
+func (beq *BillingEventQuery) Only(ctx context.Context) (*BillingEvent, error) {
+	nodes, err := beq.Limit(2).All(setContextOp(ctx, beq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{billingevent.Label}
+	default:
+		return nil, &NotSingularError{billingevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (beq *BillingEventQuery) OnlyX(ctx context.Context) *BillingEvent {
+	node, err := beq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BillingEvent ID in the query.
+// Returns a *NotSingularError when more than one BillingEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (beq *BillingEventQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = beq.Limit(2).IDs(setContextOp(ctx, beq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{billingevent.Label}
+	default:
+		err = &NotSingularError{billingevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (beq *BillingEventQuery) OnlyIDX(ctx context.Context) int {
+	id, err := beq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BillingEvents.
+func (beq *BillingEventQuery) All(ctx context.Context) ([]*BillingEvent, error) {
+	ctx = setContextOp(ctx, beq.ctx, ent.OpQueryAll)
+	if err := beq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BillingEvent, *BillingEventQuery]()
+	return withInterceptors[[]*BillingEvent](ctx, beq, qr, beq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (beq *BillingEventQuery) AllX(ctx context.Context) []*BillingEvent {
+	nodes, err := beq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BillingEvent IDs.
+func (beq *BillingEventQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if beq.ctx.Unique == nil && beq.path != nil {
+		beq.Unique(true)
+	}
+	ctx = setContextOp(ctx, beq.ctx, ent.OpQueryIDs)
+	if err = beq.Select(billingevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (beq *BillingEventQuery) IDsX(ctx context.Context) []int {
+	ids, err := beq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (beq *BillingEventQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, beq.ctx, ent.OpQueryCount)
+	if err := beq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, beq, querierCount[*BillingEventQuery](), beq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (beq *BillingEventQuery) CountX(ctx context.Context) int {
+	count, err := beq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (beq *BillingEventQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, beq.ctx, ent.OpQueryExist)
+	switch _, err := beq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (beq *BillingEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := beq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BillingEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (beq *BillingEventQuery) Clone() *BillingEventQuery {
+	if beq == nil {
+		return nil
+	}
+	return &BillingEventQuery{
+		config:     beq.config,
+		ctx:        beq.ctx.Clone(),
+		order:      append([]billingevent.OrderOption{}, beq.order...),
+		inters:     append([]Interceptor{}, beq.inters...),
+		predicates: append([]predicate.BillingEvent{}, beq.predicates...),
+		withUser:   beq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  beq.sql.Clone(),
+		path: beq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (beq *BillingEventQuery) WithUser(opts ...func(*UserQuery)) *BillingEventQuery {
+	query := (&UserClient{config: beq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	beq.withUser = query
+	return beq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Provider string `json:"provider,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BillingEvent.Query().
+//		GroupBy(billingevent.FieldProvider).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (beq *BillingEventQuery) GroupBy(field string, fields ...string) *BillingEventGroupBy {
+	beq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BillingEventGroupBy{build: beq}
+	grbuild.flds = &beq.ctx.Fields
+	grbuild.label = billingevent.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Provider string `json:"provider,omitempty"`
+//	}
+//
+//	client.BillingEvent.Query().
+//		Select(billingevent.FieldProvider).
+//		Scan(ctx, &v)
+func (beq *BillingEventQuery) Select(fields ...string) *BillingEventSelect {
+	beq.ctx.Fields = append(beq.ctx.Fields, fields...)
+	sbuild := &BillingEventSelect{BillingEventQuery: beq}
+	sbuild.label = billingevent.Label
+	sbuild.flds, sbuild.scan = &beq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BillingEventSelect configured with the given aggregations.
+func (beq *BillingEventQuery) Aggregate(fns ...AggregateFunc) *BillingEventSelect {
+	return beq.Select().Aggregate(fns...)
+}
+
+func (beq *BillingEventQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range beq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, beq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range beq.ctx.Fields {
+		if !billingevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if beq.path != nil {
+		prev, err := beq.path(ctx)
+		if err != nil {
+			return err
+		}
+		beq.sql = prev
+	}
+	return nil
+}
+
+func (beq *BillingEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BillingEvent, error) {
+	var (
+		nodes       = []*BillingEvent{}
+		withFKs     = beq.withFKs
+		_spec       = beq.querySpec()
+		loadedTypes = [1]bool{
+			beq.withUser != nil,
+		}
+	)
+	if beq.withUser != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, billingevent.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BillingEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BillingEvent{config: beq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, beq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := beq.withUser; query != nil {
+		if err := beq.loadUser(ctx, query, nodes, nil,
+			func(n *BillingEvent, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (beq *BillingEventQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*BillingEvent, init func(*BillingEvent), assign func(*BillingEvent, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*BillingEvent)
+	for i := range nodes {
+		if nodes[i].user_billing_events == nil {
+			continue
+		}
+		fk := *nodes[i].user_billing_events
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_billing_events" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (beq *BillingEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := beq.querySpec()
+	_spec.Node.Columns = beq.ctx.Fields
+	if len(beq.ctx.Fields) > 0 {
+		_spec.Unique = beq.ctx.Unique != nil && *beq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, beq.driver, _spec)
+}
+
+func (beq *BillingEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(billingevent.Table, billingevent.Columns, sqlgraph.NewFieldSpec(billingevent.FieldID, field.TypeInt))
+	_spec.From = beq.sql
+	if unique := beq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if beq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := beq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, billingevent.FieldID)
+		for i := range fields {
+			if fields[i] != billingevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := beq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := beq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := beq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := beq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (beq *BillingEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(beq.driver.Dialect())
+	t1 := builder.Table(billingevent.Table)
+	columns := beq.ctx.Fields
+	if len(columns) == 0 {
+		columns = billingevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if beq.sql != nil {
+		selector = beq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if beq.ctx.Unique != nil && *beq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range beq.predicates {
+		p(selector)
+	}
+	for _, p := range beq.order {
+		p(selector)
+	}
+	if offset := beq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := beq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BillingEventGroupBy is the group-by builder for BillingEvent entities.
+type BillingEventGroupBy struct {
+	selector
+	build *BillingEventQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (begb *BillingEventGroupBy) Aggregate(fns ...AggregateFunc) *BillingEventGroupBy {
+	begb.fns = append(begb.fns, fns...)
+	return begb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (begb *BillingEventGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, begb.build.ctx, ent.OpQueryGroupBy)
+	if err := begb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BillingEventQuery, *BillingEventGroupBy](ctx, begb.build, begb, begb.build.inters, v)
+}
+
+func (begb *BillingEventGroupBy) sqlScan(ctx context.Context, root *BillingEventQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(begb.fns))
+	for _, fn := range begb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*begb.flds)+len(begb.fns))
+		for _, f := range *begb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*begb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := begb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BillingEventSelect is the builder for selecting fields of BillingEvent entities.
+type BillingEventSelect struct {
+	*BillingEventQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (bes *BillingEventSelect) Aggregate(fns ...AggregateFunc) *BillingEventSelect {
+	bes.fns = append(bes.fns, fns...)
+	return bes
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bes *BillingEventSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, bes.ctx, ent.OpQuerySelect)
+	if err := bes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BillingEventQuery, *BillingEventSelect](ctx, bes.BillingEventQuery, bes, bes.inters, v)
+}
+
+func (bes *BillingEventSelect) sqlScan(ctx context.Context, root *BillingEventQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(bes.fns))
+	for _, fn := range bes.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*bes.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
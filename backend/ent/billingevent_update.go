@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/matsuokashuhei/morrow-backend/ent/billingevent"
+	"github.com/matsuokashuhei/morrow-backend/ent/predicate"
+)
+
+// BillingEventUpdate is the builder for updating BillingEvent entities.
+type BillingEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BillingEventMutation
+}
+
+// Where appends a list predicates to the BillingEventUpdate builder.
+func (beu *BillingEventUpdate) Where(ps ...predicate.BillingEvent) *BillingEventUpdate {
+	beu.mutation.Where(ps...)
+	return beu
+}
+
+// Mutation returns the BillingEventMutation object of the builder.
+func (beu *BillingEventUpdate) Mutation() *BillingEventMutation {
+	return beu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (beu *BillingEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, beu.sqlSave, beu.mutation, beu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (beu *BillingEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := beu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (beu *BillingEventUpdate) Exec(ctx context.Context) error {
+	_, err := beu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (beu *BillingEventUpdate) ExecX(ctx context.Context) {
+	if err := beu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (beu *BillingEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(billingevent.Table, billingevent.Columns, sqlgraph.NewFieldSpec(billingevent.FieldID, field.TypeInt))
+	if ps := beu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if beu.mutation.PlanKeyCleared() {
+		_spec.ClearField(billingevent.FieldPlanKey, field.TypeString)
+	}
+	if beu.mutation.ProviderSubscriptionIDCleared() {
+		_spec.ClearField(billingevent.FieldProviderSubscriptionID, field.TypeString)
+	}
+	if beu.mutation.PeriodEndCleared() {
+		_spec.ClearField(billingevent.FieldPeriodEnd, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, beu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{billingevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	beu.mutation.done = true
+	return n, nil
+}
+
+// BillingEventUpdateOne is the builder for updating a single BillingEvent entity.
+type BillingEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BillingEventMutation
+}
+
+// Mutation returns the BillingEventMutation object of the builder.
+func (beuo *BillingEventUpdateOne) Mutation() *BillingEventMutation {
+	return beuo.mutation
+}
+
+// Where appends a list predicates to the BillingEventUpdate builder.
+func (beuo *BillingEventUpdateOne) Where(ps ...predicate.BillingEvent) *BillingEventUpdateOne {
+	beuo.mutation.Where(ps...)
+	return beuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (beuo *BillingEventUpdateOne) Select(field string, fields ...string) *BillingEventUpdateOne {
+	beuo.fields = append([]string{field}, fields...)
+	return beuo
+}
+
+// Save executes the query and returns the updated BillingEvent entity.
+func (beuo *BillingEventUpdateOne) Save(ctx context.Context) (*BillingEvent, error) {
+	return withHooks(ctx, beuo.sqlSave, beuo.mutation, beuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (beuo *BillingEventUpdateOne) SaveX(ctx context.Context) *BillingEvent {
+	node, err := beuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (beuo *BillingEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := beuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (beuo *BillingEventUpdateOne) ExecX(ctx context.Context) {
+	if err := beuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (beuo *BillingEventUpdateOne) sqlSave(ctx context.Context) (_node *BillingEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(billingevent.Table, billingevent.Columns, sqlgraph.NewFieldSpec(billingevent.FieldID, field.TypeInt))
+	id, ok := beuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BillingEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := beuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, billingevent.FieldID)
+		for _, f := range fields {
+			if !billingevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != billingevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := beuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if beuo.mutation.PlanKeyCleared() {
+		_spec.ClearField(billingevent.FieldPlanKey, field.TypeString)
+	}
+	if beuo.mutation.ProviderSubscriptionIDCleared() {
+		_spec.ClearField(billingevent.FieldProviderSubscriptionID, field.TypeString)
+	}
+	if beuo.mutation.PeriodEndCleared() {
+		_spec.ClearField(billingevent.FieldPeriodEnd, field.TypeTime)
+	}
+	_node = &BillingEvent{config: beuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, beuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{billingevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	beuo.mutation.done = true
+	return _node, nil
+}
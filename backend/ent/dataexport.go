@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/matsuokashuhei/morrow-backend/ent/dataexport"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+)
+
+// DataExport is the model entity for the DataExport schema.
+type DataExport struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 処理状態 (pending: 待機中, processing: 作成中, ready: ダウンロード可能, failed: 失敗, expired: 期限切れで削除済み)
+	Status dataexport.Status `json:"status,omitempty"`
+	// 作成したzipのオブジェクトキー
+	Key string `json:"key,omitempty"`
+	// zipのサイズ (バイト)
+	Size int64 `json:"size,omitempty"`
+	// 失敗した理由
+	Error string `json:"error,omitempty"`
+	// 依頼日時
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ワーカーが作成を始めた日時 (作成中のまま止まったエクスポートを見つけるため)
+	ClaimedAt *time.Time `json:"claimed_at,omitempty"`
+	// 作成が完了した日時
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// zipを削除してダウンロードできなくなる日時
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DataExportQuery when eager-loading is set.
+	Edges             DataExportEdges `json:"edges"`
+	user_data_exports *int
+	selectValues      sql.SelectValues
+}
+
+// DataExportEdges holds the relations/edges for other nodes in the graph.
+type DataExportEdges struct {
+	// 依頼したユーザー
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DataExportEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DataExport) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case dataexport.FieldID, dataexport.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case dataexport.FieldStatus, dataexport.FieldKey, dataexport.FieldError:
+			values[i] = new(sql.NullString)
+		case dataexport.FieldCreatedAt, dataexport.FieldClaimedAt, dataexport.FieldCompletedAt, dataexport.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case dataexport.ForeignKeys[0]: // user_data_exports
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DataExport fields.
+func (de *DataExport) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case dataexport.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			de.ID = int(value.Int64)
+		case dataexport.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				de.Status = dataexport.Status(value.String)
+			}
+		case dataexport.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				de.Key = value.String
+			}
+		case dataexport.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				de.Size = value.Int64
+			}
+		case dataexport.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				de.Error = value.String
+			}
+		case dataexport.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				de.CreatedAt = value.Time
+			}
+		case dataexport.FieldClaimedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field claimed_at", values[i])
+			} else if value.Valid {
+				de.ClaimedAt = new(time.Time)
+				*de.ClaimedAt = value.Time
+			}
+		case dataexport.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				de.CompletedAt = new(time.Time)
+				*de.CompletedAt = value.Time
+			}
+		case dataexport.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				de.ExpiresAt = new(time.Time)
+				*de.ExpiresAt = value.Time
+			}
+		case dataexport.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_data_exports", value)
+			} else if value.Valid {
+				de.user_data_exports = new(int)
+				*de.user_data_exports = int(value.Int64)
+			}
+		default:
+			de.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DataExport.
+// This includes values selected through modifiers, order, etc.
+func (de *DataExport) Value(name string) (ent.Value, error) {
+	return de.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the DataExport entity.
+func (de *DataExport) QueryUser() *UserQuery {
+	return NewDataExportClient(de.config).QueryUser(de)
+}
+
+// Update returns a builder for updating this DataExport.
+// Note that you need to call DataExport.Unwrap() before calling this method if this DataExport
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (de *DataExport) Update() *DataExportUpdateOne {
+	return NewDataExportClient(de.config).UpdateOne(de)
+}
+
+// Unwrap unwraps the DataExport entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (de *DataExport) Unwrap() *DataExport {
+	_tx, ok := de.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DataExport is not a transactional entity")
+	}
+	de.config.driver = _tx.drv
+	return de
+}
+
+// String implements the fmt.Stringer.
+func (de *DataExport) String() string {
+	var builder strings.Builder
+	builder.WriteString("DataExport(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", de.ID))
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", de.Status))
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(de.Key)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", de.Size))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(de.Error)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(de.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := de.ClaimedAt; v != nil {
+		builder.WriteString("claimed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := de.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := de.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DataExports is a parsable slice of DataExport.
+type DataExports []*DataExport
@@ -0,0 +1,384 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/matsuokashuhei/morrow-backend/ent/dataexport"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+)
+
+// DataExportCreate is the builder for creating a DataExport entity.
+type DataExportCreate struct {
+	config
+	mutation *DataExportMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (dec *DataExportCreate) SetStatus(d dataexport.Status) *DataExportCreate {
+	dec.mutation.SetStatus(d)
+	return dec
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableStatus(d *dataexport.Status) *DataExportCreate {
+	if d != nil {
+		dec.SetStatus(*d)
+	}
+	return dec
+}
+
+// SetKey sets the "key" field.
+func (dec *DataExportCreate) SetKey(s string) *DataExportCreate {
+	dec.mutation.SetKey(s)
+	return dec
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableKey(s *string) *DataExportCreate {
+	if s != nil {
+		dec.SetKey(*s)
+	}
+	return dec
+}
+
+// SetSize sets the "size" field.
+func (dec *DataExportCreate) SetSize(i int64) *DataExportCreate {
+	dec.mutation.SetSize(i)
+	return dec
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableSize(i *int64) *DataExportCreate {
+	if i != nil {
+		dec.SetSize(*i)
+	}
+	return dec
+}
+
+// SetError sets the "error" field.
+func (dec *DataExportCreate) SetError(s string) *DataExportCreate {
+	dec.mutation.SetError(s)
+	return dec
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableError(s *string) *DataExportCreate {
+	if s != nil {
+		dec.SetError(*s)
+	}
+	return dec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dec *DataExportCreate) SetCreatedAt(t time.Time) *DataExportCreate {
+	dec.mutation.SetCreatedAt(t)
+	return dec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableCreatedAt(t *time.Time) *DataExportCreate {
+	if t != nil {
+		dec.SetCreatedAt(*t)
+	}
+	return dec
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (dec *DataExportCreate) SetClaimedAt(t time.Time) *DataExportCreate {
+	dec.mutation.SetClaimedAt(t)
+	return dec
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableClaimedAt(t *time.Time) *DataExportCreate {
+	if t != nil {
+		dec.SetClaimedAt(*t)
+	}
+	return dec
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (dec *DataExportCreate) SetCompletedAt(t time.Time) *DataExportCreate {
+	dec.mutation.SetCompletedAt(t)
+	return dec
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableCompletedAt(t *time.Time) *DataExportCreate {
+	if t != nil {
+		dec.SetCompletedAt(*t)
+	}
+	return dec
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (dec *DataExportCreate) SetExpiresAt(t time.Time) *DataExportCreate {
+	dec.mutation.SetExpiresAt(t)
+	return dec
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableExpiresAt(t *time.Time) *DataExportCreate {
+	if t != nil {
+		dec.SetExpiresAt(*t)
+	}
+	return dec
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (dec *DataExportCreate) SetUserID(id int) *DataExportCreate {
+	dec.mutation.SetUserID(id)
+	return dec
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (dec *DataExportCreate) SetUser(u *User) *DataExportCreate {
+	return dec.SetUserID(u.ID)
+}
+
+// Mutation returns the DataExportMutation object of the builder.
+func (dec *DataExportCreate) Mutation() *DataExportMutation {
+	return dec.mutation
+}
+
+// Save creates the DataExport in the database.
+func (dec *DataExportCreate) Save(ctx context.Context) (*DataExport, error) {
+	dec.defaults()
+	return withHooks(ctx, dec.sqlSave, dec.mutation, dec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dec *DataExportCreate) SaveX(ctx context.Context) *DataExport {
+	v, err := dec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dec *DataExportCreate) Exec(ctx context.Context) error {
+	_, err := dec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dec *DataExportCreate) ExecX(ctx context.Context) {
+	if err := dec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dec *DataExportCreate) defaults() {
+	if _, ok := dec.mutation.Status(); !ok {
+		v := dataexport.DefaultStatus
+		dec.mutation.SetStatus(v)
+	}
+	if _, ok := dec.mutation.Size(); !ok {
+		v := dataexport.DefaultSize
+		dec.mutation.SetSize(v)
+	}
+	if _, ok := dec.mutation.CreatedAt(); !ok {
+		v := dataexport.DefaultCreatedAt()
+		dec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dec *DataExportCreate) check() error {
+	if _, ok := dec.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DataExport.status"`)}
+	}
+	if v, ok := dec.mutation.Status(); ok {
+		if err := dataexport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DataExport.status": %w`, err)}
+		}
+	}
+	if _, ok := dec.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "DataExport.size"`)}
+	}
+	if v, ok := dec.mutation.Size(); ok {
+		if err := dataexport.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "DataExport.size": %w`, err)}
+		}
+	}
+	if _, ok := dec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DataExport.created_at"`)}
+	}
+	if len(dec.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "DataExport.user"`)}
+	}
+	return nil
+}
+
+func (dec *DataExportCreate) sqlSave(ctx context.Context) (*DataExport, error) {
+	if err := dec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	dec.mutation.id = &_node.ID
+	dec.mutation.done = true
+	return _node, nil
+}
+
+func (dec *DataExportCreate) createSpec() (*DataExport, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DataExport{config: dec.config}
+		_spec = sqlgraph.NewCreateSpec(dataexport.Table, sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt))
+	)
+	if value, ok := dec.mutation.Status(); ok {
+		_spec.SetField(dataexport.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := dec.mutation.Key(); ok {
+		_spec.SetField(dataexport.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := dec.mutation.Size(); ok {
+		_spec.SetField(dataexport.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := dec.mutation.Error(); ok {
+		_spec.SetField(dataexport.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := dec.mutation.CreatedAt(); ok {
+		_spec.SetField(dataexport.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dec.mutation.ClaimedAt(); ok {
+		_spec.SetField(dataexport.FieldClaimedAt, field.TypeTime, value)
+		_node.ClaimedAt = &value
+	}
+	if value, ok := dec.mutation.CompletedAt(); ok {
+		_spec.SetField(dataexport.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := dec.mutation.ExpiresAt(); ok {
+		_spec.SetField(dataexport.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if nodes := dec.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   dataexport.UserTable,
+			Columns: []string{dataexport.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_data_exports = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DataExportCreateBulk is the builder for creating many DataExport entities in bulk.
+type DataExportCreateBulk struct {
+	config
+	err      error
+	builders []*DataExportCreate
+}
+
+// Save creates the DataExport entities in the database.
+func (decb *DataExportCreateBulk) Save(ctx context.Context) ([]*DataExport, error) {
+	if decb.err != nil {
+		return nil, decb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(decb.builders))
+	nodes := make([]*DataExport, len(decb.builders))
+	mutators := make([]Mutator, len(decb.builders))
+	for i := range decb.builders {
+		func(i int, root context.Context) {
+			builder := decb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DataExportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, decb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, decb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, decb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (decb *DataExportCreateBulk) SaveX(ctx context.Context) []*DataExport {
+	v, err := decb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (decb *DataExportCreateBulk) Exec(ctx context.Context) error {
+	_, err := decb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (decb *DataExportCreateBulk) ExecX(ctx context.Context) {
+	if err := decb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
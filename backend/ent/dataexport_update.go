@@ -0,0 +1,566 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/matsuokashuhei/morrow-backend/ent/dataexport"
+	"github.com/matsuokashuhei/morrow-backend/ent/predicate"
+)
+
+// DataExportUpdate is the builder for updating DataExport entities.
+type DataExportUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DataExportMutation
+}
+
+// Where appends a list predicates to the DataExportUpdate builder.
+func (deu *DataExportUpdate) Where(ps ...predicate.DataExport) *DataExportUpdate {
+	deu.mutation.Where(ps...)
+	return deu
+}
+
+// SetStatus sets the "status" field.
+func (deu *DataExportUpdate) SetStatus(d dataexport.Status) *DataExportUpdate {
+	deu.mutation.SetStatus(d)
+	return deu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (deu *DataExportUpdate) SetNillableStatus(d *dataexport.Status) *DataExportUpdate {
+	if d != nil {
+		deu.SetStatus(*d)
+	}
+	return deu
+}
+
+// SetKey sets the "key" field.
+func (deu *DataExportUpdate) SetKey(s string) *DataExportUpdate {
+	deu.mutation.SetKey(s)
+	return deu
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (deu *DataExportUpdate) SetNillableKey(s *string) *DataExportUpdate {
+	if s != nil {
+		deu.SetKey(*s)
+	}
+	return deu
+}
+
+// ClearKey clears the value of the "key" field.
+func (deu *DataExportUpdate) ClearKey() *DataExportUpdate {
+	deu.mutation.ClearKey()
+	return deu
+}
+
+// SetSize sets the "size" field.
+func (deu *DataExportUpdate) SetSize(i int64) *DataExportUpdate {
+	deu.mutation.ResetSize()
+	deu.mutation.SetSize(i)
+	return deu
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (deu *DataExportUpdate) SetNillableSize(i *int64) *DataExportUpdate {
+	if i != nil {
+		deu.SetSize(*i)
+	}
+	return deu
+}
+
+// AddSize adds i to the "size" field.
+func (deu *DataExportUpdate) AddSize(i int64) *DataExportUpdate {
+	deu.mutation.AddSize(i)
+	return deu
+}
+
+// SetError sets the "error" field.
+func (deu *DataExportUpdate) SetError(s string) *DataExportUpdate {
+	deu.mutation.SetError(s)
+	return deu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (deu *DataExportUpdate) SetNillableError(s *string) *DataExportUpdate {
+	if s != nil {
+		deu.SetError(*s)
+	}
+	return deu
+}
+
+// ClearError clears the value of the "error" field.
+func (deu *DataExportUpdate) ClearError() *DataExportUpdate {
+	deu.mutation.ClearError()
+	return deu
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (deu *DataExportUpdate) SetClaimedAt(t time.Time) *DataExportUpdate {
+	deu.mutation.SetClaimedAt(t)
+	return deu
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (deu *DataExportUpdate) SetNillableClaimedAt(t *time.Time) *DataExportUpdate {
+	if t != nil {
+		deu.SetClaimedAt(*t)
+	}
+	return deu
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (deu *DataExportUpdate) ClearClaimedAt() *DataExportUpdate {
+	deu.mutation.ClearClaimedAt()
+	return deu
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (deu *DataExportUpdate) SetCompletedAt(t time.Time) *DataExportUpdate {
+	deu.mutation.SetCompletedAt(t)
+	return deu
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (deu *DataExportUpdate) SetNillableCompletedAt(t *time.Time) *DataExportUpdate {
+	if t != nil {
+		deu.SetCompletedAt(*t)
+	}
+	return deu
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (deu *DataExportUpdate) ClearCompletedAt() *DataExportUpdate {
+	deu.mutation.ClearCompletedAt()
+	return deu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (deu *DataExportUpdate) SetExpiresAt(t time.Time) *DataExportUpdate {
+	deu.mutation.SetExpiresAt(t)
+	return deu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (deu *DataExportUpdate) SetNillableExpiresAt(t *time.Time) *DataExportUpdate {
+	if t != nil {
+		deu.SetExpiresAt(*t)
+	}
+	return deu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (deu *DataExportUpdate) ClearExpiresAt() *DataExportUpdate {
+	deu.mutation.ClearExpiresAt()
+	return deu
+}
+
+// Mutation returns the DataExportMutation object of the builder.
+func (deu *DataExportUpdate) Mutation() *DataExportMutation {
+	return deu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (deu *DataExportUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, deu.sqlSave, deu.mutation, deu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (deu *DataExportUpdate) SaveX(ctx context.Context) int {
+	affected, err := deu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (deu *DataExportUpdate) Exec(ctx context.Context) error {
+	_, err := deu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (deu *DataExportUpdate) ExecX(ctx context.Context) {
+	if err := deu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (deu *DataExportUpdate) check() error {
+	if v, ok := deu.mutation.Status(); ok {
+		if err := dataexport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DataExport.status": %w`, err)}
+		}
+	}
+	if v, ok := deu.mutation.Size(); ok {
+		if err := dataexport.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "DataExport.size": %w`, err)}
+		}
+	}
+	if deu.mutation.UserCleared() && len(deu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DataExport.user"`)
+	}
+	return nil
+}
+
+func (deu *DataExportUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := deu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dataexport.Table, dataexport.Columns, sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt))
+	if ps := deu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := deu.mutation.Status(); ok {
+		_spec.SetField(dataexport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := deu.mutation.Key(); ok {
+		_spec.SetField(dataexport.FieldKey, field.TypeString, value)
+	}
+	if deu.mutation.KeyCleared() {
+		_spec.ClearField(dataexport.FieldKey, field.TypeString)
+	}
+	if value, ok := deu.mutation.Size(); ok {
+		_spec.SetField(dataexport.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := deu.mutation.AddedSize(); ok {
+		_spec.AddField(dataexport.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := deu.mutation.Error(); ok {
+		_spec.SetField(dataexport.FieldError, field.TypeString, value)
+	}
+	if deu.mutation.ErrorCleared() {
+		_spec.ClearField(dataexport.FieldError, field.TypeString)
+	}
+	if value, ok := deu.mutation.ClaimedAt(); ok {
+		_spec.SetField(dataexport.FieldClaimedAt, field.TypeTime, value)
+	}
+	if deu.mutation.ClaimedAtCleared() {
+		_spec.ClearField(dataexport.FieldClaimedAt, field.TypeTime)
+	}
+	if value, ok := deu.mutation.CompletedAt(); ok {
+		_spec.SetField(dataexport.FieldCompletedAt, field.TypeTime, value)
+	}
+	if deu.mutation.CompletedAtCleared() {
+		_spec.ClearField(dataexport.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := deu.mutation.ExpiresAt(); ok {
+		_spec.SetField(dataexport.FieldExpiresAt, field.TypeTime, value)
+	}
+	if deu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(dataexport.FieldExpiresAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, deu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dataexport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	deu.mutation.done = true
+	return n, nil
+}
+
+// DataExportUpdateOne is the builder for updating a single DataExport entity.
+type DataExportUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DataExportMutation
+}
+
+// SetStatus sets the "status" field.
+func (deuo *DataExportUpdateOne) SetStatus(d dataexport.Status) *DataExportUpdateOne {
+	deuo.mutation.SetStatus(d)
+	return deuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (deuo *DataExportUpdateOne) SetNillableStatus(d *dataexport.Status) *DataExportUpdateOne {
+	if d != nil {
+		deuo.SetStatus(*d)
+	}
+	return deuo
+}
+
+// SetKey sets the "key" field.
+func (deuo *DataExportUpdateOne) SetKey(s string) *DataExportUpdateOne {
+	deuo.mutation.SetKey(s)
+	return deuo
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (deuo *DataExportUpdateOne) SetNillableKey(s *string) *DataExportUpdateOne {
+	if s != nil {
+		deuo.SetKey(*s)
+	}
+	return deuo
+}
+
+// ClearKey clears the value of the "key" field.
+func (deuo *DataExportUpdateOne) ClearKey() *DataExportUpdateOne {
+	deuo.mutation.ClearKey()
+	return deuo
+}
+
+// SetSize sets the "size" field.
+func (deuo *DataExportUpdateOne) SetSize(i int64) *DataExportUpdateOne {
+	deuo.mutation.ResetSize()
+	deuo.mutation.SetSize(i)
+	return deuo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (deuo *DataExportUpdateOne) SetNillableSize(i *int64) *DataExportUpdateOne {
+	if i != nil {
+		deuo.SetSize(*i)
+	}
+	return deuo
+}
+
+// AddSize adds i to the "size" field.
+func (deuo *DataExportUpdateOne) AddSize(i int64) *DataExportUpdateOne {
+	deuo.mutation.AddSize(i)
+	return deuo
+}
+
+// SetError sets the "error" field.
+func (deuo *DataExportUpdateOne) SetError(s string) *DataExportUpdateOne {
+	deuo.mutation.SetError(s)
+	return deuo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (deuo *DataExportUpdateOne) SetNillableError(s *string) *DataExportUpdateOne {
+	if s != nil {
+		deuo.SetError(*s)
+	}
+	return deuo
+}
+
+// ClearError clears the value of the "error" field.
+func (deuo *DataExportUpdateOne) ClearError() *DataExportUpdateOne {
+	deuo.mutation.ClearError()
+	return deuo
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (deuo *DataExportUpdateOne) SetClaimedAt(t time.Time) *DataExportUpdateOne {
+	deuo.mutation.SetClaimedAt(t)
+	return deuo
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (deuo *DataExportUpdateOne) SetNillableClaimedAt(t *time.Time) *DataExportUpdateOne {
+	if t != nil {
+		deuo.SetClaimedAt(*t)
+	}
+	return deuo
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (deuo *DataExportUpdateOne) ClearClaimedAt() *DataExportUpdateOne {
+	deuo.mutation.ClearClaimedAt()
+	return deuo
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (deuo *DataExportUpdateOne) SetCompletedAt(t time.Time) *DataExportUpdateOne {
+	deuo.mutation.SetCompletedAt(t)
+	return deuo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (deuo *DataExportUpdateOne) SetNillableCompletedAt(t *time.Time) *DataExportUpdateOne {
+	if t != nil {
+		deuo.SetCompletedAt(*t)
+	}
+	return deuo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (deuo *DataExportUpdateOne) ClearCompletedAt() *DataExportUpdateOne {
+	deuo.mutation.ClearCompletedAt()
+	return deuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (deuo *DataExportUpdateOne) SetExpiresAt(t time.Time) *DataExportUpdateOne {
+	deuo.mutation.SetExpiresAt(t)
+	return deuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (deuo *DataExportUpdateOne) SetNillableExpiresAt(t *time.Time) *DataExportUpdateOne {
+	if t != nil {
+		deuo.SetExpiresAt(*t)
+	}
+	return deuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (deuo *DataExportUpdateOne) ClearExpiresAt() *DataExportUpdateOne {
+	deuo.mutation.ClearExpiresAt()
+	return deuo
+}
+
+// Mutation returns the DataExportMutation object of the builder.
+func (deuo *DataExportUpdateOne) Mutation() *DataExportMutation {
+	return deuo.mutation
+}
+
+// Where appends a list predicates to the DataExportUpdate builder.
+func (deuo *DataExportUpdateOne) Where(ps ...predicate.DataExport) *DataExportUpdateOne {
+	deuo.mutation.Where(ps...)
+	return deuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (deuo *DataExportUpdateOne) Select(field string, fields ...string) *DataExportUpdateOne {
+	deuo.fields = append([]string{field}, fields...)
+	return deuo
+}
+
+// Save executes the query and returns the updated DataExport entity.
+func (deuo *DataExportUpdateOne) Save(ctx context.Context) (*DataExport, error) {
+	return withHooks(ctx, deuo.sqlSave, deuo.mutation, deuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (deuo *DataExportUpdateOne) SaveX(ctx context.Context) *DataExport {
+	node, err := deuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (deuo *DataExportUpdateOne) Exec(ctx context.Context) error {
+	_, err := deuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (deuo *DataExportUpdateOne) ExecX(ctx context.Context) {
+	if err := deuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (deuo *DataExportUpdateOne) check() error {
+	if v, ok := deuo.mutation.Status(); ok {
+		if err := dataexport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DataExport.status": %w`, err)}
+		}
+	}
+	if v, ok := deuo.mutation.Size(); ok {
+		if err := dataexport.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "DataExport.size": %w`, err)}
+		}
+	}
+	if deuo.mutation.UserCleared() && len(deuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DataExport.user"`)
+	}
+	return nil
+}
+
+func (deuo *DataExportUpdateOne) sqlSave(ctx context.Context) (_node *DataExport, err error) {
+	if err := deuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dataexport.Table, dataexport.Columns, sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt))
+	id, ok := deuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DataExport.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := deuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dataexport.FieldID)
+		for _, f := range fields {
+			if !dataexport.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != dataexport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := deuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := deuo.mutation.Status(); ok {
+		_spec.SetField(dataexport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := deuo.mutation.Key(); ok {
+		_spec.SetField(dataexport.FieldKey, field.TypeString, value)
+	}
+	if deuo.mutation.KeyCleared() {
+		_spec.ClearField(dataexport.FieldKey, field.TypeString)
+	}
+	if value, ok := deuo.mutation.Size(); ok {
+		_spec.SetField(dataexport.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := deuo.mutation.AddedSize(); ok {
+		_spec.AddField(dataexport.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := deuo.mutation.Error(); ok {
+		_spec.SetField(dataexport.FieldError, field.TypeString, value)
+	}
+	if deuo.mutation.ErrorCleared() {
+		_spec.ClearField(dataexport.FieldError, field.TypeString)
+	}
+	if value, ok := deuo.mutation.ClaimedAt(); ok {
+		_spec.SetField(dataexport.FieldClaimedAt, field.TypeTime, value)
+	}
+	if deuo.mutation.ClaimedAtCleared() {
+		_spec.ClearField(dataexport.FieldClaimedAt, field.TypeTime)
+	}
+	if value, ok := deuo.mutation.CompletedAt(); ok {
+		_spec.SetField(dataexport.FieldCompletedAt, field.TypeTime, value)
+	}
+	if deuo.mutation.CompletedAtCleared() {
+		_spec.ClearField(dataexport.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := deuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(dataexport.FieldExpiresAt, field.TypeTime, value)
+	}
+	if deuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(dataexport.FieldExpiresAt, field.TypeTime)
+	}
+	_node = &DataExport{config: deuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, deuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dataexport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	deuo.mutation.done = true
+	return _node, nil
+}
@@ -12,8 +12,18 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/matsuokashuhei/morrow-backend/ent/attachment"
+	"github.com/matsuokashuhei/morrow-backend/ent/auditentry"
+	"github.com/matsuokashuhei/morrow-backend/ent/billingevent"
+	"github.com/matsuokashuhei/morrow-backend/ent/dataexport"
 	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/message"
 	"github.com/matsuokashuhei/morrow-backend/ent/participant"
+	"github.com/matsuokashuhei/morrow-backend/ent/plan"
+	"github.com/matsuokashuhei/morrow-backend/ent/ratelimitbucket"
+	"github.com/matsuokashuhei/morrow-backend/ent/reaction"
+	"github.com/matsuokashuhei/morrow-backend/ent/readcursor"
+	"github.com/matsuokashuhei/morrow-backend/ent/subscription"
 	"github.com/matsuokashuhei/morrow-backend/ent/user"
 )
 
@@ -75,9 +85,19 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			event.Table:       event.ValidColumn,
-			participant.Table: participant.ValidColumn,
-			user.Table:        user.ValidColumn,
+			attachment.Table:      attachment.ValidColumn,
+			auditentry.Table:      auditentry.ValidColumn,
+			billingevent.Table:    billingevent.ValidColumn,
+			dataexport.Table:      dataexport.ValidColumn,
+			event.Table:           event.ValidColumn,
+			message.Table:         message.ValidColumn,
+			participant.Table:     participant.ValidColumn,
+			plan.Table:            plan.ValidColumn,
+			ratelimitbucket.Table: ratelimitbucket.ValidColumn,
+			reaction.Table:        reaction.ValidColumn,
+			readcursor.Table:      readcursor.ValidColumn,
+			subscription.Table:    subscription.ValidColumn,
+			user.Table:            user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -18,6 +18,8 @@ type Event struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// 削除日時 (ゴミ箱にある間だけ設定され、保持期間を過ぎると完全に削除される)
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// イベントのタイトル
 	Title string `json:"title,omitempty"`
 	// イベントの説明
@@ -26,6 +28,14 @@ type Event struct {
 	StartTime time.Time `json:"start_time,omitempty"`
 	// イベント終了日時
 	EndTime time.Time `json:"end_time,omitempty"`
+	// イベントのタイムゾーン (IANA名, 例: Asia/Tokyo)
+	TimeZone string `json:"time_zone,omitempty"`
+	// 繰り返しルール (RFC 5545 RRULE, 例: FREQ=WEEKLY;COUNT=10)
+	RecurrenceRule string `json:"recurrence_rule,omitempty"`
+	// iCalendarからインポートしたイベントのUID (再インポート時の重複排除用)
+	IcalUID string `json:"ical_uid,omitempty"`
+	// iCalendarのSEQUENCE (招待状の更新のたびに増える)
+	Sequence int `json:"sequence,omitempty"`
 	// イベントの絵文字
 	Emoji string `json:"emoji,omitempty"`
 	// イベントの公開設定
@@ -47,9 +57,15 @@ type EventEdges struct {
 	Creator *User `json:"creator,omitempty"`
 	// イベントの参加者情報
 	Participants []*Participant `json:"participants,omitempty"`
+	// イベントのチャットメッセージ
+	Messages []*Message `json:"messages,omitempty"`
+	// イベントへのリアクション
+	Reactions []*Reaction `json:"reactions,omitempty"`
+	// 参加者ごとのチャット既読位置
+	ReadCursors []*ReadCursor `json:"read_cursors,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [5]bool
 }
 
 // CreatorOrErr returns the Creator value or an error if the edge
@@ -72,16 +88,43 @@ func (e EventEdges) ParticipantsOrErr() ([]*Participant, error) {
 	return nil, &NotLoadedError{edge: "participants"}
 }
 
+// MessagesOrErr returns the Messages value or an error if the edge
+// was not loaded in eager-loading.
+func (e EventEdges) MessagesOrErr() ([]*Message, error) {
+	if e.loadedTypes[2] {
+		return e.Messages, nil
+	}
+	return nil, &NotLoadedError{edge: "messages"}
+}
+
+// ReactionsOrErr returns the Reactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e EventEdges) ReactionsOrErr() ([]*Reaction, error) {
+	if e.loadedTypes[3] {
+		return e.Reactions, nil
+	}
+	return nil, &NotLoadedError{edge: "reactions"}
+}
+
+// ReadCursorsOrErr returns the ReadCursors value or an error if the edge
+// was not loaded in eager-loading.
+func (e EventEdges) ReadCursorsOrErr() ([]*ReadCursor, error) {
+	if e.loadedTypes[4] {
+		return e.ReadCursors, nil
+	}
+	return nil, &NotLoadedError{edge: "read_cursors"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Event) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case event.FieldID:
+		case event.FieldID, event.FieldSequence:
 			values[i] = new(sql.NullInt64)
-		case event.FieldTitle, event.FieldDescription, event.FieldEmoji, event.FieldVisibility:
+		case event.FieldTitle, event.FieldDescription, event.FieldTimeZone, event.FieldRecurrenceRule, event.FieldIcalUID, event.FieldEmoji, event.FieldVisibility:
 			values[i] = new(sql.NullString)
-		case event.FieldStartTime, event.FieldEndTime, event.FieldCreatedAt, event.FieldUpdatedAt:
+		case event.FieldDeletedAt, event.FieldStartTime, event.FieldEndTime, event.FieldCreatedAt, event.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case event.ForeignKeys[0]: // user_created_events
 			values[i] = new(sql.NullInt64)
@@ -106,6 +149,13 @@ func (e *Event) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			e.ID = int(value.Int64)
+		case event.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				e.DeletedAt = new(time.Time)
+				*e.DeletedAt = value.Time
+			}
 		case event.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field title", values[i])
@@ -130,6 +180,30 @@ func (e *Event) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				e.EndTime = value.Time
 			}
+		case event.FieldTimeZone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field time_zone", values[i])
+			} else if value.Valid {
+				e.TimeZone = value.String
+			}
+		case event.FieldRecurrenceRule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recurrence_rule", values[i])
+			} else if value.Valid {
+				e.RecurrenceRule = value.String
+			}
+		case event.FieldIcalUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ical_uid", values[i])
+			} else if value.Valid {
+				e.IcalUID = value.String
+			}
+		case event.FieldSequence:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sequence", values[i])
+			} else if value.Valid {
+				e.Sequence = int(value.Int64)
+			}
 		case event.FieldEmoji:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field emoji", values[i])
@@ -184,6 +258,21 @@ func (e *Event) QueryParticipants() *ParticipantQuery {
 	return NewEventClient(e.config).QueryParticipants(e)
 }
 
+// QueryMessages queries the "messages" edge of the Event entity.
+func (e *Event) QueryMessages() *MessageQuery {
+	return NewEventClient(e.config).QueryMessages(e)
+}
+
+// QueryReactions queries the "reactions" edge of the Event entity.
+func (e *Event) QueryReactions() *ReactionQuery {
+	return NewEventClient(e.config).QueryReactions(e)
+}
+
+// QueryReadCursors queries the "read_cursors" edge of the Event entity.
+func (e *Event) QueryReadCursors() *ReadCursorQuery {
+	return NewEventClient(e.config).QueryReadCursors(e)
+}
+
 // Update returns a builder for updating this Event.
 // Note that you need to call Event.Unwrap() before calling this method if this Event
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -207,6 +296,11 @@ func (e *Event) String() string {
 	var builder strings.Builder
 	builder.WriteString("Event(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", e.ID))
+	if v := e.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("title=")
 	builder.WriteString(e.Title)
 	builder.WriteString(", ")
@@ -219,6 +313,18 @@ func (e *Event) String() string {
 	builder.WriteString("end_time=")
 	builder.WriteString(e.EndTime.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("time_zone=")
+	builder.WriteString(e.TimeZone)
+	builder.WriteString(", ")
+	builder.WriteString("recurrence_rule=")
+	builder.WriteString(e.RecurrenceRule)
+	builder.WriteString(", ")
+	builder.WriteString("ical_uid=")
+	builder.WriteString(e.IcalUID)
+	builder.WriteString(", ")
+	builder.WriteString("sequence=")
+	builder.WriteString(fmt.Sprintf("%v", e.Sequence))
+	builder.WriteString(", ")
 	builder.WriteString("emoji=")
 	builder.WriteString(e.Emoji)
 	builder.WriteString(", ")
@@ -55,6 +55,11 @@ func IDLTE(id int) predicate.Event {
 	return predicate.Event(sql.FieldLTE(FieldID, id))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
 func Title(v string) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldTitle, v))
@@ -75,6 +80,26 @@ func EndTime(v time.Time) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldEndTime, v))
 }
 
+// TimeZone applies equality check predicate on the "time_zone" field. It's identical to TimeZoneEQ.
+func TimeZone(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldTimeZone, v))
+}
+
+// RecurrenceRule applies equality check predicate on the "recurrence_rule" field. It's identical to RecurrenceRuleEQ.
+func RecurrenceRule(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldRecurrenceRule, v))
+}
+
+// IcalUID applies equality check predicate on the "ical_uid" field. It's identical to IcalUIDEQ.
+func IcalUID(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldIcalUID, v))
+}
+
+// Sequence applies equality check predicate on the "sequence" field. It's identical to SequenceEQ.
+func Sequence(v int) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldSequence, v))
+}
+
 // Emoji applies equality check predicate on the "emoji" field. It's identical to EmojiEQ.
 func Emoji(v string) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldEmoji, v))
@@ -90,6 +115,56 @@ func UpdatedAt(v time.Time) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Event {
+	return predicate.Event(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Event {
+	return predicate.Event(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldTitle, v))
@@ -310,6 +385,271 @@ func EndTimeLTE(v time.Time) predicate.Event {
 	return predicate.Event(sql.FieldLTE(FieldEndTime, v))
 }
 
+// TimeZoneEQ applies the EQ predicate on the "time_zone" field.
+func TimeZoneEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldTimeZone, v))
+}
+
+// TimeZoneNEQ applies the NEQ predicate on the "time_zone" field.
+func TimeZoneNEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldTimeZone, v))
+}
+
+// TimeZoneIn applies the In predicate on the "time_zone" field.
+func TimeZoneIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldTimeZone, vs...))
+}
+
+// TimeZoneNotIn applies the NotIn predicate on the "time_zone" field.
+func TimeZoneNotIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldTimeZone, vs...))
+}
+
+// TimeZoneGT applies the GT predicate on the "time_zone" field.
+func TimeZoneGT(v string) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldTimeZone, v))
+}
+
+// TimeZoneGTE applies the GTE predicate on the "time_zone" field.
+func TimeZoneGTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldTimeZone, v))
+}
+
+// TimeZoneLT applies the LT predicate on the "time_zone" field.
+func TimeZoneLT(v string) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldTimeZone, v))
+}
+
+// TimeZoneLTE applies the LTE predicate on the "time_zone" field.
+func TimeZoneLTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldTimeZone, v))
+}
+
+// TimeZoneContains applies the Contains predicate on the "time_zone" field.
+func TimeZoneContains(v string) predicate.Event {
+	return predicate.Event(sql.FieldContains(FieldTimeZone, v))
+}
+
+// TimeZoneHasPrefix applies the HasPrefix predicate on the "time_zone" field.
+func TimeZoneHasPrefix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasPrefix(FieldTimeZone, v))
+}
+
+// TimeZoneHasSuffix applies the HasSuffix predicate on the "time_zone" field.
+func TimeZoneHasSuffix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasSuffix(FieldTimeZone, v))
+}
+
+// TimeZoneIsNil applies the IsNil predicate on the "time_zone" field.
+func TimeZoneIsNil() predicate.Event {
+	return predicate.Event(sql.FieldIsNull(FieldTimeZone))
+}
+
+// TimeZoneNotNil applies the NotNil predicate on the "time_zone" field.
+func TimeZoneNotNil() predicate.Event {
+	return predicate.Event(sql.FieldNotNull(FieldTimeZone))
+}
+
+// TimeZoneEqualFold applies the EqualFold predicate on the "time_zone" field.
+func TimeZoneEqualFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldEqualFold(FieldTimeZone, v))
+}
+
+// TimeZoneContainsFold applies the ContainsFold predicate on the "time_zone" field.
+func TimeZoneContainsFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldContainsFold(FieldTimeZone, v))
+}
+
+// RecurrenceRuleEQ applies the EQ predicate on the "recurrence_rule" field.
+func RecurrenceRuleEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleNEQ applies the NEQ predicate on the "recurrence_rule" field.
+func RecurrenceRuleNEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleIn applies the In predicate on the "recurrence_rule" field.
+func RecurrenceRuleIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldRecurrenceRule, vs...))
+}
+
+// RecurrenceRuleNotIn applies the NotIn predicate on the "recurrence_rule" field.
+func RecurrenceRuleNotIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldRecurrenceRule, vs...))
+}
+
+// RecurrenceRuleGT applies the GT predicate on the "recurrence_rule" field.
+func RecurrenceRuleGT(v string) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleGTE applies the GTE predicate on the "recurrence_rule" field.
+func RecurrenceRuleGTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleLT applies the LT predicate on the "recurrence_rule" field.
+func RecurrenceRuleLT(v string) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleLTE applies the LTE predicate on the "recurrence_rule" field.
+func RecurrenceRuleLTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleContains applies the Contains predicate on the "recurrence_rule" field.
+func RecurrenceRuleContains(v string) predicate.Event {
+	return predicate.Event(sql.FieldContains(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleHasPrefix applies the HasPrefix predicate on the "recurrence_rule" field.
+func RecurrenceRuleHasPrefix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasPrefix(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleHasSuffix applies the HasSuffix predicate on the "recurrence_rule" field.
+func RecurrenceRuleHasSuffix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasSuffix(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleIsNil applies the IsNil predicate on the "recurrence_rule" field.
+func RecurrenceRuleIsNil() predicate.Event {
+	return predicate.Event(sql.FieldIsNull(FieldRecurrenceRule))
+}
+
+// RecurrenceRuleNotNil applies the NotNil predicate on the "recurrence_rule" field.
+func RecurrenceRuleNotNil() predicate.Event {
+	return predicate.Event(sql.FieldNotNull(FieldRecurrenceRule))
+}
+
+// RecurrenceRuleEqualFold applies the EqualFold predicate on the "recurrence_rule" field.
+func RecurrenceRuleEqualFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldEqualFold(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleContainsFold applies the ContainsFold predicate on the "recurrence_rule" field.
+func RecurrenceRuleContainsFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldContainsFold(FieldRecurrenceRule, v))
+}
+
+// IcalUIDEQ applies the EQ predicate on the "ical_uid" field.
+func IcalUIDEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldIcalUID, v))
+}
+
+// IcalUIDNEQ applies the NEQ predicate on the "ical_uid" field.
+func IcalUIDNEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldIcalUID, v))
+}
+
+// IcalUIDIn applies the In predicate on the "ical_uid" field.
+func IcalUIDIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldIcalUID, vs...))
+}
+
+// IcalUIDNotIn applies the NotIn predicate on the "ical_uid" field.
+func IcalUIDNotIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldIcalUID, vs...))
+}
+
+// IcalUIDGT applies the GT predicate on the "ical_uid" field.
+func IcalUIDGT(v string) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldIcalUID, v))
+}
+
+// IcalUIDGTE applies the GTE predicate on the "ical_uid" field.
+func IcalUIDGTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldIcalUID, v))
+}
+
+// IcalUIDLT applies the LT predicate on the "ical_uid" field.
+func IcalUIDLT(v string) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldIcalUID, v))
+}
+
+// IcalUIDLTE applies the LTE predicate on the "ical_uid" field.
+func IcalUIDLTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldIcalUID, v))
+}
+
+// IcalUIDContains applies the Contains predicate on the "ical_uid" field.
+func IcalUIDContains(v string) predicate.Event {
+	return predicate.Event(sql.FieldContains(FieldIcalUID, v))
+}
+
+// IcalUIDHasPrefix applies the HasPrefix predicate on the "ical_uid" field.
+func IcalUIDHasPrefix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasPrefix(FieldIcalUID, v))
+}
+
+// IcalUIDHasSuffix applies the HasSuffix predicate on the "ical_uid" field.
+func IcalUIDHasSuffix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasSuffix(FieldIcalUID, v))
+}
+
+// IcalUIDIsNil applies the IsNil predicate on the "ical_uid" field.
+func IcalUIDIsNil() predicate.Event {
+	return predicate.Event(sql.FieldIsNull(FieldIcalUID))
+}
+
+// IcalUIDNotNil applies the NotNil predicate on the "ical_uid" field.
+func IcalUIDNotNil() predicate.Event {
+	return predicate.Event(sql.FieldNotNull(FieldIcalUID))
+}
+
+// IcalUIDEqualFold applies the EqualFold predicate on the "ical_uid" field.
+func IcalUIDEqualFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldEqualFold(FieldIcalUID, v))
+}
+
+// IcalUIDContainsFold applies the ContainsFold predicate on the "ical_uid" field.
+func IcalUIDContainsFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldContainsFold(FieldIcalUID, v))
+}
+
+// SequenceEQ applies the EQ predicate on the "sequence" field.
+func SequenceEQ(v int) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldSequence, v))
+}
+
+// SequenceNEQ applies the NEQ predicate on the "sequence" field.
+func SequenceNEQ(v int) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldSequence, v))
+}
+
+// SequenceIn applies the In predicate on the "sequence" field.
+func SequenceIn(vs ...int) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldSequence, vs...))
+}
+
+// SequenceNotIn applies the NotIn predicate on the "sequence" field.
+func SequenceNotIn(vs ...int) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldSequence, vs...))
+}
+
+// SequenceGT applies the GT predicate on the "sequence" field.
+func SequenceGT(v int) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldSequence, v))
+}
+
+// SequenceGTE applies the GTE predicate on the "sequence" field.
+func SequenceGTE(v int) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldSequence, v))
+}
+
+// SequenceLT applies the LT predicate on the "sequence" field.
+func SequenceLT(v int) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldSequence, v))
+}
+
+// SequenceLTE applies the LTE predicate on the "sequence" field.
+func SequenceLTE(v int) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldSequence, v))
+}
+
 // EmojiEQ applies the EQ predicate on the "emoji" field.
 func EmojiEQ(v string) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldEmoji, v))
@@ -531,6 +871,75 @@ func HasParticipantsWith(preds ...predicate.Participant) predicate.Event {
 	})
 }
 
+// HasMessages applies the HasEdge predicate on the "messages" edge.
+func HasMessages() predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MessagesTable, MessagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessagesWith applies the HasEdge predicate on the "messages" edge with a given conditions (other predicates).
+func HasMessagesWith(preds ...predicate.Message) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		step := newMessagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReactions applies the HasEdge predicate on the "reactions" edge.
+func HasReactions() predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReactionsTable, ReactionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReactionsWith applies the HasEdge predicate on the "reactions" edge with a given conditions (other predicates).
+func HasReactionsWith(preds ...predicate.Reaction) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		step := newReactionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReadCursors applies the HasEdge predicate on the "read_cursors" edge.
+func HasReadCursors() predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReadCursorsTable, ReadCursorsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReadCursorsWith applies the HasEdge predicate on the "read_cursors" edge with a given conditions (other predicates).
+func HasReadCursorsWith(preds ...predicate.ReadCursor) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		step := newReadCursorsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Event) predicate.Event {
 	return predicate.Event(sql.AndPredicates(predicates...))
@@ -11,7 +11,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/message"
 	"github.com/matsuokashuhei/morrow-backend/ent/participant"
+	"github.com/matsuokashuhei/morrow-backend/ent/reaction"
+	"github.com/matsuokashuhei/morrow-backend/ent/readcursor"
 	"github.com/matsuokashuhei/morrow-backend/ent/user"
 )
 
@@ -22,6 +25,20 @@ type EventCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (ec *EventCreate) SetDeletedAt(t time.Time) *EventCreate {
+	ec.mutation.SetDeletedAt(t)
+	return ec
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ec *EventCreate) SetNillableDeletedAt(t *time.Time) *EventCreate {
+	if t != nil {
+		ec.SetDeletedAt(*t)
+	}
+	return ec
+}
+
 // SetTitle sets the "title" field.
 func (ec *EventCreate) SetTitle(s string) *EventCreate {
 	ec.mutation.SetTitle(s)
@@ -54,6 +71,62 @@ func (ec *EventCreate) SetEndTime(t time.Time) *EventCreate {
 	return ec
 }
 
+// SetTimeZone sets the "time_zone" field.
+func (ec *EventCreate) SetTimeZone(s string) *EventCreate {
+	ec.mutation.SetTimeZone(s)
+	return ec
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (ec *EventCreate) SetNillableTimeZone(s *string) *EventCreate {
+	if s != nil {
+		ec.SetTimeZone(*s)
+	}
+	return ec
+}
+
+// SetRecurrenceRule sets the "recurrence_rule" field.
+func (ec *EventCreate) SetRecurrenceRule(s string) *EventCreate {
+	ec.mutation.SetRecurrenceRule(s)
+	return ec
+}
+
+// SetNillableRecurrenceRule sets the "recurrence_rule" field if the given value is not nil.
+func (ec *EventCreate) SetNillableRecurrenceRule(s *string) *EventCreate {
+	if s != nil {
+		ec.SetRecurrenceRule(*s)
+	}
+	return ec
+}
+
+// SetIcalUID sets the "ical_uid" field.
+func (ec *EventCreate) SetIcalUID(s string) *EventCreate {
+	ec.mutation.SetIcalUID(s)
+	return ec
+}
+
+// SetNillableIcalUID sets the "ical_uid" field if the given value is not nil.
+func (ec *EventCreate) SetNillableIcalUID(s *string) *EventCreate {
+	if s != nil {
+		ec.SetIcalUID(*s)
+	}
+	return ec
+}
+
+// SetSequence sets the "sequence" field.
+func (ec *EventCreate) SetSequence(i int) *EventCreate {
+	ec.mutation.SetSequence(i)
+	return ec
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (ec *EventCreate) SetNillableSequence(i *int) *EventCreate {
+	if i != nil {
+		ec.SetSequence(*i)
+	}
+	return ec
+}
+
 // SetEmoji sets the "emoji" field.
 func (ec *EventCreate) SetEmoji(s string) *EventCreate {
 	ec.mutation.SetEmoji(s)
@@ -136,6 +209,51 @@ func (ec *EventCreate) AddParticipants(p ...*Participant) *EventCreate {
 	return ec.AddParticipantIDs(ids...)
 }
 
+// AddMessageIDs adds the "messages" edge to the Message entity by IDs.
+func (ec *EventCreate) AddMessageIDs(ids ...int) *EventCreate {
+	ec.mutation.AddMessageIDs(ids...)
+	return ec
+}
+
+// AddMessages adds the "messages" edges to the Message entity.
+func (ec *EventCreate) AddMessages(m ...*Message) *EventCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return ec.AddMessageIDs(ids...)
+}
+
+// AddReactionIDs adds the "reactions" edge to the Reaction entity by IDs.
+func (ec *EventCreate) AddReactionIDs(ids ...int) *EventCreate {
+	ec.mutation.AddReactionIDs(ids...)
+	return ec
+}
+
+// AddReactions adds the "reactions" edges to the Reaction entity.
+func (ec *EventCreate) AddReactions(r ...*Reaction) *EventCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ec.AddReactionIDs(ids...)
+}
+
+// AddReadCursorIDs adds the "read_cursors" edge to the ReadCursor entity by IDs.
+func (ec *EventCreate) AddReadCursorIDs(ids ...int) *EventCreate {
+	ec.mutation.AddReadCursorIDs(ids...)
+	return ec
+}
+
+// AddReadCursors adds the "read_cursors" edges to the ReadCursor entity.
+func (ec *EventCreate) AddReadCursors(r ...*ReadCursor) *EventCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ec.AddReadCursorIDs(ids...)
+}
+
 // Mutation returns the EventMutation object of the builder.
 func (ec *EventCreate) Mutation() *EventMutation {
 	return ec.mutation
@@ -171,6 +289,10 @@ func (ec *EventCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (ec *EventCreate) defaults() {
+	if _, ok := ec.mutation.Sequence(); !ok {
+		v := event.DefaultSequence
+		ec.mutation.SetSequence(v)
+	}
 	if _, ok := ec.mutation.Visibility(); !ok {
 		v := event.DefaultVisibility
 		ec.mutation.SetVisibility(v)
@@ -196,6 +318,29 @@ func (ec *EventCreate) check() error {
 	if _, ok := ec.mutation.EndTime(); !ok {
 		return &ValidationError{Name: "end_time", err: errors.New(`ent: missing required field "Event.end_time"`)}
 	}
+	if v, ok := ec.mutation.TimeZone(); ok {
+		if err := event.TimeZoneValidator(v); err != nil {
+			return &ValidationError{Name: "time_zone", err: fmt.Errorf(`ent: validator failed for field "Event.time_zone": %w`, err)}
+		}
+	}
+	if v, ok := ec.mutation.RecurrenceRule(); ok {
+		if err := event.RecurrenceRuleValidator(v); err != nil {
+			return &ValidationError{Name: "recurrence_rule", err: fmt.Errorf(`ent: validator failed for field "Event.recurrence_rule": %w`, err)}
+		}
+	}
+	if _, ok := ec.mutation.Sequence(); !ok {
+		return &ValidationError{Name: "sequence", err: errors.New(`ent: missing required field "Event.sequence"`)}
+	}
+	if v, ok := ec.mutation.Sequence(); ok {
+		if err := event.SequenceValidator(v); err != nil {
+			return &ValidationError{Name: "sequence", err: fmt.Errorf(`ent: validator failed for field "Event.sequence": %w`, err)}
+		}
+	}
+	if v, ok := ec.mutation.Emoji(); ok {
+		if err := event.EmojiValidator(v); err != nil {
+			return &ValidationError{Name: "emoji", err: fmt.Errorf(`ent: validator failed for field "Event.emoji": %w`, err)}
+		}
+	}
 	if _, ok := ec.mutation.Visibility(); !ok {
 		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "Event.visibility"`)}
 	}
@@ -239,6 +384,10 @@ func (ec *EventCreate) createSpec() (*Event, *sqlgraph.CreateSpec) {
 		_node = &Event{config: ec.config}
 		_spec = sqlgraph.NewCreateSpec(event.Table, sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt))
 	)
+	if value, ok := ec.mutation.DeletedAt(); ok {
+		_spec.SetField(event.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := ec.mutation.Title(); ok {
 		_spec.SetField(event.FieldTitle, field.TypeString, value)
 		_node.Title = value
@@ -255,6 +404,22 @@ func (ec *EventCreate) createSpec() (*Event, *sqlgraph.CreateSpec) {
 		_spec.SetField(event.FieldEndTime, field.TypeTime, value)
 		_node.EndTime = value
 	}
+	if value, ok := ec.mutation.TimeZone(); ok {
+		_spec.SetField(event.FieldTimeZone, field.TypeString, value)
+		_node.TimeZone = value
+	}
+	if value, ok := ec.mutation.RecurrenceRule(); ok {
+		_spec.SetField(event.FieldRecurrenceRule, field.TypeString, value)
+		_node.RecurrenceRule = value
+	}
+	if value, ok := ec.mutation.IcalUID(); ok {
+		_spec.SetField(event.FieldIcalUID, field.TypeString, value)
+		_node.IcalUID = value
+	}
+	if value, ok := ec.mutation.Sequence(); ok {
+		_spec.SetField(event.FieldSequence, field.TypeInt, value)
+		_node.Sequence = value
+	}
 	if value, ok := ec.mutation.Emoji(); ok {
 		_spec.SetField(event.FieldEmoji, field.TypeString, value)
 		_node.Emoji = value
@@ -304,6 +469,54 @@ func (ec *EventCreate) createSpec() (*Event, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ec.mutation.MessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.MessagesTable,
+			Columns: []string{event.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ReactionsTable,
+			Columns: []string{event.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.ReadCursorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ReadCursorsTable,
+			Columns: []string{event.ReadCursorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readcursor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -13,8 +13,11 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/message"
 	"github.com/matsuokashuhei/morrow-backend/ent/participant"
 	"github.com/matsuokashuhei/morrow-backend/ent/predicate"
+	"github.com/matsuokashuhei/morrow-backend/ent/reaction"
+	"github.com/matsuokashuhei/morrow-backend/ent/readcursor"
 	"github.com/matsuokashuhei/morrow-backend/ent/user"
 )
 
@@ -27,6 +30,9 @@ type EventQuery struct {
 	predicates       []predicate.Event
 	withCreator      *UserQuery
 	withParticipants *ParticipantQuery
+	withMessages     *MessageQuery
+	withReactions    *ReactionQuery
+	withReadCursors  *ReadCursorQuery
 	withFKs          bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -108,6 +114,72 @@ func (eq *EventQuery) QueryParticipants() *ParticipantQuery {
 	return query
 }
 
+// QueryMessages chains the current query on the "messages" edge.
+func (eq *EventQuery) QueryMessages() *MessageQuery {
+	query := (&MessageClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(event.Table, event.FieldID, selector),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, event.MessagesTable, event.MessagesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReactions chains the current query on the "reactions" edge.
+func (eq *EventQuery) QueryReactions() *ReactionQuery {
+	query := (&ReactionClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(event.Table, event.FieldID, selector),
+			sqlgraph.To(reaction.Table, reaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, event.ReactionsTable, event.ReactionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReadCursors chains the current query on the "read_cursors" edge.
+func (eq *EventQuery) QueryReadCursors() *ReadCursorQuery {
+	query := (&ReadCursorClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(event.Table, event.FieldID, selector),
+			sqlgraph.To(readcursor.Table, readcursor.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, event.ReadCursorsTable, event.ReadCursorsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Event entity from the query.
 // Returns a *NotFoundError when no Event was found.
 func (eq *EventQuery) First(ctx context.Context) (*Event, error) {
@@ -302,6 +374,9 @@ func (eq *EventQuery) Clone() *EventQuery {
 		predicates:       append([]predicate.Event{}, eq.predicates...),
 		withCreator:      eq.withCreator.Clone(),
 		withParticipants: eq.withParticipants.Clone(),
+		withMessages:     eq.withMessages.Clone(),
+		withReactions:    eq.withReactions.Clone(),
+		withReadCursors:  eq.withReadCursors.Clone(),
 		// clone intermediate query.
 		sql:  eq.sql.Clone(),
 		path: eq.path,
@@ -330,18 +405,51 @@ func (eq *EventQuery) WithParticipants(opts ...func(*ParticipantQuery)) *EventQu
 	return eq
 }
 
+// WithMessages tells the query-builder to eager-load the nodes that are connected to
+// the "messages" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EventQuery) WithMessages(opts ...func(*MessageQuery)) *EventQuery {
+	query := (&MessageClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withMessages = query
+	return eq
+}
+
+// WithReactions tells the query-builder to eager-load the nodes that are connected to
+// the "reactions" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EventQuery) WithReactions(opts ...func(*ReactionQuery)) *EventQuery {
+	query := (&ReactionClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withReactions = query
+	return eq
+}
+
+// WithReadCursors tells the query-builder to eager-load the nodes that are connected to
+// the "read_cursors" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EventQuery) WithReadCursors(opts ...func(*ReadCursorQuery)) *EventQuery {
+	query := (&ReadCursorClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withReadCursors = query
+	return eq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Title string `json:"title,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Event.Query().
-//		GroupBy(event.FieldTitle).
+//		GroupBy(event.FieldDeletedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (eq *EventQuery) GroupBy(field string, fields ...string) *EventGroupBy {
@@ -359,11 +467,11 @@ func (eq *EventQuery) GroupBy(field string, fields ...string) *EventGroupBy {
 // Example:
 //
 //	var v []struct {
-//		Title string `json:"title,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //	}
 //
 //	client.Event.Query().
-//		Select(event.FieldTitle).
+//		Select(event.FieldDeletedAt).
 //		Scan(ctx, &v)
 func (eq *EventQuery) Select(fields ...string) *EventSelect {
 	eq.ctx.Fields = append(eq.ctx.Fields, fields...)
@@ -409,9 +517,12 @@ func (eq *EventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Event,
 		nodes       = []*Event{}
 		withFKs     = eq.withFKs
 		_spec       = eq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [5]bool{
 			eq.withCreator != nil,
 			eq.withParticipants != nil,
+			eq.withMessages != nil,
+			eq.withReactions != nil,
+			eq.withReadCursors != nil,
 		}
 	)
 	if eq.withCreator != nil {
@@ -451,6 +562,27 @@ func (eq *EventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Event,
 			return nil, err
 		}
 	}
+	if query := eq.withMessages; query != nil {
+		if err := eq.loadMessages(ctx, query, nodes,
+			func(n *Event) { n.Edges.Messages = []*Message{} },
+			func(n *Event, e *Message) { n.Edges.Messages = append(n.Edges.Messages, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withReactions; query != nil {
+		if err := eq.loadReactions(ctx, query, nodes,
+			func(n *Event) { n.Edges.Reactions = []*Reaction{} },
+			func(n *Event, e *Reaction) { n.Edges.Reactions = append(n.Edges.Reactions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withReadCursors; query != nil {
+		if err := eq.loadReadCursors(ctx, query, nodes,
+			func(n *Event) { n.Edges.ReadCursors = []*ReadCursor{} },
+			func(n *Event, e *ReadCursor) { n.Edges.ReadCursors = append(n.Edges.ReadCursors, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -517,6 +649,99 @@ func (eq *EventQuery) loadParticipants(ctx context.Context, query *ParticipantQu
 	}
 	return nil
 }
+func (eq *EventQuery) loadMessages(ctx context.Context, query *MessageQuery, nodes []*Event, init func(*Event), assign func(*Event, *Message)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Event)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Message(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(event.MessagesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.event_messages
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "event_messages" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "event_messages" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EventQuery) loadReactions(ctx context.Context, query *ReactionQuery, nodes []*Event, init func(*Event), assign func(*Event, *Reaction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Event)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Reaction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(event.ReactionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.event_reactions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "event_reactions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "event_reactions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EventQuery) loadReadCursors(ctx context.Context, query *ReadCursorQuery, nodes []*Event, init func(*Event), assign func(*Event, *ReadCursor)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Event)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ReadCursor(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(event.ReadCursorsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.event_read_cursors
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "event_read_cursors" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "event_read_cursors" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (eq *EventQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := eq.querySpec()
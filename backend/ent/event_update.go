@@ -12,8 +12,11 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/message"
 	"github.com/matsuokashuhei/morrow-backend/ent/participant"
 	"github.com/matsuokashuhei/morrow-backend/ent/predicate"
+	"github.com/matsuokashuhei/morrow-backend/ent/reaction"
+	"github.com/matsuokashuhei/morrow-backend/ent/readcursor"
 	"github.com/matsuokashuhei/morrow-backend/ent/user"
 )
 
@@ -30,6 +33,26 @@ func (eu *EventUpdate) Where(ps ...predicate.Event) *EventUpdate {
 	return eu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (eu *EventUpdate) SetDeletedAt(t time.Time) *EventUpdate {
+	eu.mutation.SetDeletedAt(t)
+	return eu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (eu *EventUpdate) SetNillableDeletedAt(t *time.Time) *EventUpdate {
+	if t != nil {
+		eu.SetDeletedAt(*t)
+	}
+	return eu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (eu *EventUpdate) ClearDeletedAt() *EventUpdate {
+	eu.mutation.ClearDeletedAt()
+	return eu
+}
+
 // SetTitle sets the "title" field.
 func (eu *EventUpdate) SetTitle(s string) *EventUpdate {
 	eu.mutation.SetTitle(s)
@@ -92,6 +115,67 @@ func (eu *EventUpdate) SetNillableEndTime(t *time.Time) *EventUpdate {
 	return eu
 }
 
+// SetTimeZone sets the "time_zone" field.
+func (eu *EventUpdate) SetTimeZone(s string) *EventUpdate {
+	eu.mutation.SetTimeZone(s)
+	return eu
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (eu *EventUpdate) SetNillableTimeZone(s *string) *EventUpdate {
+	if s != nil {
+		eu.SetTimeZone(*s)
+	}
+	return eu
+}
+
+// ClearTimeZone clears the value of the "time_zone" field.
+func (eu *EventUpdate) ClearTimeZone() *EventUpdate {
+	eu.mutation.ClearTimeZone()
+	return eu
+}
+
+// SetRecurrenceRule sets the "recurrence_rule" field.
+func (eu *EventUpdate) SetRecurrenceRule(s string) *EventUpdate {
+	eu.mutation.SetRecurrenceRule(s)
+	return eu
+}
+
+// SetNillableRecurrenceRule sets the "recurrence_rule" field if the given value is not nil.
+func (eu *EventUpdate) SetNillableRecurrenceRule(s *string) *EventUpdate {
+	if s != nil {
+		eu.SetRecurrenceRule(*s)
+	}
+	return eu
+}
+
+// ClearRecurrenceRule clears the value of the "recurrence_rule" field.
+func (eu *EventUpdate) ClearRecurrenceRule() *EventUpdate {
+	eu.mutation.ClearRecurrenceRule()
+	return eu
+}
+
+// SetSequence sets the "sequence" field.
+func (eu *EventUpdate) SetSequence(i int) *EventUpdate {
+	eu.mutation.ResetSequence()
+	eu.mutation.SetSequence(i)
+	return eu
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (eu *EventUpdate) SetNillableSequence(i *int) *EventUpdate {
+	if i != nil {
+		eu.SetSequence(*i)
+	}
+	return eu
+}
+
+// AddSequence adds i to the "sequence" field.
+func (eu *EventUpdate) AddSequence(i int) *EventUpdate {
+	eu.mutation.AddSequence(i)
+	return eu
+}
+
 // SetEmoji sets the "emoji" field.
 func (eu *EventUpdate) SetEmoji(s string) *EventUpdate {
 	eu.mutation.SetEmoji(s)
@@ -172,6 +256,51 @@ func (eu *EventUpdate) AddParticipants(p ...*Participant) *EventUpdate {
 	return eu.AddParticipantIDs(ids...)
 }
 
+// AddMessageIDs adds the "messages" edge to the Message entity by IDs.
+func (eu *EventUpdate) AddMessageIDs(ids ...int) *EventUpdate {
+	eu.mutation.AddMessageIDs(ids...)
+	return eu
+}
+
+// AddMessages adds the "messages" edges to the Message entity.
+func (eu *EventUpdate) AddMessages(m ...*Message) *EventUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return eu.AddMessageIDs(ids...)
+}
+
+// AddReactionIDs adds the "reactions" edge to the Reaction entity by IDs.
+func (eu *EventUpdate) AddReactionIDs(ids ...int) *EventUpdate {
+	eu.mutation.AddReactionIDs(ids...)
+	return eu
+}
+
+// AddReactions adds the "reactions" edges to the Reaction entity.
+func (eu *EventUpdate) AddReactions(r ...*Reaction) *EventUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return eu.AddReactionIDs(ids...)
+}
+
+// AddReadCursorIDs adds the "read_cursors" edge to the ReadCursor entity by IDs.
+func (eu *EventUpdate) AddReadCursorIDs(ids ...int) *EventUpdate {
+	eu.mutation.AddReadCursorIDs(ids...)
+	return eu
+}
+
+// AddReadCursors adds the "read_cursors" edges to the ReadCursor entity.
+func (eu *EventUpdate) AddReadCursors(r ...*ReadCursor) *EventUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return eu.AddReadCursorIDs(ids...)
+}
+
 // Mutation returns the EventMutation object of the builder.
 func (eu *EventUpdate) Mutation() *EventMutation {
 	return eu.mutation
@@ -204,6 +333,69 @@ func (eu *EventUpdate) RemoveParticipants(p ...*Participant) *EventUpdate {
 	return eu.RemoveParticipantIDs(ids...)
 }
 
+// ClearMessages clears all "messages" edges to the Message entity.
+func (eu *EventUpdate) ClearMessages() *EventUpdate {
+	eu.mutation.ClearMessages()
+	return eu
+}
+
+// RemoveMessageIDs removes the "messages" edge to Message entities by IDs.
+func (eu *EventUpdate) RemoveMessageIDs(ids ...int) *EventUpdate {
+	eu.mutation.RemoveMessageIDs(ids...)
+	return eu
+}
+
+// RemoveMessages removes "messages" edges to Message entities.
+func (eu *EventUpdate) RemoveMessages(m ...*Message) *EventUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return eu.RemoveMessageIDs(ids...)
+}
+
+// ClearReactions clears all "reactions" edges to the Reaction entity.
+func (eu *EventUpdate) ClearReactions() *EventUpdate {
+	eu.mutation.ClearReactions()
+	return eu
+}
+
+// RemoveReactionIDs removes the "reactions" edge to Reaction entities by IDs.
+func (eu *EventUpdate) RemoveReactionIDs(ids ...int) *EventUpdate {
+	eu.mutation.RemoveReactionIDs(ids...)
+	return eu
+}
+
+// RemoveReactions removes "reactions" edges to Reaction entities.
+func (eu *EventUpdate) RemoveReactions(r ...*Reaction) *EventUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return eu.RemoveReactionIDs(ids...)
+}
+
+// ClearReadCursors clears all "read_cursors" edges to the ReadCursor entity.
+func (eu *EventUpdate) ClearReadCursors() *EventUpdate {
+	eu.mutation.ClearReadCursors()
+	return eu
+}
+
+// RemoveReadCursorIDs removes the "read_cursors" edge to ReadCursor entities by IDs.
+func (eu *EventUpdate) RemoveReadCursorIDs(ids ...int) *EventUpdate {
+	eu.mutation.RemoveReadCursorIDs(ids...)
+	return eu
+}
+
+// RemoveReadCursors removes "read_cursors" edges to ReadCursor entities.
+func (eu *EventUpdate) RemoveReadCursors(r ...*ReadCursor) *EventUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return eu.RemoveReadCursorIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (eu *EventUpdate) Save(ctx context.Context) (int, error) {
 	eu.defaults()
@@ -242,6 +434,26 @@ func (eu *EventUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (eu *EventUpdate) check() error {
+	if v, ok := eu.mutation.TimeZone(); ok {
+		if err := event.TimeZoneValidator(v); err != nil {
+			return &ValidationError{Name: "time_zone", err: fmt.Errorf(`ent: validator failed for field "Event.time_zone": %w`, err)}
+		}
+	}
+	if v, ok := eu.mutation.RecurrenceRule(); ok {
+		if err := event.RecurrenceRuleValidator(v); err != nil {
+			return &ValidationError{Name: "recurrence_rule", err: fmt.Errorf(`ent: validator failed for field "Event.recurrence_rule": %w`, err)}
+		}
+	}
+	if v, ok := eu.mutation.Sequence(); ok {
+		if err := event.SequenceValidator(v); err != nil {
+			return &ValidationError{Name: "sequence", err: fmt.Errorf(`ent: validator failed for field "Event.sequence": %w`, err)}
+		}
+	}
+	if v, ok := eu.mutation.Emoji(); ok {
+		if err := event.EmojiValidator(v); err != nil {
+			return &ValidationError{Name: "emoji", err: fmt.Errorf(`ent: validator failed for field "Event.emoji": %w`, err)}
+		}
+	}
 	if v, ok := eu.mutation.Visibility(); ok {
 		if err := event.VisibilityValidator(v); err != nil {
 			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Event.visibility": %w`, err)}
@@ -265,6 +477,12 @@ func (eu *EventUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := eu.mutation.DeletedAt(); ok {
+		_spec.SetField(event.FieldDeletedAt, field.TypeTime, value)
+	}
+	if eu.mutation.DeletedAtCleared() {
+		_spec.ClearField(event.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := eu.mutation.Title(); ok {
 		_spec.SetField(event.FieldTitle, field.TypeString, value)
 	}
@@ -280,6 +498,27 @@ func (eu *EventUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := eu.mutation.EndTime(); ok {
 		_spec.SetField(event.FieldEndTime, field.TypeTime, value)
 	}
+	if value, ok := eu.mutation.TimeZone(); ok {
+		_spec.SetField(event.FieldTimeZone, field.TypeString, value)
+	}
+	if eu.mutation.TimeZoneCleared() {
+		_spec.ClearField(event.FieldTimeZone, field.TypeString)
+	}
+	if value, ok := eu.mutation.RecurrenceRule(); ok {
+		_spec.SetField(event.FieldRecurrenceRule, field.TypeString, value)
+	}
+	if eu.mutation.RecurrenceRuleCleared() {
+		_spec.ClearField(event.FieldRecurrenceRule, field.TypeString)
+	}
+	if eu.mutation.IcalUIDCleared() {
+		_spec.ClearField(event.FieldIcalUID, field.TypeString)
+	}
+	if value, ok := eu.mutation.Sequence(); ok {
+		_spec.SetField(event.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := eu.mutation.AddedSequence(); ok {
+		_spec.AddField(event.FieldSequence, field.TypeInt, value)
+	}
 	if value, ok := eu.mutation.Emoji(); ok {
 		_spec.SetField(event.FieldEmoji, field.TypeString, value)
 	}
@@ -369,6 +608,141 @@ func (eu *EventUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if eu.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.MessagesTable,
+			Columns: []string{event.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedMessagesIDs(); len(nodes) > 0 && !eu.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.MessagesTable,
+			Columns: []string{event.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.MessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.MessagesTable,
+			Columns: []string{event.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ReactionsTable,
+			Columns: []string{event.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedReactionsIDs(); len(nodes) > 0 && !eu.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ReactionsTable,
+			Columns: []string{event.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ReactionsTable,
+			Columns: []string{event.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.ReadCursorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ReadCursorsTable,
+			Columns: []string{event.ReadCursorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readcursor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedReadCursorsIDs(); len(nodes) > 0 && !eu.mutation.ReadCursorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ReadCursorsTable,
+			Columns: []string{event.ReadCursorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readcursor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.ReadCursorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ReadCursorsTable,
+			Columns: []string{event.ReadCursorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readcursor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, eu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{event.Label}
@@ -389,6 +763,26 @@ type EventUpdateOne struct {
 	mutation *EventMutation
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (euo *EventUpdateOne) SetDeletedAt(t time.Time) *EventUpdateOne {
+	euo.mutation.SetDeletedAt(t)
+	return euo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableDeletedAt(t *time.Time) *EventUpdateOne {
+	if t != nil {
+		euo.SetDeletedAt(*t)
+	}
+	return euo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (euo *EventUpdateOne) ClearDeletedAt() *EventUpdateOne {
+	euo.mutation.ClearDeletedAt()
+	return euo
+}
+
 // SetTitle sets the "title" field.
 func (euo *EventUpdateOne) SetTitle(s string) *EventUpdateOne {
 	euo.mutation.SetTitle(s)
@@ -451,6 +845,67 @@ func (euo *EventUpdateOne) SetNillableEndTime(t *time.Time) *EventUpdateOne {
 	return euo
 }
 
+// SetTimeZone sets the "time_zone" field.
+func (euo *EventUpdateOne) SetTimeZone(s string) *EventUpdateOne {
+	euo.mutation.SetTimeZone(s)
+	return euo
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableTimeZone(s *string) *EventUpdateOne {
+	if s != nil {
+		euo.SetTimeZone(*s)
+	}
+	return euo
+}
+
+// ClearTimeZone clears the value of the "time_zone" field.
+func (euo *EventUpdateOne) ClearTimeZone() *EventUpdateOne {
+	euo.mutation.ClearTimeZone()
+	return euo
+}
+
+// SetRecurrenceRule sets the "recurrence_rule" field.
+func (euo *EventUpdateOne) SetRecurrenceRule(s string) *EventUpdateOne {
+	euo.mutation.SetRecurrenceRule(s)
+	return euo
+}
+
+// SetNillableRecurrenceRule sets the "recurrence_rule" field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableRecurrenceRule(s *string) *EventUpdateOne {
+	if s != nil {
+		euo.SetRecurrenceRule(*s)
+	}
+	return euo
+}
+
+// ClearRecurrenceRule clears the value of the "recurrence_rule" field.
+func (euo *EventUpdateOne) ClearRecurrenceRule() *EventUpdateOne {
+	euo.mutation.ClearRecurrenceRule()
+	return euo
+}
+
+// SetSequence sets the "sequence" field.
+func (euo *EventUpdateOne) SetSequence(i int) *EventUpdateOne {
+	euo.mutation.ResetSequence()
+	euo.mutation.SetSequence(i)
+	return euo
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableSequence(i *int) *EventUpdateOne {
+	if i != nil {
+		euo.SetSequence(*i)
+	}
+	return euo
+}
+
+// AddSequence adds i to the "sequence" field.
+func (euo *EventUpdateOne) AddSequence(i int) *EventUpdateOne {
+	euo.mutation.AddSequence(i)
+	return euo
+}
+
 // SetEmoji sets the "emoji" field.
 func (euo *EventUpdateOne) SetEmoji(s string) *EventUpdateOne {
 	euo.mutation.SetEmoji(s)
@@ -531,6 +986,51 @@ func (euo *EventUpdateOne) AddParticipants(p ...*Participant) *EventUpdateOne {
 	return euo.AddParticipantIDs(ids...)
 }
 
+// AddMessageIDs adds the "messages" edge to the Message entity by IDs.
+func (euo *EventUpdateOne) AddMessageIDs(ids ...int) *EventUpdateOne {
+	euo.mutation.AddMessageIDs(ids...)
+	return euo
+}
+
+// AddMessages adds the "messages" edges to the Message entity.
+func (euo *EventUpdateOne) AddMessages(m ...*Message) *EventUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return euo.AddMessageIDs(ids...)
+}
+
+// AddReactionIDs adds the "reactions" edge to the Reaction entity by IDs.
+func (euo *EventUpdateOne) AddReactionIDs(ids ...int) *EventUpdateOne {
+	euo.mutation.AddReactionIDs(ids...)
+	return euo
+}
+
+// AddReactions adds the "reactions" edges to the Reaction entity.
+func (euo *EventUpdateOne) AddReactions(r ...*Reaction) *EventUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return euo.AddReactionIDs(ids...)
+}
+
+// AddReadCursorIDs adds the "read_cursors" edge to the ReadCursor entity by IDs.
+func (euo *EventUpdateOne) AddReadCursorIDs(ids ...int) *EventUpdateOne {
+	euo.mutation.AddReadCursorIDs(ids...)
+	return euo
+}
+
+// AddReadCursors adds the "read_cursors" edges to the ReadCursor entity.
+func (euo *EventUpdateOne) AddReadCursors(r ...*ReadCursor) *EventUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return euo.AddReadCursorIDs(ids...)
+}
+
 // Mutation returns the EventMutation object of the builder.
 func (euo *EventUpdateOne) Mutation() *EventMutation {
 	return euo.mutation
@@ -563,6 +1063,69 @@ func (euo *EventUpdateOne) RemoveParticipants(p ...*Participant) *EventUpdateOne
 	return euo.RemoveParticipantIDs(ids...)
 }
 
+// ClearMessages clears all "messages" edges to the Message entity.
+func (euo *EventUpdateOne) ClearMessages() *EventUpdateOne {
+	euo.mutation.ClearMessages()
+	return euo
+}
+
+// RemoveMessageIDs removes the "messages" edge to Message entities by IDs.
+func (euo *EventUpdateOne) RemoveMessageIDs(ids ...int) *EventUpdateOne {
+	euo.mutation.RemoveMessageIDs(ids...)
+	return euo
+}
+
+// RemoveMessages removes "messages" edges to Message entities.
+func (euo *EventUpdateOne) RemoveMessages(m ...*Message) *EventUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return euo.RemoveMessageIDs(ids...)
+}
+
+// ClearReactions clears all "reactions" edges to the Reaction entity.
+func (euo *EventUpdateOne) ClearReactions() *EventUpdateOne {
+	euo.mutation.ClearReactions()
+	return euo
+}
+
+// RemoveReactionIDs removes the "reactions" edge to Reaction entities by IDs.
+func (euo *EventUpdateOne) RemoveReactionIDs(ids ...int) *EventUpdateOne {
+	euo.mutation.RemoveReactionIDs(ids...)
+	return euo
+}
+
+// RemoveReactions removes "reactions" edges to Reaction entities.
+func (euo *EventUpdateOne) RemoveReactions(r ...*Reaction) *EventUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return euo.RemoveReactionIDs(ids...)
+}
+
+// ClearReadCursors clears all "read_cursors" edges to the ReadCursor entity.
+func (euo *EventUpdateOne) ClearReadCursors() *EventUpdateOne {
+	euo.mutation.ClearReadCursors()
+	return euo
+}
+
+// RemoveReadCursorIDs removes the "read_cursors" edge to ReadCursor entities by IDs.
+func (euo *EventUpdateOne) RemoveReadCursorIDs(ids ...int) *EventUpdateOne {
+	euo.mutation.RemoveReadCursorIDs(ids...)
+	return euo
+}
+
+// RemoveReadCursors removes "read_cursors" edges to ReadCursor entities.
+func (euo *EventUpdateOne) RemoveReadCursors(r ...*ReadCursor) *EventUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return euo.RemoveReadCursorIDs(ids...)
+}
+
 // Where appends a list predicates to the EventUpdate builder.
 func (euo *EventUpdateOne) Where(ps ...predicate.Event) *EventUpdateOne {
 	euo.mutation.Where(ps...)
@@ -614,6 +1177,26 @@ func (euo *EventUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (euo *EventUpdateOne) check() error {
+	if v, ok := euo.mutation.TimeZone(); ok {
+		if err := event.TimeZoneValidator(v); err != nil {
+			return &ValidationError{Name: "time_zone", err: fmt.Errorf(`ent: validator failed for field "Event.time_zone": %w`, err)}
+		}
+	}
+	if v, ok := euo.mutation.RecurrenceRule(); ok {
+		if err := event.RecurrenceRuleValidator(v); err != nil {
+			return &ValidationError{Name: "recurrence_rule", err: fmt.Errorf(`ent: validator failed for field "Event.recurrence_rule": %w`, err)}
+		}
+	}
+	if v, ok := euo.mutation.Sequence(); ok {
+		if err := event.SequenceValidator(v); err != nil {
+			return &ValidationError{Name: "sequence", err: fmt.Errorf(`ent: validator failed for field "Event.sequence": %w`, err)}
+		}
+	}
+	if v, ok := euo.mutation.Emoji(); ok {
+		if err := event.EmojiValidator(v); err != nil {
+			return &ValidationError{Name: "emoji", err: fmt.Errorf(`ent: validator failed for field "Event.emoji": %w`, err)}
+		}
+	}
 	if v, ok := euo.mutation.Visibility(); ok {
 		if err := event.VisibilityValidator(v); err != nil {
 			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Event.visibility": %w`, err)}
@@ -654,6 +1237,12 @@ func (euo *EventUpdateOne) sqlSave(ctx context.Context) (_node *Event, err error
 			}
 		}
 	}
+	if value, ok := euo.mutation.DeletedAt(); ok {
+		_spec.SetField(event.FieldDeletedAt, field.TypeTime, value)
+	}
+	if euo.mutation.DeletedAtCleared() {
+		_spec.ClearField(event.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := euo.mutation.Title(); ok {
 		_spec.SetField(event.FieldTitle, field.TypeString, value)
 	}
@@ -669,6 +1258,27 @@ func (euo *EventUpdateOne) sqlSave(ctx context.Context) (_node *Event, err error
 	if value, ok := euo.mutation.EndTime(); ok {
 		_spec.SetField(event.FieldEndTime, field.TypeTime, value)
 	}
+	if value, ok := euo.mutation.TimeZone(); ok {
+		_spec.SetField(event.FieldTimeZone, field.TypeString, value)
+	}
+	if euo.mutation.TimeZoneCleared() {
+		_spec.ClearField(event.FieldTimeZone, field.TypeString)
+	}
+	if value, ok := euo.mutation.RecurrenceRule(); ok {
+		_spec.SetField(event.FieldRecurrenceRule, field.TypeString, value)
+	}
+	if euo.mutation.RecurrenceRuleCleared() {
+		_spec.ClearField(event.FieldRecurrenceRule, field.TypeString)
+	}
+	if euo.mutation.IcalUIDCleared() {
+		_spec.ClearField(event.FieldIcalUID, field.TypeString)
+	}
+	if value, ok := euo.mutation.Sequence(); ok {
+		_spec.SetField(event.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := euo.mutation.AddedSequence(); ok {
+		_spec.AddField(event.FieldSequence, field.TypeInt, value)
+	}
 	if value, ok := euo.mutation.Emoji(); ok {
 		_spec.SetField(event.FieldEmoji, field.TypeString, value)
 	}
@@ -758,6 +1368,141 @@ func (euo *EventUpdateOne) sqlSave(ctx context.Context) (_node *Event, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if euo.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.MessagesTable,
+			Columns: []string{event.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedMessagesIDs(); len(nodes) > 0 && !euo.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.MessagesTable,
+			Columns: []string{event.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.MessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.MessagesTable,
+			Columns: []string{event.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ReactionsTable,
+			Columns: []string{event.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedReactionsIDs(); len(nodes) > 0 && !euo.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ReactionsTable,
+			Columns: []string{event.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ReactionsTable,
+			Columns: []string{event.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.ReadCursorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ReadCursorsTable,
+			Columns: []string{event.ReadCursorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readcursor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedReadCursorsIDs(); len(nodes) > 0 && !euo.mutation.ReadCursorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ReadCursorsTable,
+			Columns: []string{event.ReadCursorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readcursor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.ReadCursorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.ReadCursorsTable,
+			Columns: []string{event.ReadCursorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readcursor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Event{config: euo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/message"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+)
+
+// Message is the model entity for the Message schema.
+type Message struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// メッセージ本文
+	Body string `json:"body,omitempty"`
+	// 最終編集日時
+	EditedAt *time.Time `json:"edited_at,omitempty"`
+	// 削除日時 (履歴保持のため行は残す)
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 送信日時
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新日時
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MessageQuery when eager-loading is set.
+	Edges          MessageEdges `json:"edges"`
+	event_messages *int
+	user_messages  *int
+	selectValues   sql.SelectValues
+}
+
+// MessageEdges holds the relations/edges for other nodes in the graph.
+type MessageEdges struct {
+	// 投稿先のイベント
+	Event *Event `json:"event,omitempty"`
+	// メッセージの送信者
+	Author *User `json:"author,omitempty"`
+	// メッセージへのリアクション
+	Reactions []*Reaction `json:"reactions,omitempty"`
+	// メッセージの添付ファイル
+	Attachments []*Attachment `json:"attachments,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// EventOrErr returns the Event value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEdges) EventOrErr() (*Event, error) {
+	if e.Event != nil {
+		return e.Event, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: event.Label}
+	}
+	return nil, &NotLoadedError{edge: "event"}
+}
+
+// AuthorOrErr returns the Author value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEdges) AuthorOrErr() (*User, error) {
+	if e.Author != nil {
+		return e.Author, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "author"}
+}
+
+// ReactionsOrErr returns the Reactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) ReactionsOrErr() ([]*Reaction, error) {
+	if e.loadedTypes[2] {
+		return e.Reactions, nil
+	}
+	return nil, &NotLoadedError{edge: "reactions"}
+}
+
+// AttachmentsOrErr returns the Attachments value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) AttachmentsOrErr() ([]*Attachment, error) {
+	if e.loadedTypes[3] {
+		return e.Attachments, nil
+	}
+	return nil, &NotLoadedError{edge: "attachments"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Message) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case message.FieldID:
+			values[i] = new(sql.NullInt64)
+		case message.FieldBody:
+			values[i] = new(sql.NullString)
+		case message.FieldEditedAt, message.FieldDeletedAt, message.FieldCreatedAt, message.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case message.ForeignKeys[0]: // event_messages
+			values[i] = new(sql.NullInt64)
+		case message.ForeignKeys[1]: // user_messages
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Message fields.
+func (m *Message) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case message.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			m.ID = int(value.Int64)
+		case message.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				m.Body = value.String
+			}
+		case message.FieldEditedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field edited_at", values[i])
+			} else if value.Valid {
+				m.EditedAt = new(time.Time)
+				*m.EditedAt = value.Time
+			}
+		case message.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				m.DeletedAt = new(time.Time)
+				*m.DeletedAt = value.Time
+			}
+		case message.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				m.CreatedAt = value.Time
+			}
+		case message.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				m.UpdatedAt = value.Time
+			}
+		case message.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field event_messages", value)
+			} else if value.Valid {
+				m.event_messages = new(int)
+				*m.event_messages = int(value.Int64)
+			}
+		case message.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_messages", value)
+			} else if value.Valid {
+				m.user_messages = new(int)
+				*m.user_messages = int(value.Int64)
+			}
+		default:
+			m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Message.
+// This includes values selected through modifiers, order, etc.
+func (m *Message) Value(name string) (ent.Value, error) {
+	return m.selectValues.Get(name)
+}
+
+// QueryEvent queries the "event" edge of the Message entity.
+func (m *Message) QueryEvent() *EventQuery {
+	return NewMessageClient(m.config).QueryEvent(m)
+}
+
+// QueryAuthor queries the "author" edge of the Message entity.
+func (m *Message) QueryAuthor() *UserQuery {
+	return NewMessageClient(m.config).QueryAuthor(m)
+}
+
+// QueryReactions queries the "reactions" edge of the Message entity.
+func (m *Message) QueryReactions() *ReactionQuery {
+	return NewMessageClient(m.config).QueryReactions(m)
+}
+
+// QueryAttachments queries the "attachments" edge of the Message entity.
+func (m *Message) QueryAttachments() *AttachmentQuery {
+	return NewMessageClient(m.config).QueryAttachments(m)
+}
+
+// Update returns a builder for updating this Message.
+// Note that you need to call Message.Unwrap() before calling this method if this Message
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (m *Message) Update() *MessageUpdateOne {
+	return NewMessageClient(m.config).UpdateOne(m)
+}
+
+// Unwrap unwraps the Message entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (m *Message) Unwrap() *Message {
+	_tx, ok := m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Message is not a transactional entity")
+	}
+	m.config.driver = _tx.drv
+	return m
+}
+
+// String implements the fmt.Stringer.
+func (m *Message) String() string {
+	var builder strings.Builder
+	builder.WriteString("Message(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", m.ID))
+	builder.WriteString("body=")
+	builder.WriteString(m.Body)
+	builder.WriteString(", ")
+	if v := m.EditedAt; v != nil {
+		builder.WriteString("edited_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Messages is a parsable slice of Message.
+type Messages []*Message
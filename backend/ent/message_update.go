@@ -0,0 +1,910 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/matsuokashuhei/morrow-backend/ent/attachment"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/message"
+	"github.com/matsuokashuhei/morrow-backend/ent/predicate"
+	"github.com/matsuokashuhei/morrow-backend/ent/reaction"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+)
+
+// MessageUpdate is the builder for updating Message entities.
+type MessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MessageMutation
+}
+
+// Where appends a list predicates to the MessageUpdate builder.
+func (mu *MessageUpdate) Where(ps ...predicate.Message) *MessageUpdate {
+	mu.mutation.Where(ps...)
+	return mu
+}
+
+// SetBody sets the "body" field.
+func (mu *MessageUpdate) SetBody(s string) *MessageUpdate {
+	mu.mutation.SetBody(s)
+	return mu
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableBody(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetBody(*s)
+	}
+	return mu
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (mu *MessageUpdate) SetEditedAt(t time.Time) *MessageUpdate {
+	mu.mutation.SetEditedAt(t)
+	return mu
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableEditedAt(t *time.Time) *MessageUpdate {
+	if t != nil {
+		mu.SetEditedAt(*t)
+	}
+	return mu
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (mu *MessageUpdate) ClearEditedAt() *MessageUpdate {
+	mu.mutation.ClearEditedAt()
+	return mu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (mu *MessageUpdate) SetDeletedAt(t time.Time) *MessageUpdate {
+	mu.mutation.SetDeletedAt(t)
+	return mu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableDeletedAt(t *time.Time) *MessageUpdate {
+	if t != nil {
+		mu.SetDeletedAt(*t)
+	}
+	return mu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (mu *MessageUpdate) ClearDeletedAt() *MessageUpdate {
+	mu.mutation.ClearDeletedAt()
+	return mu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mu *MessageUpdate) SetUpdatedAt(t time.Time) *MessageUpdate {
+	mu.mutation.SetUpdatedAt(t)
+	return mu
+}
+
+// SetEventID sets the "event" edge to the Event entity by ID.
+func (mu *MessageUpdate) SetEventID(id int) *MessageUpdate {
+	mu.mutation.SetEventID(id)
+	return mu
+}
+
+// SetEvent sets the "event" edge to the Event entity.
+func (mu *MessageUpdate) SetEvent(e *Event) *MessageUpdate {
+	return mu.SetEventID(e.ID)
+}
+
+// SetAuthorID sets the "author" edge to the User entity by ID.
+func (mu *MessageUpdate) SetAuthorID(id int) *MessageUpdate {
+	mu.mutation.SetAuthorID(id)
+	return mu
+}
+
+// SetNillableAuthorID sets the "author" edge to the User entity by ID if the given value is not nil.
+func (mu *MessageUpdate) SetNillableAuthorID(id *int) *MessageUpdate {
+	if id != nil {
+		mu = mu.SetAuthorID(*id)
+	}
+	return mu
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (mu *MessageUpdate) SetAuthor(u *User) *MessageUpdate {
+	return mu.SetAuthorID(u.ID)
+}
+
+// AddReactionIDs adds the "reactions" edge to the Reaction entity by IDs.
+func (mu *MessageUpdate) AddReactionIDs(ids ...int) *MessageUpdate {
+	mu.mutation.AddReactionIDs(ids...)
+	return mu
+}
+
+// AddReactions adds the "reactions" edges to the Reaction entity.
+func (mu *MessageUpdate) AddReactions(r ...*Reaction) *MessageUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return mu.AddReactionIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (mu *MessageUpdate) AddAttachmentIDs(ids ...int) *MessageUpdate {
+	mu.mutation.AddAttachmentIDs(ids...)
+	return mu
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (mu *MessageUpdate) AddAttachments(a ...*Attachment) *MessageUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return mu.AddAttachmentIDs(ids...)
+}
+
+// Mutation returns the MessageMutation object of the builder.
+func (mu *MessageUpdate) Mutation() *MessageMutation {
+	return mu.mutation
+}
+
+// ClearEvent clears the "event" edge to the Event entity.
+func (mu *MessageUpdate) ClearEvent() *MessageUpdate {
+	mu.mutation.ClearEvent()
+	return mu
+}
+
+// ClearAuthor clears the "author" edge to the User entity.
+func (mu *MessageUpdate) ClearAuthor() *MessageUpdate {
+	mu.mutation.ClearAuthor()
+	return mu
+}
+
+// ClearReactions clears all "reactions" edges to the Reaction entity.
+func (mu *MessageUpdate) ClearReactions() *MessageUpdate {
+	mu.mutation.ClearReactions()
+	return mu
+}
+
+// RemoveReactionIDs removes the "reactions" edge to Reaction entities by IDs.
+func (mu *MessageUpdate) RemoveReactionIDs(ids ...int) *MessageUpdate {
+	mu.mutation.RemoveReactionIDs(ids...)
+	return mu
+}
+
+// RemoveReactions removes "reactions" edges to Reaction entities.
+func (mu *MessageUpdate) RemoveReactions(r ...*Reaction) *MessageUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return mu.RemoveReactionIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (mu *MessageUpdate) ClearAttachments() *MessageUpdate {
+	mu.mutation.ClearAttachments()
+	return mu
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (mu *MessageUpdate) RemoveAttachmentIDs(ids ...int) *MessageUpdate {
+	mu.mutation.RemoveAttachmentIDs(ids...)
+	return mu
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (mu *MessageUpdate) RemoveAttachments(a ...*Attachment) *MessageUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return mu.RemoveAttachmentIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mu *MessageUpdate) Save(ctx context.Context) (int, error) {
+	mu.defaults()
+	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mu *MessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := mu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mu *MessageUpdate) Exec(ctx context.Context) error {
+	_, err := mu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mu *MessageUpdate) ExecX(ctx context.Context) {
+	if err := mu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mu *MessageUpdate) defaults() {
+	if _, ok := mu.mutation.UpdatedAt(); !ok {
+		v := message.UpdateDefaultUpdatedAt()
+		mu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mu *MessageUpdate) check() error {
+	if v, ok := mu.mutation.Body(); ok {
+		if err := message.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "Message.body": %w`, err)}
+		}
+	}
+	if mu.mutation.EventCleared() && len(mu.mutation.EventIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Message.event"`)
+	}
+	return nil
+}
+
+func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(message.Table, message.Columns, sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt))
+	if ps := mu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mu.mutation.Body(); ok {
+		_spec.SetField(message.FieldBody, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.EditedAt(); ok {
+		_spec.SetField(message.FieldEditedAt, field.TypeTime, value)
+	}
+	if mu.mutation.EditedAtCleared() {
+		_spec.ClearField(message.FieldEditedAt, field.TypeTime)
+	}
+	if value, ok := mu.mutation.DeletedAt(); ok {
+		_spec.SetField(message.FieldDeletedAt, field.TypeTime, value)
+	}
+	if mu.mutation.DeletedAtCleared() {
+		_spec.ClearField(message.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := mu.mutation.UpdatedAt(); ok {
+		_spec.SetField(message.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if mu.mutation.EventCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.EventTable,
+			Columns: []string{message.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.EventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.EventTable,
+			Columns: []string{message.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.AuthorTable,
+			Columns: []string{message.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.AuthorTable,
+			Columns: []string{message.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedReactionsIDs(); len(nodes) > 0 && !mu.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.AttachmentsTable,
+			Columns: []string{message.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !mu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.AttachmentsTable,
+			Columns: []string{message.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.AttachmentsTable,
+			Columns: []string{message.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{message.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mu.mutation.done = true
+	return n, nil
+}
+
+// MessageUpdateOne is the builder for updating a single Message entity.
+type MessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MessageMutation
+}
+
+// SetBody sets the "body" field.
+func (muo *MessageUpdateOne) SetBody(s string) *MessageUpdateOne {
+	muo.mutation.SetBody(s)
+	return muo
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableBody(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetBody(*s)
+	}
+	return muo
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (muo *MessageUpdateOne) SetEditedAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetEditedAt(t)
+	return muo
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableEditedAt(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetEditedAt(*t)
+	}
+	return muo
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (muo *MessageUpdateOne) ClearEditedAt() *MessageUpdateOne {
+	muo.mutation.ClearEditedAt()
+	return muo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (muo *MessageUpdateOne) SetDeletedAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetDeletedAt(t)
+	return muo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableDeletedAt(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetDeletedAt(*t)
+	}
+	return muo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (muo *MessageUpdateOne) ClearDeletedAt() *MessageUpdateOne {
+	muo.mutation.ClearDeletedAt()
+	return muo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (muo *MessageUpdateOne) SetUpdatedAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetUpdatedAt(t)
+	return muo
+}
+
+// SetEventID sets the "event" edge to the Event entity by ID.
+func (muo *MessageUpdateOne) SetEventID(id int) *MessageUpdateOne {
+	muo.mutation.SetEventID(id)
+	return muo
+}
+
+// SetEvent sets the "event" edge to the Event entity.
+func (muo *MessageUpdateOne) SetEvent(e *Event) *MessageUpdateOne {
+	return muo.SetEventID(e.ID)
+}
+
+// SetAuthorID sets the "author" edge to the User entity by ID.
+func (muo *MessageUpdateOne) SetAuthorID(id int) *MessageUpdateOne {
+	muo.mutation.SetAuthorID(id)
+	return muo
+}
+
+// SetNillableAuthorID sets the "author" edge to the User entity by ID if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableAuthorID(id *int) *MessageUpdateOne {
+	if id != nil {
+		muo = muo.SetAuthorID(*id)
+	}
+	return muo
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (muo *MessageUpdateOne) SetAuthor(u *User) *MessageUpdateOne {
+	return muo.SetAuthorID(u.ID)
+}
+
+// AddReactionIDs adds the "reactions" edge to the Reaction entity by IDs.
+func (muo *MessageUpdateOne) AddReactionIDs(ids ...int) *MessageUpdateOne {
+	muo.mutation.AddReactionIDs(ids...)
+	return muo
+}
+
+// AddReactions adds the "reactions" edges to the Reaction entity.
+func (muo *MessageUpdateOne) AddReactions(r ...*Reaction) *MessageUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return muo.AddReactionIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (muo *MessageUpdateOne) AddAttachmentIDs(ids ...int) *MessageUpdateOne {
+	muo.mutation.AddAttachmentIDs(ids...)
+	return muo
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (muo *MessageUpdateOne) AddAttachments(a ...*Attachment) *MessageUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return muo.AddAttachmentIDs(ids...)
+}
+
+// Mutation returns the MessageMutation object of the builder.
+func (muo *MessageUpdateOne) Mutation() *MessageMutation {
+	return muo.mutation
+}
+
+// ClearEvent clears the "event" edge to the Event entity.
+func (muo *MessageUpdateOne) ClearEvent() *MessageUpdateOne {
+	muo.mutation.ClearEvent()
+	return muo
+}
+
+// ClearAuthor clears the "author" edge to the User entity.
+func (muo *MessageUpdateOne) ClearAuthor() *MessageUpdateOne {
+	muo.mutation.ClearAuthor()
+	return muo
+}
+
+// ClearReactions clears all "reactions" edges to the Reaction entity.
+func (muo *MessageUpdateOne) ClearReactions() *MessageUpdateOne {
+	muo.mutation.ClearReactions()
+	return muo
+}
+
+// RemoveReactionIDs removes the "reactions" edge to Reaction entities by IDs.
+func (muo *MessageUpdateOne) RemoveReactionIDs(ids ...int) *MessageUpdateOne {
+	muo.mutation.RemoveReactionIDs(ids...)
+	return muo
+}
+
+// RemoveReactions removes "reactions" edges to Reaction entities.
+func (muo *MessageUpdateOne) RemoveReactions(r ...*Reaction) *MessageUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return muo.RemoveReactionIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (muo *MessageUpdateOne) ClearAttachments() *MessageUpdateOne {
+	muo.mutation.ClearAttachments()
+	return muo
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (muo *MessageUpdateOne) RemoveAttachmentIDs(ids ...int) *MessageUpdateOne {
+	muo.mutation.RemoveAttachmentIDs(ids...)
+	return muo
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (muo *MessageUpdateOne) RemoveAttachments(a ...*Attachment) *MessageUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return muo.RemoveAttachmentIDs(ids...)
+}
+
+// Where appends a list predicates to the MessageUpdate builder.
+func (muo *MessageUpdateOne) Where(ps ...predicate.Message) *MessageUpdateOne {
+	muo.mutation.Where(ps...)
+	return muo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (muo *MessageUpdateOne) Select(field string, fields ...string) *MessageUpdateOne {
+	muo.fields = append([]string{field}, fields...)
+	return muo
+}
+
+// Save executes the query and returns the updated Message entity.
+func (muo *MessageUpdateOne) Save(ctx context.Context) (*Message, error) {
+	muo.defaults()
+	return withHooks(ctx, muo.sqlSave, muo.mutation, muo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (muo *MessageUpdateOne) SaveX(ctx context.Context) *Message {
+	node, err := muo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (muo *MessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := muo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (muo *MessageUpdateOne) ExecX(ctx context.Context) {
+	if err := muo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (muo *MessageUpdateOne) defaults() {
+	if _, ok := muo.mutation.UpdatedAt(); !ok {
+		v := message.UpdateDefaultUpdatedAt()
+		muo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (muo *MessageUpdateOne) check() error {
+	if v, ok := muo.mutation.Body(); ok {
+		if err := message.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "Message.body": %w`, err)}
+		}
+	}
+	if muo.mutation.EventCleared() && len(muo.mutation.EventIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Message.event"`)
+	}
+	return nil
+}
+
+func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err error) {
+	if err := muo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(message.Table, message.Columns, sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt))
+	id, ok := muo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Message.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := muo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, message.FieldID)
+		for _, f := range fields {
+			if !message.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != message.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := muo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := muo.mutation.Body(); ok {
+		_spec.SetField(message.FieldBody, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.EditedAt(); ok {
+		_spec.SetField(message.FieldEditedAt, field.TypeTime, value)
+	}
+	if muo.mutation.EditedAtCleared() {
+		_spec.ClearField(message.FieldEditedAt, field.TypeTime)
+	}
+	if value, ok := muo.mutation.DeletedAt(); ok {
+		_spec.SetField(message.FieldDeletedAt, field.TypeTime, value)
+	}
+	if muo.mutation.DeletedAtCleared() {
+		_spec.ClearField(message.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := muo.mutation.UpdatedAt(); ok {
+		_spec.SetField(message.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if muo.mutation.EventCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.EventTable,
+			Columns: []string{message.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.EventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.EventTable,
+			Columns: []string{message.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.AuthorTable,
+			Columns: []string{message.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.AuthorTable,
+			Columns: []string{message.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedReactionsIDs(); len(nodes) > 0 && !muo.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.AttachmentsTable,
+			Columns: []string{message.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !muo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.AttachmentsTable,
+			Columns: []string{message.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.AttachmentsTable,
+			Columns: []string{message.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Message{config: muo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, muo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{message.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	muo.mutation.done = true
+	return _node, nil
+}
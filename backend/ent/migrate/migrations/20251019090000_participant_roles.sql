@@ -0,0 +1,8 @@
+-- Participant roles now include "editor" and "moderator" (see internal/permission).
+-- The event creator is the only owner; co-organizers previously stored as "owner" become "editor".
+UPDATE "public"."participants" AS p
+SET "role" = 'editor'
+FROM "public"."events" AS e
+WHERE p."event_participants" = e."id"
+  AND p."role" = 'owner'
+  AND p."user_participants" <> e."user_created_events";
@@ -0,0 +1,17 @@
+-- Create "messages" table
+CREATE TABLE "public"."messages" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "body" text NOT NULL,
+  "edited_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "event_messages" bigint NOT NULL,
+  "user_messages" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "messages_events_messages" FOREIGN KEY ("event_messages") REFERENCES "public"."events" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "messages_users_messages" FOREIGN KEY ("user_messages") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+
+-- Create index "message_event_messages" to table: "messages"
+CREATE INDEX "message_event_messages" ON "public"."messages" ("event_messages");
@@ -0,0 +1,25 @@
+-- Create "reactions" table
+CREATE TABLE "public"."reactions" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "emoji" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "event_reactions" bigint NULL,
+  "message_reactions" bigint NULL,
+  "user_reactions" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "reactions_events_reactions" FOREIGN KEY ("event_reactions") REFERENCES "public"."events" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "reactions_messages_reactions" FOREIGN KEY ("message_reactions") REFERENCES "public"."messages" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "reactions_users_reactions" FOREIGN KEY ("user_reactions") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+
+-- Create index "reaction_emoji_user_reactions_message_reactions" to table: "reactions"
+CREATE UNIQUE INDEX "reaction_emoji_user_reactions_message_reactions" ON "public"."reactions" ("emoji", "user_reactions", "message_reactions");
+
+-- Create index "reaction_emoji_user_reactions_event_reactions" to table: "reactions"
+CREATE UNIQUE INDEX "reaction_emoji_user_reactions_event_reactions" ON "public"."reactions" ("emoji", "user_reactions", "event_reactions");
+
+-- Create index "reaction_message_reactions" to table: "reactions"
+CREATE INDEX "reaction_message_reactions" ON "public"."reactions" ("message_reactions");
+
+-- Create index "reaction_event_reactions" to table: "reactions"
+CREATE INDEX "reaction_event_reactions" ON "public"."reactions" ("event_reactions");
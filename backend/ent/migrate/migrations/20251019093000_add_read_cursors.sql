@@ -0,0 +1,17 @@
+-- Create "read_cursors" table
+CREATE TABLE "public"."read_cursors" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "last_read_message_id" bigint NOT NULL DEFAULT 0,
+  "read_at" timestamptz NOT NULL,
+  "event_read_cursors" bigint NOT NULL,
+  "user_read_cursors" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "read_cursors_events_read_cursors" FOREIGN KEY ("event_read_cursors") REFERENCES "public"."events" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "read_cursors_users_read_cursors" FOREIGN KEY ("user_read_cursors") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+
+-- Create index "readcursor_user_read_cursors_event_read_cursors" to table: "read_cursors"
+CREATE UNIQUE INDEX "readcursor_user_read_cursors_event_read_cursors" ON "public"."read_cursors" ("user_read_cursors", "event_read_cursors");
+
+-- Create index "readcursor_last_read_message_id_event_read_cursors" to table: "read_cursors"
+CREATE INDEX "readcursor_last_read_message_id_event_read_cursors" ON "public"."read_cursors" ("last_read_message_id", "event_read_cursors");
@@ -0,0 +1,19 @@
+-- Create "attachments" table
+CREATE TABLE "public"."attachments" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "key" character varying NOT NULL,
+  "thumbnail_key" character varying NULL,
+  "content_type" character varying NOT NULL,
+  "size" bigint NOT NULL,
+  "width" bigint NOT NULL,
+  "height" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "message_attachments" bigint NOT NULL,
+  "user_attachments" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "attachments_messages_attachments" FOREIGN KEY ("message_attachments") REFERENCES "public"."messages" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "attachments_users_attachments" FOREIGN KEY ("user_attachments") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+
+-- Create index "attachment_message_attachments" to table: "attachments"
+CREATE INDEX "attachment_message_attachments" ON "public"."attachments" ("message_attachments");
@@ -0,0 +1,8 @@
+-- Modify "events" table
+ALTER TABLE "public"."events" ADD COLUMN "time_zone" character varying NULL, ADD COLUMN "recurrence_rule" character varying NULL;
+
+-- Modify "users" table
+ALTER TABLE "public"."users" ADD COLUMN "calendar_token_hash" character varying NULL;
+
+-- Create index "users_calendar_token_hash_key" to table: "users"
+CREATE UNIQUE INDEX "users_calendar_token_hash_key" ON "public"."users" ("calendar_token_hash");
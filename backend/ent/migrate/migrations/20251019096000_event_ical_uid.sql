@@ -0,0 +1,5 @@
+-- Modify "events" table
+ALTER TABLE "public"."events" ADD COLUMN "ical_uid" character varying NULL;
+
+-- Create index "event_ical_uid_user_created_events" to table: "events"
+CREATE UNIQUE INDEX "event_ical_uid_user_created_events" ON "public"."events" ("ical_uid", "user_created_events");
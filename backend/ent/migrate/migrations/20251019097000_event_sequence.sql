@@ -0,0 +1,2 @@
+-- Modify "events" table
+ALTER TABLE "public"."events" ADD COLUMN "sequence" bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,40 @@
+-- Modify "users" table
+ALTER TABLE "public"."users" ADD COLUMN "is_admin" boolean NOT NULL DEFAULT false;
+
+-- Create "plans" table
+CREATE TABLE "public"."plans" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "key" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "max_active_events" bigint NULL,
+  "max_participants_per_event" bigint NULL,
+  "max_attachment_storage" bigint NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+
+-- Create index "plans_key_key" to table: "plans"
+CREATE UNIQUE INDEX "plans_key_key" ON "public"."plans" ("key");
+
+-- Create "subscriptions" table
+CREATE TABLE "public"."subscriptions" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "status" character varying NOT NULL DEFAULT 'active',
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "plan_subscriptions" bigint NOT NULL,
+  "user_subscription" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "subscriptions_plans_subscriptions" FOREIGN KEY ("plan_subscriptions") REFERENCES "public"."plans" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "subscriptions_users_subscription" FOREIGN KEY ("user_subscription") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+
+-- Create index "subscriptions_user_subscription_key" to table: "subscriptions"
+CREATE UNIQUE INDEX "subscriptions_user_subscription_key" ON "public"."subscriptions" ("user_subscription");
+
+-- Seed the default plans (internal/entitlement.Catalog)
+INSERT INTO "public"."plans" ("key", "name", "max_active_events", "max_participants_per_event", "max_attachment_storage", "created_at", "updated_at") VALUES
+  ('free', 'Free', 10, 10, 104857600, now(), now()),
+  ('pro', 'Pro', 200, 100, 10737418240, now(), now()),
+  ('team', 'Team', NULL, 500, 107374182400, now(), now());
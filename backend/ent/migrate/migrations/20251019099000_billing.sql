@@ -0,0 +1,28 @@
+-- Modify "subscriptions" table
+ALTER TABLE "public"."subscriptions" ADD COLUMN "provider_customer_id" character varying NULL, ADD COLUMN "provider_subscription_id" character varying NULL, ADD COLUMN "current_period_end" timestamptz NULL, ADD COLUMN "last_event_at" timestamptz NULL;
+
+-- Create index "subscriptions_provider_subscription_id_key" to table: "subscriptions"
+CREATE UNIQUE INDEX "subscriptions_provider_subscription_id_key" ON "public"."subscriptions" ("provider_subscription_id");
+
+-- Create "billing_events" table
+CREATE TABLE "public"."billing_events" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "provider" character varying NOT NULL,
+  "provider_event_id" character varying NOT NULL,
+  "type" character varying NOT NULL,
+  "plan_key" character varying NULL,
+  "provider_subscription_id" character varying NULL,
+  "period_end" timestamptz NULL,
+  "payload" text NOT NULL,
+  "occurred_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "user_billing_events" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "billing_events_users_billing_events" FOREIGN KEY ("user_billing_events") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+
+-- Create index "billing_events_provider_event_id_key" to table: "billing_events"
+CREATE UNIQUE INDEX "billing_events_provider_event_id_key" ON "public"."billing_events" ("provider_event_id");
+
+-- Create index "billingevent_occurred_at_user_billing_events" to table: "billing_events"
+CREATE INDEX "billingevent_occurred_at_user_billing_events" ON "public"."billing_events" ("occurred_at", "user_billing_events");
@@ -0,0 +1,20 @@
+-- Create "audit_entries" table
+CREATE TABLE "public"."audit_entries" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "actor_id" bigint NULL,
+  "actor_subject" character varying NULL,
+  "entity_type" character varying NOT NULL,
+  "entity_id" bigint NOT NULL,
+  "operation" character varying NOT NULL,
+  "changes" jsonb NOT NULL,
+  "request_id" character varying NULL,
+  "ip" character varying NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+
+-- Create index "auditentry_entity_type_entity_id" to table: "audit_entries"
+CREATE INDEX "auditentry_entity_type_entity_id" ON "public"."audit_entries" ("entity_type", "entity_id");
+
+-- Create index "auditentry_actor_id" to table: "audit_entries"
+CREATE INDEX "auditentry_actor_id" ON "public"."audit_entries" ("actor_id");
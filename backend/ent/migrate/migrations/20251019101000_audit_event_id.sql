@@ -0,0 +1,4 @@
+-- Modify "audit_entries" table
+ALTER TABLE "public"."audit_entries" ADD COLUMN "event_id" bigint NULL;
+-- Create index "auditentry_event_id" to table: "audit_entries"
+CREATE INDEX "auditentry_event_id" ON "public"."audit_entries" ("event_id");
@@ -0,0 +1,8 @@
+-- Modify "events" table
+ALTER TABLE "public"."events" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "event_deleted_at" to table: "events"
+CREATE INDEX "event_deleted_at" ON "public"."events" ("deleted_at");
+-- Modify "users" table
+ALTER TABLE "public"."users" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "user_deleted_at" to table: "users"
+CREATE INDEX "user_deleted_at" ON "public"."users" ("deleted_at");
@@ -0,0 +1,12 @@
+-- Modify "attachments" table
+ALTER TABLE "public"."attachments" DROP CONSTRAINT "attachments_messages_attachments", ADD CONSTRAINT "attachments_messages_attachments" FOREIGN KEY ("message_attachments") REFERENCES "public"."messages" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, DROP CONSTRAINT "attachments_users_attachments", ADD CONSTRAINT "attachments_users_attachments" FOREIGN KEY ("user_attachments") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "messages" table
+ALTER TABLE "public"."messages" DROP CONSTRAINT "messages_events_messages", ADD CONSTRAINT "messages_events_messages" FOREIGN KEY ("event_messages") REFERENCES "public"."events" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, DROP CONSTRAINT "messages_users_messages", ADD CONSTRAINT "messages_users_messages" FOREIGN KEY ("user_messages") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "participants" table
+ALTER TABLE "public"."participants" DROP CONSTRAINT "participants_events_participants", ADD CONSTRAINT "participants_events_participants" FOREIGN KEY ("event_participants") REFERENCES "public"."events" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, DROP CONSTRAINT "participants_users_participants", ADD CONSTRAINT "participants_users_participants" FOREIGN KEY ("user_participants") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "reactions" table
+ALTER TABLE "public"."reactions" DROP CONSTRAINT "reactions_events_reactions", ADD CONSTRAINT "reactions_events_reactions" FOREIGN KEY ("event_reactions") REFERENCES "public"."events" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, DROP CONSTRAINT "reactions_messages_reactions", ADD CONSTRAINT "reactions_messages_reactions" FOREIGN KEY ("message_reactions") REFERENCES "public"."messages" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, DROP CONSTRAINT "reactions_users_reactions", ADD CONSTRAINT "reactions_users_reactions" FOREIGN KEY ("user_reactions") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "read_cursors" table
+ALTER TABLE "public"."read_cursors" DROP CONSTRAINT "read_cursors_events_read_cursors", ADD CONSTRAINT "read_cursors_events_read_cursors" FOREIGN KEY ("event_read_cursors") REFERENCES "public"."events" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, DROP CONSTRAINT "read_cursors_users_read_cursors", ADD CONSTRAINT "read_cursors_users_read_cursors" FOREIGN KEY ("user_read_cursors") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "subscriptions" table
+ALTER TABLE "public"."subscriptions" DROP CONSTRAINT "subscriptions_users_subscription", ADD CONSTRAINT "subscriptions_users_subscription" FOREIGN KEY ("user_subscription") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
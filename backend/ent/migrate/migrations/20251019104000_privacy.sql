@@ -0,0 +1,23 @@
+-- Modify "users" table
+ALTER TABLE "public"."users" ADD COLUMN "deletion_scheduled_at" timestamptz NULL;
+
+-- Modify "messages" table
+ALTER TABLE "public"."messages" ALTER COLUMN "user_messages" DROP NOT NULL, DROP CONSTRAINT "messages_users_messages", ADD CONSTRAINT "messages_users_messages" FOREIGN KEY ("user_messages") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+
+-- Create "data_exports" table
+CREATE TABLE "public"."data_exports" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "status" character varying NOT NULL DEFAULT 'pending',
+  "key" character varying NULL,
+  "size" bigint NOT NULL DEFAULT 0,
+  "error" character varying NULL,
+  "created_at" timestamptz NOT NULL,
+  "completed_at" timestamptz NULL,
+  "expires_at" timestamptz NULL,
+  "user_data_exports" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "data_exports_users_data_exports" FOREIGN KEY ("user_data_exports") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+-- Create index "dataexport_status" to table: "data_exports"
+CREATE INDEX "dataexport_status" ON "public"."data_exports" ("status");
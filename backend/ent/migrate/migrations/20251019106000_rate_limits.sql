@@ -0,0 +1,15 @@
+-- Create "rate_limit_buckets" table
+CREATE TABLE "public"."rate_limit_buckets" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "key" character varying NOT NULL,
+  "tokens" double precision NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "full_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+
+-- Create index "rate_limit_buckets_key_key" to table: "rate_limit_buckets"
+CREATE UNIQUE INDEX "rate_limit_buckets_key_key" ON "public"."rate_limit_buckets" ("key");
+
+-- Create index "ratelimitbucket_full_at" to table: "rate_limit_buckets"
+CREATE INDEX "ratelimitbucket_full_at" ON "public"."rate_limit_buckets" ("full_at");
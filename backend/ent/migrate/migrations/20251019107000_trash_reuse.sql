@@ -0,0 +1,10 @@
+-- Drop index "users_email_key" from table: "users"
+DROP INDEX "public"."users_email_key";
+-- Create index "user_email" to table: "users"
+CREATE UNIQUE INDEX "user_email" ON "public"."users" ("email") WHERE (deleted_at IS NULL);
+-- Drop index "users_cognito_id_key" from table: "users"
+DROP INDEX "public"."users_cognito_id_key";
+-- Create index "user_cognito_id" to table: "users"
+CREATE UNIQUE INDEX "user_cognito_id" ON "public"."users" ("cognito_id") WHERE (deleted_at IS NULL);
+-- Modify "billing_events" table
+ALTER TABLE "public"."billing_events" DROP CONSTRAINT "billing_events_users_billing_events", ALTER COLUMN "user_billing_events" DROP NOT NULL, ADD CONSTRAINT "billing_events_users_billing_events" FOREIGN KEY ("user_billing_events") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
@@ -0,0 +1,2 @@
+-- Modify "data_exports" table
+ALTER TABLE "public"."data_exports" ADD COLUMN "claimed_at" timestamptz NULL;
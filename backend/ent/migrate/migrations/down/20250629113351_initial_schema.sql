@@ -0,0 +1,8 @@
+-- Drop "participants" table
+DROP TABLE "public"."participants";
+
+-- Drop "events" table
+DROP TABLE "public"."events";
+
+-- Drop "users" table
+DROP TABLE "public"."users";
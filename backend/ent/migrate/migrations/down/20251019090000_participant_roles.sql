@@ -0,0 +1,2 @@
+-- Co-organizers moved from "owner" to "editor" cannot be told apart from other editors,
+-- so they keep the "editor" role.
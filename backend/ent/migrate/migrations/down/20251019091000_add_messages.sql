@@ -0,0 +1,2 @@
+-- Drop "messages" table
+DROP TABLE "public"."messages";
@@ -0,0 +1,2 @@
+-- Drop "reactions" table
+DROP TABLE "public"."reactions";
@@ -0,0 +1,2 @@
+-- Drop "read_cursors" table
+DROP TABLE "public"."read_cursors";
@@ -0,0 +1,8 @@
+-- Drop index "users_calendar_token_hash_key" from table: "users"
+DROP INDEX "public"."users_calendar_token_hash_key";
+
+-- Modify "users" table
+ALTER TABLE "public"."users" DROP COLUMN "calendar_token_hash";
+
+-- Modify "events" table
+ALTER TABLE "public"."events" DROP COLUMN "time_zone", DROP COLUMN "recurrence_rule";
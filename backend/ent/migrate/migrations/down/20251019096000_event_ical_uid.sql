@@ -0,0 +1,5 @@
+-- Drop index "event_ical_uid_user_created_events" from table: "events"
+DROP INDEX "public"."event_ical_uid_user_created_events";
+
+-- Modify "events" table
+ALTER TABLE "public"."events" DROP COLUMN "ical_uid";
@@ -0,0 +1,2 @@
+-- Modify "events" table
+ALTER TABLE "public"."events" DROP COLUMN "sequence";
@@ -0,0 +1,8 @@
+-- Drop "subscriptions" table
+DROP TABLE "public"."subscriptions";
+
+-- Drop "plans" table
+DROP TABLE "public"."plans";
+
+-- Modify "users" table
+ALTER TABLE "public"."users" DROP COLUMN "is_admin";
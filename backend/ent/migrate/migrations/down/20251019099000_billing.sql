@@ -0,0 +1,8 @@
+-- Drop "billing_events" table
+DROP TABLE "public"."billing_events";
+
+-- Drop index "subscriptions_provider_subscription_id_key" from table: "subscriptions"
+DROP INDEX "public"."subscriptions_provider_subscription_id_key";
+
+-- Modify "subscriptions" table
+ALTER TABLE "public"."subscriptions" DROP COLUMN "provider_customer_id", DROP COLUMN "provider_subscription_id", DROP COLUMN "current_period_end", DROP COLUMN "last_event_at";
@@ -0,0 +1,2 @@
+-- Drop "audit_entries" table
+DROP TABLE "public"."audit_entries";
@@ -0,0 +1,5 @@
+-- Drop index "auditentry_event_id" from table: "audit_entries"
+DROP INDEX "public"."auditentry_event_id";
+
+-- Modify "audit_entries" table
+ALTER TABLE "public"."audit_entries" DROP COLUMN "event_id";
@@ -0,0 +1,11 @@
+-- Drop index "user_deleted_at" from table: "users"
+DROP INDEX "public"."user_deleted_at";
+
+-- Modify "users" table
+ALTER TABLE "public"."users" DROP COLUMN "deleted_at";
+
+-- Drop index "event_deleted_at" from table: "events"
+DROP INDEX "public"."event_deleted_at";
+
+-- Modify "events" table
+ALTER TABLE "public"."events" DROP COLUMN "deleted_at";
@@ -0,0 +1,10 @@
+-- Drop "data_exports" table
+DROP TABLE "public"."data_exports";
+
+-- Modify "messages" table
+-- Messages of closed accounts have no author and cannot be kept once the column is required again
+DELETE FROM "public"."messages" WHERE "user_messages" IS NULL;
+ALTER TABLE "public"."messages" DROP CONSTRAINT "messages_users_messages", ADD CONSTRAINT "messages_users_messages" FOREIGN KEY ("user_messages") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, ALTER COLUMN "user_messages" SET NOT NULL;
+
+-- Modify "users" table
+ALTER TABLE "public"."users" DROP COLUMN "deletion_scheduled_at";
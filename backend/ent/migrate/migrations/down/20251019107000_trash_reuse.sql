@@ -0,0 +1,13 @@
+-- Reverse modify "billing_events" table
+-- The ledger is never deleted, so this fails once a purged user left rows without a user
+ALTER TABLE "public"."billing_events" DROP CONSTRAINT "billing_events_users_billing_events", ALTER COLUMN "user_billing_events" SET NOT NULL, ADD CONSTRAINT "billing_events_users_billing_events" FOREIGN KEY ("user_billing_events") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- Drop index "user_cognito_id" from table: "users"
+DROP INDEX "public"."user_cognito_id";
+-- Create index "users_cognito_id_key" to table: "users"
+-- Fails while a trashed user shares the Cognito ID of an active user
+CREATE UNIQUE INDEX "users_cognito_id_key" ON "public"."users" ("cognito_id");
+-- Drop index "user_email" from table: "users"
+DROP INDEX "public"."user_email";
+-- Create index "users_email_key" to table: "users"
+-- Fails while a trashed user shares the email of an active user
+CREATE UNIQUE INDEX "users_email_key" ON "public"."users" ("email");
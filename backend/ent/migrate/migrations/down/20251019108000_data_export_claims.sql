@@ -0,0 +1,2 @@
+-- Modify "data_exports" table
+ALTER TABLE "public"."data_exports" DROP COLUMN "claimed_at";
@@ -0,0 +1,10 @@
+// Package migrations embeds the versioned migration directory so the server
+// binary can apply it without the files on disk.
+package migrations
+
+import "embed"
+
+// FS holds the up migrations, their atlas.sum and the down scripts under down/
+//
+//go:embed *.sql atlas.sum down/*.sql
+var FS embed.FS
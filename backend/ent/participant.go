@@ -19,7 +19,7 @@ type Participant struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
-	// 参加者の権限 (owner: 作成者, viewer: 閲覧者)
+	// 参加者の権限 (owner: 作成者, editor: 共同編集者, moderator: チャット管理者, viewer: 閲覧者)
 	Role participant.Role `json:"role,omitempty"`
 	// 参加状態
 	Status participant.Status `json:"status,omitempty"`
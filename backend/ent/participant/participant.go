@@ -93,8 +93,10 @@ const DefaultRole = RoleViewer
 
 // Role values.
 const (
-	RoleOwner  Role = "owner"
-	RoleViewer Role = "viewer"
+	RoleOwner     Role = "owner"
+	RoleEditor    Role = "editor"
+	RoleModerator Role = "moderator"
+	RoleViewer    Role = "viewer"
 )
 
 func (r Role) String() string {
@@ -104,7 +106,7 @@ func (r Role) String() string {
 // RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
 func RoleValidator(r Role) error {
 	switch r {
-	case RoleOwner, RoleViewer:
+	case RoleOwner, RoleEditor, RoleModerator, RoleViewer:
 		return nil
 	default:
 		return fmt.Errorf("participant: invalid enum value for role field: %q", r)
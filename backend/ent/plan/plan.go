@@ -0,0 +1,147 @@
+// Code generated by ent, DO NOT EDIT.
+
+package plan
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the plan type in the database.
+	Label = "plan"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldKey holds the string denoting the key field in the database.
+	FieldKey = "key"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldMaxActiveEvents holds the string denoting the max_active_events field in the database.
+	FieldMaxActiveEvents = "max_active_events"
+	// FieldMaxParticipantsPerEvent holds the string denoting the max_participants_per_event field in the database.
+	FieldMaxParticipantsPerEvent = "max_participants_per_event"
+	// FieldMaxAttachmentStorage holds the string denoting the max_attachment_storage field in the database.
+	FieldMaxAttachmentStorage = "max_attachment_storage"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeSubscriptions holds the string denoting the subscriptions edge name in mutations.
+	EdgeSubscriptions = "subscriptions"
+	// Table holds the table name of the plan in the database.
+	Table = "plans"
+	// SubscriptionsTable is the table that holds the subscriptions relation/edge.
+	SubscriptionsTable = "subscriptions"
+	// SubscriptionsInverseTable is the table name for the Subscription entity.
+	// It exists in this package in order to avoid circular dependency with the "subscription" package.
+	SubscriptionsInverseTable = "subscriptions"
+	// SubscriptionsColumn is the table column denoting the subscriptions relation/edge.
+	SubscriptionsColumn = "plan_subscriptions"
+)
+
+// Columns holds all SQL columns for plan fields.
+var Columns = []string{
+	FieldID,
+	FieldKey,
+	FieldName,
+	FieldMaxActiveEvents,
+	FieldMaxParticipantsPerEvent,
+	FieldMaxAttachmentStorage,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	KeyValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// MaxActiveEventsValidator is a validator for the "max_active_events" field. It is called by the builders before save.
+	MaxActiveEventsValidator func(int) error
+	// MaxParticipantsPerEventValidator is a validator for the "max_participants_per_event" field. It is called by the builders before save.
+	MaxParticipantsPerEventValidator func(int) error
+	// MaxAttachmentStorageValidator is a validator for the "max_attachment_storage" field. It is called by the builders before save.
+	MaxAttachmentStorageValidator func(int64) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Plan queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByKey orders the results by the key field.
+func ByKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKey, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByMaxActiveEvents orders the results by the max_active_events field.
+func ByMaxActiveEvents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxActiveEvents, opts...).ToFunc()
+}
+
+// ByMaxParticipantsPerEvent orders the results by the max_participants_per_event field.
+func ByMaxParticipantsPerEvent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxParticipantsPerEvent, opts...).ToFunc()
+}
+
+// ByMaxAttachmentStorage orders the results by the max_attachment_storage field.
+func ByMaxAttachmentStorage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxAttachmentStorage, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// BySubscriptionsCount orders the results by subscriptions count.
+func BySubscriptionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSubscriptionsStep(), opts...)
+	}
+}
+
+// BySubscriptions orders the results by subscriptions terms.
+func BySubscriptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSubscriptionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newSubscriptionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SubscriptionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SubscriptionsTable, SubscriptionsColumn),
+	)
+}
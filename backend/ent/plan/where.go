@@ -0,0 +1,489 @@
+// Code generated by ent, DO NOT EDIT.
+
+package plan
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/matsuokashuhei/morrow-backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldID, id))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldKey, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldName, v))
+}
+
+// MaxActiveEvents applies equality check predicate on the "max_active_events" field. It's identical to MaxActiveEventsEQ.
+func MaxActiveEvents(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldMaxActiveEvents, v))
+}
+
+// MaxParticipantsPerEvent applies equality check predicate on the "max_participants_per_event" field. It's identical to MaxParticipantsPerEventEQ.
+func MaxParticipantsPerEvent(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldMaxParticipantsPerEvent, v))
+}
+
+// MaxAttachmentStorage applies equality check predicate on the "max_attachment_storage" field. It's identical to MaxAttachmentStorageEQ.
+func MaxAttachmentStorage(v int64) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldMaxAttachmentStorage, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldContainsFold(FieldKey, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldContainsFold(FieldName, v))
+}
+
+// MaxActiveEventsEQ applies the EQ predicate on the "max_active_events" field.
+func MaxActiveEventsEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldMaxActiveEvents, v))
+}
+
+// MaxActiveEventsNEQ applies the NEQ predicate on the "max_active_events" field.
+func MaxActiveEventsNEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldMaxActiveEvents, v))
+}
+
+// MaxActiveEventsIn applies the In predicate on the "max_active_events" field.
+func MaxActiveEventsIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldMaxActiveEvents, vs...))
+}
+
+// MaxActiveEventsNotIn applies the NotIn predicate on the "max_active_events" field.
+func MaxActiveEventsNotIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldMaxActiveEvents, vs...))
+}
+
+// MaxActiveEventsGT applies the GT predicate on the "max_active_events" field.
+func MaxActiveEventsGT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldMaxActiveEvents, v))
+}
+
+// MaxActiveEventsGTE applies the GTE predicate on the "max_active_events" field.
+func MaxActiveEventsGTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldMaxActiveEvents, v))
+}
+
+// MaxActiveEventsLT applies the LT predicate on the "max_active_events" field.
+func MaxActiveEventsLT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldMaxActiveEvents, v))
+}
+
+// MaxActiveEventsLTE applies the LTE predicate on the "max_active_events" field.
+func MaxActiveEventsLTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldMaxActiveEvents, v))
+}
+
+// MaxActiveEventsIsNil applies the IsNil predicate on the "max_active_events" field.
+func MaxActiveEventsIsNil() predicate.Plan {
+	return predicate.Plan(sql.FieldIsNull(FieldMaxActiveEvents))
+}
+
+// MaxActiveEventsNotNil applies the NotNil predicate on the "max_active_events" field.
+func MaxActiveEventsNotNil() predicate.Plan {
+	return predicate.Plan(sql.FieldNotNull(FieldMaxActiveEvents))
+}
+
+// MaxParticipantsPerEventEQ applies the EQ predicate on the "max_participants_per_event" field.
+func MaxParticipantsPerEventEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldMaxParticipantsPerEvent, v))
+}
+
+// MaxParticipantsPerEventNEQ applies the NEQ predicate on the "max_participants_per_event" field.
+func MaxParticipantsPerEventNEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldMaxParticipantsPerEvent, v))
+}
+
+// MaxParticipantsPerEventIn applies the In predicate on the "max_participants_per_event" field.
+func MaxParticipantsPerEventIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldMaxParticipantsPerEvent, vs...))
+}
+
+// MaxParticipantsPerEventNotIn applies the NotIn predicate on the "max_participants_per_event" field.
+func MaxParticipantsPerEventNotIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldMaxParticipantsPerEvent, vs...))
+}
+
+// MaxParticipantsPerEventGT applies the GT predicate on the "max_participants_per_event" field.
+func MaxParticipantsPerEventGT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldMaxParticipantsPerEvent, v))
+}
+
+// MaxParticipantsPerEventGTE applies the GTE predicate on the "max_participants_per_event" field.
+func MaxParticipantsPerEventGTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldMaxParticipantsPerEvent, v))
+}
+
+// MaxParticipantsPerEventLT applies the LT predicate on the "max_participants_per_event" field.
+func MaxParticipantsPerEventLT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldMaxParticipantsPerEvent, v))
+}
+
+// MaxParticipantsPerEventLTE applies the LTE predicate on the "max_participants_per_event" field.
+func MaxParticipantsPerEventLTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldMaxParticipantsPerEvent, v))
+}
+
+// MaxParticipantsPerEventIsNil applies the IsNil predicate on the "max_participants_per_event" field.
+func MaxParticipantsPerEventIsNil() predicate.Plan {
+	return predicate.Plan(sql.FieldIsNull(FieldMaxParticipantsPerEvent))
+}
+
+// MaxParticipantsPerEventNotNil applies the NotNil predicate on the "max_participants_per_event" field.
+func MaxParticipantsPerEventNotNil() predicate.Plan {
+	return predicate.Plan(sql.FieldNotNull(FieldMaxParticipantsPerEvent))
+}
+
+// MaxAttachmentStorageEQ applies the EQ predicate on the "max_attachment_storage" field.
+func MaxAttachmentStorageEQ(v int64) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldMaxAttachmentStorage, v))
+}
+
+// MaxAttachmentStorageNEQ applies the NEQ predicate on the "max_attachment_storage" field.
+func MaxAttachmentStorageNEQ(v int64) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldMaxAttachmentStorage, v))
+}
+
+// MaxAttachmentStorageIn applies the In predicate on the "max_attachment_storage" field.
+func MaxAttachmentStorageIn(vs ...int64) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldMaxAttachmentStorage, vs...))
+}
+
+// MaxAttachmentStorageNotIn applies the NotIn predicate on the "max_attachment_storage" field.
+func MaxAttachmentStorageNotIn(vs ...int64) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldMaxAttachmentStorage, vs...))
+}
+
+// MaxAttachmentStorageGT applies the GT predicate on the "max_attachment_storage" field.
+func MaxAttachmentStorageGT(v int64) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldMaxAttachmentStorage, v))
+}
+
+// MaxAttachmentStorageGTE applies the GTE predicate on the "max_attachment_storage" field.
+func MaxAttachmentStorageGTE(v int64) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldMaxAttachmentStorage, v))
+}
+
+// MaxAttachmentStorageLT applies the LT predicate on the "max_attachment_storage" field.
+func MaxAttachmentStorageLT(v int64) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldMaxAttachmentStorage, v))
+}
+
+// MaxAttachmentStorageLTE applies the LTE predicate on the "max_attachment_storage" field.
+func MaxAttachmentStorageLTE(v int64) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldMaxAttachmentStorage, v))
+}
+
+// MaxAttachmentStorageIsNil applies the IsNil predicate on the "max_attachment_storage" field.
+func MaxAttachmentStorageIsNil() predicate.Plan {
+	return predicate.Plan(sql.FieldIsNull(FieldMaxAttachmentStorage))
+}
+
+// MaxAttachmentStorageNotNil applies the NotNil predicate on the "max_attachment_storage" field.
+func MaxAttachmentStorageNotNil() predicate.Plan {
+	return predicate.Plan(sql.FieldNotNull(FieldMaxAttachmentStorage))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasSubscriptions applies the HasEdge predicate on the "subscriptions" edge.
+func HasSubscriptions() predicate.Plan {
+	return predicate.Plan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SubscriptionsTable, SubscriptionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSubscriptionsWith applies the HasEdge predicate on the "subscriptions" edge with a given conditions (other predicates).
+func HasSubscriptionsWith(preds ...predicate.Subscription) predicate.Plan {
+	return predicate.Plan(func(s *sql.Selector) {
+		step := newSubscriptionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Plan) predicate.Plan {
+	return predicate.Plan(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Plan) predicate.Plan {
+	return predicate.Plan(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Plan) predicate.Plan {
+	return predicate.Plan(sql.NotPredicates(p))
+}
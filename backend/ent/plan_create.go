@@ -0,0 +1,364 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/matsuokashuhei/morrow-backend/ent/plan"
+	"github.com/matsuokashuhei/morrow-backend/ent/subscription"
+)
+
+// PlanCreate is the builder for creating a Plan entity.
+type PlanCreate struct {
+	config
+	mutation *PlanMutation
+	hooks    []Hook
+}
+
+// SetKey sets the "key" field.
+func (pc *PlanCreate) SetKey(s string) *PlanCreate {
+	pc.mutation.SetKey(s)
+	return pc
+}
+
+// SetName sets the "name" field.
+func (pc *PlanCreate) SetName(s string) *PlanCreate {
+	pc.mutation.SetName(s)
+	return pc
+}
+
+// SetMaxActiveEvents sets the "max_active_events" field.
+func (pc *PlanCreate) SetMaxActiveEvents(i int) *PlanCreate {
+	pc.mutation.SetMaxActiveEvents(i)
+	return pc
+}
+
+// SetNillableMaxActiveEvents sets the "max_active_events" field if the given value is not nil.
+func (pc *PlanCreate) SetNillableMaxActiveEvents(i *int) *PlanCreate {
+	if i != nil {
+		pc.SetMaxActiveEvents(*i)
+	}
+	return pc
+}
+
+// SetMaxParticipantsPerEvent sets the "max_participants_per_event" field.
+func (pc *PlanCreate) SetMaxParticipantsPerEvent(i int) *PlanCreate {
+	pc.mutation.SetMaxParticipantsPerEvent(i)
+	return pc
+}
+
+// SetNillableMaxParticipantsPerEvent sets the "max_participants_per_event" field if the given value is not nil.
+func (pc *PlanCreate) SetNillableMaxParticipantsPerEvent(i *int) *PlanCreate {
+	if i != nil {
+		pc.SetMaxParticipantsPerEvent(*i)
+	}
+	return pc
+}
+
+// SetMaxAttachmentStorage sets the "max_attachment_storage" field.
+func (pc *PlanCreate) SetMaxAttachmentStorage(i int64) *PlanCreate {
+	pc.mutation.SetMaxAttachmentStorage(i)
+	return pc
+}
+
+// SetNillableMaxAttachmentStorage sets the "max_attachment_storage" field if the given value is not nil.
+func (pc *PlanCreate) SetNillableMaxAttachmentStorage(i *int64) *PlanCreate {
+	if i != nil {
+		pc.SetMaxAttachmentStorage(*i)
+	}
+	return pc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pc *PlanCreate) SetCreatedAt(t time.Time) *PlanCreate {
+	pc.mutation.SetCreatedAt(t)
+	return pc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pc *PlanCreate) SetNillableCreatedAt(t *time.Time) *PlanCreate {
+	if t != nil {
+		pc.SetCreatedAt(*t)
+	}
+	return pc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pc *PlanCreate) SetUpdatedAt(t time.Time) *PlanCreate {
+	pc.mutation.SetUpdatedAt(t)
+	return pc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pc *PlanCreate) SetNillableUpdatedAt(t *time.Time) *PlanCreate {
+	if t != nil {
+		pc.SetUpdatedAt(*t)
+	}
+	return pc
+}
+
+// AddSubscriptionIDs adds the "subscriptions" edge to the Subscription entity by IDs.
+func (pc *PlanCreate) AddSubscriptionIDs(ids ...int) *PlanCreate {
+	pc.mutation.AddSubscriptionIDs(ids...)
+	return pc
+}
+
+// AddSubscriptions adds the "subscriptions" edges to the Subscription entity.
+func (pc *PlanCreate) AddSubscriptions(s ...*Subscription) *PlanCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pc.AddSubscriptionIDs(ids...)
+}
+
+// Mutation returns the PlanMutation object of the builder.
+func (pc *PlanCreate) Mutation() *PlanMutation {
+	return pc.mutation
+}
+
+// Save creates the Plan in the database.
+func (pc *PlanCreate) Save(ctx context.Context) (*Plan, error) {
+	pc.defaults()
+	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *PlanCreate) SaveX(ctx context.Context) *Plan {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *PlanCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *PlanCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *PlanCreate) defaults() {
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		v := plan.DefaultCreatedAt()
+		pc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		v := plan.DefaultUpdatedAt()
+		pc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *PlanCreate) check() error {
+	if _, ok := pc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "Plan.key"`)}
+	}
+	if v, ok := pc.mutation.Key(); ok {
+		if err := plan.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "Plan.key": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Plan.name"`)}
+	}
+	if v, ok := pc.mutation.Name(); ok {
+		if err := plan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Plan.name": %w`, err)}
+		}
+	}
+	if v, ok := pc.mutation.MaxActiveEvents(); ok {
+		if err := plan.MaxActiveEventsValidator(v); err != nil {
+			return &ValidationError{Name: "max_active_events", err: fmt.Errorf(`ent: validator failed for field "Plan.max_active_events": %w`, err)}
+		}
+	}
+	if v, ok := pc.mutation.MaxParticipantsPerEvent(); ok {
+		if err := plan.MaxParticipantsPerEventValidator(v); err != nil {
+			return &ValidationError{Name: "max_participants_per_event", err: fmt.Errorf(`ent: validator failed for field "Plan.max_participants_per_event": %w`, err)}
+		}
+	}
+	if v, ok := pc.mutation.MaxAttachmentStorage(); ok {
+		if err := plan.MaxAttachmentStorageValidator(v); err != nil {
+			return &ValidationError{Name: "max_attachment_storage", err: fmt.Errorf(`ent: validator failed for field "Plan.max_attachment_storage": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Plan.created_at"`)}
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Plan.updated_at"`)}
+	}
+	return nil
+}
+
+func (pc *PlanCreate) sqlSave(ctx context.Context) (*Plan, error) {
+	if err := pc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pc.mutation.id = &_node.ID
+	pc.mutation.done = true
+	return _node, nil
+}
+
+func (pc *PlanCreate) createSpec() (*Plan, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Plan{config: pc.config}
+		_spec = sqlgraph.NewCreateSpec(plan.Table, sqlgraph.NewFieldSpec(plan.FieldID, field.TypeInt))
+	)
+	if value, ok := pc.mutation.Key(); ok {
+		_spec.SetField(plan.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := pc.mutation.Name(); ok {
+		_spec.SetField(plan.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := pc.mutation.MaxActiveEvents(); ok {
+		_spec.SetField(plan.FieldMaxActiveEvents, field.TypeInt, value)
+		_node.MaxActiveEvents = &value
+	}
+	if value, ok := pc.mutation.MaxParticipantsPerEvent(); ok {
+		_spec.SetField(plan.FieldMaxParticipantsPerEvent, field.TypeInt, value)
+		_node.MaxParticipantsPerEvent = &value
+	}
+	if value, ok := pc.mutation.MaxAttachmentStorage(); ok {
+		_spec.SetField(plan.FieldMaxAttachmentStorage, field.TypeInt64, value)
+		_node.MaxAttachmentStorage = &value
+	}
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.SetField(plan.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pc.mutation.UpdatedAt(); ok {
+		_spec.SetField(plan.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := pc.mutation.SubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.SubscriptionsTable,
+			Columns: []string{plan.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PlanCreateBulk is the builder for creating many Plan entities in bulk.
+type PlanCreateBulk struct {
+	config
+	err      error
+	builders []*PlanCreate
+}
+
+// Save creates the Plan entities in the database.
+func (pcb *PlanCreateBulk) Save(ctx context.Context) ([]*Plan, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Plan, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlanMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *PlanCreateBulk) SaveX(ctx context.Context) []*Plan {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *PlanCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *PlanCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/matsuokashuhei/morrow-backend/ent/plan"
+	"github.com/matsuokashuhei/morrow-backend/ent/predicate"
+	"github.com/matsuokashuhei/morrow-backend/ent/subscription"
+)
+
+// PlanUpdate is the builder for updating Plan entities.
+type PlanUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PlanMutation
+}
+
+// Where appends a list predicates to the PlanUpdate builder.
+func (pu *PlanUpdate) Where(ps ...predicate.Plan) *PlanUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetName sets the "name" field.
+func (pu *PlanUpdate) SetName(s string) *PlanUpdate {
+	pu.mutation.SetName(s)
+	return pu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pu *PlanUpdate) SetNillableName(s *string) *PlanUpdate {
+	if s != nil {
+		pu.SetName(*s)
+	}
+	return pu
+}
+
+// SetMaxActiveEvents sets the "max_active_events" field.
+func (pu *PlanUpdate) SetMaxActiveEvents(i int) *PlanUpdate {
+	pu.mutation.ResetMaxActiveEvents()
+	pu.mutation.SetMaxActiveEvents(i)
+	return pu
+}
+
+// SetNillableMaxActiveEvents sets the "max_active_events" field if the given value is not nil.
+func (pu *PlanUpdate) SetNillableMaxActiveEvents(i *int) *PlanUpdate {
+	if i != nil {
+		pu.SetMaxActiveEvents(*i)
+	}
+	return pu
+}
+
+// AddMaxActiveEvents adds i to the "max_active_events" field.
+func (pu *PlanUpdate) AddMaxActiveEvents(i int) *PlanUpdate {
+	pu.mutation.AddMaxActiveEvents(i)
+	return pu
+}
+
+// ClearMaxActiveEvents clears the value of the "max_active_events" field.
+func (pu *PlanUpdate) ClearMaxActiveEvents() *PlanUpdate {
+	pu.mutation.ClearMaxActiveEvents()
+	return pu
+}
+
+// SetMaxParticipantsPerEvent sets the "max_participants_per_event" field.
+func (pu *PlanUpdate) SetMaxParticipantsPerEvent(i int) *PlanUpdate {
+	pu.mutation.ResetMaxParticipantsPerEvent()
+	pu.mutation.SetMaxParticipantsPerEvent(i)
+	return pu
+}
+
+// SetNillableMaxParticipantsPerEvent sets the "max_participants_per_event" field if the given value is not nil.
+func (pu *PlanUpdate) SetNillableMaxParticipantsPerEvent(i *int) *PlanUpdate {
+	if i != nil {
+		pu.SetMaxParticipantsPerEvent(*i)
+	}
+	return pu
+}
+
+// AddMaxParticipantsPerEvent adds i to the "max_participants_per_event" field.
+func (pu *PlanUpdate) AddMaxParticipantsPerEvent(i int) *PlanUpdate {
+	pu.mutation.AddMaxParticipantsPerEvent(i)
+	return pu
+}
+
+// ClearMaxParticipantsPerEvent clears the value of the "max_participants_per_event" field.
+func (pu *PlanUpdate) ClearMaxParticipantsPerEvent() *PlanUpdate {
+	pu.mutation.ClearMaxParticipantsPerEvent()
+	return pu
+}
+
+// SetMaxAttachmentStorage sets the "max_attachment_storage" field.
+func (pu *PlanUpdate) SetMaxAttachmentStorage(i int64) *PlanUpdate {
+	pu.mutation.ResetMaxAttachmentStorage()
+	pu.mutation.SetMaxAttachmentStorage(i)
+	return pu
+}
+
+// SetNillableMaxAttachmentStorage sets the "max_attachment_storage" field if the given value is not nil.
+func (pu *PlanUpdate) SetNillableMaxAttachmentStorage(i *int64) *PlanUpdate {
+	if i != nil {
+		pu.SetMaxAttachmentStorage(*i)
+	}
+	return pu
+}
+
+// AddMaxAttachmentStorage adds i to the "max_attachment_storage" field.
+func (pu *PlanUpdate) AddMaxAttachmentStorage(i int64) *PlanUpdate {
+	pu.mutation.AddMaxAttachmentStorage(i)
+	return pu
+}
+
+// ClearMaxAttachmentStorage clears the value of the "max_attachment_storage" field.
+func (pu *PlanUpdate) ClearMaxAttachmentStorage() *PlanUpdate {
+	pu.mutation.ClearMaxAttachmentStorage()
+	return pu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pu *PlanUpdate) SetUpdatedAt(t time.Time) *PlanUpdate {
+	pu.mutation.SetUpdatedAt(t)
+	return pu
+}
+
+// AddSubscriptionIDs adds the "subscriptions" edge to the Subscription entity by IDs.
+func (pu *PlanUpdate) AddSubscriptionIDs(ids ...int) *PlanUpdate {
+	pu.mutation.AddSubscriptionIDs(ids...)
+	return pu
+}
+
+// AddSubscriptions adds the "subscriptions" edges to the Subscription entity.
+func (pu *PlanUpdate) AddSubscriptions(s ...*Subscription) *PlanUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pu.AddSubscriptionIDs(ids...)
+}
+
+// Mutation returns the PlanMutation object of the builder.
+func (pu *PlanUpdate) Mutation() *PlanMutation {
+	return pu.mutation
+}
+
+// ClearSubscriptions clears all "subscriptions" edges to the Subscription entity.
+func (pu *PlanUpdate) ClearSubscriptions() *PlanUpdate {
+	pu.mutation.ClearSubscriptions()
+	return pu
+}
+
+// RemoveSubscriptionIDs removes the "subscriptions" edge to Subscription entities by IDs.
+func (pu *PlanUpdate) RemoveSubscriptionIDs(ids ...int) *PlanUpdate {
+	pu.mutation.RemoveSubscriptionIDs(ids...)
+	return pu
+}
+
+// RemoveSubscriptions removes "subscriptions" edges to Subscription entities.
+func (pu *PlanUpdate) RemoveSubscriptions(s ...*Subscription) *PlanUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pu.RemoveSubscriptionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PlanUpdate) Save(ctx context.Context) (int, error) {
+	pu.defaults()
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PlanUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PlanUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PlanUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pu *PlanUpdate) defaults() {
+	if _, ok := pu.mutation.UpdatedAt(); !ok {
+		v := plan.UpdateDefaultUpdatedAt()
+		pu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *PlanUpdate) check() error {
+	if v, ok := pu.mutation.Name(); ok {
+		if err := plan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Plan.name": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.MaxActiveEvents(); ok {
+		if err := plan.MaxActiveEventsValidator(v); err != nil {
+			return &ValidationError{Name: "max_active_events", err: fmt.Errorf(`ent: validator failed for field "Plan.max_active_events": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.MaxParticipantsPerEvent(); ok {
+		if err := plan.MaxParticipantsPerEventValidator(v); err != nil {
+			return &ValidationError{Name: "max_participants_per_event", err: fmt.Errorf(`ent: validator failed for field "Plan.max_participants_per_event": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.MaxAttachmentStorage(); ok {
+		if err := plan.MaxAttachmentStorageValidator(v); err != nil {
+			return &ValidationError{Name: "max_attachment_storage", err: fmt.Errorf(`ent: validator failed for field "Plan.max_attachment_storage": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pu *PlanUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(plan.Table, plan.Columns, sqlgraph.NewFieldSpec(plan.FieldID, field.TypeInt))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.Name(); ok {
+		_spec.SetField(plan.FieldName, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.MaxActiveEvents(); ok {
+		_spec.SetField(plan.FieldMaxActiveEvents, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedMaxActiveEvents(); ok {
+		_spec.AddField(plan.FieldMaxActiveEvents, field.TypeInt, value)
+	}
+	if pu.mutation.MaxActiveEventsCleared() {
+		_spec.ClearField(plan.FieldMaxActiveEvents, field.TypeInt)
+	}
+	if value, ok := pu.mutation.MaxParticipantsPerEvent(); ok {
+		_spec.SetField(plan.FieldMaxParticipantsPerEvent, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedMaxParticipantsPerEvent(); ok {
+		_spec.AddField(plan.FieldMaxParticipantsPerEvent, field.TypeInt, value)
+	}
+	if pu.mutation.MaxParticipantsPerEventCleared() {
+		_spec.ClearField(plan.FieldMaxParticipantsPerEvent, field.TypeInt)
+	}
+	if value, ok := pu.mutation.MaxAttachmentStorage(); ok {
+		_spec.SetField(plan.FieldMaxAttachmentStorage, field.TypeInt64, value)
+	}
+	if value, ok := pu.mutation.AddedMaxAttachmentStorage(); ok {
+		_spec.AddField(plan.FieldMaxAttachmentStorage, field.TypeInt64, value)
+	}
+	if pu.mutation.MaxAttachmentStorageCleared() {
+		_spec.ClearField(plan.FieldMaxAttachmentStorage, field.TypeInt64)
+	}
+	if value, ok := pu.mutation.UpdatedAt(); ok {
+		_spec.SetField(plan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pu.mutation.SubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.SubscriptionsTable,
+			Columns: []string{plan.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedSubscriptionsIDs(); len(nodes) > 0 && !pu.mutation.SubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.SubscriptionsTable,
+			Columns: []string{plan.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.SubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.SubscriptionsTable,
+			Columns: []string{plan.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{plan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// PlanUpdateOne is the builder for updating a single Plan entity.
+type PlanUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PlanMutation
+}
+
+// SetName sets the "name" field.
+func (puo *PlanUpdateOne) SetName(s string) *PlanUpdateOne {
+	puo.mutation.SetName(s)
+	return puo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (puo *PlanUpdateOne) SetNillableName(s *string) *PlanUpdateOne {
+	if s != nil {
+		puo.SetName(*s)
+	}
+	return puo
+}
+
+// SetMaxActiveEvents sets the "max_active_events" field.
+func (puo *PlanUpdateOne) SetMaxActiveEvents(i int) *PlanUpdateOne {
+	puo.mutation.ResetMaxActiveEvents()
+	puo.mutation.SetMaxActiveEvents(i)
+	return puo
+}
+
+// SetNillableMaxActiveEvents sets the "max_active_events" field if the given value is not nil.
+func (puo *PlanUpdateOne) SetNillableMaxActiveEvents(i *int) *PlanUpdateOne {
+	if i != nil {
+		puo.SetMaxActiveEvents(*i)
+	}
+	return puo
+}
+
+// AddMaxActiveEvents adds i to the "max_active_events" field.
+func (puo *PlanUpdateOne) AddMaxActiveEvents(i int) *PlanUpdateOne {
+	puo.mutation.AddMaxActiveEvents(i)
+	return puo
+}
+
+// ClearMaxActiveEvents clears the value of the "max_active_events" field.
+func (puo *PlanUpdateOne) ClearMaxActiveEvents() *PlanUpdateOne {
+	puo.mutation.ClearMaxActiveEvents()
+	return puo
+}
+
+// SetMaxParticipantsPerEvent sets the "max_participants_per_event" field.
+func (puo *PlanUpdateOne) SetMaxParticipantsPerEvent(i int) *PlanUpdateOne {
+	puo.mutation.ResetMaxParticipantsPerEvent()
+	puo.mutation.SetMaxParticipantsPerEvent(i)
+	return puo
+}
+
+// SetNillableMaxParticipantsPerEvent sets the "max_participants_per_event" field if the given value is not nil.
+func (puo *PlanUpdateOne) SetNillableMaxParticipantsPerEvent(i *int) *PlanUpdateOne {
+	if i != nil {
+		puo.SetMaxParticipantsPerEvent(*i)
+	}
+	return puo
+}
+
+// AddMaxParticipantsPerEvent adds i to the "max_participants_per_event" field.
+func (puo *PlanUpdateOne) AddMaxParticipantsPerEvent(i int) *PlanUpdateOne {
+	puo.mutation.AddMaxParticipantsPerEvent(i)
+	return puo
+}
+
+// ClearMaxParticipantsPerEvent clears the value of the "max_participants_per_event" field.
+func (puo *PlanUpdateOne) ClearMaxParticipantsPerEvent() *PlanUpdateOne {
+	puo.mutation.ClearMaxParticipantsPerEvent()
+	return puo
+}
+
+// SetMaxAttachmentStorage sets the "max_attachment_storage" field.
+func (puo *PlanUpdateOne) SetMaxAttachmentStorage(i int64) *PlanUpdateOne {
+	puo.mutation.ResetMaxAttachmentStorage()
+	puo.mutation.SetMaxAttachmentStorage(i)
+	return puo
+}
+
+// SetNillableMaxAttachmentStorage sets the "max_attachment_storage" field if the given value is not nil.
+func (puo *PlanUpdateOne) SetNillableMaxAttachmentStorage(i *int64) *PlanUpdateOne {
+	if i != nil {
+		puo.SetMaxAttachmentStorage(*i)
+	}
+	return puo
+}
+
+// AddMaxAttachmentStorage adds i to the "max_attachment_storage" field.
+func (puo *PlanUpdateOne) AddMaxAttachmentStorage(i int64) *PlanUpdateOne {
+	puo.mutation.AddMaxAttachmentStorage(i)
+	return puo
+}
+
+// ClearMaxAttachmentStorage clears the value of the "max_attachment_storage" field.
+func (puo *PlanUpdateOne) ClearMaxAttachmentStorage() *PlanUpdateOne {
+	puo.mutation.ClearMaxAttachmentStorage()
+	return puo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (puo *PlanUpdateOne) SetUpdatedAt(t time.Time) *PlanUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// AddSubscriptionIDs adds the "subscriptions" edge to the Subscription entity by IDs.
+func (puo *PlanUpdateOne) AddSubscriptionIDs(ids ...int) *PlanUpdateOne {
+	puo.mutation.AddSubscriptionIDs(ids...)
+	return puo
+}
+
+// AddSubscriptions adds the "subscriptions" edges to the Subscription entity.
+func (puo *PlanUpdateOne) AddSubscriptions(s ...*Subscription) *PlanUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return puo.AddSubscriptionIDs(ids...)
+}
+
+// Mutation returns the PlanMutation object of the builder.
+func (puo *PlanUpdateOne) Mutation() *PlanMutation {
+	return puo.mutation
+}
+
+// ClearSubscriptions clears all "subscriptions" edges to the Subscription entity.
+func (puo *PlanUpdateOne) ClearSubscriptions() *PlanUpdateOne {
+	puo.mutation.ClearSubscriptions()
+	return puo
+}
+
+// RemoveSubscriptionIDs removes the "subscriptions" edge to Subscription entities by IDs.
+func (puo *PlanUpdateOne) RemoveSubscriptionIDs(ids ...int) *PlanUpdateOne {
+	puo.mutation.RemoveSubscriptionIDs(ids...)
+	return puo
+}
+
+// RemoveSubscriptions removes "subscriptions" edges to Subscription entities.
+func (puo *PlanUpdateOne) RemoveSubscriptions(s ...*Subscription) *PlanUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return puo.RemoveSubscriptionIDs(ids...)
+}
+
+// Where appends a list predicates to the PlanUpdate builder.
+func (puo *PlanUpdateOne) Where(ps ...predicate.Plan) *PlanUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PlanUpdateOne) Select(field string, fields ...string) *PlanUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Plan entity.
+func (puo *PlanUpdateOne) Save(ctx context.Context) (*Plan, error) {
+	puo.defaults()
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PlanUpdateOne) SaveX(ctx context.Context) *Plan {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PlanUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PlanUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (puo *PlanUpdateOne) defaults() {
+	if _, ok := puo.mutation.UpdatedAt(); !ok {
+		v := plan.UpdateDefaultUpdatedAt()
+		puo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *PlanUpdateOne) check() error {
+	if v, ok := puo.mutation.Name(); ok {
+		if err := plan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Plan.name": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.MaxActiveEvents(); ok {
+		if err := plan.MaxActiveEventsValidator(v); err != nil {
+			return &ValidationError{Name: "max_active_events", err: fmt.Errorf(`ent: validator failed for field "Plan.max_active_events": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.MaxParticipantsPerEvent(); ok {
+		if err := plan.MaxParticipantsPerEventValidator(v); err != nil {
+			return &ValidationError{Name: "max_participants_per_event", err: fmt.Errorf(`ent: validator failed for field "Plan.max_participants_per_event": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.MaxAttachmentStorage(); ok {
+		if err := plan.MaxAttachmentStorageValidator(v); err != nil {
+			return &ValidationError{Name: "max_attachment_storage", err: fmt.Errorf(`ent: validator failed for field "Plan.max_attachment_storage": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (puo *PlanUpdateOne) sqlSave(ctx context.Context) (_node *Plan, err error) {
+	if err := puo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(plan.Table, plan.Columns, sqlgraph.NewFieldSpec(plan.FieldID, field.TypeInt))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Plan.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, plan.FieldID)
+		for _, f := range fields {
+			if !plan.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != plan.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.Name(); ok {
+		_spec.SetField(plan.FieldName, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.MaxActiveEvents(); ok {
+		_spec.SetField(plan.FieldMaxActiveEvents, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedMaxActiveEvents(); ok {
+		_spec.AddField(plan.FieldMaxActiveEvents, field.TypeInt, value)
+	}
+	if puo.mutation.MaxActiveEventsCleared() {
+		_spec.ClearField(plan.FieldMaxActiveEvents, field.TypeInt)
+	}
+	if value, ok := puo.mutation.MaxParticipantsPerEvent(); ok {
+		_spec.SetField(plan.FieldMaxParticipantsPerEvent, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedMaxParticipantsPerEvent(); ok {
+		_spec.AddField(plan.FieldMaxParticipantsPerEvent, field.TypeInt, value)
+	}
+	if puo.mutation.MaxParticipantsPerEventCleared() {
+		_spec.ClearField(plan.FieldMaxParticipantsPerEvent, field.TypeInt)
+	}
+	if value, ok := puo.mutation.MaxAttachmentStorage(); ok {
+		_spec.SetField(plan.FieldMaxAttachmentStorage, field.TypeInt64, value)
+	}
+	if value, ok := puo.mutation.AddedMaxAttachmentStorage(); ok {
+		_spec.AddField(plan.FieldMaxAttachmentStorage, field.TypeInt64, value)
+	}
+	if puo.mutation.MaxAttachmentStorageCleared() {
+		_spec.ClearField(plan.FieldMaxAttachmentStorage, field.TypeInt64)
+	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.SetField(plan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if puo.mutation.SubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.SubscriptionsTable,
+			Columns: []string{plan.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedSubscriptionsIDs(); len(nodes) > 0 && !puo.mutation.SubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.SubscriptionsTable,
+			Columns: []string{plan.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.SubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.SubscriptionsTable,
+			Columns: []string{plan.SubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Plan{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{plan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
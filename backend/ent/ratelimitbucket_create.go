@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/matsuokashuhei/morrow-backend/ent/ratelimitbucket"
+)
+
+// RateLimitBucketCreate is the builder for creating a RateLimitBucket entity.
+type RateLimitBucketCreate struct {
+	config
+	mutation *RateLimitBucketMutation
+	hooks    []Hook
+}
+
+// SetKey sets the "key" field.
+func (rlbc *RateLimitBucketCreate) SetKey(s string) *RateLimitBucketCreate {
+	rlbc.mutation.SetKey(s)
+	return rlbc
+}
+
+// SetTokens sets the "tokens" field.
+func (rlbc *RateLimitBucketCreate) SetTokens(f float64) *RateLimitBucketCreate {
+	rlbc.mutation.SetTokens(f)
+	return rlbc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rlbc *RateLimitBucketCreate) SetUpdatedAt(t time.Time) *RateLimitBucketCreate {
+	rlbc.mutation.SetUpdatedAt(t)
+	return rlbc
+}
+
+// SetFullAt sets the "full_at" field.
+func (rlbc *RateLimitBucketCreate) SetFullAt(t time.Time) *RateLimitBucketCreate {
+	rlbc.mutation.SetFullAt(t)
+	return rlbc
+}
+
+// Mutation returns the RateLimitBucketMutation object of the builder.
+func (rlbc *RateLimitBucketCreate) Mutation() *RateLimitBucketMutation {
+	return rlbc.mutation
+}
+
+// Save creates the RateLimitBucket in the database.
+func (rlbc *RateLimitBucketCreate) Save(ctx context.Context) (*RateLimitBucket, error) {
+	return withHooks(ctx, rlbc.sqlSave, rlbc.mutation, rlbc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rlbc *RateLimitBucketCreate) SaveX(ctx context.Context) *RateLimitBucket {
+	v, err := rlbc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rlbc *RateLimitBucketCreate) Exec(ctx context.Context) error {
+	_, err := rlbc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rlbc *RateLimitBucketCreate) ExecX(ctx context.Context) {
+	if err := rlbc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rlbc *RateLimitBucketCreate) check() error {
+	if _, ok := rlbc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "RateLimitBucket.key"`)}
+	}
+	if v, ok := rlbc.mutation.Key(); ok {
+		if err := ratelimitbucket.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "RateLimitBucket.key": %w`, err)}
+		}
+	}
+	if _, ok := rlbc.mutation.Tokens(); !ok {
+		return &ValidationError{Name: "tokens", err: errors.New(`ent: missing required field "RateLimitBucket.tokens"`)}
+	}
+	if _, ok := rlbc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RateLimitBucket.updated_at"`)}
+	}
+	if _, ok := rlbc.mutation.FullAt(); !ok {
+		return &ValidationError{Name: "full_at", err: errors.New(`ent: missing required field "RateLimitBucket.full_at"`)}
+	}
+	return nil
+}
+
+func (rlbc *RateLimitBucketCreate) sqlSave(ctx context.Context) (*RateLimitBucket, error) {
+	if err := rlbc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rlbc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rlbc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rlbc.mutation.id = &_node.ID
+	rlbc.mutation.done = true
+	return _node, nil
+}
+
+func (rlbc *RateLimitBucketCreate) createSpec() (*RateLimitBucket, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RateLimitBucket{config: rlbc.config}
+		_spec = sqlgraph.NewCreateSpec(ratelimitbucket.Table, sqlgraph.NewFieldSpec(ratelimitbucket.FieldID, field.TypeInt))
+	)
+	if value, ok := rlbc.mutation.Key(); ok {
+		_spec.SetField(ratelimitbucket.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := rlbc.mutation.Tokens(); ok {
+		_spec.SetField(ratelimitbucket.FieldTokens, field.TypeFloat64, value)
+		_node.Tokens = value
+	}
+	if value, ok := rlbc.mutation.UpdatedAt(); ok {
+		_spec.SetField(ratelimitbucket.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rlbc.mutation.FullAt(); ok {
+		_spec.SetField(ratelimitbucket.FieldFullAt, field.TypeTime, value)
+		_node.FullAt = value
+	}
+	return _node, _spec
+}
+
+// RateLimitBucketCreateBulk is the builder for creating many RateLimitBucket entities in bulk.
+type RateLimitBucketCreateBulk struct {
+	config
+	err      error
+	builders []*RateLimitBucketCreate
+}
+
+// Save creates the RateLimitBucket entities in the database.
+func (rlbcb *RateLimitBucketCreateBulk) Save(ctx context.Context) ([]*RateLimitBucket, error) {
+	if rlbcb.err != nil {
+		return nil, rlbcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rlbcb.builders))
+	nodes := make([]*RateLimitBucket, len(rlbcb.builders))
+	mutators := make([]Mutator, len(rlbcb.builders))
+	for i := range rlbcb.builders {
+		func(i int, root context.Context) {
+			builder := rlbcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RateLimitBucketMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rlbcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rlbcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rlbcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rlbcb *RateLimitBucketCreateBulk) SaveX(ctx context.Context) []*RateLimitBucket {
+	v, err := rlbcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rlbcb *RateLimitBucketCreateBulk) Exec(ctx context.Context) error {
+	_, err := rlbcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rlbcb *RateLimitBucketCreateBulk) ExecX(ctx context.Context) {
+	if err := rlbcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/matsuokashuhei/morrow-backend/ent/predicate"
+	"github.com/matsuokashuhei/morrow-backend/ent/ratelimitbucket"
+)
+
+// RateLimitBucketDelete is the builder for deleting a RateLimitBucket entity.
+type RateLimitBucketDelete struct {
+	config
+	hooks    []Hook
+	mutation *RateLimitBucketMutation
+}
+
+// Where appends a list predicates to the RateLimitBucketDelete builder.
+func (rlbd *RateLimitBucketDelete) Where(ps ...predicate.RateLimitBucket) *RateLimitBucketDelete {
+	rlbd.mutation.Where(ps...)
+	return rlbd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rlbd *RateLimitBucketDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rlbd.sqlExec, rlbd.mutation, rlbd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rlbd *RateLimitBucketDelete) ExecX(ctx context.Context) int {
+	n, err := rlbd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rlbd *RateLimitBucketDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(ratelimitbucket.Table, sqlgraph.NewFieldSpec(ratelimitbucket.FieldID, field.TypeInt))
+	if ps := rlbd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rlbd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rlbd.mutation.done = true
+	return affected, err
+}
+
+// RateLimitBucketDeleteOne is the builder for deleting a single RateLimitBucket entity.
+type RateLimitBucketDeleteOne struct {
+	rlbd *RateLimitBucketDelete
+}
+
+// Where appends a list predicates to the RateLimitBucketDelete builder.
+func (rlbdo *RateLimitBucketDeleteOne) Where(ps ...predicate.RateLimitBucket) *RateLimitBucketDeleteOne {
+	rlbdo.rlbd.mutation.Where(ps...)
+	return rlbdo
+}
+
+// Exec executes the deletion query.
+func (rlbdo *RateLimitBucketDeleteOne) Exec(ctx context.Context) error {
+	n, err := rlbdo.rlbd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{ratelimitbucket.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rlbdo *RateLimitBucketDeleteOne) ExecX(ctx context.Context) {
+	if err := rlbdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
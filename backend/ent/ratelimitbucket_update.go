@@ -0,0 +1,298 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/matsuokashuhei/morrow-backend/ent/predicate"
+	"github.com/matsuokashuhei/morrow-backend/ent/ratelimitbucket"
+)
+
+// RateLimitBucketUpdate is the builder for updating RateLimitBucket entities.
+type RateLimitBucketUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RateLimitBucketMutation
+}
+
+// Where appends a list predicates to the RateLimitBucketUpdate builder.
+func (rlbu *RateLimitBucketUpdate) Where(ps ...predicate.RateLimitBucket) *RateLimitBucketUpdate {
+	rlbu.mutation.Where(ps...)
+	return rlbu
+}
+
+// SetTokens sets the "tokens" field.
+func (rlbu *RateLimitBucketUpdate) SetTokens(f float64) *RateLimitBucketUpdate {
+	rlbu.mutation.ResetTokens()
+	rlbu.mutation.SetTokens(f)
+	return rlbu
+}
+
+// SetNillableTokens sets the "tokens" field if the given value is not nil.
+func (rlbu *RateLimitBucketUpdate) SetNillableTokens(f *float64) *RateLimitBucketUpdate {
+	if f != nil {
+		rlbu.SetTokens(*f)
+	}
+	return rlbu
+}
+
+// AddTokens adds f to the "tokens" field.
+func (rlbu *RateLimitBucketUpdate) AddTokens(f float64) *RateLimitBucketUpdate {
+	rlbu.mutation.AddTokens(f)
+	return rlbu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rlbu *RateLimitBucketUpdate) SetUpdatedAt(t time.Time) *RateLimitBucketUpdate {
+	rlbu.mutation.SetUpdatedAt(t)
+	return rlbu
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rlbu *RateLimitBucketUpdate) SetNillableUpdatedAt(t *time.Time) *RateLimitBucketUpdate {
+	if t != nil {
+		rlbu.SetUpdatedAt(*t)
+	}
+	return rlbu
+}
+
+// SetFullAt sets the "full_at" field.
+func (rlbu *RateLimitBucketUpdate) SetFullAt(t time.Time) *RateLimitBucketUpdate {
+	rlbu.mutation.SetFullAt(t)
+	return rlbu
+}
+
+// SetNillableFullAt sets the "full_at" field if the given value is not nil.
+func (rlbu *RateLimitBucketUpdate) SetNillableFullAt(t *time.Time) *RateLimitBucketUpdate {
+	if t != nil {
+		rlbu.SetFullAt(*t)
+	}
+	return rlbu
+}
+
+// Mutation returns the RateLimitBucketMutation object of the builder.
+func (rlbu *RateLimitBucketUpdate) Mutation() *RateLimitBucketMutation {
+	return rlbu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rlbu *RateLimitBucketUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rlbu.sqlSave, rlbu.mutation, rlbu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rlbu *RateLimitBucketUpdate) SaveX(ctx context.Context) int {
+	affected, err := rlbu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rlbu *RateLimitBucketUpdate) Exec(ctx context.Context) error {
+	_, err := rlbu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rlbu *RateLimitBucketUpdate) ExecX(ctx context.Context) {
+	if err := rlbu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (rlbu *RateLimitBucketUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(ratelimitbucket.Table, ratelimitbucket.Columns, sqlgraph.NewFieldSpec(ratelimitbucket.FieldID, field.TypeInt))
+	if ps := rlbu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rlbu.mutation.Tokens(); ok {
+		_spec.SetField(ratelimitbucket.FieldTokens, field.TypeFloat64, value)
+	}
+	if value, ok := rlbu.mutation.AddedTokens(); ok {
+		_spec.AddField(ratelimitbucket.FieldTokens, field.TypeFloat64, value)
+	}
+	if value, ok := rlbu.mutation.UpdatedAt(); ok {
+		_spec.SetField(ratelimitbucket.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rlbu.mutation.FullAt(); ok {
+		_spec.SetField(ratelimitbucket.FieldFullAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rlbu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ratelimitbucket.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rlbu.mutation.done = true
+	return n, nil
+}
+
+// RateLimitBucketUpdateOne is the builder for updating a single RateLimitBucket entity.
+type RateLimitBucketUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RateLimitBucketMutation
+}
+
+// SetTokens sets the "tokens" field.
+func (rlbuo *RateLimitBucketUpdateOne) SetTokens(f float64) *RateLimitBucketUpdateOne {
+	rlbuo.mutation.ResetTokens()
+	rlbuo.mutation.SetTokens(f)
+	return rlbuo
+}
+
+// SetNillableTokens sets the "tokens" field if the given value is not nil.
+func (rlbuo *RateLimitBucketUpdateOne) SetNillableTokens(f *float64) *RateLimitBucketUpdateOne {
+	if f != nil {
+		rlbuo.SetTokens(*f)
+	}
+	return rlbuo
+}
+
+// AddTokens adds f to the "tokens" field.
+func (rlbuo *RateLimitBucketUpdateOne) AddTokens(f float64) *RateLimitBucketUpdateOne {
+	rlbuo.mutation.AddTokens(f)
+	return rlbuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rlbuo *RateLimitBucketUpdateOne) SetUpdatedAt(t time.Time) *RateLimitBucketUpdateOne {
+	rlbuo.mutation.SetUpdatedAt(t)
+	return rlbuo
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rlbuo *RateLimitBucketUpdateOne) SetNillableUpdatedAt(t *time.Time) *RateLimitBucketUpdateOne {
+	if t != nil {
+		rlbuo.SetUpdatedAt(*t)
+	}
+	return rlbuo
+}
+
+// SetFullAt sets the "full_at" field.
+func (rlbuo *RateLimitBucketUpdateOne) SetFullAt(t time.Time) *RateLimitBucketUpdateOne {
+	rlbuo.mutation.SetFullAt(t)
+	return rlbuo
+}
+
+// SetNillableFullAt sets the "full_at" field if the given value is not nil.
+func (rlbuo *RateLimitBucketUpdateOne) SetNillableFullAt(t *time.Time) *RateLimitBucketUpdateOne {
+	if t != nil {
+		rlbuo.SetFullAt(*t)
+	}
+	return rlbuo
+}
+
+// Mutation returns the RateLimitBucketMutation object of the builder.
+func (rlbuo *RateLimitBucketUpdateOne) Mutation() *RateLimitBucketMutation {
+	return rlbuo.mutation
+}
+
+// Where appends a list predicates to the RateLimitBucketUpdate builder.
+func (rlbuo *RateLimitBucketUpdateOne) Where(ps ...predicate.RateLimitBucket) *RateLimitBucketUpdateOne {
+	rlbuo.mutation.Where(ps...)
+	return rlbuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rlbuo *RateLimitBucketUpdateOne) Select(field string, fields ...string) *RateLimitBucketUpdateOne {
+	rlbuo.fields = append([]string{field}, fields...)
+	return rlbuo
+}
+
+// Save executes the query and returns the updated RateLimitBucket entity.
+func (rlbuo *RateLimitBucketUpdateOne) Save(ctx context.Context) (*RateLimitBucket, error) {
+	return withHooks(ctx, rlbuo.sqlSave, rlbuo.mutation, rlbuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rlbuo *RateLimitBucketUpdateOne) SaveX(ctx context.Context) *RateLimitBucket {
+	node, err := rlbuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rlbuo *RateLimitBucketUpdateOne) Exec(ctx context.Context) error {
+	_, err := rlbuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rlbuo *RateLimitBucketUpdateOne) ExecX(ctx context.Context) {
+	if err := rlbuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (rlbuo *RateLimitBucketUpdateOne) sqlSave(ctx context.Context) (_node *RateLimitBucket, err error) {
+	_spec := sqlgraph.NewUpdateSpec(ratelimitbucket.Table, ratelimitbucket.Columns, sqlgraph.NewFieldSpec(ratelimitbucket.FieldID, field.TypeInt))
+	id, ok := rlbuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RateLimitBucket.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rlbuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ratelimitbucket.FieldID)
+		for _, f := range fields {
+			if !ratelimitbucket.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ratelimitbucket.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rlbuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rlbuo.mutation.Tokens(); ok {
+		_spec.SetField(ratelimitbucket.FieldTokens, field.TypeFloat64, value)
+	}
+	if value, ok := rlbuo.mutation.AddedTokens(); ok {
+		_spec.AddField(ratelimitbucket.FieldTokens, field.TypeFloat64, value)
+	}
+	if value, ok := rlbuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(ratelimitbucket.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rlbuo.mutation.FullAt(); ok {
+		_spec.SetField(ratelimitbucket.FieldFullAt, field.TypeTime, value)
+	}
+	_node = &RateLimitBucket{config: rlbuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rlbuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ratelimitbucket.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rlbuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/message"
+	"github.com/matsuokashuhei/morrow-backend/ent/reaction"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+)
+
+// Reaction is the model entity for the Reaction schema.
+type Reaction struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// リアクションの絵文字 (スタンプ)
+	Emoji string `json:"emoji,omitempty"`
+	// 作成日時
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReactionQuery when eager-loading is set.
+	Edges             ReactionEdges `json:"edges"`
+	event_reactions   *int
+	message_reactions *int
+	user_reactions    *int
+	selectValues      sql.SelectValues
+}
+
+// ReactionEdges holds the relations/edges for other nodes in the graph.
+type ReactionEdges struct {
+	// リアクションしたユーザー
+	User *User `json:"user,omitempty"`
+	// 対象メッセージ (メッセージへのリアクションの場合)
+	Message *Message `json:"message,omitempty"`
+	// 対象イベント (イベントへのリアクションの場合)
+	Event *Event `json:"event,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReactionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// MessageOrErr returns the Message value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReactionEdges) MessageOrErr() (*Message, error) {
+	if e.Message != nil {
+		return e.Message, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "message"}
+}
+
+// EventOrErr returns the Event value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReactionEdges) EventOrErr() (*Event, error) {
+	if e.Event != nil {
+		return e.Event, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: event.Label}
+	}
+	return nil, &NotLoadedError{edge: "event"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Reaction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reaction.FieldID:
+			values[i] = new(sql.NullInt64)
+		case reaction.FieldEmoji:
+			values[i] = new(sql.NullString)
+		case reaction.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case reaction.ForeignKeys[0]: // event_reactions
+			values[i] = new(sql.NullInt64)
+		case reaction.ForeignKeys[1]: // message_reactions
+			values[i] = new(sql.NullInt64)
+		case reaction.ForeignKeys[2]: // user_reactions
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Reaction fields.
+func (r *Reaction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reaction.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = int(value.Int64)
+		case reaction.FieldEmoji:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field emoji", values[i])
+			} else if value.Valid {
+				r.Emoji = value.String
+			}
+		case reaction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				r.CreatedAt = value.Time
+			}
+		case reaction.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field event_reactions", value)
+			} else if value.Valid {
+				r.event_reactions = new(int)
+				*r.event_reactions = int(value.Int64)
+			}
+		case reaction.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field message_reactions", value)
+			} else if value.Valid {
+				r.message_reactions = new(int)
+				*r.message_reactions = int(value.Int64)
+			}
+		case reaction.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_reactions", value)
+			} else if value.Valid {
+				r.user_reactions = new(int)
+				*r.user_reactions = int(value.Int64)
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Reaction.
+// This includes values selected through modifiers, order, etc.
+func (r *Reaction) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Reaction entity.
+func (r *Reaction) QueryUser() *UserQuery {
+	return NewReactionClient(r.config).QueryUser(r)
+}
+
+// QueryMessage queries the "message" edge of the Reaction entity.
+func (r *Reaction) QueryMessage() *MessageQuery {
+	return NewReactionClient(r.config).QueryMessage(r)
+}
+
+// QueryEvent queries the "event" edge of the Reaction entity.
+func (r *Reaction) QueryEvent() *EventQuery {
+	return NewReactionClient(r.config).QueryEvent(r)
+}
+
+// Update returns a builder for updating this Reaction.
+// Note that you need to call Reaction.Unwrap() before calling this method if this Reaction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Reaction) Update() *ReactionUpdateOne {
+	return NewReactionClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Reaction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Reaction) Unwrap() *Reaction {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Reaction is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Reaction) String() string {
+	var builder strings.Builder
+	builder.WriteString("Reaction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("emoji=")
+	builder.WriteString(r.Emoji)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(r.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Reactions is a parsable slice of Reaction.
+type Reactions []*Reaction
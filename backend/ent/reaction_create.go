@@ -0,0 +1,326 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/message"
+	"github.com/matsuokashuhei/morrow-backend/ent/reaction"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+)
+
+// ReactionCreate is the builder for creating a Reaction entity.
+type ReactionCreate struct {
+	config
+	mutation *ReactionMutation
+	hooks    []Hook
+}
+
+// SetEmoji sets the "emoji" field.
+func (rc *ReactionCreate) SetEmoji(s string) *ReactionCreate {
+	rc.mutation.SetEmoji(s)
+	return rc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *ReactionCreate) SetCreatedAt(t time.Time) *ReactionCreate {
+	rc.mutation.SetCreatedAt(t)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *ReactionCreate) SetNillableCreatedAt(t *time.Time) *ReactionCreate {
+	if t != nil {
+		rc.SetCreatedAt(*t)
+	}
+	return rc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (rc *ReactionCreate) SetUserID(id int) *ReactionCreate {
+	rc.mutation.SetUserID(id)
+	return rc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (rc *ReactionCreate) SetUser(u *User) *ReactionCreate {
+	return rc.SetUserID(u.ID)
+}
+
+// SetMessageID sets the "message" edge to the Message entity by ID.
+func (rc *ReactionCreate) SetMessageID(id int) *ReactionCreate {
+	rc.mutation.SetMessageID(id)
+	return rc
+}
+
+// SetNillableMessageID sets the "message" edge to the Message entity by ID if the given value is not nil.
+func (rc *ReactionCreate) SetNillableMessageID(id *int) *ReactionCreate {
+	if id != nil {
+		rc = rc.SetMessageID(*id)
+	}
+	return rc
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (rc *ReactionCreate) SetMessage(m *Message) *ReactionCreate {
+	return rc.SetMessageID(m.ID)
+}
+
+// SetEventID sets the "event" edge to the Event entity by ID.
+func (rc *ReactionCreate) SetEventID(id int) *ReactionCreate {
+	rc.mutation.SetEventID(id)
+	return rc
+}
+
+// SetNillableEventID sets the "event" edge to the Event entity by ID if the given value is not nil.
+func (rc *ReactionCreate) SetNillableEventID(id *int) *ReactionCreate {
+	if id != nil {
+		rc = rc.SetEventID(*id)
+	}
+	return rc
+}
+
+// SetEvent sets the "event" edge to the Event entity.
+func (rc *ReactionCreate) SetEvent(e *Event) *ReactionCreate {
+	return rc.SetEventID(e.ID)
+}
+
+// Mutation returns the ReactionMutation object of the builder.
+func (rc *ReactionCreate) Mutation() *ReactionMutation {
+	return rc.mutation
+}
+
+// Save creates the Reaction in the database.
+func (rc *ReactionCreate) Save(ctx context.Context) (*Reaction, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *ReactionCreate) SaveX(ctx context.Context) *Reaction {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *ReactionCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *ReactionCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *ReactionCreate) defaults() {
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		v := reaction.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *ReactionCreate) check() error {
+	if _, ok := rc.mutation.Emoji(); !ok {
+		return &ValidationError{Name: "emoji", err: errors.New(`ent: missing required field "Reaction.emoji"`)}
+	}
+	if v, ok := rc.mutation.Emoji(); ok {
+		if err := reaction.EmojiValidator(v); err != nil {
+			return &ValidationError{Name: "emoji", err: fmt.Errorf(`ent: validator failed for field "Reaction.emoji": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Reaction.created_at"`)}
+	}
+	if len(rc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Reaction.user"`)}
+	}
+	return nil
+}
+
+func (rc *ReactionCreate) sqlSave(ctx context.Context) (*Reaction, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *ReactionCreate) createSpec() (*Reaction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Reaction{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(reaction.Table, sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt))
+	)
+	if value, ok := rc.mutation.Emoji(); ok {
+		_spec.SetField(reaction.FieldEmoji, field.TypeString, value)
+		_node.Emoji = value
+	}
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.SetField(reaction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := rc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reaction.UserTable,
+			Columns: []string{reaction.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_reactions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reaction.MessageTable,
+			Columns: []string{reaction.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.message_reactions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.EventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reaction.EventTable,
+			Columns: []string{reaction.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.event_reactions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ReactionCreateBulk is the builder for creating many Reaction entities in bulk.
+type ReactionCreateBulk struct {
+	config
+	err      error
+	builders []*ReactionCreate
+}
+
+// Save creates the Reaction entities in the database.
+func (rcb *ReactionCreateBulk) Save(ctx context.Context) ([]*Reaction, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Reaction, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReactionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *ReactionCreateBulk) SaveX(ctx context.Context) []*Reaction {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *ReactionCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *ReactionCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
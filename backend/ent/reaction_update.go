@@ -0,0 +1,552 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/message"
+	"github.com/matsuokashuhei/morrow-backend/ent/predicate"
+	"github.com/matsuokashuhei/morrow-backend/ent/reaction"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+)
+
+// ReactionUpdate is the builder for updating Reaction entities.
+type ReactionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReactionMutation
+}
+
+// Where appends a list predicates to the ReactionUpdate builder.
+func (ru *ReactionUpdate) Where(ps ...predicate.Reaction) *ReactionUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetEmoji sets the "emoji" field.
+func (ru *ReactionUpdate) SetEmoji(s string) *ReactionUpdate {
+	ru.mutation.SetEmoji(s)
+	return ru
+}
+
+// SetNillableEmoji sets the "emoji" field if the given value is not nil.
+func (ru *ReactionUpdate) SetNillableEmoji(s *string) *ReactionUpdate {
+	if s != nil {
+		ru.SetEmoji(*s)
+	}
+	return ru
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ru *ReactionUpdate) SetUserID(id int) *ReactionUpdate {
+	ru.mutation.SetUserID(id)
+	return ru
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ru *ReactionUpdate) SetUser(u *User) *ReactionUpdate {
+	return ru.SetUserID(u.ID)
+}
+
+// SetMessageID sets the "message" edge to the Message entity by ID.
+func (ru *ReactionUpdate) SetMessageID(id int) *ReactionUpdate {
+	ru.mutation.SetMessageID(id)
+	return ru
+}
+
+// SetNillableMessageID sets the "message" edge to the Message entity by ID if the given value is not nil.
+func (ru *ReactionUpdate) SetNillableMessageID(id *int) *ReactionUpdate {
+	if id != nil {
+		ru = ru.SetMessageID(*id)
+	}
+	return ru
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (ru *ReactionUpdate) SetMessage(m *Message) *ReactionUpdate {
+	return ru.SetMessageID(m.ID)
+}
+
+// SetEventID sets the "event" edge to the Event entity by ID.
+func (ru *ReactionUpdate) SetEventID(id int) *ReactionUpdate {
+	ru.mutation.SetEventID(id)
+	return ru
+}
+
+// SetNillableEventID sets the "event" edge to the Event entity by ID if the given value is not nil.
+func (ru *ReactionUpdate) SetNillableEventID(id *int) *ReactionUpdate {
+	if id != nil {
+		ru = ru.SetEventID(*id)
+	}
+	return ru
+}
+
+// SetEvent sets the "event" edge to the Event entity.
+func (ru *ReactionUpdate) SetEvent(e *Event) *ReactionUpdate {
+	return ru.SetEventID(e.ID)
+}
+
+// Mutation returns the ReactionMutation object of the builder.
+func (ru *ReactionUpdate) Mutation() *ReactionMutation {
+	return ru.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ru *ReactionUpdate) ClearUser() *ReactionUpdate {
+	ru.mutation.ClearUser()
+	return ru
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (ru *ReactionUpdate) ClearMessage() *ReactionUpdate {
+	ru.mutation.ClearMessage()
+	return ru
+}
+
+// ClearEvent clears the "event" edge to the Event entity.
+func (ru *ReactionUpdate) ClearEvent() *ReactionUpdate {
+	ru.mutation.ClearEvent()
+	return ru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *ReactionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *ReactionUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *ReactionUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *ReactionUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *ReactionUpdate) check() error {
+	if v, ok := ru.mutation.Emoji(); ok {
+		if err := reaction.EmojiValidator(v); err != nil {
+			return &ValidationError{Name: "emoji", err: fmt.Errorf(`ent: validator failed for field "Reaction.emoji": %w`, err)}
+		}
+	}
+	if ru.mutation.UserCleared() && len(ru.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Reaction.user"`)
+	}
+	return nil
+}
+
+func (ru *ReactionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reaction.Table, reaction.Columns, sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.Emoji(); ok {
+		_spec.SetField(reaction.FieldEmoji, field.TypeString, value)
+	}
+	if ru.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reaction.UserTable,
+			Columns: []string{reaction.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reaction.UserTable,
+			Columns: []string{reaction.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reaction.MessageTable,
+			Columns: []string{reaction.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reaction.MessageTable,
+			Columns: []string{reaction.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.EventCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reaction.EventTable,
+			Columns: []string{reaction.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.EventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reaction.EventTable,
+			Columns: []string{reaction.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// ReactionUpdateOne is the builder for updating a single Reaction entity.
+type ReactionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReactionMutation
+}
+
+// SetEmoji sets the "emoji" field.
+func (ruo *ReactionUpdateOne) SetEmoji(s string) *ReactionUpdateOne {
+	ruo.mutation.SetEmoji(s)
+	return ruo
+}
+
+// SetNillableEmoji sets the "emoji" field if the given value is not nil.
+func (ruo *ReactionUpdateOne) SetNillableEmoji(s *string) *ReactionUpdateOne {
+	if s != nil {
+		ruo.SetEmoji(*s)
+	}
+	return ruo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ruo *ReactionUpdateOne) SetUserID(id int) *ReactionUpdateOne {
+	ruo.mutation.SetUserID(id)
+	return ruo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ruo *ReactionUpdateOne) SetUser(u *User) *ReactionUpdateOne {
+	return ruo.SetUserID(u.ID)
+}
+
+// SetMessageID sets the "message" edge to the Message entity by ID.
+func (ruo *ReactionUpdateOne) SetMessageID(id int) *ReactionUpdateOne {
+	ruo.mutation.SetMessageID(id)
+	return ruo
+}
+
+// SetNillableMessageID sets the "message" edge to the Message entity by ID if the given value is not nil.
+func (ruo *ReactionUpdateOne) SetNillableMessageID(id *int) *ReactionUpdateOne {
+	if id != nil {
+		ruo = ruo.SetMessageID(*id)
+	}
+	return ruo
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (ruo *ReactionUpdateOne) SetMessage(m *Message) *ReactionUpdateOne {
+	return ruo.SetMessageID(m.ID)
+}
+
+// SetEventID sets the "event" edge to the Event entity by ID.
+func (ruo *ReactionUpdateOne) SetEventID(id int) *ReactionUpdateOne {
+	ruo.mutation.SetEventID(id)
+	return ruo
+}
+
+// SetNillableEventID sets the "event" edge to the Event entity by ID if the given value is not nil.
+func (ruo *ReactionUpdateOne) SetNillableEventID(id *int) *ReactionUpdateOne {
+	if id != nil {
+		ruo = ruo.SetEventID(*id)
+	}
+	return ruo
+}
+
+// SetEvent sets the "event" edge to the Event entity.
+func (ruo *ReactionUpdateOne) SetEvent(e *Event) *ReactionUpdateOne {
+	return ruo.SetEventID(e.ID)
+}
+
+// Mutation returns the ReactionMutation object of the builder.
+func (ruo *ReactionUpdateOne) Mutation() *ReactionMutation {
+	return ruo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ruo *ReactionUpdateOne) ClearUser() *ReactionUpdateOne {
+	ruo.mutation.ClearUser()
+	return ruo
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (ruo *ReactionUpdateOne) ClearMessage() *ReactionUpdateOne {
+	ruo.mutation.ClearMessage()
+	return ruo
+}
+
+// ClearEvent clears the "event" edge to the Event entity.
+func (ruo *ReactionUpdateOne) ClearEvent() *ReactionUpdateOne {
+	ruo.mutation.ClearEvent()
+	return ruo
+}
+
+// Where appends a list predicates to the ReactionUpdate builder.
+func (ruo *ReactionUpdateOne) Where(ps ...predicate.Reaction) *ReactionUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *ReactionUpdateOne) Select(field string, fields ...string) *ReactionUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Reaction entity.
+func (ruo *ReactionUpdateOne) Save(ctx context.Context) (*Reaction, error) {
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *ReactionUpdateOne) SaveX(ctx context.Context) *Reaction {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *ReactionUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *ReactionUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *ReactionUpdateOne) check() error {
+	if v, ok := ruo.mutation.Emoji(); ok {
+		if err := reaction.EmojiValidator(v); err != nil {
+			return &ValidationError{Name: "emoji", err: fmt.Errorf(`ent: validator failed for field "Reaction.emoji": %w`, err)}
+		}
+	}
+	if ruo.mutation.UserCleared() && len(ruo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Reaction.user"`)
+	}
+	return nil
+}
+
+func (ruo *ReactionUpdateOne) sqlSave(ctx context.Context) (_node *Reaction, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reaction.Table, reaction.Columns, sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Reaction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reaction.FieldID)
+		for _, f := range fields {
+			if !reaction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.Emoji(); ok {
+		_spec.SetField(reaction.FieldEmoji, field.TypeString, value)
+	}
+	if ruo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reaction.UserTable,
+			Columns: []string{reaction.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reaction.UserTable,
+			Columns: []string{reaction.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reaction.MessageTable,
+			Columns: []string{reaction.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reaction.MessageTable,
+			Columns: []string{reaction.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.EventCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reaction.EventTable,
+			Columns: []string{reaction.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.EventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reaction.EventTable,
+			Columns: []string{reaction.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Reaction{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/readcursor"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+)
+
+// ReadCursor is the model entity for the ReadCursor schema.
+type ReadCursor struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 既読にした最後のメッセージID (0は未読)
+	LastReadMessageID int `json:"last_read_message_id,omitempty"`
+	// 最終既読日時
+	ReadAt time.Time `json:"read_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReadCursorQuery when eager-loading is set.
+	Edges              ReadCursorEdges `json:"edges"`
+	event_read_cursors *int
+	user_read_cursors  *int
+	selectValues       sql.SelectValues
+}
+
+// ReadCursorEdges holds the relations/edges for other nodes in the graph.
+type ReadCursorEdges struct {
+	// 既読を付けたユーザー
+	User *User `json:"user,omitempty"`
+	// 対象イベント
+	Event *Event `json:"event,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReadCursorEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// EventOrErr returns the Event value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReadCursorEdges) EventOrErr() (*Event, error) {
+	if e.Event != nil {
+		return e.Event, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: event.Label}
+	}
+	return nil, &NotLoadedError{edge: "event"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReadCursor) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case readcursor.FieldID, readcursor.FieldLastReadMessageID:
+			values[i] = new(sql.NullInt64)
+		case readcursor.FieldReadAt:
+			values[i] = new(sql.NullTime)
+		case readcursor.ForeignKeys[0]: // event_read_cursors
+			values[i] = new(sql.NullInt64)
+		case readcursor.ForeignKeys[1]: // user_read_cursors
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReadCursor fields.
+func (rc *ReadCursor) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case readcursor.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rc.ID = int(value.Int64)
+		case readcursor.FieldLastReadMessageID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_read_message_id", values[i])
+			} else if value.Valid {
+				rc.LastReadMessageID = int(value.Int64)
+			}
+		case readcursor.FieldReadAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field read_at", values[i])
+			} else if value.Valid {
+				rc.ReadAt = value.Time
+			}
+		case readcursor.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field event_read_cursors", value)
+			} else if value.Valid {
+				rc.event_read_cursors = new(int)
+				*rc.event_read_cursors = int(value.Int64)
+			}
+		case readcursor.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_read_cursors", value)
+			} else if value.Valid {
+				rc.user_read_cursors = new(int)
+				*rc.user_read_cursors = int(value.Int64)
+			}
+		default:
+			rc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReadCursor.
+// This includes values selected through modifiers, order, etc.
+func (rc *ReadCursor) Value(name string) (ent.Value, error) {
+	return rc.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the ReadCursor entity.
+func (rc *ReadCursor) QueryUser() *UserQuery {
+	return NewReadCursorClient(rc.config).QueryUser(rc)
+}
+
+// QueryEvent queries the "event" edge of the ReadCursor entity.
+func (rc *ReadCursor) QueryEvent() *EventQuery {
+	return NewReadCursorClient(rc.config).QueryEvent(rc)
+}
+
+// Update returns a builder for updating this ReadCursor.
+// Note that you need to call ReadCursor.Unwrap() before calling this method if this ReadCursor
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rc *ReadCursor) Update() *ReadCursorUpdateOne {
+	return NewReadCursorClient(rc.config).UpdateOne(rc)
+}
+
+// Unwrap unwraps the ReadCursor entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rc *ReadCursor) Unwrap() *ReadCursor {
+	_tx, ok := rc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReadCursor is not a transactional entity")
+	}
+	rc.config.driver = _tx.drv
+	return rc
+}
+
+// String implements the fmt.Stringer.
+func (rc *ReadCursor) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReadCursor(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rc.ID))
+	builder.WriteString("last_read_message_id=")
+	builder.WriteString(fmt.Sprintf("%v", rc.LastReadMessageID))
+	builder.WriteString(", ")
+	builder.WriteString("read_at=")
+	builder.WriteString(rc.ReadAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReadCursors is a parsable slice of ReadCursor.
+type ReadCursors []*ReadCursor
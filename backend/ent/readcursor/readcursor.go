@@ -0,0 +1,127 @@
+// Code generated by ent, DO NOT EDIT.
+
+package readcursor
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the readcursor type in the database.
+	Label = "read_cursor"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldLastReadMessageID holds the string denoting the last_read_message_id field in the database.
+	FieldLastReadMessageID = "last_read_message_id"
+	// FieldReadAt holds the string denoting the read_at field in the database.
+	FieldReadAt = "read_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeEvent holds the string denoting the event edge name in mutations.
+	EdgeEvent = "event"
+	// Table holds the table name of the readcursor in the database.
+	Table = "read_cursors"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "read_cursors"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_read_cursors"
+	// EventTable is the table that holds the event relation/edge.
+	EventTable = "read_cursors"
+	// EventInverseTable is the table name for the Event entity.
+	// It exists in this package in order to avoid circular dependency with the "event" package.
+	EventInverseTable = "events"
+	// EventColumn is the table column denoting the event relation/edge.
+	EventColumn = "event_read_cursors"
+)
+
+// Columns holds all SQL columns for readcursor fields.
+var Columns = []string{
+	FieldID,
+	FieldLastReadMessageID,
+	FieldReadAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "read_cursors"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"event_read_cursors",
+	"user_read_cursors",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultLastReadMessageID holds the default value on creation for the "last_read_message_id" field.
+	DefaultLastReadMessageID int
+	// LastReadMessageIDValidator is a validator for the "last_read_message_id" field. It is called by the builders before save.
+	LastReadMessageIDValidator func(int) error
+	// DefaultReadAt holds the default value on creation for the "read_at" field.
+	DefaultReadAt func() time.Time
+	// UpdateDefaultReadAt holds the default value on update for the "read_at" field.
+	UpdateDefaultReadAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ReadCursor queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByLastReadMessageID orders the results by the last_read_message_id field.
+func ByLastReadMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastReadMessageID, opts...).ToFunc()
+}
+
+// ByReadAt orders the results by the read_at field.
+func ByReadAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReadAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByEventField orders the results by event field.
+func ByEventField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEventStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
+func newEventStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EventInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, EventTable, EventColumn),
+	)
+}
@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package readcursor
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/matsuokashuhei/morrow-backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldLTE(FieldID, id))
+}
+
+// LastReadMessageID applies equality check predicate on the "last_read_message_id" field. It's identical to LastReadMessageIDEQ.
+func LastReadMessageID(v int) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldEQ(FieldLastReadMessageID, v))
+}
+
+// ReadAt applies equality check predicate on the "read_at" field. It's identical to ReadAtEQ.
+func ReadAt(v time.Time) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldEQ(FieldReadAt, v))
+}
+
+// LastReadMessageIDEQ applies the EQ predicate on the "last_read_message_id" field.
+func LastReadMessageIDEQ(v int) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldEQ(FieldLastReadMessageID, v))
+}
+
+// LastReadMessageIDNEQ applies the NEQ predicate on the "last_read_message_id" field.
+func LastReadMessageIDNEQ(v int) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldNEQ(FieldLastReadMessageID, v))
+}
+
+// LastReadMessageIDIn applies the In predicate on the "last_read_message_id" field.
+func LastReadMessageIDIn(vs ...int) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldIn(FieldLastReadMessageID, vs...))
+}
+
+// LastReadMessageIDNotIn applies the NotIn predicate on the "last_read_message_id" field.
+func LastReadMessageIDNotIn(vs ...int) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldNotIn(FieldLastReadMessageID, vs...))
+}
+
+// LastReadMessageIDGT applies the GT predicate on the "last_read_message_id" field.
+func LastReadMessageIDGT(v int) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldGT(FieldLastReadMessageID, v))
+}
+
+// LastReadMessageIDGTE applies the GTE predicate on the "last_read_message_id" field.
+func LastReadMessageIDGTE(v int) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldGTE(FieldLastReadMessageID, v))
+}
+
+// LastReadMessageIDLT applies the LT predicate on the "last_read_message_id" field.
+func LastReadMessageIDLT(v int) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldLT(FieldLastReadMessageID, v))
+}
+
+// LastReadMessageIDLTE applies the LTE predicate on the "last_read_message_id" field.
+func LastReadMessageIDLTE(v int) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldLTE(FieldLastReadMessageID, v))
+}
+
+// ReadAtEQ applies the EQ predicate on the "read_at" field.
+func ReadAtEQ(v time.Time) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldEQ(FieldReadAt, v))
+}
+
+// ReadAtNEQ applies the NEQ predicate on the "read_at" field.
+func ReadAtNEQ(v time.Time) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldNEQ(FieldReadAt, v))
+}
+
+// ReadAtIn applies the In predicate on the "read_at" field.
+func ReadAtIn(vs ...time.Time) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldIn(FieldReadAt, vs...))
+}
+
+// ReadAtNotIn applies the NotIn predicate on the "read_at" field.
+func ReadAtNotIn(vs ...time.Time) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldNotIn(FieldReadAt, vs...))
+}
+
+// ReadAtGT applies the GT predicate on the "read_at" field.
+func ReadAtGT(v time.Time) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldGT(FieldReadAt, v))
+}
+
+// ReadAtGTE applies the GTE predicate on the "read_at" field.
+func ReadAtGTE(v time.Time) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldGTE(FieldReadAt, v))
+}
+
+// ReadAtLT applies the LT predicate on the "read_at" field.
+func ReadAtLT(v time.Time) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldLT(FieldReadAt, v))
+}
+
+// ReadAtLTE applies the LTE predicate on the "read_at" field.
+func ReadAtLTE(v time.Time) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.FieldLTE(FieldReadAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.ReadCursor {
+	return predicate.ReadCursor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.ReadCursor {
+	return predicate.ReadCursor(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEvent applies the HasEdge predicate on the "event" edge.
+func HasEvent() predicate.ReadCursor {
+	return predicate.ReadCursor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EventTable, EventColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEventWith applies the HasEdge predicate on the "event" edge with a given conditions (other predicates).
+func HasEventWith(preds ...predicate.Event) predicate.ReadCursor {
+	return predicate.ReadCursor(func(s *sql.Selector) {
+		step := newEventStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReadCursor) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReadCursor) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReadCursor) predicate.ReadCursor {
+	return predicate.ReadCursor(sql.NotPredicates(p))
+}
@@ -0,0 +1,296 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/readcursor"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+)
+
+// ReadCursorCreate is the builder for creating a ReadCursor entity.
+type ReadCursorCreate struct {
+	config
+	mutation *ReadCursorMutation
+	hooks    []Hook
+}
+
+// SetLastReadMessageID sets the "last_read_message_id" field.
+func (rcc *ReadCursorCreate) SetLastReadMessageID(i int) *ReadCursorCreate {
+	rcc.mutation.SetLastReadMessageID(i)
+	return rcc
+}
+
+// SetNillableLastReadMessageID sets the "last_read_message_id" field if the given value is not nil.
+func (rcc *ReadCursorCreate) SetNillableLastReadMessageID(i *int) *ReadCursorCreate {
+	if i != nil {
+		rcc.SetLastReadMessageID(*i)
+	}
+	return rcc
+}
+
+// SetReadAt sets the "read_at" field.
+func (rcc *ReadCursorCreate) SetReadAt(t time.Time) *ReadCursorCreate {
+	rcc.mutation.SetReadAt(t)
+	return rcc
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (rcc *ReadCursorCreate) SetNillableReadAt(t *time.Time) *ReadCursorCreate {
+	if t != nil {
+		rcc.SetReadAt(*t)
+	}
+	return rcc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (rcc *ReadCursorCreate) SetUserID(id int) *ReadCursorCreate {
+	rcc.mutation.SetUserID(id)
+	return rcc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (rcc *ReadCursorCreate) SetUser(u *User) *ReadCursorCreate {
+	return rcc.SetUserID(u.ID)
+}
+
+// SetEventID sets the "event" edge to the Event entity by ID.
+func (rcc *ReadCursorCreate) SetEventID(id int) *ReadCursorCreate {
+	rcc.mutation.SetEventID(id)
+	return rcc
+}
+
+// SetEvent sets the "event" edge to the Event entity.
+func (rcc *ReadCursorCreate) SetEvent(e *Event) *ReadCursorCreate {
+	return rcc.SetEventID(e.ID)
+}
+
+// Mutation returns the ReadCursorMutation object of the builder.
+func (rcc *ReadCursorCreate) Mutation() *ReadCursorMutation {
+	return rcc.mutation
+}
+
+// Save creates the ReadCursor in the database.
+func (rcc *ReadCursorCreate) Save(ctx context.Context) (*ReadCursor, error) {
+	rcc.defaults()
+	return withHooks(ctx, rcc.sqlSave, rcc.mutation, rcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rcc *ReadCursorCreate) SaveX(ctx context.Context) *ReadCursor {
+	v, err := rcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcc *ReadCursorCreate) Exec(ctx context.Context) error {
+	_, err := rcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcc *ReadCursorCreate) ExecX(ctx context.Context) {
+	if err := rcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rcc *ReadCursorCreate) defaults() {
+	if _, ok := rcc.mutation.LastReadMessageID(); !ok {
+		v := readcursor.DefaultLastReadMessageID
+		rcc.mutation.SetLastReadMessageID(v)
+	}
+	if _, ok := rcc.mutation.ReadAt(); !ok {
+		v := readcursor.DefaultReadAt()
+		rcc.mutation.SetReadAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rcc *ReadCursorCreate) check() error {
+	if _, ok := rcc.mutation.LastReadMessageID(); !ok {
+		return &ValidationError{Name: "last_read_message_id", err: errors.New(`ent: missing required field "ReadCursor.last_read_message_id"`)}
+	}
+	if v, ok := rcc.mutation.LastReadMessageID(); ok {
+		if err := readcursor.LastReadMessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "last_read_message_id", err: fmt.Errorf(`ent: validator failed for field "ReadCursor.last_read_message_id": %w`, err)}
+		}
+	}
+	if _, ok := rcc.mutation.ReadAt(); !ok {
+		return &ValidationError{Name: "read_at", err: errors.New(`ent: missing required field "ReadCursor.read_at"`)}
+	}
+	if len(rcc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ReadCursor.user"`)}
+	}
+	if len(rcc.mutation.EventIDs()) == 0 {
+		return &ValidationError{Name: "event", err: errors.New(`ent: missing required edge "ReadCursor.event"`)}
+	}
+	return nil
+}
+
+func (rcc *ReadCursorCreate) sqlSave(ctx context.Context) (*ReadCursor, error) {
+	if err := rcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rcc.mutation.id = &_node.ID
+	rcc.mutation.done = true
+	return _node, nil
+}
+
+func (rcc *ReadCursorCreate) createSpec() (*ReadCursor, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReadCursor{config: rcc.config}
+		_spec = sqlgraph.NewCreateSpec(readcursor.Table, sqlgraph.NewFieldSpec(readcursor.FieldID, field.TypeInt))
+	)
+	if value, ok := rcc.mutation.LastReadMessageID(); ok {
+		_spec.SetField(readcursor.FieldLastReadMessageID, field.TypeInt, value)
+		_node.LastReadMessageID = value
+	}
+	if value, ok := rcc.mutation.ReadAt(); ok {
+		_spec.SetField(readcursor.FieldReadAt, field.TypeTime, value)
+		_node.ReadAt = value
+	}
+	if nodes := rcc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readcursor.UserTable,
+			Columns: []string{readcursor.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_read_cursors = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rcc.mutation.EventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readcursor.EventTable,
+			Columns: []string{readcursor.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.event_read_cursors = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ReadCursorCreateBulk is the builder for creating many ReadCursor entities in bulk.
+type ReadCursorCreateBulk struct {
+	config
+	err      error
+	builders []*ReadCursorCreate
+}
+
+// Save creates the ReadCursor entities in the database.
+func (rccb *ReadCursorCreateBulk) Save(ctx context.Context) ([]*ReadCursor, error) {
+	if rccb.err != nil {
+		return nil, rccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rccb.builders))
+	nodes := make([]*ReadCursor, len(rccb.builders))
+	mutators := make([]Mutator, len(rccb.builders))
+	for i := range rccb.builders {
+		func(i int, root context.Context) {
+			builder := rccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReadCursorMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rccb *ReadCursorCreateBulk) SaveX(ctx context.Context) []*ReadCursor {
+	v, err := rccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rccb *ReadCursorCreateBulk) Exec(ctx context.Context) error {
+	_, err := rccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rccb *ReadCursorCreateBulk) ExecX(ctx context.Context) {
+	if err := rccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/matsuokashuhei/morrow-backend/ent/predicate"
+	"github.com/matsuokashuhei/morrow-backend/ent/readcursor"
+)
+
+// ReadCursorDelete is the builder for deleting a ReadCursor entity.
+type ReadCursorDelete struct {
+	config
+	hooks    []Hook
+	mutation *ReadCursorMutation
+}
+
+// Where appends a list predicates to the ReadCursorDelete builder.
+func (rcd *ReadCursorDelete) Where(ps ...predicate.ReadCursor) *ReadCursorDelete {
+	rcd.mutation.Where(ps...)
+	return rcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rcd *ReadCursorDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rcd.sqlExec, rcd.mutation, rcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcd *ReadCursorDelete) ExecX(ctx context.Context) int {
+	n, err := rcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rcd *ReadCursorDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(readcursor.Table, sqlgraph.NewFieldSpec(readcursor.FieldID, field.TypeInt))
+	if ps := rcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rcd.mutation.done = true
+	return affected, err
+}
+
+// ReadCursorDeleteOne is the builder for deleting a single ReadCursor entity.
+type ReadCursorDeleteOne struct {
+	rcd *ReadCursorDelete
+}
+
+// Where appends a list predicates to the ReadCursorDelete builder.
+func (rcdo *ReadCursorDeleteOne) Where(ps ...predicate.ReadCursor) *ReadCursorDeleteOne {
+	rcdo.rcd.mutation.Where(ps...)
+	return rcdo
+}
+
+// Exec executes the deletion query.
+func (rcdo *ReadCursorDeleteOne) Exec(ctx context.Context) error {
+	n, err := rcdo.rcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{readcursor.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcdo *ReadCursorDeleteOne) ExecX(ctx context.Context) {
+	if err := rcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,689 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/predicate"
+	"github.com/matsuokashuhei/morrow-backend/ent/readcursor"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+)
+
+// ReadCursorQuery is the builder for querying ReadCursor entities.
+type ReadCursorQuery struct {
+	config
+	ctx        *QueryContext
+	order      []readcursor.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ReadCursor
+	withUser   *UserQuery
+	withEvent  *EventQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ReadCursorQuery builder.
+func (rcq *ReadCursorQuery) Where(ps ...predicate.ReadCursor) *ReadCursorQuery {
+	rcq.predicates = append(rcq.predicates, ps...)
+	return rcq
+}
+
+// Limit the number of records to be returned by this query.
+func (rcq *ReadCursorQuery) Limit(limit int) *ReadCursorQuery {
+	rcq.ctx.Limit = &limit
+	return rcq
+}
+
+// Offset to start from.
+func (rcq *ReadCursorQuery) Offset(offset int) *ReadCursorQuery {
+	rcq.ctx.Offset = &offset
+	return rcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rcq *ReadCursorQuery) Unique(unique bool) *ReadCursorQuery {
+	rcq.ctx.Unique = &unique
+	return rcq
+}
+
+// Order specifies how the records should be ordered.
+func (rcq *ReadCursorQuery) Order(o ...readcursor.OrderOption) *ReadCursorQuery {
+	rcq.order = append(rcq.order, o...)
+	return rcq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (rcq *ReadCursorQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: rcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(readcursor.Table, readcursor.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, readcursor.UserTable, readcursor.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEvent chains the current query on the "event" edge.
+func (rcq *ReadCursorQuery) QueryEvent() *EventQuery {
+	query := (&EventClient{config: rcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(readcursor.Table, readcursor.FieldID, selector),
+			sqlgraph.To(event.Table, event.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, readcursor.EventTable, readcursor.EventColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ReadCursor entity from the query.
+// Returns a *NotFoundError when no ReadCursor was found.
+func (rcq *ReadCursorQuery) First(ctx context.Context) (*ReadCursor, error) {
+	nodes, err := rcq.Limit(1).All(setContextOp(ctx, rcq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{readcursor.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rcq *ReadCursorQuery) FirstX(ctx context.Context) *ReadCursor {
+	node, err := rcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ReadCursor ID from the query.
+// Returns a *NotFoundError when no ReadCursor ID was found.
+func (rcq *ReadCursorQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rcq.Limit(1).IDs(setContextOp(ctx, rcq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{readcursor.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rcq *ReadCursorQuery) FirstIDX(ctx context.Context) int {
+	id, err := rcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ReadCursor entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ReadCursor entity is found.
+// Returns a *NotFoundError when no ReadCursor entities are found.
+func (rcq *ReadCursorQuery) Only(ctx context.Context) (*ReadCursor, error) {
+	nodes, err := rcq.Limit(2).All(setContextOp(ctx, rcq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{readcursor.Label}
+	default:
+		return nil, &NotSingularError{readcursor.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rcq *ReadCursorQuery) OnlyX(ctx context.Context) *ReadCursor {
+	node, err := rcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ReadCursor ID in the query.
+// Returns a *NotSingularError when more than one ReadCursor ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rcq *ReadCursorQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rcq.Limit(2).IDs(setContextOp(ctx, rcq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{readcursor.Label}
+	default:
+		err = &NotSingularError{readcursor.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rcq *ReadCursorQuery) OnlyIDX(ctx context.Context) int {
+	id, err := rcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ReadCursors.
+func (rcq *ReadCursorQuery) All(ctx context.Context) ([]*ReadCursor, error) {
+	ctx = setContextOp(ctx, rcq.ctx, ent.OpQueryAll)
+	if err := rcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ReadCursor, *ReadCursorQuery]()
+	return withInterceptors[[]*ReadCursor](ctx, rcq, qr, rcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rcq *ReadCursorQuery) AllX(ctx context.Context) []*ReadCursor {
+	nodes, err := rcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ReadCursor IDs.
+func (rcq *ReadCursorQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if rcq.ctx.Unique == nil && rcq.path != nil {
+		rcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rcq.ctx, ent.OpQueryIDs)
+	if err = rcq.Select(readcursor.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rcq *ReadCursorQuery) IDsX(ctx context.Context) []int {
+	ids, err := rcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rcq *ReadCursorQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rcq.ctx, ent.OpQueryCount)
+	if err := rcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rcq, querierCount[*ReadCursorQuery](), rcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rcq *ReadCursorQuery) CountX(ctx context.Context) int {
+	count, err := rcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rcq *ReadCursorQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rcq.ctx, ent.OpQueryExist)
+	switch _, err := rcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rcq *ReadCursorQuery) ExistX(ctx context.Context) bool {
+	exist, err := rcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ReadCursorQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rcq *ReadCursorQuery) Clone() *ReadCursorQuery {
+	if rcq == nil {
+		return nil
+	}
+	return &ReadCursorQuery{
+		config:     rcq.config,
+		ctx:        rcq.ctx.Clone(),
+		order:      append([]readcursor.OrderOption{}, rcq.order...),
+		inters:     append([]Interceptor{}, rcq.inters...),
+		predicates: append([]predicate.ReadCursor{}, rcq.predicates...),
+		withUser:   rcq.withUser.Clone(),
+		withEvent:  rcq.withEvent.Clone(),
+		// clone intermediate query.
+		sql:  rcq.sql.Clone(),
+		path: rcq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (rcq *ReadCursorQuery) WithUser(opts ...func(*UserQuery)) *ReadCursorQuery {
+	query := (&UserClient{config: rcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rcq.withUser = query
+	return rcq
+}
+
+// WithEvent tells the query-builder to eager-load the nodes that are connected to
+// the "event" edge. The optional arguments are used to configure the query builder of the edge.
+func (rcq *ReadCursorQuery) WithEvent(opts ...func(*EventQuery)) *ReadCursorQuery {
+	query := (&EventClient{config: rcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rcq.withEvent = query
+	return rcq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		LastReadMessageID int `json:"last_read_message_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ReadCursor.Query().
+//		GroupBy(readcursor.FieldLastReadMessageID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rcq *ReadCursorQuery) GroupBy(field string, fields ...string) *ReadCursorGroupBy {
+	rcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ReadCursorGroupBy{build: rcq}
+	grbuild.flds = &rcq.ctx.Fields
+	grbuild.label = readcursor.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		LastReadMessageID int `json:"last_read_message_id,omitempty"`
+//	}
+//
+//	client.ReadCursor.Query().
+//		Select(readcursor.FieldLastReadMessageID).
+//		Scan(ctx, &v)
+func (rcq *ReadCursorQuery) Select(fields ...string) *ReadCursorSelect {
+	rcq.ctx.Fields = append(rcq.ctx.Fields, fields...)
+	sbuild := &ReadCursorSelect{ReadCursorQuery: rcq}
+	sbuild.label = readcursor.Label
+	sbuild.flds, sbuild.scan = &rcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ReadCursorSelect configured with the given aggregations.
+func (rcq *ReadCursorQuery) Aggregate(fns ...AggregateFunc) *ReadCursorSelect {
+	return rcq.Select().Aggregate(fns...)
+}
+
+func (rcq *ReadCursorQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rcq.ctx.Fields {
+		if !readcursor.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rcq.path != nil {
+		prev, err := rcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rcq.sql = prev
+	}
+	return nil
+}
+
+func (rcq *ReadCursorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ReadCursor, error) {
+	var (
+		nodes       = []*ReadCursor{}
+		withFKs     = rcq.withFKs
+		_spec       = rcq.querySpec()
+		loadedTypes = [2]bool{
+			rcq.withUser != nil,
+			rcq.withEvent != nil,
+		}
+	)
+	if rcq.withUser != nil || rcq.withEvent != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, readcursor.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ReadCursor).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ReadCursor{config: rcq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rcq.withUser; query != nil {
+		if err := rcq.loadUser(ctx, query, nodes, nil,
+			func(n *ReadCursor, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rcq.withEvent; query != nil {
+		if err := rcq.loadEvent(ctx, query, nodes, nil,
+			func(n *ReadCursor, e *Event) { n.Edges.Event = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rcq *ReadCursorQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*ReadCursor, init func(*ReadCursor), assign func(*ReadCursor, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ReadCursor)
+	for i := range nodes {
+		if nodes[i].user_read_cursors == nil {
+			continue
+		}
+		fk := *nodes[i].user_read_cursors
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_read_cursors" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (rcq *ReadCursorQuery) loadEvent(ctx context.Context, query *EventQuery, nodes []*ReadCursor, init func(*ReadCursor), assign func(*ReadCursor, *Event)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ReadCursor)
+	for i := range nodes {
+		if nodes[i].event_read_cursors == nil {
+			continue
+		}
+		fk := *nodes[i].event_read_cursors
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(event.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "event_read_cursors" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (rcq *ReadCursorQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rcq.querySpec()
+	_spec.Node.Columns = rcq.ctx.Fields
+	if len(rcq.ctx.Fields) > 0 {
+		_spec.Unique = rcq.ctx.Unique != nil && *rcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rcq.driver, _spec)
+}
+
+func (rcq *ReadCursorQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(readcursor.Table, readcursor.Columns, sqlgraph.NewFieldSpec(readcursor.FieldID, field.TypeInt))
+	_spec.From = rcq.sql
+	if unique := rcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, readcursor.FieldID)
+		for i := range fields {
+			if fields[i] != readcursor.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rcq *ReadCursorQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rcq.driver.Dialect())
+	t1 := builder.Table(readcursor.Table)
+	columns := rcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = readcursor.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rcq.sql != nil {
+		selector = rcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rcq.ctx.Unique != nil && *rcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range rcq.predicates {
+		p(selector)
+	}
+	for _, p := range rcq.order {
+		p(selector)
+	}
+	if offset := rcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ReadCursorGroupBy is the group-by builder for ReadCursor entities.
+type ReadCursorGroupBy struct {
+	selector
+	build *ReadCursorQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rcgb *ReadCursorGroupBy) Aggregate(fns ...AggregateFunc) *ReadCursorGroupBy {
+	rcgb.fns = append(rcgb.fns, fns...)
+	return rcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rcgb *ReadCursorGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rcgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReadCursorQuery, *ReadCursorGroupBy](ctx, rcgb.build, rcgb, rcgb.build.inters, v)
+}
+
+func (rcgb *ReadCursorGroupBy) sqlScan(ctx context.Context, root *ReadCursorQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rcgb.fns))
+	for _, fn := range rcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rcgb.flds)+len(rcgb.fns))
+		for _, f := range *rcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ReadCursorSelect is the builder for selecting fields of ReadCursor entities.
+type ReadCursorSelect struct {
+	*ReadCursorQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rcs *ReadCursorSelect) Aggregate(fns ...AggregateFunc) *ReadCursorSelect {
+	rcs.fns = append(rcs.fns, fns...)
+	return rcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rcs *ReadCursorSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rcs.ctx, ent.OpQuerySelect)
+	if err := rcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReadCursorQuery, *ReadCursorSelect](ctx, rcs.ReadCursorQuery, rcs, rcs.inters, v)
+}
+
+func (rcs *ReadCursorSelect) sqlScan(ctx context.Context, root *ReadCursorQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rcs.fns))
+	for _, fn := range rcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
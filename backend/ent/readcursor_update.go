@@ -0,0 +1,490 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/predicate"
+	"github.com/matsuokashuhei/morrow-backend/ent/readcursor"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+)
+
+// ReadCursorUpdate is the builder for updating ReadCursor entities.
+type ReadCursorUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReadCursorMutation
+}
+
+// Where appends a list predicates to the ReadCursorUpdate builder.
+func (rcu *ReadCursorUpdate) Where(ps ...predicate.ReadCursor) *ReadCursorUpdate {
+	rcu.mutation.Where(ps...)
+	return rcu
+}
+
+// SetLastReadMessageID sets the "last_read_message_id" field.
+func (rcu *ReadCursorUpdate) SetLastReadMessageID(i int) *ReadCursorUpdate {
+	rcu.mutation.ResetLastReadMessageID()
+	rcu.mutation.SetLastReadMessageID(i)
+	return rcu
+}
+
+// SetNillableLastReadMessageID sets the "last_read_message_id" field if the given value is not nil.
+func (rcu *ReadCursorUpdate) SetNillableLastReadMessageID(i *int) *ReadCursorUpdate {
+	if i != nil {
+		rcu.SetLastReadMessageID(*i)
+	}
+	return rcu
+}
+
+// AddLastReadMessageID adds i to the "last_read_message_id" field.
+func (rcu *ReadCursorUpdate) AddLastReadMessageID(i int) *ReadCursorUpdate {
+	rcu.mutation.AddLastReadMessageID(i)
+	return rcu
+}
+
+// SetReadAt sets the "read_at" field.
+func (rcu *ReadCursorUpdate) SetReadAt(t time.Time) *ReadCursorUpdate {
+	rcu.mutation.SetReadAt(t)
+	return rcu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (rcu *ReadCursorUpdate) SetUserID(id int) *ReadCursorUpdate {
+	rcu.mutation.SetUserID(id)
+	return rcu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (rcu *ReadCursorUpdate) SetUser(u *User) *ReadCursorUpdate {
+	return rcu.SetUserID(u.ID)
+}
+
+// SetEventID sets the "event" edge to the Event entity by ID.
+func (rcu *ReadCursorUpdate) SetEventID(id int) *ReadCursorUpdate {
+	rcu.mutation.SetEventID(id)
+	return rcu
+}
+
+// SetEvent sets the "event" edge to the Event entity.
+func (rcu *ReadCursorUpdate) SetEvent(e *Event) *ReadCursorUpdate {
+	return rcu.SetEventID(e.ID)
+}
+
+// Mutation returns the ReadCursorMutation object of the builder.
+func (rcu *ReadCursorUpdate) Mutation() *ReadCursorMutation {
+	return rcu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (rcu *ReadCursorUpdate) ClearUser() *ReadCursorUpdate {
+	rcu.mutation.ClearUser()
+	return rcu
+}
+
+// ClearEvent clears the "event" edge to the Event entity.
+func (rcu *ReadCursorUpdate) ClearEvent() *ReadCursorUpdate {
+	rcu.mutation.ClearEvent()
+	return rcu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rcu *ReadCursorUpdate) Save(ctx context.Context) (int, error) {
+	rcu.defaults()
+	return withHooks(ctx, rcu.sqlSave, rcu.mutation, rcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcu *ReadCursorUpdate) SaveX(ctx context.Context) int {
+	affected, err := rcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rcu *ReadCursorUpdate) Exec(ctx context.Context) error {
+	_, err := rcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcu *ReadCursorUpdate) ExecX(ctx context.Context) {
+	if err := rcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rcu *ReadCursorUpdate) defaults() {
+	if _, ok := rcu.mutation.ReadAt(); !ok {
+		v := readcursor.UpdateDefaultReadAt()
+		rcu.mutation.SetReadAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rcu *ReadCursorUpdate) check() error {
+	if v, ok := rcu.mutation.LastReadMessageID(); ok {
+		if err := readcursor.LastReadMessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "last_read_message_id", err: fmt.Errorf(`ent: validator failed for field "ReadCursor.last_read_message_id": %w`, err)}
+		}
+	}
+	if rcu.mutation.UserCleared() && len(rcu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReadCursor.user"`)
+	}
+	if rcu.mutation.EventCleared() && len(rcu.mutation.EventIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReadCursor.event"`)
+	}
+	return nil
+}
+
+func (rcu *ReadCursorUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(readcursor.Table, readcursor.Columns, sqlgraph.NewFieldSpec(readcursor.FieldID, field.TypeInt))
+	if ps := rcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rcu.mutation.LastReadMessageID(); ok {
+		_spec.SetField(readcursor.FieldLastReadMessageID, field.TypeInt, value)
+	}
+	if value, ok := rcu.mutation.AddedLastReadMessageID(); ok {
+		_spec.AddField(readcursor.FieldLastReadMessageID, field.TypeInt, value)
+	}
+	if value, ok := rcu.mutation.ReadAt(); ok {
+		_spec.SetField(readcursor.FieldReadAt, field.TypeTime, value)
+	}
+	if rcu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readcursor.UserTable,
+			Columns: []string{readcursor.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rcu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readcursor.UserTable,
+			Columns: []string{readcursor.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rcu.mutation.EventCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readcursor.EventTable,
+			Columns: []string{readcursor.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rcu.mutation.EventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readcursor.EventTable,
+			Columns: []string{readcursor.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{readcursor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rcu.mutation.done = true
+	return n, nil
+}
+
+// ReadCursorUpdateOne is the builder for updating a single ReadCursor entity.
+type ReadCursorUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReadCursorMutation
+}
+
+// SetLastReadMessageID sets the "last_read_message_id" field.
+func (rcuo *ReadCursorUpdateOne) SetLastReadMessageID(i int) *ReadCursorUpdateOne {
+	rcuo.mutation.ResetLastReadMessageID()
+	rcuo.mutation.SetLastReadMessageID(i)
+	return rcuo
+}
+
+// SetNillableLastReadMessageID sets the "last_read_message_id" field if the given value is not nil.
+func (rcuo *ReadCursorUpdateOne) SetNillableLastReadMessageID(i *int) *ReadCursorUpdateOne {
+	if i != nil {
+		rcuo.SetLastReadMessageID(*i)
+	}
+	return rcuo
+}
+
+// AddLastReadMessageID adds i to the "last_read_message_id" field.
+func (rcuo *ReadCursorUpdateOne) AddLastReadMessageID(i int) *ReadCursorUpdateOne {
+	rcuo.mutation.AddLastReadMessageID(i)
+	return rcuo
+}
+
+// SetReadAt sets the "read_at" field.
+func (rcuo *ReadCursorUpdateOne) SetReadAt(t time.Time) *ReadCursorUpdateOne {
+	rcuo.mutation.SetReadAt(t)
+	return rcuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (rcuo *ReadCursorUpdateOne) SetUserID(id int) *ReadCursorUpdateOne {
+	rcuo.mutation.SetUserID(id)
+	return rcuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (rcuo *ReadCursorUpdateOne) SetUser(u *User) *ReadCursorUpdateOne {
+	return rcuo.SetUserID(u.ID)
+}
+
+// SetEventID sets the "event" edge to the Event entity by ID.
+func (rcuo *ReadCursorUpdateOne) SetEventID(id int) *ReadCursorUpdateOne {
+	rcuo.mutation.SetEventID(id)
+	return rcuo
+}
+
+// SetEvent sets the "event" edge to the Event entity.
+func (rcuo *ReadCursorUpdateOne) SetEvent(e *Event) *ReadCursorUpdateOne {
+	return rcuo.SetEventID(e.ID)
+}
+
+// Mutation returns the ReadCursorMutation object of the builder.
+func (rcuo *ReadCursorUpdateOne) Mutation() *ReadCursorMutation {
+	return rcuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (rcuo *ReadCursorUpdateOne) ClearUser() *ReadCursorUpdateOne {
+	rcuo.mutation.ClearUser()
+	return rcuo
+}
+
+// ClearEvent clears the "event" edge to the Event entity.
+func (rcuo *ReadCursorUpdateOne) ClearEvent() *ReadCursorUpdateOne {
+	rcuo.mutation.ClearEvent()
+	return rcuo
+}
+
+// Where appends a list predicates to the ReadCursorUpdate builder.
+func (rcuo *ReadCursorUpdateOne) Where(ps ...predicate.ReadCursor) *ReadCursorUpdateOne {
+	rcuo.mutation.Where(ps...)
+	return rcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rcuo *ReadCursorUpdateOne) Select(field string, fields ...string) *ReadCursorUpdateOne {
+	rcuo.fields = append([]string{field}, fields...)
+	return rcuo
+}
+
+// Save executes the query and returns the updated ReadCursor entity.
+func (rcuo *ReadCursorUpdateOne) Save(ctx context.Context) (*ReadCursor, error) {
+	rcuo.defaults()
+	return withHooks(ctx, rcuo.sqlSave, rcuo.mutation, rcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcuo *ReadCursorUpdateOne) SaveX(ctx context.Context) *ReadCursor {
+	node, err := rcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rcuo *ReadCursorUpdateOne) Exec(ctx context.Context) error {
+	_, err := rcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcuo *ReadCursorUpdateOne) ExecX(ctx context.Context) {
+	if err := rcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rcuo *ReadCursorUpdateOne) defaults() {
+	if _, ok := rcuo.mutation.ReadAt(); !ok {
+		v := readcursor.UpdateDefaultReadAt()
+		rcuo.mutation.SetReadAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rcuo *ReadCursorUpdateOne) check() error {
+	if v, ok := rcuo.mutation.LastReadMessageID(); ok {
+		if err := readcursor.LastReadMessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "last_read_message_id", err: fmt.Errorf(`ent: validator failed for field "ReadCursor.last_read_message_id": %w`, err)}
+		}
+	}
+	if rcuo.mutation.UserCleared() && len(rcuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReadCursor.user"`)
+	}
+	if rcuo.mutation.EventCleared() && len(rcuo.mutation.EventIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReadCursor.event"`)
+	}
+	return nil
+}
+
+func (rcuo *ReadCursorUpdateOne) sqlSave(ctx context.Context) (_node *ReadCursor, err error) {
+	if err := rcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(readcursor.Table, readcursor.Columns, sqlgraph.NewFieldSpec(readcursor.FieldID, field.TypeInt))
+	id, ok := rcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReadCursor.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, readcursor.FieldID)
+		for _, f := range fields {
+			if !readcursor.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != readcursor.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rcuo.mutation.LastReadMessageID(); ok {
+		_spec.SetField(readcursor.FieldLastReadMessageID, field.TypeInt, value)
+	}
+	if value, ok := rcuo.mutation.AddedLastReadMessageID(); ok {
+		_spec.AddField(readcursor.FieldLastReadMessageID, field.TypeInt, value)
+	}
+	if value, ok := rcuo.mutation.ReadAt(); ok {
+		_spec.SetField(readcursor.FieldReadAt, field.TypeTime, value)
+	}
+	if rcuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readcursor.UserTable,
+			Columns: []string{readcursor.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rcuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readcursor.UserTable,
+			Columns: []string{readcursor.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rcuo.mutation.EventCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readcursor.EventTable,
+			Columns: []string{readcursor.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rcuo.mutation.EventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readcursor.EventTable,
+			Columns: []string{readcursor.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ReadCursor{config: rcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{readcursor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rcuo.mutation.done = true
+	return _node, nil
+}
@@ -5,9 +5,19 @@ package ent
 import (
 	"time"
 
+	"github.com/matsuokashuhei/morrow-backend/ent/attachment"
+	"github.com/matsuokashuhei/morrow-backend/ent/auditentry"
+	"github.com/matsuokashuhei/morrow-backend/ent/billingevent"
+	"github.com/matsuokashuhei/morrow-backend/ent/dataexport"
 	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/message"
 	"github.com/matsuokashuhei/morrow-backend/ent/participant"
+	"github.com/matsuokashuhei/morrow-backend/ent/plan"
+	"github.com/matsuokashuhei/morrow-backend/ent/ratelimitbucket"
+	"github.com/matsuokashuhei/morrow-backend/ent/reaction"
+	"github.com/matsuokashuhei/morrow-backend/ent/readcursor"
 	"github.com/matsuokashuhei/morrow-backend/ent/schema"
+	"github.com/matsuokashuhei/morrow-backend/ent/subscription"
 	"github.com/matsuokashuhei/morrow-backend/ent/user"
 )
 
@@ -15,18 +25,110 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	attachmentFields := schema.Attachment{}.Fields()
+	_ = attachmentFields
+	// attachmentDescKey is the schema descriptor for key field.
+	attachmentDescKey := attachmentFields[0].Descriptor()
+	// attachment.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	attachment.KeyValidator = attachmentDescKey.Validators[0].(func(string) error)
+	// attachmentDescSize is the schema descriptor for size field.
+	attachmentDescSize := attachmentFields[3].Descriptor()
+	// attachment.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	attachment.SizeValidator = attachmentDescSize.Validators[0].(func(int64) error)
+	// attachmentDescWidth is the schema descriptor for width field.
+	attachmentDescWidth := attachmentFields[4].Descriptor()
+	// attachment.WidthValidator is a validator for the "width" field. It is called by the builders before save.
+	attachment.WidthValidator = attachmentDescWidth.Validators[0].(func(int) error)
+	// attachmentDescHeight is the schema descriptor for height field.
+	attachmentDescHeight := attachmentFields[5].Descriptor()
+	// attachment.HeightValidator is a validator for the "height" field. It is called by the builders before save.
+	attachment.HeightValidator = attachmentDescHeight.Validators[0].(func(int) error)
+	// attachmentDescCreatedAt is the schema descriptor for created_at field.
+	attachmentDescCreatedAt := attachmentFields[6].Descriptor()
+	// attachment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	attachment.DefaultCreatedAt = attachmentDescCreatedAt.Default.(func() time.Time)
+	auditentryFields := schema.AuditEntry{}.Fields()
+	_ = auditentryFields
+	// auditentryDescEntityType is the schema descriptor for entity_type field.
+	auditentryDescEntityType := auditentryFields[2].Descriptor()
+	// auditentry.EntityTypeValidator is a validator for the "entity_type" field. It is called by the builders before save.
+	auditentry.EntityTypeValidator = auditentryDescEntityType.Validators[0].(func(string) error)
+	// auditentryDescCreatedAt is the schema descriptor for created_at field.
+	auditentryDescCreatedAt := auditentryFields[9].Descriptor()
+	// auditentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditentry.DefaultCreatedAt = auditentryDescCreatedAt.Default.(func() time.Time)
+	billingeventFields := schema.BillingEvent{}.Fields()
+	_ = billingeventFields
+	// billingeventDescProvider is the schema descriptor for provider field.
+	billingeventDescProvider := billingeventFields[0].Descriptor()
+	// billingevent.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	billingevent.ProviderValidator = billingeventDescProvider.Validators[0].(func(string) error)
+	// billingeventDescProviderEventID is the schema descriptor for provider_event_id field.
+	billingeventDescProviderEventID := billingeventFields[1].Descriptor()
+	// billingevent.ProviderEventIDValidator is a validator for the "provider_event_id" field. It is called by the builders before save.
+	billingevent.ProviderEventIDValidator = billingeventDescProviderEventID.Validators[0].(func(string) error)
+	// billingeventDescCreatedAt is the schema descriptor for created_at field.
+	billingeventDescCreatedAt := billingeventFields[8].Descriptor()
+	// billingevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	billingevent.DefaultCreatedAt = billingeventDescCreatedAt.Default.(func() time.Time)
+	dataexportFields := schema.DataExport{}.Fields()
+	_ = dataexportFields
+	// dataexportDescSize is the schema descriptor for size field.
+	dataexportDescSize := dataexportFields[2].Descriptor()
+	// dataexport.DefaultSize holds the default value on creation for the size field.
+	dataexport.DefaultSize = dataexportDescSize.Default.(int64)
+	// dataexport.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	dataexport.SizeValidator = dataexportDescSize.Validators[0].(func(int64) error)
+	// dataexportDescCreatedAt is the schema descriptor for created_at field.
+	dataexportDescCreatedAt := dataexportFields[4].Descriptor()
+	// dataexport.DefaultCreatedAt holds the default value on creation for the created_at field.
+	dataexport.DefaultCreatedAt = dataexportDescCreatedAt.Default.(func() time.Time)
 	eventFields := schema.Event{}.Fields()
 	_ = eventFields
+	// eventDescTimeZone is the schema descriptor for time_zone field.
+	eventDescTimeZone := eventFields[4].Descriptor()
+	// event.TimeZoneValidator is a validator for the "time_zone" field. It is called by the builders before save.
+	event.TimeZoneValidator = eventDescTimeZone.Validators[0].(func(string) error)
+	// eventDescRecurrenceRule is the schema descriptor for recurrence_rule field.
+	eventDescRecurrenceRule := eventFields[5].Descriptor()
+	// event.RecurrenceRuleValidator is a validator for the "recurrence_rule" field. It is called by the builders before save.
+	event.RecurrenceRuleValidator = eventDescRecurrenceRule.Validators[0].(func(string) error)
+	// eventDescSequence is the schema descriptor for sequence field.
+	eventDescSequence := eventFields[7].Descriptor()
+	// event.DefaultSequence holds the default value on creation for the sequence field.
+	event.DefaultSequence = eventDescSequence.Default.(int)
+	// event.SequenceValidator is a validator for the "sequence" field. It is called by the builders before save.
+	event.SequenceValidator = eventDescSequence.Validators[0].(func(int) error)
+	// eventDescEmoji is the schema descriptor for emoji field.
+	eventDescEmoji := eventFields[8].Descriptor()
+	// event.EmojiValidator is a validator for the "emoji" field. It is called by the builders before save.
+	event.EmojiValidator = eventDescEmoji.Validators[0].(func(string) error)
 	// eventDescCreatedAt is the schema descriptor for created_at field.
-	eventDescCreatedAt := eventFields[6].Descriptor()
+	eventDescCreatedAt := eventFields[10].Descriptor()
 	// event.DefaultCreatedAt holds the default value on creation for the created_at field.
 	event.DefaultCreatedAt = eventDescCreatedAt.Default.(func() time.Time)
 	// eventDescUpdatedAt is the schema descriptor for updated_at field.
-	eventDescUpdatedAt := eventFields[7].Descriptor()
+	eventDescUpdatedAt := eventFields[11].Descriptor()
 	// event.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	event.DefaultUpdatedAt = eventDescUpdatedAt.Default.(func() time.Time)
 	// event.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	event.UpdateDefaultUpdatedAt = eventDescUpdatedAt.UpdateDefault.(func() time.Time)
+	messageFields := schema.Message{}.Fields()
+	_ = messageFields
+	// messageDescBody is the schema descriptor for body field.
+	messageDescBody := messageFields[0].Descriptor()
+	// message.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	message.BodyValidator = messageDescBody.Validators[0].(func(string) error)
+	// messageDescCreatedAt is the schema descriptor for created_at field.
+	messageDescCreatedAt := messageFields[3].Descriptor()
+	// message.DefaultCreatedAt holds the default value on creation for the created_at field.
+	message.DefaultCreatedAt = messageDescCreatedAt.Default.(func() time.Time)
+	// messageDescUpdatedAt is the schema descriptor for updated_at field.
+	messageDescUpdatedAt := messageFields[4].Descriptor()
+	// message.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	message.DefaultUpdatedAt = messageDescUpdatedAt.Default.(func() time.Time)
+	// message.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	message.UpdateDefaultUpdatedAt = messageDescUpdatedAt.UpdateDefault.(func() time.Time)
 	participantFields := schema.Participant{}.Fields()
 	_ = participantFields
 	// participantDescJoinedAt is the schema descriptor for joined_at field.
@@ -39,14 +141,92 @@ func init() {
 	participant.DefaultUpdatedAt = participantDescUpdatedAt.Default.(func() time.Time)
 	// participant.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	participant.UpdateDefaultUpdatedAt = participantDescUpdatedAt.UpdateDefault.(func() time.Time)
+	planFields := schema.Plan{}.Fields()
+	_ = planFields
+	// planDescKey is the schema descriptor for key field.
+	planDescKey := planFields[0].Descriptor()
+	// plan.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	plan.KeyValidator = planDescKey.Validators[0].(func(string) error)
+	// planDescName is the schema descriptor for name field.
+	planDescName := planFields[1].Descriptor()
+	// plan.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	plan.NameValidator = planDescName.Validators[0].(func(string) error)
+	// planDescMaxActiveEvents is the schema descriptor for max_active_events field.
+	planDescMaxActiveEvents := planFields[2].Descriptor()
+	// plan.MaxActiveEventsValidator is a validator for the "max_active_events" field. It is called by the builders before save.
+	plan.MaxActiveEventsValidator = planDescMaxActiveEvents.Validators[0].(func(int) error)
+	// planDescMaxParticipantsPerEvent is the schema descriptor for max_participants_per_event field.
+	planDescMaxParticipantsPerEvent := planFields[3].Descriptor()
+	// plan.MaxParticipantsPerEventValidator is a validator for the "max_participants_per_event" field. It is called by the builders before save.
+	plan.MaxParticipantsPerEventValidator = planDescMaxParticipantsPerEvent.Validators[0].(func(int) error)
+	// planDescMaxAttachmentStorage is the schema descriptor for max_attachment_storage field.
+	planDescMaxAttachmentStorage := planFields[4].Descriptor()
+	// plan.MaxAttachmentStorageValidator is a validator for the "max_attachment_storage" field. It is called by the builders before save.
+	plan.MaxAttachmentStorageValidator = planDescMaxAttachmentStorage.Validators[0].(func(int64) error)
+	// planDescCreatedAt is the schema descriptor for created_at field.
+	planDescCreatedAt := planFields[5].Descriptor()
+	// plan.DefaultCreatedAt holds the default value on creation for the created_at field.
+	plan.DefaultCreatedAt = planDescCreatedAt.Default.(func() time.Time)
+	// planDescUpdatedAt is the schema descriptor for updated_at field.
+	planDescUpdatedAt := planFields[6].Descriptor()
+	// plan.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	plan.DefaultUpdatedAt = planDescUpdatedAt.Default.(func() time.Time)
+	// plan.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	plan.UpdateDefaultUpdatedAt = planDescUpdatedAt.UpdateDefault.(func() time.Time)
+	ratelimitbucketFields := schema.RateLimitBucket{}.Fields()
+	_ = ratelimitbucketFields
+	// ratelimitbucketDescKey is the schema descriptor for key field.
+	ratelimitbucketDescKey := ratelimitbucketFields[0].Descriptor()
+	// ratelimitbucket.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	ratelimitbucket.KeyValidator = ratelimitbucketDescKey.Validators[0].(func(string) error)
+	reactionFields := schema.Reaction{}.Fields()
+	_ = reactionFields
+	// reactionDescEmoji is the schema descriptor for emoji field.
+	reactionDescEmoji := reactionFields[0].Descriptor()
+	// reaction.EmojiValidator is a validator for the "emoji" field. It is called by the builders before save.
+	reaction.EmojiValidator = reactionDescEmoji.Validators[0].(func(string) error)
+	// reactionDescCreatedAt is the schema descriptor for created_at field.
+	reactionDescCreatedAt := reactionFields[1].Descriptor()
+	// reaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reaction.DefaultCreatedAt = reactionDescCreatedAt.Default.(func() time.Time)
+	readcursorFields := schema.ReadCursor{}.Fields()
+	_ = readcursorFields
+	// readcursorDescLastReadMessageID is the schema descriptor for last_read_message_id field.
+	readcursorDescLastReadMessageID := readcursorFields[0].Descriptor()
+	// readcursor.DefaultLastReadMessageID holds the default value on creation for the last_read_message_id field.
+	readcursor.DefaultLastReadMessageID = readcursorDescLastReadMessageID.Default.(int)
+	// readcursor.LastReadMessageIDValidator is a validator for the "last_read_message_id" field. It is called by the builders before save.
+	readcursor.LastReadMessageIDValidator = readcursorDescLastReadMessageID.Validators[0].(func(int) error)
+	// readcursorDescReadAt is the schema descriptor for read_at field.
+	readcursorDescReadAt := readcursorFields[1].Descriptor()
+	// readcursor.DefaultReadAt holds the default value on creation for the read_at field.
+	readcursor.DefaultReadAt = readcursorDescReadAt.Default.(func() time.Time)
+	// readcursor.UpdateDefaultReadAt holds the default value on update for the read_at field.
+	readcursor.UpdateDefaultReadAt = readcursorDescReadAt.UpdateDefault.(func() time.Time)
+	subscriptionFields := schema.Subscription{}.Fields()
+	_ = subscriptionFields
+	// subscriptionDescCreatedAt is the schema descriptor for created_at field.
+	subscriptionDescCreatedAt := subscriptionFields[5].Descriptor()
+	// subscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	subscription.DefaultCreatedAt = subscriptionDescCreatedAt.Default.(func() time.Time)
+	// subscriptionDescUpdatedAt is the schema descriptor for updated_at field.
+	subscriptionDescUpdatedAt := subscriptionFields[6].Descriptor()
+	// subscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	subscription.DefaultUpdatedAt = subscriptionDescUpdatedAt.Default.(func() time.Time)
+	// subscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	subscription.UpdateDefaultUpdatedAt = subscriptionDescUpdatedAt.UpdateDefault.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
+	// userDescIsAdmin is the schema descriptor for is_admin field.
+	userDescIsAdmin := userFields[6].Descriptor()
+	// user.DefaultIsAdmin holds the default value on creation for the is_admin field.
+	user.DefaultIsAdmin = userDescIsAdmin.Default.(bool)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[4].Descriptor()
+	userDescCreatedAt := userFields[8].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[5].Descriptor()
+	userDescUpdatedAt := userFields[9].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
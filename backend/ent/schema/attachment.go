@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Attachment holds the schema definition for the Attachment entity.
+// The file itself lives in the storage backend (internal/storage).
+type Attachment struct {
+	ent.Schema
+}
+
+// Fields of the Attachment.
+func (Attachment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			NotEmpty().
+			Immutable().
+			Comment("ストレージ上のオブジェクトキー"),
+		field.String("thumbnail_key").
+			Optional().
+			Immutable().
+			Comment("サムネイルのオブジェクトキー"),
+		field.String("content_type").
+			Immutable().
+			Comment("内容から判定したMIMEタイプ"),
+		field.Int64("size").
+			NonNegative().
+			Immutable().
+			Comment("ファイルサイズ (バイト)"),
+		field.Int("width").
+			NonNegative().
+			Immutable().
+			Comment("画像の幅 (px)"),
+		field.Int("height").
+			NonNegative().
+			Immutable().
+			Comment("画像の高さ (px)"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("アップロード日時"),
+	}
+}
+
+// Edges of the Attachment.
+func (Attachment) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 添付先のメッセージ
+		edge.From("message", Message.Type).
+			Ref("attachments").
+			Unique().
+			Required().
+			Comment("添付先のメッセージ"),
+		// アップロードしたユーザー
+		edge.From("uploader", User.Type).
+			Ref("attachments").
+			Unique().
+			Required().
+			Comment("アップロードしたユーザー"),
+	}
+}
+
+// Indexes of the Attachment.
+func (Attachment) Indexes() []ent.Index {
+	return []ent.Index{
+		// メッセージごとの添付ファイル取得用
+		index.Edges("message"),
+	}
+}
+
+// Annotations of the Attachment.
+func (Attachment) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+	}
+}
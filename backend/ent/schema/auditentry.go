@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// FieldChange is one changed field of an audited mutation. Values are JSON encoded.
+type FieldChange struct {
+	Field  string `json:"field"`
+	Before any    `json:"before,omitempty"`
+	After  any    `json:"after,omitempty"`
+}
+
+// AuditEntry holds the schema definition for the AuditEntry entity.
+// Entries are written by the hooks of internal/audit and never modified,
+// except that closing an account redacts the personal values of the user.
+type AuditEntry struct {
+	ent.Schema
+}
+
+// Fields of the AuditEntry.
+func (AuditEntry) Fields() []ent.Field {
+	return []ent.Field{
+		// ユーザー削除後も記録を残すため、エッジではなくIDで保持する
+		field.Int("actor_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("操作したユーザーのID (匿名・システムによる操作はNULL)"),
+		field.String("actor_subject").
+			Optional().
+			Immutable().
+			Comment("操作した認証主体 (Cognito sub)"),
+		field.String("entity_type").
+			NotEmpty().
+			Immutable().
+			Comment("対象のエンティティ種別 (例: Event)"),
+		field.Int("entity_id").
+			Immutable().
+			Comment("対象のエンティティID"),
+		field.Int("event_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("イベントまたはその参加者に関する記録の場合のイベントID (アクティビティ表示用)"),
+		field.Enum("operation").
+			Values("create", "update", "delete").
+			Immutable().
+			Comment("操作の種類"),
+		// 退会したユーザーの個人情報を消せるよう、変更前後の値だけは更新できる
+		field.JSON("changes", []FieldChange{}).
+			Comment("変更されたフィールドと変更前後の値"),
+		field.String("request_id").
+			Optional().
+			Immutable().
+			Comment("操作を行ったリクエストのID"),
+		field.String("ip").
+			Optional().
+			Immutable().
+			Comment("操作元のIPアドレス"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("記録日時"),
+	}
+}
+
+// Indexes of the AuditEntry.
+func (AuditEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		// エンティティごとの履歴取得用
+		index.Fields("entity_type", "entity_id"),
+		// 操作者ごとの履歴取得用
+		index.Fields("actor_id"),
+		// イベントのアクティビティ取得用
+		index.Fields("event_id"),
+	}
+}
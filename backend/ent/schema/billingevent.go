@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BillingEvent holds the schema definition for the BillingEvent entity.
+// It is an append-only ledger of the payment provider webhooks applied to a user.
+type BillingEvent struct {
+	ent.Schema
+}
+
+// Fields of the BillingEvent.
+func (BillingEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("provider").
+			NotEmpty().
+			Immutable().
+			Comment("決済プロバイダー名 (例: stripe)"),
+		field.String("provider_event_id").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Comment("プロバイダーのイベントID (Webhookの重複処理防止用)"),
+		field.Enum("type").
+			Values("subscription_created", "subscription_renewed", "subscription_cancelled").
+			Immutable().
+			Comment("イベントの種類"),
+		field.String("plan_key").
+			Optional().
+			Immutable().
+			Comment("イベント時点のプラン"),
+		field.String("provider_subscription_id").
+			Optional().
+			Immutable().
+			Comment("プロバイダーの契約ID"),
+		field.Time("period_end").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("契約期間の終了日時"),
+		field.Text("payload").
+			Immutable().
+			Sensitive().
+			Comment("受信したWebhookの本文"),
+		field.Time("occurred_at").
+			Immutable().
+			Comment("プロバイダー側でイベントが発生した日時"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("記録日時"),
+	}
+}
+
+// Edges of the BillingEvent.
+func (BillingEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 対象ユーザー (ユーザーの完全削除後は空になる)
+		edge.From("user", User.Type).
+			Ref("billing_events").
+			Unique().
+			Immutable().
+			Comment("対象ユーザー"),
+	}
+}
+
+// Indexes of the BillingEvent.
+func (BillingEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		// ユーザーごとの履歴取得用
+		index.Fields("occurred_at").
+			Edges("user"),
+	}
+}
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DataExport holds the schema definition for the DataExport entity.
+// It tracks a zip of everything stored about a user, built by a background job.
+type DataExport struct {
+	ent.Schema
+}
+
+// Fields of the DataExport.
+func (DataExport) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("status").
+			Values("pending", "processing", "ready", "failed", "expired").
+			Default("pending").
+			Comment("処理状態 (pending: 待機中, processing: 作成中, ready: ダウンロード可能, failed: 失敗, expired: 期限切れで削除済み)"),
+		field.String("key").
+			Optional().
+			Comment("作成したzipのオブジェクトキー"),
+		field.Int64("size").
+			NonNegative().
+			Default(0).
+			Comment("zipのサイズ (バイト)"),
+		field.String("error").
+			Optional().
+			Comment("失敗した理由"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("依頼日時"),
+		field.Time("claimed_at").
+			Optional().
+			Nillable().
+			Comment("ワーカーが作成を始めた日時 (作成中のまま止まったエクスポートを見つけるため)"),
+		field.Time("completed_at").
+			Optional().
+			Nillable().
+			Comment("作成が完了した日時"),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("zipを削除してダウンロードできなくなる日時"),
+	}
+}
+
+// Edges of the DataExport.
+func (DataExport) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 依頼したユーザー
+		edge.From("user", User.Type).
+			Ref("data_exports").
+			Unique().
+			Required().
+			Immutable().
+			Comment("依頼したユーザー"),
+	}
+}
+
+// Indexes of the DataExport.
+func (DataExport) Indexes() []ent.Index {
+	return []ent.Index{
+		// バックグラウンドジョブが処理対象を探すため
+		index.Fields("status"),
+	}
+}
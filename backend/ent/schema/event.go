@@ -5,9 +5,13 @@ import (
 
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/matsuokashuhei/morrow-backend/internal/emoji"
+	"github.com/matsuokashuhei/morrow-backend/internal/ical"
 )
 
 // Event holds the schema definition for the Event entity.
@@ -15,6 +19,14 @@ type Event struct {
 	ent.Schema
 }
 
+// Mixin of the Event.
+func (Event) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		// 削除はゴミ箱への移動とし、保持期間内なら復元できる
+		SoftDeleteMixin{},
+	}
+}
+
 // Fields of the Event.
 func (Event) Fields() []ent.Field {
 	return []ent.Field{
@@ -30,8 +42,25 @@ func (Event) Fields() []ent.Field {
 		field.Time("end_time").
 			Comment("イベント終了日時").
 			Annotations(entgql.OrderField("END_TIME")),
+		field.String("time_zone").
+			Optional().
+			Validate(ical.ValidateTimeZone).
+			Comment("イベントのタイムゾーン (IANA名, 例: Asia/Tokyo)"),
+		field.String("recurrence_rule").
+			Optional().
+			Validate(ical.ValidateRRule).
+			Comment("繰り返しルール (RFC 5545 RRULE, 例: FREQ=WEEKLY;COUNT=10)"),
+		field.String("ical_uid").
+			Optional().
+			Immutable().
+			Comment("iCalendarからインポートしたイベントのUID (再インポート時の重複排除用)"),
+		field.Int("sequence").
+			NonNegative().
+			Default(0).
+			Comment("iCalendarのSEQUENCE (招待状の更新のたびに増える)"),
 		field.String("emoji").
 			Optional().
+			Validate(emoji.ValidateOptional).
 			Comment("イベントの絵文字"),
 		field.Enum("visibility").
 			Values("private", "shared", "public").
@@ -60,7 +89,30 @@ func (Event) Edges() []ent.Edge {
 			Comment("イベントの作成者"),
 		// イベントの参加者（Participantを通じて）
 		edge.To("participants", Participant.Type).
-			Comment("イベントの参加者情報"),
+			Comment("イベントの参加者情報").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// イベントのチャットメッセージ
+		edge.To("messages", Message.Type).
+			Comment("イベントのチャットメッセージ").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// イベントへのリアクション
+		edge.To("reactions", Reaction.Type).
+			Comment("イベントへのリアクション").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// チャットの既読位置
+		edge.To("read_cursors", ReadCursor.Type).
+			Comment("参加者ごとのチャット既読位置").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Event.
+func (Event) Indexes() []ent.Index {
+	return []ent.Index{
+		// 同じユーザーが同じUIDを二重にインポートしないようにする
+		index.Fields("ical_uid").
+			Edges("creator").
+			Unique(),
 	}
 }
 
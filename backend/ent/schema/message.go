@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Message holds the schema definition for the Message entity.
+type Message struct {
+	ent.Schema
+}
+
+// Fields of the Message.
+func (Message) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("body").
+			NotEmpty().
+			Comment("メッセージ本文"),
+		field.Time("edited_at").
+			Optional().
+			Nillable().
+			Comment("最終編集日時"),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("削除日時 (履歴保持のため行は残す)"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("送信日時").
+			Annotations(entgql.OrderField("CREATED_AT")),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新日時"),
+	}
+}
+
+// Edges of the Message.
+func (Message) Edges() []ent.Edge {
+	return []ent.Edge{
+		// メッセージが投稿されたイベント
+		edge.From("event", Event.Type).
+			Ref("messages").
+			Unique().
+			Required().
+			Comment("投稿先のイベント"),
+		// メッセージの送信者
+		// 退会したユーザーのメッセージは送信者がNULLになる
+		edge.From("author", User.Type).
+			Ref("messages").
+			Unique().
+			Comment("メッセージの送信者"),
+		// メッセージへのリアクション
+		edge.To("reactions", Reaction.Type).
+			Comment("メッセージへのリアクション").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// メッセージの添付ファイル
+		edge.To("attachments", Attachment.Type).
+			Comment("メッセージの添付ファイル").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Message.
+func (Message) Indexes() []ent.Index {
+	return []ent.Index{
+		// イベントごとのチャット履歴のページング用
+		index.Edges("event"),
+	}
+}
+
+// Annotations of the Message.
+func (Message) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+	}
+}
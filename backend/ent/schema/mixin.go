@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// SoftDeleteMixin adds deleted_at to entities that are moved to the trash instead of being deleted.
+// The interceptors and hooks of internal/softdelete hide trashed rows and set the field.
+type SoftDeleteMixin struct {
+	mixin.Schema
+}
+
+// Fields of the SoftDeleteMixin.
+func (SoftDeleteMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("削除日時 (ゴミ箱にある間だけ設定され、保持期間を過ぎると完全に削除される)"),
+	}
+}
+
+// Indexes of the SoftDeleteMixin.
+func (SoftDeleteMixin) Indexes() []ent.Index {
+	return []ent.Index{
+		// ゴミ箱の一覧と期限切れの削除用
+		index.Fields("deleted_at"),
+	}
+}
@@ -19,9 +19,9 @@ type Participant struct {
 func (Participant) Fields() []ent.Field {
 	return []ent.Field{
 		field.Enum("role").
-			Values("owner", "viewer").
+			Values("owner", "editor", "moderator", "viewer").
 			Default("viewer").
-			Comment("参加者の権限 (owner: 作成者, viewer: 閲覧者)"),
+			Comment("参加者の権限 (owner: 作成者, editor: 共同編集者, moderator: チャット管理者, viewer: 閲覧者)"),
 		field.Enum("status").
 			Values("pending", "accepted", "declined").
 			Default("pending").
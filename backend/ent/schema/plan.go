@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Plan holds the schema definition for the Plan entity.
+// A plan defines the limits enforced by internal/entitlement.
+type Plan struct {
+	ent.Schema
+}
+
+// Fields of the Plan.
+func (Plan) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Comment("プランの識別子 (例: free, pro)"),
+		field.String("name").
+			NotEmpty().
+			Comment("プランの表示名"),
+		field.Int("max_active_events").
+			Optional().
+			Nillable().
+			NonNegative().
+			Comment("終了していないイベントの作成上限 (NULLは無制限)"),
+		field.Int("max_participants_per_event").
+			Optional().
+			Nillable().
+			NonNegative().
+			Comment("1イベントあたりの参加者の上限 (NULLは無制限)"),
+		field.Int64("max_attachment_storage").
+			Optional().
+			Nillable().
+			NonNegative().
+			Comment("チャット添付ファイルの合計サイズの上限 (バイト, NULLは無制限)"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("作成日時"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新日時"),
+	}
+}
+
+// Edges of the Plan.
+func (Plan) Edges() []ent.Edge {
+	return []ent.Edge{
+		// このプランの契約
+		edge.To("subscriptions", Subscription.Type).
+			Comment("このプランの契約"),
+	}
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RateLimitBucket holds the schema definition for the RateLimitBucket entity.
+// It is a token bucket of the rate limiter shared by all API servers.
+type RateLimitBucket struct {
+	ent.Schema
+}
+
+// Fields of the RateLimitBucket.
+func (RateLimitBucket) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Comment("予算とクライアントの組 (例: mutation:user:abc)"),
+		field.Float("tokens").
+			Comment("updated_at 時点の残りトークン数"),
+		field.Time("updated_at").
+			Comment("トークン数を更新した日時"),
+		field.Time("full_at").
+			Comment("トークンが満タンに戻る日時 (以降は削除してよい)"),
+	}
+}
+
+// Indexes of the RateLimitBucket.
+func (RateLimitBucket) Indexes() []ent.Index {
+	return []ent.Index{
+		// 満タンに戻ったバケットの削除用
+		index.Fields("full_at"),
+	}
+}
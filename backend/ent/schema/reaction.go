@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/matsuokashuhei/morrow-backend/internal/emoji"
+)
+
+// Reaction holds the schema definition for the Reaction entity.
+// A reaction targets either a message or an event, never both.
+type Reaction struct {
+	ent.Schema
+}
+
+// Fields of the Reaction.
+func (Reaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("emoji").
+			Validate(emoji.Validate).
+			Comment("リアクションの絵文字 (スタンプ)"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("作成日時"),
+	}
+}
+
+// Edges of the Reaction.
+func (Reaction) Edges() []ent.Edge {
+	return []ent.Edge{
+		// リアクションしたユーザー
+		edge.From("user", User.Type).
+			Ref("reactions").
+			Unique().
+			Required().
+			Comment("リアクションしたユーザー"),
+		// リアクション対象のメッセージ
+		edge.From("message", Message.Type).
+			Ref("reactions").
+			Unique().
+			Comment("対象メッセージ (メッセージへのリアクションの場合)"),
+		// リアクション対象のイベント
+		edge.From("event", Event.Type).
+			Ref("reactions").
+			Unique().
+			Comment("対象イベント (イベントへのリアクションの場合)"),
+	}
+}
+
+// Indexes of the Reaction.
+func (Reaction) Indexes() []ent.Index {
+	return []ent.Index{
+		// 同じ対象に同じ絵文字は1ユーザー1回まで
+		index.Fields("emoji").
+			Edges("user", "message").
+			Unique(),
+		index.Fields("emoji").
+			Edges("user", "event").
+			Unique(),
+		// 対象ごとの集計用
+		index.Edges("message"),
+		index.Edges("event"),
+	}
+}
+
+// Annotations of the Reaction.
+func (Reaction) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+	}
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ReadCursor holds the schema definition for the ReadCursor entity.
+// It records how far a user has read the chat of an event.
+type ReadCursor struct {
+	ent.Schema
+}
+
+// Fields of the ReadCursor.
+func (ReadCursor) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("last_read_message_id").
+			Default(0).
+			NonNegative().
+			Comment("既読にした最後のメッセージID (0は未読)"),
+		field.Time("read_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("最終既読日時"),
+	}
+}
+
+// Edges of the ReadCursor.
+func (ReadCursor) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 既読を付けたユーザー
+		edge.From("user", User.Type).
+			Ref("read_cursors").
+			Unique().
+			Required().
+			Comment("既読を付けたユーザー"),
+		// 対象イベントのチャット
+		edge.From("event", Event.Type).
+			Ref("read_cursors").
+			Unique().
+			Required().
+			Comment("対象イベント"),
+	}
+}
+
+// Indexes of the ReadCursor.
+func (ReadCursor) Indexes() []ent.Index {
+	return []ent.Index{
+		// ユーザーごと・イベントごとに1件
+		index.Edges("user", "event").
+			Unique(),
+		// メッセージごとの既読者集計用
+		index.Fields("last_read_message_id").
+			Edges("event"),
+	}
+}
@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Subscription holds the schema definition for the Subscription entity.
+// Users without a subscription are on the free plan.
+type Subscription struct {
+	ent.Schema
+}
+
+// Fields of the Subscription.
+func (Subscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("status").
+			Values("active", "canceled").
+			Default("active").
+			Comment("契約状態 (canceledの場合は無料プランとして扱う)"),
+		field.String("provider_customer_id").
+			Optional().
+			Comment("決済プロバイダーの顧客ID (管理者による変更の場合は空)"),
+		field.String("provider_subscription_id").
+			Optional().
+			Nillable().
+			Unique().
+			Comment("決済プロバイダーの契約ID"),
+		field.Time("current_period_end").
+			Optional().
+			Nillable().
+			Comment("現在の契約期間の終了日時"),
+		field.Time("last_event_at").
+			Optional().
+			Nillable().
+			Comment("最後に反映した課金イベントの発生日時 (順序が入れ替わったWebhookを無視するため)"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("作成日時"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新日時"),
+	}
+}
+
+// Edges of the Subscription.
+func (Subscription) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 契約しているユーザー
+		edge.From("user", User.Type).
+			Ref("subscription").
+			Unique().
+			Required().
+			Comment("契約しているユーザー"),
+		// 契約しているプラン
+		edge.From("plan", Plan.Type).
+			Ref("subscriptions").
+			Unique().
+			Required().
+			Comment("契約しているプラン"),
+	}
+}
@@ -5,9 +5,11 @@ import (
 
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // User holds the schema definition for the User entity.
@@ -15,11 +17,18 @@ type User struct {
 	ent.Schema
 }
 
+// Mixin of the User.
+func (User) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		// 削除はゴミ箱への移動とし、保持期間内なら復元できる
+		SoftDeleteMixin{},
+	}
+}
+
 // Fields of the User.
 func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("email").
-			Unique().
 			Comment("ユーザーのメールアドレス").
 			Annotations(entgql.OrderField("EMAIL")),
 		field.String("name").
@@ -27,11 +36,27 @@ func (User) Fields() []ent.Field {
 			Annotations(entgql.OrderField("NAME")),
 		field.String("avatar_url").
 			Optional().
-			Comment("プロフィール画像のURL (S3)"),
+			Comment("プロフィール画像のURL (アップロード画像の場合は storage: で始まるオブジェクト参照)"),
 		field.String("cognito_id").
 			Optional().
-			Unique().
 			Comment("Amazon Cognito User ID (Phase 2で使用)"),
+		field.String("calendar_token_hash").
+			Optional().
+			Nillable().
+			Unique().
+			Sensitive().
+			Comment("カレンダーフィードURLのトークンのSHA-256ハッシュ (未発行・失効時はNULL)"),
+		field.Time("deletion_scheduled_at").
+			Optional().
+			Nillable().
+			Comment("退会の猶予期間が終わり、アカウントが削除される日時 (退会を申請していない場合はNULL)"),
+		field.Bool("is_admin").
+			Default(false).
+			Comment("管理者かどうか (プラン変更などの管理操作を行える)"),
+		field.Time("disabled_at").
+			Optional().
+			Nillable().
+			Comment("管理者がアカウントを無効にした日時 (無効なユーザーはサインインできない。有効な場合はNULL)"),
 		field.Time("created_at").
 			Default(time.Now).
 			Comment("作成日時").
@@ -48,11 +73,57 @@ func (User) Fields() []ent.Field {
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		// ユーザーが作成したイベント
+		// 削除時に他のユーザーへ引き継ぐか削除するかをアプリケーションで決めるため、連鎖削除しない
 		edge.To("created_events", Event.Type).
 			Comment("ユーザーが作成したイベント"),
 		// ユーザーが参加しているイベント（Participantを通じて）
 		edge.To("participants", Participant.Type).
-			Comment("ユーザーの参加情報"),
+			Comment("ユーザーの参加情報").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// ユーザーが送信したチャットメッセージ
+		// 退会後もチャットの履歴は送信者を匿名にして残す
+		edge.To("messages", Message.Type).
+			Comment("ユーザーが送信したメッセージ").
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		// ユーザーが付けたリアクション
+		edge.To("reactions", Reaction.Type).
+			Comment("ユーザーが付けたリアクション").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// ユーザーのチャット既読位置
+		edge.To("read_cursors", ReadCursor.Type).
+			Comment("イベントごとのチャット既読位置").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// ユーザーがアップロードした添付ファイル
+		edge.To("attachments", Attachment.Type).
+			Comment("ユーザーがアップロードした添付ファイル").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// ユーザーの契約 (未契約の場合は無料プラン)
+		edge.To("subscription", Subscription.Type).
+			Unique().
+			Comment("ユーザーの契約プラン").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// 決済プロバイダーから受け取った課金イベント
+		// 会計のため課金台帳は削除せず、ユーザーの完全削除後は対象ユーザーを空にして残す
+		edge.To("billing_events", BillingEvent.Type).
+			Comment("課金イベントの履歴").
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		// 個人データのエクスポート
+		edge.To("data_exports", DataExport.Type).
+			Comment("個人データのエクスポート").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the User.
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		// ゴミ箱にあるユーザーのメールアドレスとCognito IDは新しいユーザーが使えるようにする
+		index.Fields("email").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")),
+		index.Fields("cognito_id").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")),
 	}
 }
 
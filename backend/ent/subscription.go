@@ -0,0 +1,249 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/matsuokashuhei/morrow-backend/ent/plan"
+	"github.com/matsuokashuhei/morrow-backend/ent/subscription"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+)
+
+// Subscription is the model entity for the Subscription schema.
+type Subscription struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 契約状態 (canceledの場合は無料プランとして扱う)
+	Status subscription.Status `json:"status,omitempty"`
+	// 決済プロバイダーの顧客ID (管理者による変更の場合は空)
+	ProviderCustomerID string `json:"provider_customer_id,omitempty"`
+	// 決済プロバイダーの契約ID
+	ProviderSubscriptionID *string `json:"provider_subscription_id,omitempty"`
+	// 現在の契約期間の終了日時
+	CurrentPeriodEnd *time.Time `json:"current_period_end,omitempty"`
+	// 最後に反映した課金イベントの発生日時 (順序が入れ替わったWebhookを無視するため)
+	LastEventAt *time.Time `json:"last_event_at,omitempty"`
+	// 作成日時
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新日時
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SubscriptionQuery when eager-loading is set.
+	Edges              SubscriptionEdges `json:"edges"`
+	plan_subscriptions *int
+	user_subscription  *int
+	selectValues       sql.SelectValues
+}
+
+// SubscriptionEdges holds the relations/edges for other nodes in the graph.
+type SubscriptionEdges struct {
+	// 契約しているユーザー
+	User *User `json:"user,omitempty"`
+	// 契約しているプラン
+	Plan *Plan `json:"plan,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SubscriptionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// PlanOrErr returns the Plan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SubscriptionEdges) PlanOrErr() (*Plan, error) {
+	if e.Plan != nil {
+		return e.Plan, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: plan.Label}
+	}
+	return nil, &NotLoadedError{edge: "plan"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Subscription) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case subscription.FieldID:
+			values[i] = new(sql.NullInt64)
+		case subscription.FieldStatus, subscription.FieldProviderCustomerID, subscription.FieldProviderSubscriptionID:
+			values[i] = new(sql.NullString)
+		case subscription.FieldCurrentPeriodEnd, subscription.FieldLastEventAt, subscription.FieldCreatedAt, subscription.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case subscription.ForeignKeys[0]: // plan_subscriptions
+			values[i] = new(sql.NullInt64)
+		case subscription.ForeignKeys[1]: // user_subscription
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Subscription fields.
+func (s *Subscription) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case subscription.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			s.ID = int(value.Int64)
+		case subscription.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				s.Status = subscription.Status(value.String)
+			}
+		case subscription.FieldProviderCustomerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_customer_id", values[i])
+			} else if value.Valid {
+				s.ProviderCustomerID = value.String
+			}
+		case subscription.FieldProviderSubscriptionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_subscription_id", values[i])
+			} else if value.Valid {
+				s.ProviderSubscriptionID = new(string)
+				*s.ProviderSubscriptionID = value.String
+			}
+		case subscription.FieldCurrentPeriodEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field current_period_end", values[i])
+			} else if value.Valid {
+				s.CurrentPeriodEnd = new(time.Time)
+				*s.CurrentPeriodEnd = value.Time
+			}
+		case subscription.FieldLastEventAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_event_at", values[i])
+			} else if value.Valid {
+				s.LastEventAt = new(time.Time)
+				*s.LastEventAt = value.Time
+			}
+		case subscription.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case subscription.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				s.UpdatedAt = value.Time
+			}
+		case subscription.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field plan_subscriptions", value)
+			} else if value.Valid {
+				s.plan_subscriptions = new(int)
+				*s.plan_subscriptions = int(value.Int64)
+			}
+		case subscription.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_subscription", value)
+			} else if value.Valid {
+				s.user_subscription = new(int)
+				*s.user_subscription = int(value.Int64)
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Subscription.
+// This includes values selected through modifiers, order, etc.
+func (s *Subscription) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Subscription entity.
+func (s *Subscription) QueryUser() *UserQuery {
+	return NewSubscriptionClient(s.config).QueryUser(s)
+}
+
+// QueryPlan queries the "plan" edge of the Subscription entity.
+func (s *Subscription) QueryPlan() *PlanQuery {
+	return NewSubscriptionClient(s.config).QueryPlan(s)
+}
+
+// Update returns a builder for updating this Subscription.
+// Note that you need to call Subscription.Unwrap() before calling this method if this Subscription
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Subscription) Update() *SubscriptionUpdateOne {
+	return NewSubscriptionClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Subscription entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Subscription) Unwrap() *Subscription {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Subscription is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Subscription) String() string {
+	var builder strings.Builder
+	builder.WriteString("Subscription(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", s.Status))
+	builder.WriteString(", ")
+	builder.WriteString("provider_customer_id=")
+	builder.WriteString(s.ProviderCustomerID)
+	builder.WriteString(", ")
+	if v := s.ProviderSubscriptionID; v != nil {
+		builder.WriteString("provider_subscription_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := s.CurrentPeriodEnd; v != nil {
+		builder.WriteString("current_period_end=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := s.LastEventAt; v != nil {
+		builder.WriteString("last_event_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(s.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Subscriptions is a parsable slice of Subscription.
+type Subscriptions []*Subscription
@@ -0,0 +1,497 @@
+// Code generated by ent, DO NOT EDIT.
+
+package subscription
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/matsuokashuhei/morrow-backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldID, id))
+}
+
+// ProviderCustomerID applies equality check predicate on the "provider_customer_id" field. It's identical to ProviderCustomerIDEQ.
+func ProviderCustomerID(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldProviderCustomerID, v))
+}
+
+// ProviderSubscriptionID applies equality check predicate on the "provider_subscription_id" field. It's identical to ProviderSubscriptionIDEQ.
+func ProviderSubscriptionID(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldProviderSubscriptionID, v))
+}
+
+// CurrentPeriodEnd applies equality check predicate on the "current_period_end" field. It's identical to CurrentPeriodEndEQ.
+func CurrentPeriodEnd(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCurrentPeriodEnd, v))
+}
+
+// LastEventAt applies equality check predicate on the "last_event_at" field. It's identical to LastEventAtEQ.
+func LastEventAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldLastEventAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ProviderCustomerIDEQ applies the EQ predicate on the "provider_customer_id" field.
+func ProviderCustomerIDEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldProviderCustomerID, v))
+}
+
+// ProviderCustomerIDNEQ applies the NEQ predicate on the "provider_customer_id" field.
+func ProviderCustomerIDNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldProviderCustomerID, v))
+}
+
+// ProviderCustomerIDIn applies the In predicate on the "provider_customer_id" field.
+func ProviderCustomerIDIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldProviderCustomerID, vs...))
+}
+
+// ProviderCustomerIDNotIn applies the NotIn predicate on the "provider_customer_id" field.
+func ProviderCustomerIDNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldProviderCustomerID, vs...))
+}
+
+// ProviderCustomerIDGT applies the GT predicate on the "provider_customer_id" field.
+func ProviderCustomerIDGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldProviderCustomerID, v))
+}
+
+// ProviderCustomerIDGTE applies the GTE predicate on the "provider_customer_id" field.
+func ProviderCustomerIDGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldProviderCustomerID, v))
+}
+
+// ProviderCustomerIDLT applies the LT predicate on the "provider_customer_id" field.
+func ProviderCustomerIDLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldProviderCustomerID, v))
+}
+
+// ProviderCustomerIDLTE applies the LTE predicate on the "provider_customer_id" field.
+func ProviderCustomerIDLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldProviderCustomerID, v))
+}
+
+// ProviderCustomerIDContains applies the Contains predicate on the "provider_customer_id" field.
+func ProviderCustomerIDContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldProviderCustomerID, v))
+}
+
+// ProviderCustomerIDHasPrefix applies the HasPrefix predicate on the "provider_customer_id" field.
+func ProviderCustomerIDHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldProviderCustomerID, v))
+}
+
+// ProviderCustomerIDHasSuffix applies the HasSuffix predicate on the "provider_customer_id" field.
+func ProviderCustomerIDHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldProviderCustomerID, v))
+}
+
+// ProviderCustomerIDIsNil applies the IsNil predicate on the "provider_customer_id" field.
+func ProviderCustomerIDIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldProviderCustomerID))
+}
+
+// ProviderCustomerIDNotNil applies the NotNil predicate on the "provider_customer_id" field.
+func ProviderCustomerIDNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldProviderCustomerID))
+}
+
+// ProviderCustomerIDEqualFold applies the EqualFold predicate on the "provider_customer_id" field.
+func ProviderCustomerIDEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldProviderCustomerID, v))
+}
+
+// ProviderCustomerIDContainsFold applies the ContainsFold predicate on the "provider_customer_id" field.
+func ProviderCustomerIDContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldProviderCustomerID, v))
+}
+
+// ProviderSubscriptionIDEQ applies the EQ predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDNEQ applies the NEQ predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDIn applies the In predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldProviderSubscriptionID, vs...))
+}
+
+// ProviderSubscriptionIDNotIn applies the NotIn predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldProviderSubscriptionID, vs...))
+}
+
+// ProviderSubscriptionIDGT applies the GT predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDGTE applies the GTE predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDLT applies the LT predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDLTE applies the LTE predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDContains applies the Contains predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDHasPrefix applies the HasPrefix predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDHasSuffix applies the HasSuffix predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDIsNil applies the IsNil predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldProviderSubscriptionID))
+}
+
+// ProviderSubscriptionIDNotNil applies the NotNil predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldProviderSubscriptionID))
+}
+
+// ProviderSubscriptionIDEqualFold applies the EqualFold predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDContainsFold applies the ContainsFold predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldProviderSubscriptionID, v))
+}
+
+// CurrentPeriodEndEQ applies the EQ predicate on the "current_period_end" field.
+func CurrentPeriodEndEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCurrentPeriodEnd, v))
+}
+
+// CurrentPeriodEndNEQ applies the NEQ predicate on the "current_period_end" field.
+func CurrentPeriodEndNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCurrentPeriodEnd, v))
+}
+
+// CurrentPeriodEndIn applies the In predicate on the "current_period_end" field.
+func CurrentPeriodEndIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCurrentPeriodEnd, vs...))
+}
+
+// CurrentPeriodEndNotIn applies the NotIn predicate on the "current_period_end" field.
+func CurrentPeriodEndNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCurrentPeriodEnd, vs...))
+}
+
+// CurrentPeriodEndGT applies the GT predicate on the "current_period_end" field.
+func CurrentPeriodEndGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCurrentPeriodEnd, v))
+}
+
+// CurrentPeriodEndGTE applies the GTE predicate on the "current_period_end" field.
+func CurrentPeriodEndGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCurrentPeriodEnd, v))
+}
+
+// CurrentPeriodEndLT applies the LT predicate on the "current_period_end" field.
+func CurrentPeriodEndLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCurrentPeriodEnd, v))
+}
+
+// CurrentPeriodEndLTE applies the LTE predicate on the "current_period_end" field.
+func CurrentPeriodEndLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCurrentPeriodEnd, v))
+}
+
+// CurrentPeriodEndIsNil applies the IsNil predicate on the "current_period_end" field.
+func CurrentPeriodEndIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldCurrentPeriodEnd))
+}
+
+// CurrentPeriodEndNotNil applies the NotNil predicate on the "current_period_end" field.
+func CurrentPeriodEndNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldCurrentPeriodEnd))
+}
+
+// LastEventAtEQ applies the EQ predicate on the "last_event_at" field.
+func LastEventAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldLastEventAt, v))
+}
+
+// LastEventAtNEQ applies the NEQ predicate on the "last_event_at" field.
+func LastEventAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldLastEventAt, v))
+}
+
+// LastEventAtIn applies the In predicate on the "last_event_at" field.
+func LastEventAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldLastEventAt, vs...))
+}
+
+// LastEventAtNotIn applies the NotIn predicate on the "last_event_at" field.
+func LastEventAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldLastEventAt, vs...))
+}
+
+// LastEventAtGT applies the GT predicate on the "last_event_at" field.
+func LastEventAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldLastEventAt, v))
+}
+
+// LastEventAtGTE applies the GTE predicate on the "last_event_at" field.
+func LastEventAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldLastEventAt, v))
+}
+
+// LastEventAtLT applies the LT predicate on the "last_event_at" field.
+func LastEventAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldLastEventAt, v))
+}
+
+// LastEventAtLTE applies the LTE predicate on the "last_event_at" field.
+func LastEventAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldLastEventAt, v))
+}
+
+// LastEventAtIsNil applies the IsNil predicate on the "last_event_at" field.
+func LastEventAtIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldLastEventAt))
+}
+
+// LastEventAtNotNil applies the NotNil predicate on the "last_event_at" field.
+func LastEventAtNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldLastEventAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPlan applies the HasEdge predicate on the "plan" edge.
+func HasPlan() predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PlanTable, PlanColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlanWith applies the HasEdge predicate on the "plan" edge with a given conditions (other predicates).
+func HasPlanWith(preds ...predicate.Plan) predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		step := newPlanStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Subscription) predicate.Subscription {
+	return predicate.Subscription(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Subscription) predicate.Subscription {
+	return predicate.Subscription(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Subscription) predicate.Subscription {
+	return predicate.Subscription(sql.NotPredicates(p))
+}
@@ -0,0 +1,678 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/matsuokashuhei/morrow-backend/ent/plan"
+	"github.com/matsuokashuhei/morrow-backend/ent/predicate"
+	"github.com/matsuokashuhei/morrow-backend/ent/subscription"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+)
+
+// SubscriptionUpdate is the builder for updating Subscription entities.
+type SubscriptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SubscriptionMutation
+}
+
+// Where appends a list predicates to the SubscriptionUpdate builder.
+func (su *SubscriptionUpdate) Where(ps ...predicate.Subscription) *SubscriptionUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetStatus sets the "status" field.
+func (su *SubscriptionUpdate) SetStatus(s subscription.Status) *SubscriptionUpdate {
+	su.mutation.SetStatus(s)
+	return su
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableStatus(s *subscription.Status) *SubscriptionUpdate {
+	if s != nil {
+		su.SetStatus(*s)
+	}
+	return su
+}
+
+// SetProviderCustomerID sets the "provider_customer_id" field.
+func (su *SubscriptionUpdate) SetProviderCustomerID(s string) *SubscriptionUpdate {
+	su.mutation.SetProviderCustomerID(s)
+	return su
+}
+
+// SetNillableProviderCustomerID sets the "provider_customer_id" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableProviderCustomerID(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetProviderCustomerID(*s)
+	}
+	return su
+}
+
+// ClearProviderCustomerID clears the value of the "provider_customer_id" field.
+func (su *SubscriptionUpdate) ClearProviderCustomerID() *SubscriptionUpdate {
+	su.mutation.ClearProviderCustomerID()
+	return su
+}
+
+// SetProviderSubscriptionID sets the "provider_subscription_id" field.
+func (su *SubscriptionUpdate) SetProviderSubscriptionID(s string) *SubscriptionUpdate {
+	su.mutation.SetProviderSubscriptionID(s)
+	return su
+}
+
+// SetNillableProviderSubscriptionID sets the "provider_subscription_id" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableProviderSubscriptionID(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetProviderSubscriptionID(*s)
+	}
+	return su
+}
+
+// ClearProviderSubscriptionID clears the value of the "provider_subscription_id" field.
+func (su *SubscriptionUpdate) ClearProviderSubscriptionID() *SubscriptionUpdate {
+	su.mutation.ClearProviderSubscriptionID()
+	return su
+}
+
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (su *SubscriptionUpdate) SetCurrentPeriodEnd(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetCurrentPeriodEnd(t)
+	return su
+}
+
+// SetNillableCurrentPeriodEnd sets the "current_period_end" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableCurrentPeriodEnd(t *time.Time) *SubscriptionUpdate {
+	if t != nil {
+		su.SetCurrentPeriodEnd(*t)
+	}
+	return su
+}
+
+// ClearCurrentPeriodEnd clears the value of the "current_period_end" field.
+func (su *SubscriptionUpdate) ClearCurrentPeriodEnd() *SubscriptionUpdate {
+	su.mutation.ClearCurrentPeriodEnd()
+	return su
+}
+
+// SetLastEventAt sets the "last_event_at" field.
+func (su *SubscriptionUpdate) SetLastEventAt(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetLastEventAt(t)
+	return su
+}
+
+// SetNillableLastEventAt sets the "last_event_at" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableLastEventAt(t *time.Time) *SubscriptionUpdate {
+	if t != nil {
+		su.SetLastEventAt(*t)
+	}
+	return su
+}
+
+// ClearLastEventAt clears the value of the "last_event_at" field.
+func (su *SubscriptionUpdate) ClearLastEventAt() *SubscriptionUpdate {
+	su.mutation.ClearLastEventAt()
+	return su
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (su *SubscriptionUpdate) SetUpdatedAt(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (su *SubscriptionUpdate) SetUserID(id int) *SubscriptionUpdate {
+	su.mutation.SetUserID(id)
+	return su
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (su *SubscriptionUpdate) SetUser(u *User) *SubscriptionUpdate {
+	return su.SetUserID(u.ID)
+}
+
+// SetPlanID sets the "plan" edge to the Plan entity by ID.
+func (su *SubscriptionUpdate) SetPlanID(id int) *SubscriptionUpdate {
+	su.mutation.SetPlanID(id)
+	return su
+}
+
+// SetPlan sets the "plan" edge to the Plan entity.
+func (su *SubscriptionUpdate) SetPlan(p *Plan) *SubscriptionUpdate {
+	return su.SetPlanID(p.ID)
+}
+
+// Mutation returns the SubscriptionMutation object of the builder.
+func (su *SubscriptionUpdate) Mutation() *SubscriptionMutation {
+	return su.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (su *SubscriptionUpdate) ClearUser() *SubscriptionUpdate {
+	su.mutation.ClearUser()
+	return su
+}
+
+// ClearPlan clears the "plan" edge to the Plan entity.
+func (su *SubscriptionUpdate) ClearPlan() *SubscriptionUpdate {
+	su.mutation.ClearPlan()
+	return su
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *SubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	su.defaults()
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *SubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *SubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *SubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (su *SubscriptionUpdate) defaults() {
+	if _, ok := su.mutation.UpdatedAt(); !ok {
+		v := subscription.UpdateDefaultUpdatedAt()
+		su.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *SubscriptionUpdate) check() error {
+	if v, ok := su.mutation.Status(); ok {
+		if err := subscription.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Subscription.status": %w`, err)}
+		}
+	}
+	if su.mutation.UserCleared() && len(su.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Subscription.user"`)
+	}
+	if su.mutation.PlanCleared() && len(su.mutation.PlanIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Subscription.plan"`)
+	}
+	return nil
+}
+
+func (su *SubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscription.Table, subscription.Columns, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.Status(); ok {
+		_spec.SetField(subscription.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := su.mutation.ProviderCustomerID(); ok {
+		_spec.SetField(subscription.FieldProviderCustomerID, field.TypeString, value)
+	}
+	if su.mutation.ProviderCustomerIDCleared() {
+		_spec.ClearField(subscription.FieldProviderCustomerID, field.TypeString)
+	}
+	if value, ok := su.mutation.ProviderSubscriptionID(); ok {
+		_spec.SetField(subscription.FieldProviderSubscriptionID, field.TypeString, value)
+	}
+	if su.mutation.ProviderSubscriptionIDCleared() {
+		_spec.ClearField(subscription.FieldProviderSubscriptionID, field.TypeString)
+	}
+	if value, ok := su.mutation.CurrentPeriodEnd(); ok {
+		_spec.SetField(subscription.FieldCurrentPeriodEnd, field.TypeTime, value)
+	}
+	if su.mutation.CurrentPeriodEndCleared() {
+		_spec.ClearField(subscription.FieldCurrentPeriodEnd, field.TypeTime)
+	}
+	if value, ok := su.mutation.LastEventAt(); ok {
+		_spec.SetField(subscription.FieldLastEventAt, field.TypeTime, value)
+	}
+	if su.mutation.LastEventAtCleared() {
+		_spec.ClearField(subscription.FieldLastEventAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if su.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   subscription.UserTable,
+			Columns: []string{subscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   subscription.UserTable,
+			Columns: []string{subscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.PlanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscription.PlanTable,
+			Columns: []string{subscription.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.PlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscription.PlanTable,
+			Columns: []string{subscription.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// SubscriptionUpdateOne is the builder for updating a single Subscription entity.
+type SubscriptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SubscriptionMutation
+}
+
+// SetStatus sets the "status" field.
+func (suo *SubscriptionUpdateOne) SetStatus(s subscription.Status) *SubscriptionUpdateOne {
+	suo.mutation.SetStatus(s)
+	return suo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableStatus(s *subscription.Status) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetStatus(*s)
+	}
+	return suo
+}
+
+// SetProviderCustomerID sets the "provider_customer_id" field.
+func (suo *SubscriptionUpdateOne) SetProviderCustomerID(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetProviderCustomerID(s)
+	return suo
+}
+
+// SetNillableProviderCustomerID sets the "provider_customer_id" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableProviderCustomerID(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetProviderCustomerID(*s)
+	}
+	return suo
+}
+
+// ClearProviderCustomerID clears the value of the "provider_customer_id" field.
+func (suo *SubscriptionUpdateOne) ClearProviderCustomerID() *SubscriptionUpdateOne {
+	suo.mutation.ClearProviderCustomerID()
+	return suo
+}
+
+// SetProviderSubscriptionID sets the "provider_subscription_id" field.
+func (suo *SubscriptionUpdateOne) SetProviderSubscriptionID(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetProviderSubscriptionID(s)
+	return suo
+}
+
+// SetNillableProviderSubscriptionID sets the "provider_subscription_id" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableProviderSubscriptionID(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetProviderSubscriptionID(*s)
+	}
+	return suo
+}
+
+// ClearProviderSubscriptionID clears the value of the "provider_subscription_id" field.
+func (suo *SubscriptionUpdateOne) ClearProviderSubscriptionID() *SubscriptionUpdateOne {
+	suo.mutation.ClearProviderSubscriptionID()
+	return suo
+}
+
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (suo *SubscriptionUpdateOne) SetCurrentPeriodEnd(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetCurrentPeriodEnd(t)
+	return suo
+}
+
+// SetNillableCurrentPeriodEnd sets the "current_period_end" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableCurrentPeriodEnd(t *time.Time) *SubscriptionUpdateOne {
+	if t != nil {
+		suo.SetCurrentPeriodEnd(*t)
+	}
+	return suo
+}
+
+// ClearCurrentPeriodEnd clears the value of the "current_period_end" field.
+func (suo *SubscriptionUpdateOne) ClearCurrentPeriodEnd() *SubscriptionUpdateOne {
+	suo.mutation.ClearCurrentPeriodEnd()
+	return suo
+}
+
+// SetLastEventAt sets the "last_event_at" field.
+func (suo *SubscriptionUpdateOne) SetLastEventAt(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetLastEventAt(t)
+	return suo
+}
+
+// SetNillableLastEventAt sets the "last_event_at" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableLastEventAt(t *time.Time) *SubscriptionUpdateOne {
+	if t != nil {
+		suo.SetLastEventAt(*t)
+	}
+	return suo
+}
+
+// ClearLastEventAt clears the value of the "last_event_at" field.
+func (suo *SubscriptionUpdateOne) ClearLastEventAt() *SubscriptionUpdateOne {
+	suo.mutation.ClearLastEventAt()
+	return suo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *SubscriptionUpdateOne) SetUpdatedAt(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (suo *SubscriptionUpdateOne) SetUserID(id int) *SubscriptionUpdateOne {
+	suo.mutation.SetUserID(id)
+	return suo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (suo *SubscriptionUpdateOne) SetUser(u *User) *SubscriptionUpdateOne {
+	return suo.SetUserID(u.ID)
+}
+
+// SetPlanID sets the "plan" edge to the Plan entity by ID.
+func (suo *SubscriptionUpdateOne) SetPlanID(id int) *SubscriptionUpdateOne {
+	suo.mutation.SetPlanID(id)
+	return suo
+}
+
+// SetPlan sets the "plan" edge to the Plan entity.
+func (suo *SubscriptionUpdateOne) SetPlan(p *Plan) *SubscriptionUpdateOne {
+	return suo.SetPlanID(p.ID)
+}
+
+// Mutation returns the SubscriptionMutation object of the builder.
+func (suo *SubscriptionUpdateOne) Mutation() *SubscriptionMutation {
+	return suo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (suo *SubscriptionUpdateOne) ClearUser() *SubscriptionUpdateOne {
+	suo.mutation.ClearUser()
+	return suo
+}
+
+// ClearPlan clears the "plan" edge to the Plan entity.
+func (suo *SubscriptionUpdateOne) ClearPlan() *SubscriptionUpdateOne {
+	suo.mutation.ClearPlan()
+	return suo
+}
+
+// Where appends a list predicates to the SubscriptionUpdate builder.
+func (suo *SubscriptionUpdateOne) Where(ps ...predicate.Subscription) *SubscriptionUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *SubscriptionUpdateOne) Select(field string, fields ...string) *SubscriptionUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Subscription entity.
+func (suo *SubscriptionUpdateOne) Save(ctx context.Context) (*Subscription, error) {
+	suo.defaults()
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *SubscriptionUpdateOne) SaveX(ctx context.Context) *Subscription {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *SubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *SubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (suo *SubscriptionUpdateOne) defaults() {
+	if _, ok := suo.mutation.UpdatedAt(); !ok {
+		v := subscription.UpdateDefaultUpdatedAt()
+		suo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *SubscriptionUpdateOne) check() error {
+	if v, ok := suo.mutation.Status(); ok {
+		if err := subscription.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Subscription.status": %w`, err)}
+		}
+	}
+	if suo.mutation.UserCleared() && len(suo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Subscription.user"`)
+	}
+	if suo.mutation.PlanCleared() && len(suo.mutation.PlanIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Subscription.plan"`)
+	}
+	return nil
+}
+
+func (suo *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscription, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscription.Table, subscription.Columns, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Subscription.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, subscription.FieldID)
+		for _, f := range fields {
+			if !subscription.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != subscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.Status(); ok {
+		_spec.SetField(subscription.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := suo.mutation.ProviderCustomerID(); ok {
+		_spec.SetField(subscription.FieldProviderCustomerID, field.TypeString, value)
+	}
+	if suo.mutation.ProviderCustomerIDCleared() {
+		_spec.ClearField(subscription.FieldProviderCustomerID, field.TypeString)
+	}
+	if value, ok := suo.mutation.ProviderSubscriptionID(); ok {
+		_spec.SetField(subscription.FieldProviderSubscriptionID, field.TypeString, value)
+	}
+	if suo.mutation.ProviderSubscriptionIDCleared() {
+		_spec.ClearField(subscription.FieldProviderSubscriptionID, field.TypeString)
+	}
+	if value, ok := suo.mutation.CurrentPeriodEnd(); ok {
+		_spec.SetField(subscription.FieldCurrentPeriodEnd, field.TypeTime, value)
+	}
+	if suo.mutation.CurrentPeriodEndCleared() {
+		_spec.ClearField(subscription.FieldCurrentPeriodEnd, field.TypeTime)
+	}
+	if value, ok := suo.mutation.LastEventAt(); ok {
+		_spec.SetField(subscription.FieldLastEventAt, field.TypeTime, value)
+	}
+	if suo.mutation.LastEventAtCleared() {
+		_spec.ClearField(subscription.FieldLastEventAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if suo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   subscription.UserTable,
+			Columns: []string{subscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   subscription.UserTable,
+			Columns: []string{subscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.PlanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscription.PlanTable,
+			Columns: []string{subscription.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.PlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscription.PlanTable,
+			Columns: []string{subscription.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Subscription{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
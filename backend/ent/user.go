@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/matsuokashuhei/morrow-backend/ent/subscription"
 	"github.com/matsuokashuhei/morrow-backend/ent/user"
 )
 
@@ -17,14 +18,24 @@ type User struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// 削除日時 (ゴミ箱にある間だけ設定され、保持期間を過ぎると完全に削除される)
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// ユーザーのメールアドレス
 	Email string `json:"email,omitempty"`
 	// ユーザーの表示名
 	Name string `json:"name,omitempty"`
-	// プロフィール画像のURL (S3)
+	// プロフィール画像のURL (アップロード画像の場合は storage: で始まるオブジェクト参照)
 	AvatarURL string `json:"avatar_url,omitempty"`
 	// Amazon Cognito User ID (Phase 2で使用)
 	CognitoID string `json:"cognito_id,omitempty"`
+	// カレンダーフィードURLのトークンのSHA-256ハッシュ (未発行・失効時はNULL)
+	CalendarTokenHash *string `json:"-"`
+	// 退会の猶予期間が終わり、アカウントが削除される日時 (退会を申請していない場合はNULL)
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at,omitempty"`
+	// 管理者かどうか (プラン変更などの管理操作を行える)
+	IsAdmin bool `json:"is_admin,omitempty"`
+	// 管理者がアカウントを無効にした日時 (無効なユーザーはサインインできない。有効な場合はNULL)
+	DisabledAt *time.Time `json:"disabled_at,omitempty"`
 	// 作成日時
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// 更新日時
@@ -41,9 +52,23 @@ type UserEdges struct {
 	CreatedEvents []*Event `json:"created_events,omitempty"`
 	// ユーザーの参加情報
 	Participants []*Participant `json:"participants,omitempty"`
+	// ユーザーが送信したメッセージ
+	Messages []*Message `json:"messages,omitempty"`
+	// ユーザーが付けたリアクション
+	Reactions []*Reaction `json:"reactions,omitempty"`
+	// イベントごとのチャット既読位置
+	ReadCursors []*ReadCursor `json:"read_cursors,omitempty"`
+	// ユーザーがアップロードした添付ファイル
+	Attachments []*Attachment `json:"attachments,omitempty"`
+	// ユーザーの契約プラン
+	Subscription *Subscription `json:"subscription,omitempty"`
+	// 課金イベントの履歴
+	BillingEvents []*BillingEvent `json:"billing_events,omitempty"`
+	// 個人データのエクスポート
+	DataExports []*DataExport `json:"data_exports,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [9]bool
 }
 
 // CreatedEventsOrErr returns the CreatedEvents value or an error if the edge
@@ -64,16 +89,83 @@ func (e UserEdges) ParticipantsOrErr() ([]*Participant, error) {
 	return nil, &NotLoadedError{edge: "participants"}
 }
 
+// MessagesOrErr returns the Messages value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MessagesOrErr() ([]*Message, error) {
+	if e.loadedTypes[2] {
+		return e.Messages, nil
+	}
+	return nil, &NotLoadedError{edge: "messages"}
+}
+
+// ReactionsOrErr returns the Reactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ReactionsOrErr() ([]*Reaction, error) {
+	if e.loadedTypes[3] {
+		return e.Reactions, nil
+	}
+	return nil, &NotLoadedError{edge: "reactions"}
+}
+
+// ReadCursorsOrErr returns the ReadCursors value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ReadCursorsOrErr() ([]*ReadCursor, error) {
+	if e.loadedTypes[4] {
+		return e.ReadCursors, nil
+	}
+	return nil, &NotLoadedError{edge: "read_cursors"}
+}
+
+// AttachmentsOrErr returns the Attachments value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AttachmentsOrErr() ([]*Attachment, error) {
+	if e.loadedTypes[5] {
+		return e.Attachments, nil
+	}
+	return nil, &NotLoadedError{edge: "attachments"}
+}
+
+// SubscriptionOrErr returns the Subscription value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) SubscriptionOrErr() (*Subscription, error) {
+	if e.Subscription != nil {
+		return e.Subscription, nil
+	} else if e.loadedTypes[6] {
+		return nil, &NotFoundError{label: subscription.Label}
+	}
+	return nil, &NotLoadedError{edge: "subscription"}
+}
+
+// BillingEventsOrErr returns the BillingEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BillingEventsOrErr() ([]*BillingEvent, error) {
+	if e.loadedTypes[7] {
+		return e.BillingEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "billing_events"}
+}
+
+// DataExportsOrErr returns the DataExports value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) DataExportsOrErr() ([]*DataExport, error) {
+	if e.loadedTypes[8] {
+		return e.DataExports, nil
+	}
+	return nil, &NotLoadedError{edge: "data_exports"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case user.FieldIsAdmin:
+			values[i] = new(sql.NullBool)
 		case user.FieldID:
 			values[i] = new(sql.NullInt64)
-		case user.FieldEmail, user.FieldName, user.FieldAvatarURL, user.FieldCognitoID:
+		case user.FieldEmail, user.FieldName, user.FieldAvatarURL, user.FieldCognitoID, user.FieldCalendarTokenHash:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt:
+		case user.FieldDeletedAt, user.FieldDeletionScheduledAt, user.FieldDisabledAt, user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -96,6 +188,13 @@ func (u *User) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			u.ID = int(value.Int64)
+		case user.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				u.DeletedAt = new(time.Time)
+				*u.DeletedAt = value.Time
+			}
 		case user.FieldEmail:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field email", values[i])
@@ -120,6 +219,33 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.CognitoID = value.String
 			}
+		case user.FieldCalendarTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field calendar_token_hash", values[i])
+			} else if value.Valid {
+				u.CalendarTokenHash = new(string)
+				*u.CalendarTokenHash = value.String
+			}
+		case user.FieldDeletionScheduledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deletion_scheduled_at", values[i])
+			} else if value.Valid {
+				u.DeletionScheduledAt = new(time.Time)
+				*u.DeletionScheduledAt = value.Time
+			}
+		case user.FieldIsAdmin:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_admin", values[i])
+			} else if value.Valid {
+				u.IsAdmin = value.Bool
+			}
+		case user.FieldDisabledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field disabled_at", values[i])
+			} else if value.Valid {
+				u.DisabledAt = new(time.Time)
+				*u.DisabledAt = value.Time
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -155,6 +281,41 @@ func (u *User) QueryParticipants() *ParticipantQuery {
 	return NewUserClient(u.config).QueryParticipants(u)
 }
 
+// QueryMessages queries the "messages" edge of the User entity.
+func (u *User) QueryMessages() *MessageQuery {
+	return NewUserClient(u.config).QueryMessages(u)
+}
+
+// QueryReactions queries the "reactions" edge of the User entity.
+func (u *User) QueryReactions() *ReactionQuery {
+	return NewUserClient(u.config).QueryReactions(u)
+}
+
+// QueryReadCursors queries the "read_cursors" edge of the User entity.
+func (u *User) QueryReadCursors() *ReadCursorQuery {
+	return NewUserClient(u.config).QueryReadCursors(u)
+}
+
+// QueryAttachments queries the "attachments" edge of the User entity.
+func (u *User) QueryAttachments() *AttachmentQuery {
+	return NewUserClient(u.config).QueryAttachments(u)
+}
+
+// QuerySubscription queries the "subscription" edge of the User entity.
+func (u *User) QuerySubscription() *SubscriptionQuery {
+	return NewUserClient(u.config).QuerySubscription(u)
+}
+
+// QueryBillingEvents queries the "billing_events" edge of the User entity.
+func (u *User) QueryBillingEvents() *BillingEventQuery {
+	return NewUserClient(u.config).QueryBillingEvents(u)
+}
+
+// QueryDataExports queries the "data_exports" edge of the User entity.
+func (u *User) QueryDataExports() *DataExportQuery {
+	return NewUserClient(u.config).QueryDataExports(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -178,6 +339,11 @@ func (u *User) String() string {
 	var builder strings.Builder
 	builder.WriteString("User(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", u.ID))
+	if v := u.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("email=")
 	builder.WriteString(u.Email)
 	builder.WriteString(", ")
@@ -190,6 +356,21 @@ func (u *User) String() string {
 	builder.WriteString("cognito_id=")
 	builder.WriteString(u.CognitoID)
 	builder.WriteString(", ")
+	builder.WriteString("calendar_token_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := u.DeletionScheduledAt; v != nil {
+		builder.WriteString("deletion_scheduled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("is_admin=")
+	builder.WriteString(fmt.Sprintf("%v", u.IsAdmin))
+	builder.WriteString(", ")
+	if v := u.DisabledAt; v != nil {
+		builder.WriteString("disabled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
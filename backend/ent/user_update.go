@@ -11,9 +11,16 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/matsuokashuhei/morrow-backend/ent/attachment"
+	"github.com/matsuokashuhei/morrow-backend/ent/billingevent"
+	"github.com/matsuokashuhei/morrow-backend/ent/dataexport"
 	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/message"
 	"github.com/matsuokashuhei/morrow-backend/ent/participant"
 	"github.com/matsuokashuhei/morrow-backend/ent/predicate"
+	"github.com/matsuokashuhei/morrow-backend/ent/reaction"
+	"github.com/matsuokashuhei/morrow-backend/ent/readcursor"
+	"github.com/matsuokashuhei/morrow-backend/ent/subscription"
 	"github.com/matsuokashuhei/morrow-backend/ent/user"
 )
 
@@ -30,6 +37,26 @@ func (uu *UserUpdate) Where(ps ...predicate.User) *UserUpdate {
 	return uu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (uu *UserUpdate) SetDeletedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetDeletedAt(t)
+	return uu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableDeletedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetDeletedAt(*t)
+	}
+	return uu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (uu *UserUpdate) ClearDeletedAt() *UserUpdate {
+	uu.mutation.ClearDeletedAt()
+	return uu
+}
+
 // SetEmail sets the "email" field.
 func (uu *UserUpdate) SetEmail(s string) *UserUpdate {
 	uu.mutation.SetEmail(s)
@@ -98,6 +125,80 @@ func (uu *UserUpdate) ClearCognitoID() *UserUpdate {
 	return uu
 }
 
+// SetCalendarTokenHash sets the "calendar_token_hash" field.
+func (uu *UserUpdate) SetCalendarTokenHash(s string) *UserUpdate {
+	uu.mutation.SetCalendarTokenHash(s)
+	return uu
+}
+
+// SetNillableCalendarTokenHash sets the "calendar_token_hash" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableCalendarTokenHash(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetCalendarTokenHash(*s)
+	}
+	return uu
+}
+
+// ClearCalendarTokenHash clears the value of the "calendar_token_hash" field.
+func (uu *UserUpdate) ClearCalendarTokenHash() *UserUpdate {
+	uu.mutation.ClearCalendarTokenHash()
+	return uu
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (uu *UserUpdate) SetDeletionScheduledAt(t time.Time) *UserUpdate {
+	uu.mutation.SetDeletionScheduledAt(t)
+	return uu
+}
+
+// SetNillableDeletionScheduledAt sets the "deletion_scheduled_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableDeletionScheduledAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetDeletionScheduledAt(*t)
+	}
+	return uu
+}
+
+// ClearDeletionScheduledAt clears the value of the "deletion_scheduled_at" field.
+func (uu *UserUpdate) ClearDeletionScheduledAt() *UserUpdate {
+	uu.mutation.ClearDeletionScheduledAt()
+	return uu
+}
+
+// SetIsAdmin sets the "is_admin" field.
+func (uu *UserUpdate) SetIsAdmin(b bool) *UserUpdate {
+	uu.mutation.SetIsAdmin(b)
+	return uu
+}
+
+// SetNillableIsAdmin sets the "is_admin" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableIsAdmin(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetIsAdmin(*b)
+	}
+	return uu
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (uu *UserUpdate) SetDisabledAt(t time.Time) *UserUpdate {
+	uu.mutation.SetDisabledAt(t)
+	return uu
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableDisabledAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetDisabledAt(*t)
+	}
+	return uu
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (uu *UserUpdate) ClearDisabledAt() *UserUpdate {
+	uu.mutation.ClearDisabledAt()
+	return uu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uu *UserUpdate) SetCreatedAt(t time.Time) *UserUpdate {
 	uu.mutation.SetCreatedAt(t)
@@ -148,6 +249,115 @@ func (uu *UserUpdate) AddParticipants(p ...*Participant) *UserUpdate {
 	return uu.AddParticipantIDs(ids...)
 }
 
+// AddMessageIDs adds the "messages" edge to the Message entity by IDs.
+func (uu *UserUpdate) AddMessageIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddMessageIDs(ids...)
+	return uu
+}
+
+// AddMessages adds the "messages" edges to the Message entity.
+func (uu *UserUpdate) AddMessages(m ...*Message) *UserUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.AddMessageIDs(ids...)
+}
+
+// AddReactionIDs adds the "reactions" edge to the Reaction entity by IDs.
+func (uu *UserUpdate) AddReactionIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddReactionIDs(ids...)
+	return uu
+}
+
+// AddReactions adds the "reactions" edges to the Reaction entity.
+func (uu *UserUpdate) AddReactions(r ...*Reaction) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.AddReactionIDs(ids...)
+}
+
+// AddReadCursorIDs adds the "read_cursors" edge to the ReadCursor entity by IDs.
+func (uu *UserUpdate) AddReadCursorIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddReadCursorIDs(ids...)
+	return uu
+}
+
+// AddReadCursors adds the "read_cursors" edges to the ReadCursor entity.
+func (uu *UserUpdate) AddReadCursors(r ...*ReadCursor) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.AddReadCursorIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (uu *UserUpdate) AddAttachmentIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddAttachmentIDs(ids...)
+	return uu
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (uu *UserUpdate) AddAttachments(a ...*Attachment) *UserUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.AddAttachmentIDs(ids...)
+}
+
+// SetSubscriptionID sets the "subscription" edge to the Subscription entity by ID.
+func (uu *UserUpdate) SetSubscriptionID(id int) *UserUpdate {
+	uu.mutation.SetSubscriptionID(id)
+	return uu
+}
+
+// SetNillableSubscriptionID sets the "subscription" edge to the Subscription entity by ID if the given value is not nil.
+func (uu *UserUpdate) SetNillableSubscriptionID(id *int) *UserUpdate {
+	if id != nil {
+		uu = uu.SetSubscriptionID(*id)
+	}
+	return uu
+}
+
+// SetSubscription sets the "subscription" edge to the Subscription entity.
+func (uu *UserUpdate) SetSubscription(s *Subscription) *UserUpdate {
+	return uu.SetSubscriptionID(s.ID)
+}
+
+// AddBillingEventIDs adds the "billing_events" edge to the BillingEvent entity by IDs.
+func (uu *UserUpdate) AddBillingEventIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddBillingEventIDs(ids...)
+	return uu
+}
+
+// AddBillingEvents adds the "billing_events" edges to the BillingEvent entity.
+func (uu *UserUpdate) AddBillingEvents(b ...*BillingEvent) *UserUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uu.AddBillingEventIDs(ids...)
+}
+
+// AddDataExportIDs adds the "data_exports" edge to the DataExport entity by IDs.
+func (uu *UserUpdate) AddDataExportIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddDataExportIDs(ids...)
+	return uu
+}
+
+// AddDataExports adds the "data_exports" edges to the DataExport entity.
+func (uu *UserUpdate) AddDataExports(d ...*DataExport) *UserUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uu.AddDataExportIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -195,6 +405,138 @@ func (uu *UserUpdate) RemoveParticipants(p ...*Participant) *UserUpdate {
 	return uu.RemoveParticipantIDs(ids...)
 }
 
+// ClearMessages clears all "messages" edges to the Message entity.
+func (uu *UserUpdate) ClearMessages() *UserUpdate {
+	uu.mutation.ClearMessages()
+	return uu
+}
+
+// RemoveMessageIDs removes the "messages" edge to Message entities by IDs.
+func (uu *UserUpdate) RemoveMessageIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveMessageIDs(ids...)
+	return uu
+}
+
+// RemoveMessages removes "messages" edges to Message entities.
+func (uu *UserUpdate) RemoveMessages(m ...*Message) *UserUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.RemoveMessageIDs(ids...)
+}
+
+// ClearReactions clears all "reactions" edges to the Reaction entity.
+func (uu *UserUpdate) ClearReactions() *UserUpdate {
+	uu.mutation.ClearReactions()
+	return uu
+}
+
+// RemoveReactionIDs removes the "reactions" edge to Reaction entities by IDs.
+func (uu *UserUpdate) RemoveReactionIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveReactionIDs(ids...)
+	return uu
+}
+
+// RemoveReactions removes "reactions" edges to Reaction entities.
+func (uu *UserUpdate) RemoveReactions(r ...*Reaction) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.RemoveReactionIDs(ids...)
+}
+
+// ClearReadCursors clears all "read_cursors" edges to the ReadCursor entity.
+func (uu *UserUpdate) ClearReadCursors() *UserUpdate {
+	uu.mutation.ClearReadCursors()
+	return uu
+}
+
+// RemoveReadCursorIDs removes the "read_cursors" edge to ReadCursor entities by IDs.
+func (uu *UserUpdate) RemoveReadCursorIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveReadCursorIDs(ids...)
+	return uu
+}
+
+// RemoveReadCursors removes "read_cursors" edges to ReadCursor entities.
+func (uu *UserUpdate) RemoveReadCursors(r ...*ReadCursor) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.RemoveReadCursorIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (uu *UserUpdate) ClearAttachments() *UserUpdate {
+	uu.mutation.ClearAttachments()
+	return uu
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (uu *UserUpdate) RemoveAttachmentIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveAttachmentIDs(ids...)
+	return uu
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (uu *UserUpdate) RemoveAttachments(a ...*Attachment) *UserUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.RemoveAttachmentIDs(ids...)
+}
+
+// ClearSubscription clears the "subscription" edge to the Subscription entity.
+func (uu *UserUpdate) ClearSubscription() *UserUpdate {
+	uu.mutation.ClearSubscription()
+	return uu
+}
+
+// ClearBillingEvents clears all "billing_events" edges to the BillingEvent entity.
+func (uu *UserUpdate) ClearBillingEvents() *UserUpdate {
+	uu.mutation.ClearBillingEvents()
+	return uu
+}
+
+// RemoveBillingEventIDs removes the "billing_events" edge to BillingEvent entities by IDs.
+func (uu *UserUpdate) RemoveBillingEventIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveBillingEventIDs(ids...)
+	return uu
+}
+
+// RemoveBillingEvents removes "billing_events" edges to BillingEvent entities.
+func (uu *UserUpdate) RemoveBillingEvents(b ...*BillingEvent) *UserUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uu.RemoveBillingEventIDs(ids...)
+}
+
+// ClearDataExports clears all "data_exports" edges to the DataExport entity.
+func (uu *UserUpdate) ClearDataExports() *UserUpdate {
+	uu.mutation.ClearDataExports()
+	return uu
+}
+
+// RemoveDataExportIDs removes the "data_exports" edge to DataExport entities by IDs.
+func (uu *UserUpdate) RemoveDataExportIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveDataExportIDs(ids...)
+	return uu
+}
+
+// RemoveDataExports removes "data_exports" edges to DataExport entities.
+func (uu *UserUpdate) RemoveDataExports(d ...*DataExport) *UserUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uu.RemoveDataExportIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	uu.defaults()
@@ -240,6 +582,12 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := uu.mutation.DeletedAt(); ok {
+		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+	}
+	if uu.mutation.DeletedAtCleared() {
+		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := uu.mutation.Email(); ok {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 	}
@@ -258,6 +606,27 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.mutation.CognitoIDCleared() {
 		_spec.ClearField(user.FieldCognitoID, field.TypeString)
 	}
+	if value, ok := uu.mutation.CalendarTokenHash(); ok {
+		_spec.SetField(user.FieldCalendarTokenHash, field.TypeString, value)
+	}
+	if uu.mutation.CalendarTokenHashCleared() {
+		_spec.ClearField(user.FieldCalendarTokenHash, field.TypeString)
+	}
+	if value, ok := uu.mutation.DeletionScheduledAt(); ok {
+		_spec.SetField(user.FieldDeletionScheduledAt, field.TypeTime, value)
+	}
+	if uu.mutation.DeletionScheduledAtCleared() {
+		_spec.ClearField(user.FieldDeletionScheduledAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.IsAdmin(); ok {
+		_spec.SetField(user.FieldIsAdmin, field.TypeBool, value)
+	}
+	if value, ok := uu.mutation.DisabledAt(); ok {
+		_spec.SetField(user.FieldDisabledAt, field.TypeTime, value)
+	}
+	if uu.mutation.DisabledAtCleared() {
+		_spec.ClearField(user.FieldDisabledAt, field.TypeTime)
+	}
 	if value, ok := uu.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -354,68 +723,387 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if uu.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessagesTable,
+			Columns: []string{user.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	uu.mutation.done = true
-	return n, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
-}
-
-// SetEmail sets the "email" field.
-func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
-	uuo.mutation.SetEmail(s)
-	return uuo
-}
-
-// SetNillableEmail sets the "email" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetEmail(*s)
+	if nodes := uu.mutation.RemovedMessagesIDs(); len(nodes) > 0 && !uu.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessagesTable,
+			Columns: []string{user.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetName sets the "name" field.
-func (uuo *UserUpdateOne) SetName(s string) *UserUpdateOne {
-	uuo.mutation.SetName(s)
-	return uuo
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableName(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetName(*s)
+	if nodes := uu.mutation.MessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessagesTable,
+			Columns: []string{user.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// SetAvatarURL sets the "avatar_url" field.
-func (uuo *UserUpdateOne) SetAvatarURL(s string) *UserUpdateOne {
-	uuo.mutation.SetAvatarURL(s)
-	return uuo
-}
-
-// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableAvatarURL(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetAvatarURL(*s)
+	if uu.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReactionsTable,
+			Columns: []string{user.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
+	if nodes := uu.mutation.RemovedReactionsIDs(); len(nodes) > 0 && !uu.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReactionsTable,
+			Columns: []string{user.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReactionsTable,
+			Columns: []string{user.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.ReadCursorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReadCursorsTable,
+			Columns: []string{user.ReadCursorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readcursor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedReadCursorsIDs(); len(nodes) > 0 && !uu.mutation.ReadCursorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReadCursorsTable,
+			Columns: []string{user.ReadCursorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readcursor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.ReadCursorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReadCursorsTable,
+			Columns: []string{user.ReadCursorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readcursor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AttachmentsTable,
+			Columns: []string{user.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !uu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AttachmentsTable,
+			Columns: []string{user.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AttachmentsTable,
+			Columns: []string{user.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.SubscriptionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.SubscriptionTable,
+			Columns: []string{user.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.SubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.SubscriptionTable,
+			Columns: []string{user.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.BillingEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BillingEventsTable,
+			Columns: []string{user.BillingEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(billingevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedBillingEventsIDs(); len(nodes) > 0 && !uu.mutation.BillingEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BillingEventsTable,
+			Columns: []string{user.BillingEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(billingevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.BillingEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BillingEventsTable,
+			Columns: []string{user.BillingEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(billingevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.DataExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataExportsTable,
+			Columns: []string{user.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedDataExportsIDs(); len(nodes) > 0 && !uu.mutation.DataExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataExportsTable,
+			Columns: []string{user.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.DataExportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataExportsTable,
+			Columns: []string{user.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uu.mutation.done = true
+	return n, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserMutation
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (uuo *UserUpdateOne) SetDeletedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetDeletedAt(t)
+	return uuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDeletedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetDeletedAt(*t)
+	}
+	return uuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (uuo *UserUpdateOne) ClearDeletedAt() *UserUpdateOne {
+	uuo.mutation.ClearDeletedAt()
+	return uuo
+}
+
+// SetEmail sets the "email" field.
+func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
+	uuo.mutation.SetEmail(s)
+	return uuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetEmail(*s)
+	}
+	return uuo
+}
+
+// SetName sets the "name" field.
+func (uuo *UserUpdateOne) SetName(s string) *UserUpdateOne {
+	uuo.mutation.SetName(s)
+	return uuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableName(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetName(*s)
+	}
+	return uuo
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (uuo *UserUpdateOne) SetAvatarURL(s string) *UserUpdateOne {
+	uuo.mutation.SetAvatarURL(s)
+	return uuo
+}
+
+// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAvatarURL(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetAvatarURL(*s)
+	}
+	return uuo
+}
+
 // ClearAvatarURL clears the value of the "avatar_url" field.
 func (uuo *UserUpdateOne) ClearAvatarURL() *UserUpdateOne {
 	uuo.mutation.ClearAvatarURL()
@@ -442,6 +1130,80 @@ func (uuo *UserUpdateOne) ClearCognitoID() *UserUpdateOne {
 	return uuo
 }
 
+// SetCalendarTokenHash sets the "calendar_token_hash" field.
+func (uuo *UserUpdateOne) SetCalendarTokenHash(s string) *UserUpdateOne {
+	uuo.mutation.SetCalendarTokenHash(s)
+	return uuo
+}
+
+// SetNillableCalendarTokenHash sets the "calendar_token_hash" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableCalendarTokenHash(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetCalendarTokenHash(*s)
+	}
+	return uuo
+}
+
+// ClearCalendarTokenHash clears the value of the "calendar_token_hash" field.
+func (uuo *UserUpdateOne) ClearCalendarTokenHash() *UserUpdateOne {
+	uuo.mutation.ClearCalendarTokenHash()
+	return uuo
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (uuo *UserUpdateOne) SetDeletionScheduledAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetDeletionScheduledAt(t)
+	return uuo
+}
+
+// SetNillableDeletionScheduledAt sets the "deletion_scheduled_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDeletionScheduledAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetDeletionScheduledAt(*t)
+	}
+	return uuo
+}
+
+// ClearDeletionScheduledAt clears the value of the "deletion_scheduled_at" field.
+func (uuo *UserUpdateOne) ClearDeletionScheduledAt() *UserUpdateOne {
+	uuo.mutation.ClearDeletionScheduledAt()
+	return uuo
+}
+
+// SetIsAdmin sets the "is_admin" field.
+func (uuo *UserUpdateOne) SetIsAdmin(b bool) *UserUpdateOne {
+	uuo.mutation.SetIsAdmin(b)
+	return uuo
+}
+
+// SetNillableIsAdmin sets the "is_admin" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableIsAdmin(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetIsAdmin(*b)
+	}
+	return uuo
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (uuo *UserUpdateOne) SetDisabledAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetDisabledAt(t)
+	return uuo
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDisabledAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetDisabledAt(*t)
+	}
+	return uuo
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (uuo *UserUpdateOne) ClearDisabledAt() *UserUpdateOne {
+	uuo.mutation.ClearDisabledAt()
+	return uuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uuo *UserUpdateOne) SetCreatedAt(t time.Time) *UserUpdateOne {
 	uuo.mutation.SetCreatedAt(t)
@@ -489,54 +1251,295 @@ func (uuo *UserUpdateOne) AddParticipants(p ...*Participant) *UserUpdateOne {
 	for i := range p {
 		ids[i] = p[i].ID
 	}
-	return uuo.AddParticipantIDs(ids...)
+	return uuo.AddParticipantIDs(ids...)
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by IDs.
+func (uuo *UserUpdateOne) AddMessageIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddMessageIDs(ids...)
+	return uuo
+}
+
+// AddMessages adds the "messages" edges to the Message entity.
+func (uuo *UserUpdateOne) AddMessages(m ...*Message) *UserUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddMessageIDs(ids...)
+}
+
+// AddReactionIDs adds the "reactions" edge to the Reaction entity by IDs.
+func (uuo *UserUpdateOne) AddReactionIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddReactionIDs(ids...)
+	return uuo
+}
+
+// AddReactions adds the "reactions" edges to the Reaction entity.
+func (uuo *UserUpdateOne) AddReactions(r ...*Reaction) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddReactionIDs(ids...)
+}
+
+// AddReadCursorIDs adds the "read_cursors" edge to the ReadCursor entity by IDs.
+func (uuo *UserUpdateOne) AddReadCursorIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddReadCursorIDs(ids...)
+	return uuo
+}
+
+// AddReadCursors adds the "read_cursors" edges to the ReadCursor entity.
+func (uuo *UserUpdateOne) AddReadCursors(r ...*ReadCursor) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddReadCursorIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (uuo *UserUpdateOne) AddAttachmentIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddAttachmentIDs(ids...)
+	return uuo
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (uuo *UserUpdateOne) AddAttachments(a ...*Attachment) *UserUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.AddAttachmentIDs(ids...)
+}
+
+// SetSubscriptionID sets the "subscription" edge to the Subscription entity by ID.
+func (uuo *UserUpdateOne) SetSubscriptionID(id int) *UserUpdateOne {
+	uuo.mutation.SetSubscriptionID(id)
+	return uuo
+}
+
+// SetNillableSubscriptionID sets the "subscription" edge to the Subscription entity by ID if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableSubscriptionID(id *int) *UserUpdateOne {
+	if id != nil {
+		uuo = uuo.SetSubscriptionID(*id)
+	}
+	return uuo
+}
+
+// SetSubscription sets the "subscription" edge to the Subscription entity.
+func (uuo *UserUpdateOne) SetSubscription(s *Subscription) *UserUpdateOne {
+	return uuo.SetSubscriptionID(s.ID)
+}
+
+// AddBillingEventIDs adds the "billing_events" edge to the BillingEvent entity by IDs.
+func (uuo *UserUpdateOne) AddBillingEventIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddBillingEventIDs(ids...)
+	return uuo
+}
+
+// AddBillingEvents adds the "billing_events" edges to the BillingEvent entity.
+func (uuo *UserUpdateOne) AddBillingEvents(b ...*BillingEvent) *UserUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.AddBillingEventIDs(ids...)
+}
+
+// AddDataExportIDs adds the "data_exports" edge to the DataExport entity by IDs.
+func (uuo *UserUpdateOne) AddDataExportIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddDataExportIDs(ids...)
+	return uuo
+}
+
+// AddDataExports adds the "data_exports" edges to the DataExport entity.
+func (uuo *UserUpdateOne) AddDataExports(d ...*DataExport) *UserUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.AddDataExportIDs(ids...)
+}
+
+// Mutation returns the UserMutation object of the builder.
+func (uuo *UserUpdateOne) Mutation() *UserMutation {
+	return uuo.mutation
+}
+
+// ClearCreatedEvents clears all "created_events" edges to the Event entity.
+func (uuo *UserUpdateOne) ClearCreatedEvents() *UserUpdateOne {
+	uuo.mutation.ClearCreatedEvents()
+	return uuo
+}
+
+// RemoveCreatedEventIDs removes the "created_events" edge to Event entities by IDs.
+func (uuo *UserUpdateOne) RemoveCreatedEventIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveCreatedEventIDs(ids...)
+	return uuo
+}
+
+// RemoveCreatedEvents removes "created_events" edges to Event entities.
+func (uuo *UserUpdateOne) RemoveCreatedEvents(e ...*Event) *UserUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uuo.RemoveCreatedEventIDs(ids...)
+}
+
+// ClearParticipants clears all "participants" edges to the Participant entity.
+func (uuo *UserUpdateOne) ClearParticipants() *UserUpdateOne {
+	uuo.mutation.ClearParticipants()
+	return uuo
+}
+
+// RemoveParticipantIDs removes the "participants" edge to Participant entities by IDs.
+func (uuo *UserUpdateOne) RemoveParticipantIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveParticipantIDs(ids...)
+	return uuo
+}
+
+// RemoveParticipants removes "participants" edges to Participant entities.
+func (uuo *UserUpdateOne) RemoveParticipants(p ...*Participant) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.RemoveParticipantIDs(ids...)
+}
+
+// ClearMessages clears all "messages" edges to the Message entity.
+func (uuo *UserUpdateOne) ClearMessages() *UserUpdateOne {
+	uuo.mutation.ClearMessages()
+	return uuo
+}
+
+// RemoveMessageIDs removes the "messages" edge to Message entities by IDs.
+func (uuo *UserUpdateOne) RemoveMessageIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveMessageIDs(ids...)
+	return uuo
+}
+
+// RemoveMessages removes "messages" edges to Message entities.
+func (uuo *UserUpdateOne) RemoveMessages(m ...*Message) *UserUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveMessageIDs(ids...)
+}
+
+// ClearReactions clears all "reactions" edges to the Reaction entity.
+func (uuo *UserUpdateOne) ClearReactions() *UserUpdateOne {
+	uuo.mutation.ClearReactions()
+	return uuo
+}
+
+// RemoveReactionIDs removes the "reactions" edge to Reaction entities by IDs.
+func (uuo *UserUpdateOne) RemoveReactionIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveReactionIDs(ids...)
+	return uuo
+}
+
+// RemoveReactions removes "reactions" edges to Reaction entities.
+func (uuo *UserUpdateOne) RemoveReactions(r ...*Reaction) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveReactionIDs(ids...)
+}
+
+// ClearReadCursors clears all "read_cursors" edges to the ReadCursor entity.
+func (uuo *UserUpdateOne) ClearReadCursors() *UserUpdateOne {
+	uuo.mutation.ClearReadCursors()
+	return uuo
+}
+
+// RemoveReadCursorIDs removes the "read_cursors" edge to ReadCursor entities by IDs.
+func (uuo *UserUpdateOne) RemoveReadCursorIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveReadCursorIDs(ids...)
+	return uuo
+}
+
+// RemoveReadCursors removes "read_cursors" edges to ReadCursor entities.
+func (uuo *UserUpdateOne) RemoveReadCursors(r ...*ReadCursor) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveReadCursorIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (uuo *UserUpdateOne) ClearAttachments() *UserUpdateOne {
+	uuo.mutation.ClearAttachments()
+	return uuo
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (uuo *UserUpdateOne) RemoveAttachmentIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveAttachmentIDs(ids...)
+	return uuo
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (uuo *UserUpdateOne) RemoveAttachments(a ...*Attachment) *UserUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.RemoveAttachmentIDs(ids...)
 }
 
-// Mutation returns the UserMutation object of the builder.
-func (uuo *UserUpdateOne) Mutation() *UserMutation {
-	return uuo.mutation
+// ClearSubscription clears the "subscription" edge to the Subscription entity.
+func (uuo *UserUpdateOne) ClearSubscription() *UserUpdateOne {
+	uuo.mutation.ClearSubscription()
+	return uuo
 }
 
-// ClearCreatedEvents clears all "created_events" edges to the Event entity.
-func (uuo *UserUpdateOne) ClearCreatedEvents() *UserUpdateOne {
-	uuo.mutation.ClearCreatedEvents()
+// ClearBillingEvents clears all "billing_events" edges to the BillingEvent entity.
+func (uuo *UserUpdateOne) ClearBillingEvents() *UserUpdateOne {
+	uuo.mutation.ClearBillingEvents()
 	return uuo
 }
 
-// RemoveCreatedEventIDs removes the "created_events" edge to Event entities by IDs.
-func (uuo *UserUpdateOne) RemoveCreatedEventIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveCreatedEventIDs(ids...)
+// RemoveBillingEventIDs removes the "billing_events" edge to BillingEvent entities by IDs.
+func (uuo *UserUpdateOne) RemoveBillingEventIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveBillingEventIDs(ids...)
 	return uuo
 }
 
-// RemoveCreatedEvents removes "created_events" edges to Event entities.
-func (uuo *UserUpdateOne) RemoveCreatedEvents(e ...*Event) *UserUpdateOne {
-	ids := make([]int, len(e))
-	for i := range e {
-		ids[i] = e[i].ID
+// RemoveBillingEvents removes "billing_events" edges to BillingEvent entities.
+func (uuo *UserUpdateOne) RemoveBillingEvents(b ...*BillingEvent) *UserUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
 	}
-	return uuo.RemoveCreatedEventIDs(ids...)
+	return uuo.RemoveBillingEventIDs(ids...)
 }
 
-// ClearParticipants clears all "participants" edges to the Participant entity.
-func (uuo *UserUpdateOne) ClearParticipants() *UserUpdateOne {
-	uuo.mutation.ClearParticipants()
+// ClearDataExports clears all "data_exports" edges to the DataExport entity.
+func (uuo *UserUpdateOne) ClearDataExports() *UserUpdateOne {
+	uuo.mutation.ClearDataExports()
 	return uuo
 }
 
-// RemoveParticipantIDs removes the "participants" edge to Participant entities by IDs.
-func (uuo *UserUpdateOne) RemoveParticipantIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveParticipantIDs(ids...)
+// RemoveDataExportIDs removes the "data_exports" edge to DataExport entities by IDs.
+func (uuo *UserUpdateOne) RemoveDataExportIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveDataExportIDs(ids...)
 	return uuo
 }
 
-// RemoveParticipants removes "participants" edges to Participant entities.
-func (uuo *UserUpdateOne) RemoveParticipants(p ...*Participant) *UserUpdateOne {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
+// RemoveDataExports removes "data_exports" edges to DataExport entities.
+func (uuo *UserUpdateOne) RemoveDataExports(d ...*DataExport) *UserUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
 	}
-	return uuo.RemoveParticipantIDs(ids...)
+	return uuo.RemoveDataExportIDs(ids...)
 }
 
 // Where appends a list predicates to the UserUpdate builder.
@@ -614,6 +1617,12 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			}
 		}
 	}
+	if value, ok := uuo.mutation.DeletedAt(); ok {
+		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := uuo.mutation.Email(); ok {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 	}
@@ -632,6 +1641,27 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if uuo.mutation.CognitoIDCleared() {
 		_spec.ClearField(user.FieldCognitoID, field.TypeString)
 	}
+	if value, ok := uuo.mutation.CalendarTokenHash(); ok {
+		_spec.SetField(user.FieldCalendarTokenHash, field.TypeString, value)
+	}
+	if uuo.mutation.CalendarTokenHashCleared() {
+		_spec.ClearField(user.FieldCalendarTokenHash, field.TypeString)
+	}
+	if value, ok := uuo.mutation.DeletionScheduledAt(); ok {
+		_spec.SetField(user.FieldDeletionScheduledAt, field.TypeTime, value)
+	}
+	if uuo.mutation.DeletionScheduledAtCleared() {
+		_spec.ClearField(user.FieldDeletionScheduledAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.IsAdmin(); ok {
+		_spec.SetField(user.FieldIsAdmin, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.DisabledAt(); ok {
+		_spec.SetField(user.FieldDisabledAt, field.TypeTime, value)
+	}
+	if uuo.mutation.DisabledAtCleared() {
+		_spec.ClearField(user.FieldDisabledAt, field.TypeTime)
+	}
 	if value, ok := uuo.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -728,6 +1758,305 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessagesTable,
+			Columns: []string{user.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedMessagesIDs(); len(nodes) > 0 && !uuo.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessagesTable,
+			Columns: []string{user.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.MessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessagesTable,
+			Columns: []string{user.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReactionsTable,
+			Columns: []string{user.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedReactionsIDs(); len(nodes) > 0 && !uuo.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReactionsTable,
+			Columns: []string{user.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReactionsTable,
+			Columns: []string{user.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.ReadCursorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReadCursorsTable,
+			Columns: []string{user.ReadCursorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readcursor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedReadCursorsIDs(); len(nodes) > 0 && !uuo.mutation.ReadCursorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReadCursorsTable,
+			Columns: []string{user.ReadCursorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readcursor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.ReadCursorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReadCursorsTable,
+			Columns: []string{user.ReadCursorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readcursor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AttachmentsTable,
+			Columns: []string{user.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !uuo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AttachmentsTable,
+			Columns: []string{user.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AttachmentsTable,
+			Columns: []string{user.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.SubscriptionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.SubscriptionTable,
+			Columns: []string{user.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.SubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.SubscriptionTable,
+			Columns: []string{user.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.BillingEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BillingEventsTable,
+			Columns: []string{user.BillingEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(billingevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedBillingEventsIDs(); len(nodes) > 0 && !uuo.mutation.BillingEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BillingEventsTable,
+			Columns: []string{user.BillingEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(billingevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.BillingEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BillingEventsTable,
+			Columns: []string{user.BillingEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(billingevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.DataExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataExportsTable,
+			Columns: []string{user.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedDataExportsIDs(); len(nodes) > 0 && !uuo.mutation.DataExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataExportsTable,
+			Columns: []string{user.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.DataExportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataExportsTable,
+			Columns: []string{user.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,205 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/auditentry"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/graph/model"
+	"github.com/matsuokashuhei/morrow-backend/internal/activity"
+)
+
+const activityCursorKind = "activity"
+
+// Activity lists the change history of the event for its chat members
+func (r *eventResolver) Activity(ctx context.Context, obj *model.Event, first *int32, after *string, last *int32, before *string) (*model.EventActivityConnection, error) {
+	eventID, err := strconv.Atoi(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event ID: %w", err)
+	}
+	if err := r.canReadChat(ctx, eventID); err != nil {
+		return nil, err
+	}
+
+	args, err := newPageArgs(activityCursorKind, first, after, last, before)
+	if err != nil {
+		return nil, newError(ctx, ErrCodeBadRequest, err.Error())
+	}
+
+	// 日時は予定のタイムゾーンで表示する
+	loc := time.UTC
+	if obj.TimeZone != nil {
+		if l, err := time.LoadLocation(*obj.TimeZone); err == nil {
+			loc = l
+		}
+	}
+	query := activity.Timeline(r.Client, eventID)
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count event activity: %w", err)
+	}
+
+	if args.after != nil {
+		query = query.Where(auditentry.IDGT(*args.after))
+	}
+	if args.before != nil {
+		query = query.Where(auditentry.IDLT(*args.before))
+	}
+	if args.backward {
+		query = query.Order(ent.Desc(auditentry.FieldID))
+	} else {
+		query = query.Order(ent.Asc(auditentry.FieldID))
+	}
+	entries, err := query.Limit(args.limit + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event activity: %w", err)
+	}
+
+	hasMore := len(entries) > args.limit
+	if hasMore {
+		entries = entries[:args.limit]
+	}
+	if args.backward {
+		for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+			entries[i], entries[j] = entries[j], entries[i]
+		}
+	}
+	page, err := activity.Describe(ctx, r.Client, entries, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &model.EventActivityConnection{
+		Edges:      make([]*model.EventActivityEdge, 0, len(page)),
+		PageInfo:   &model.PageInfo{},
+		TotalCount: int32(totalCount),
+	}
+	for _, a := range page {
+		conn.Edges = append(conn.Edges, &model.EventActivityEdge{
+			Node:   activityToGraphQL(a),
+			Cursor: encodeCursor(activityCursorKind, a.Entry.ID),
+		})
+	}
+	if args.backward {
+		conn.PageInfo.HasPreviousPage = hasMore
+		conn.PageInfo.HasNextPage = args.before != nil
+	} else {
+		conn.PageInfo.HasNextPage = hasMore
+		conn.PageInfo.HasPreviousPage = args.after != nil
+	}
+	if len(conn.Edges) > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[len(conn.Edges)-1].Cursor
+	}
+	return conn, nil
+}
+
+// RestoreEventVersion puts the event's details back to how they were right after the activity
+func (r *Resolver) RestoreEventVersion(ctx context.Context, id string, activityID string) (*model.Event, error) {
+	eventID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event ID: %w", err)
+	}
+	entryID, err := strconv.Atoi(activityID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid activity ID: %w", err)
+	}
+
+	entries, err := r.Client.AuditEntry.Query().
+		Where(auditentry.EntityTypeEQ(ent.TypeEvent), auditentry.EntityIDEQ(eventID)).
+		Order(ent.Asc(auditentry.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event history: %w", err)
+	}
+	version, err := activity.Version(entries, entryID)
+	if errors.Is(err, activity.ErrUnknownVersion) {
+		return nil, newError(ctx, ErrCodeBadRequest, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := r.Client.Event.Get(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	input, changed := restoreInput(current, version)
+	if !changed {
+		return entEventToGraphQL(current), nil
+	}
+	// 通常の更新として保存し、招待状の更新や履歴の記録も同じように行う
+	return r.UpdateEvent(ctx, id, input)
+}
+
+// restoreInput builds an update setting the fields of the version that differ from the event
+func restoreInput(current *ent.Event, version map[string]any) (model.UpdateEventInput, bool) {
+	var input model.UpdateEventInput
+	changed := false
+	for field, value := range version {
+		s, _ := value.(string)
+		switch field {
+		case event.FieldTitle:
+			if s != "" && s != current.Title {
+				input.Title, changed = &s, true
+			}
+		case event.FieldDescription:
+			if s != current.Description {
+				input.Description, changed = &s, true
+			}
+		case event.FieldStartTime, event.FieldEndTime:
+			t, err := time.Parse(time.RFC3339Nano, s)
+			if err != nil {
+				continue
+			}
+			formatted := t.Format(time.RFC3339Nano)
+			if field == event.FieldStartTime && !t.Equal(current.StartTime) {
+				input.StartTime, changed = &formatted, true
+			}
+			if field == event.FieldEndTime && !t.Equal(current.EndTime) {
+				input.EndTime, changed = &formatted, true
+			}
+		case event.FieldTimeZone:
+			if s != current.TimeZone {
+				input.TimeZone, changed = &s, true
+			}
+		case event.FieldRecurrenceRule:
+			if s != current.RecurrenceRule {
+				input.RecurrenceRule, changed = &s, true
+			}
+		case event.FieldEmoji:
+			if s != current.Emoji {
+				input.Emoji, changed = &s, true
+			}
+		case event.FieldVisibility:
+			if s != "" && s != string(current.Visibility) {
+				visibility := model.EventVisibility(s)
+				input.Visibility, changed = &visibility, true
+			}
+		}
+	}
+	return input, changed
+}
+
+// activityToGraphQL converts an activity to model.EventActivity
+func activityToGraphQL(a *activity.Activity) *model.EventActivity {
+	result := &model.EventActivity{
+		ID:        strconv.Itoa(a.Entry.ID),
+		Kind:      model.ActivityKind(a.Kind),
+		Message:   a.Message,
+		Changes:   auditChangesToGraphQL(a.Changes),
+		CreatedAt: a.Entry.CreatedAt.Format(time.RFC3339),
+	}
+	if a.Actor != nil {
+		result.Actor = entUserToGraphQL(a.Actor)
+	}
+	if a.User != nil {
+		result.Participant = entUserToGraphQL(a.User)
+	}
+	return result
+}
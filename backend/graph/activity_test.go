@@ -0,0 +1,89 @@
+package graph
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/matsuokashuhei/morrow-backend/graph/model"
+	"github.com/matsuokashuhei/morrow-backend/internal/audit"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventActivity(t *testing.T) {
+	client := setupTestDB(t)
+	defer teardownTestDB(t, client)
+	audit.Register(client)
+
+	resolver := &Resolver{Client: client}
+	background := context.Background()
+	owner, err := resolver.CreateUser(background, model.CreateUserInput{Email: "alice@example.com", Name: "Alice"})
+	require.NoError(t, err)
+	guest, err := resolver.CreateUser(background, model.CreateUserInput{Email: "bob@example.com", Name: "Bob"})
+	require.NoError(t, err)
+	ownerID, err := strconv.Atoi(owner.ID)
+	require.NoError(t, err)
+	guestID, err := strconv.Atoi(guest.ID)
+	require.NoError(t, err)
+	ownerCtx := viewer.NewContext(background, &viewer.Viewer{Subject: "alice", UserID: ownerID})
+	guestCtx := viewer.NewContext(background, &viewer.Viewer{Subject: "bob", UserID: guestID})
+
+	tokyo := "Asia/Tokyo"
+	e, err := resolver.CreateEvent(ownerCtx, model.CreateEventInput{
+		Title:     "Retro",
+		StartTime: "2025-10-31T08:00:00Z",
+		EndTime:   "2025-10-31T09:00:00Z",
+		TimeZone:  &tokyo,
+		CreatorID: owner.ID,
+	})
+	require.NoError(t, err)
+	p, err := resolver.CreateParticipant(ownerCtx, model.CreateParticipantInput{UserID: guest.ID, EventID: e.ID})
+	require.NoError(t, err)
+	accepted := model.ParticipantStatusAccepted
+	_, err = resolver.UpdateParticipant(guestCtx, p.ID, model.UpdateParticipantInput{Status: &accepted})
+	require.NoError(t, err)
+	start, end := "2025-11-01T08:00:00Z", "2025-11-01T09:00:00Z"
+	_, err = resolver.UpdateEvent(ownerCtx, e.ID, model.UpdateEventInput{StartTime: &start, EndTime: &end})
+	require.NoError(t, err)
+
+	t.Run("lists human readable activity", func(t *testing.T) {
+		conn, err := (&eventResolver{resolver}).Activity(guestCtx, e, nil, nil, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, conn.Edges, 4)
+
+		messages := make([]string, 0, len(conn.Edges))
+		for _, edge := range conn.Edges {
+			messages = append(messages, edge.Node.Message)
+		}
+		assert.Equal(t, []string{
+			"Alice created the event",
+			"Alice invited Bob",
+			"Bob accepted the invitation",
+			"Alice moved the event from Fri, Oct 31 17:00 to Sat, Nov 1 17:00",
+		}, messages)
+		assert.Equal(t, model.ActivityKindRescheduled, conn.Edges[3].Node.Kind)
+		assert.Equal(t, guest.ID, conn.Edges[2].Node.Participant.ID)
+	})
+
+	t.Run("restores a previous version", func(t *testing.T) {
+		conn, err := (&eventResolver{resolver}).Activity(ownerCtx, e, nil, nil, nil, nil)
+		require.NoError(t, err)
+		created := conn.Edges[0].Node
+
+		restored, err := resolver.RestoreEventVersion(ownerCtx, e.ID, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "2025-10-31T08:00:00Z", restored.StartTime)
+		assert.Equal(t, "Retro", restored.Title)
+
+		conn, err = (&eventResolver{resolver}).Activity(ownerCtx, e, nil, nil, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, int32(5), conn.TotalCount)
+	})
+
+	t.Run("rejects activities of other entities", func(t *testing.T) {
+		_, err := resolver.RestoreEventVersion(ownerCtx, e.ID, "0")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,181 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/auditentry"
+	"github.com/matsuokashuhei/morrow-backend/ent/schema"
+	"github.com/matsuokashuhei/morrow-backend/graph/model"
+)
+
+const auditEntryCursorKind = "audit"
+
+// AuditLog lists audit entries matching the filter for administrators
+func (r *Resolver) AuditLog(ctx context.Context, first *int32, after *string, last *int32, before *string, filter *model.AuditLogFilter) (*model.AuditEntryConnection, error) {
+	if err := r.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	args, err := newPageArgs(auditEntryCursorKind, first, after, last, before)
+	if err != nil {
+		return nil, newError(ctx, ErrCodeBadRequest, err.Error())
+	}
+	query := r.Client.AuditEntry.Query()
+	if filter != nil {
+		if err := applyAuditLogFilter(query, filter); err != nil {
+			return nil, newError(ctx, ErrCodeBadRequest, err.Error())
+		}
+	}
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count audit entries: %w", err)
+	}
+
+	if args.after != nil {
+		query = query.Where(auditentry.IDGT(*args.after))
+	}
+	if args.before != nil {
+		query = query.Where(auditentry.IDLT(*args.before))
+	}
+	if args.backward {
+		query = query.Order(ent.Desc(auditentry.FieldID))
+	} else {
+		query = query.Order(ent.Asc(auditentry.FieldID))
+	}
+
+	entries, err := query.Limit(args.limit + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit entries: %w", err)
+	}
+
+	hasMore := len(entries) > args.limit
+	if hasMore {
+		entries = entries[:args.limit]
+	}
+	if args.backward {
+		for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+			entries[i], entries[j] = entries[j], entries[i]
+		}
+	}
+
+	conn := &model.AuditEntryConnection{
+		Edges:      make([]*model.AuditEntryEdge, 0, len(entries)),
+		PageInfo:   &model.PageInfo{},
+		TotalCount: int32(totalCount),
+	}
+	for _, e := range entries {
+		conn.Edges = append(conn.Edges, &model.AuditEntryEdge{
+			Node:   entAuditEntryToGraphQL(e),
+			Cursor: encodeCursor(auditEntryCursorKind, e.ID),
+		})
+	}
+	if args.backward {
+		conn.PageInfo.HasPreviousPage = hasMore
+		conn.PageInfo.HasNextPage = args.before != nil
+	} else {
+		conn.PageInfo.HasNextPage = hasMore
+		conn.PageInfo.HasPreviousPage = args.after != nil
+	}
+	if len(conn.Edges) > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[len(conn.Edges)-1].Cursor
+	}
+	return conn, nil
+}
+
+// applyAuditLogFilter narrows the query to the entries matching every condition of the filter
+func applyAuditLogFilter(query *ent.AuditEntryQuery, filter *model.AuditLogFilter) error {
+	if filter.EntityType != nil {
+		query.Where(auditentry.EntityTypeEQ(*filter.EntityType))
+	}
+	if filter.EntityID != nil {
+		id, err := strconv.Atoi(*filter.EntityID)
+		if err != nil {
+			return fmt.Errorf("invalid entity ID")
+		}
+		query.Where(auditentry.EntityIDEQ(id))
+	}
+	if filter.ActorID != nil {
+		id, err := strconv.Atoi(*filter.ActorID)
+		if err != nil {
+			return fmt.Errorf("invalid actor ID")
+		}
+		query.Where(auditentry.ActorIDEQ(id))
+	}
+	if filter.Operation != nil {
+		query.Where(auditentry.OperationEQ(auditentry.Operation(*filter.Operation)))
+	}
+	if filter.Since != nil {
+		since, err := time.Parse(time.RFC3339, *filter.Since)
+		if err != nil {
+			return fmt.Errorf("since must be an RFC 3339 timestamp")
+		}
+		query.Where(auditentry.CreatedAtGTE(since))
+	}
+	if filter.Until != nil {
+		until, err := time.Parse(time.RFC3339, *filter.Until)
+		if err != nil {
+			return fmt.Errorf("until must be an RFC 3339 timestamp")
+		}
+		query.Where(auditentry.CreatedAtLT(until))
+	}
+	return nil
+}
+
+// entAuditEntryToGraphQL converts ent.AuditEntry to model.AuditEntry
+func entAuditEntryToGraphQL(e *ent.AuditEntry) *model.AuditEntry {
+	result := &model.AuditEntry{
+		ID:         strconv.Itoa(e.ID),
+		EntityType: e.EntityType,
+		EntityID:   strconv.Itoa(e.EntityID),
+		Operation:  model.AuditOperation(e.Operation),
+		Changes:    auditChangesToGraphQL(e.Changes),
+		CreatedAt:  e.CreatedAt.Format(time.RFC3339),
+	}
+	if e.ActorID != nil {
+		actorID := strconv.Itoa(*e.ActorID)
+		result.ActorID = &actorID
+	}
+	if e.ActorSubject != "" {
+		result.ActorSubject = &e.ActorSubject
+	}
+	if e.RequestID != "" {
+		result.RequestID = &e.RequestID
+	}
+	if e.IP != "" {
+		result.IP = &e.IP
+	}
+	return result
+}
+
+// auditChangesToGraphQL converts audited field changes to model.AuditChange
+func auditChangesToGraphQL(changes []schema.FieldChange) []*model.AuditChange {
+	result := make([]*model.AuditChange, 0, len(changes))
+	for _, c := range changes {
+		result = append(result, &model.AuditChange{
+			Field:  c.Field,
+			Before: jsonValue(c.Before),
+			After:  jsonValue(c.After),
+		})
+	}
+	return result
+}
+
+// jsonValue encodes an audited value, returning nil for absent values
+func jsonValue(v any) *string {
+	if v == nil {
+		return nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	s := string(b)
+	return &s
+}
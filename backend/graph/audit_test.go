@@ -0,0 +1,90 @@
+package graph
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/matsuokashuhei/morrow-backend/graph/model"
+	"github.com/matsuokashuhei/morrow-backend/internal/audit"
+	"github.com/matsuokashuhei/morrow-backend/internal/requestmeta"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLog(t *testing.T) {
+	client := setupTestDB(t)
+	defer teardownTestDB(t, client)
+	audit.Register(client)
+
+	resolver := &Resolver{Client: client}
+	background := context.Background()
+	admin, err := resolver.CreateUser(background, model.CreateUserInput{Email: "auditor@example.com", Name: "Auditor"})
+	require.NoError(t, err)
+	adminID, err := strconv.Atoi(admin.ID)
+	require.NoError(t, err)
+	require.NoError(t, client.User.UpdateOneID(adminID).SetIsAdmin(true).Exec(background))
+	adminCtx := viewer.NewContext(background, &viewer.Viewer{Subject: "auditor", UserID: adminID})
+
+	ctx := requestmeta.NewContext(adminCtx, &requestmeta.Meta{RequestID: "req-1", IP: "192.0.2.1"})
+	e, err := resolver.CreateEvent(ctx, model.CreateEventInput{
+		Title:     "Planning",
+		StartTime: "2025-11-01T10:00:00Z",
+		EndTime:   "2025-11-01T11:00:00Z",
+		CreatorID: admin.ID,
+	})
+	require.NoError(t, err)
+	title := "Sprint planning"
+	_, err = resolver.UpdateEvent(ctx, e.ID, model.UpdateEventInput{Title: &title})
+	require.NoError(t, err)
+	_, err = resolver.DeleteEvent(ctx, e.ID)
+	require.NoError(t, err)
+
+	entityType := "Event"
+	filter := &model.AuditLogFilter{EntityType: &entityType, EntityID: &e.ID}
+
+	t.Run("records every operation on the event", func(t *testing.T) {
+		conn, err := resolver.AuditLog(adminCtx, nil, nil, nil, nil, filter)
+		require.NoError(t, err)
+		require.Len(t, conn.Edges, 3)
+		assert.Equal(t, int32(3), conn.TotalCount)
+
+		created, updated, deleted := conn.Edges[0].Node, conn.Edges[1].Node, conn.Edges[2].Node
+		assert.Equal(t, model.AuditOperationCreate, created.Operation)
+		assert.Equal(t, model.AuditOperationUpdate, updated.Operation)
+		assert.Equal(t, model.AuditOperationDelete, deleted.Operation)
+
+		assert.Equal(t, admin.ID, *created.ActorID)
+		assert.Equal(t, "req-1", *created.RequestID)
+		assert.Equal(t, "192.0.2.1", *created.IP)
+
+		var titleChange *model.AuditChange
+		for _, c := range updated.Changes {
+			if c.Field == "title" {
+				titleChange = c
+			}
+		}
+		require.NotNil(t, titleChange)
+		assert.Equal(t, `"Planning"`, *titleChange.Before)
+		assert.Equal(t, `"Sprint planning"`, *titleChange.After)
+	})
+
+	t.Run("paginates entries", func(t *testing.T) {
+		first := int32(2)
+		page, err := resolver.AuditLog(adminCtx, &first, nil, nil, nil, filter)
+		require.NoError(t, err)
+		require.Len(t, page.Edges, 2)
+		assert.True(t, page.PageInfo.HasNextPage)
+
+		next, err := resolver.AuditLog(adminCtx, &first, page.PageInfo.EndCursor, nil, nil, filter)
+		require.NoError(t, err)
+		require.Len(t, next.Edges, 1)
+		assert.Equal(t, model.AuditOperationDelete, next.Edges[0].Node.Operation)
+	})
+
+	t.Run("is restricted to administrators", func(t *testing.T) {
+		_, err := resolver.AuditLog(background, nil, nil, nil, nil, nil)
+		assert.Error(t, err)
+	})
+}
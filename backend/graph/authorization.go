@@ -0,0 +1,178 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/participant"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+	"github.com/matsuokashuhei/morrow-backend/graph/model"
+	"github.com/matsuokashuhei/morrow-backend/internal/permission"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
+)
+
+// errAnonymous is returned by viewerRole when the request carries no authenticated viewer
+var errAnonymous = errors.New("anonymous viewer")
+
+// viewerRole returns the role the viewer holds on the event.
+// The creator of the event is always treated as its owner; other viewers need an accepted participation.
+func (r *Resolver) viewerRole(ctx context.Context, eventID int) (participant.Role, error) {
+	v, ok := viewer.FromContext(ctx)
+	if !ok {
+		return "", errAnonymous
+	}
+	if v.UserID == 0 {
+		return "", nil
+	}
+
+	isCreator, err := r.Client.Event.Query().
+		Where(event.IDEQ(eventID), event.HasCreatorWith(user.IDEQ(v.UserID))).
+		Exist(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to check event creator: %w", err)
+	}
+	if isCreator {
+		return participant.RoleOwner, nil
+	}
+
+	p, err := r.Client.Participant.Query().
+		Where(
+			participant.HasEventWith(event.IDEQ(eventID)),
+			participant.HasUserWith(user.IDEQ(v.UserID)),
+			participant.StatusEQ(participant.StatusAccepted),
+		).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get viewer participation: %w", err)
+	}
+	return p.Role, nil
+}
+
+// authorizeEvent checks that the viewer holds perm on the event.
+// Requests without an authenticated viewer are rejected unless AnonymousAccess is enabled.
+func (r *Resolver) authorizeEvent(ctx context.Context, eventID int, perm permission.Permission) error {
+	role, err := r.viewerRole(ctx, eventID)
+	if errors.Is(err, errAnonymous) {
+		return r.allowAnonymous(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	if !permission.Allows(role, perm) {
+		return newError(ctx, ErrCodeForbidden, fmt.Sprintf("permission %q is required on this event", perm))
+	}
+	return nil
+}
+
+// allowAnonymous rejects requests without an authenticated viewer unless
+// AnonymousAccess keeps the MVP mode of the Auth middleware open
+func (r *Resolver) allowAnonymous(ctx context.Context) error {
+	if r.AnonymousAccess {
+		return nil
+	}
+	return newError(ctx, ErrCodeUnauthenticated, "authentication is required for this event")
+}
+
+// assignableRole rejects the owner role, which only the event creator holds.
+// Ownership cannot be transferred yet.
+func assignableRole(ctx context.Context, role model.ParticipantRole) error {
+	if role == model.ParticipantRoleOwner {
+		return newError(ctx, ErrCodeBadRequest, "the owner role cannot be assigned; the event creator is its only owner")
+	}
+	return nil
+}
+
+// isViewer reports whether the authenticated viewer is the given user
+func isViewer(ctx context.Context, userID int) bool {
+	id, ok := viewer.UserID(ctx)
+	return ok && id == userID
+}
+
+// authorizeUserDeletion lets users delete themselves and administrators delete anyone.
+// Users handing over their events may only pick someone who accepted to take part
+// in every one of them, so that nobody becomes an owner without consent.
+func (r *Resolver) authorizeUserDeletion(ctx context.Context, userID, transferTo int) error {
+	if _, ok := viewer.UserID(ctx); !ok {
+		return newError(ctx, ErrCodeUnauthenticated, "authentication is required to delete users")
+	}
+	admin, err := r.isAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	if admin {
+		return nil
+	}
+	if !isViewer(ctx, userID) {
+		return newError(ctx, ErrCodeForbidden, "only administrators can delete other users")
+	}
+	if transferTo == 0 || transferTo == userID {
+		return nil
+	}
+
+	unaccepted, err := r.Client.Event.Query().
+		Where(
+			event.HasCreatorWith(user.IDEQ(userID)),
+			event.Not(event.HasParticipantsWith(
+				participant.HasUserWith(user.IDEQ(transferTo)),
+				participant.StatusEQ(participant.StatusAccepted),
+			)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check new owner: %w", err)
+	}
+	if unaccepted {
+		return newError(ctx, ErrCodeForbidden, "events can only be transferred to a user who accepted to take part in all of them")
+	}
+	return nil
+}
+
+// HasEventRole implements the @hasEventRole directive
+func (r *Resolver) HasEventRole(ctx context.Context, obj any, next graphql.Resolver, role model.ParticipantRole, eventIDArg *string) (any, error) {
+	argName := "id"
+	if eventIDArg != nil {
+		argName = *eventIDArg
+	}
+
+	raw, ok := graphql.GetFieldContext(ctx).Args[argName].(string)
+	if !ok {
+		return nil, newError(ctx, ErrCodeInternal, fmt.Sprintf("argument %q not found for @hasEventRole", argName))
+	}
+	eventID, err := strconv.Atoi(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event ID: %w", err)
+	}
+
+	granted, err := r.viewerRole(ctx, eventID)
+	if errors.Is(err, errAnonymous) {
+		if err := r.allowAnonymous(ctx); err != nil {
+			return nil, err
+		}
+		return next(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !permission.AtLeast(granted, participant.Role(role)) {
+		return nil, newError(ctx, ErrCodeForbidden, fmt.Sprintf("role %q or higher is required on this event", role))
+	}
+	return next(ctx)
+}
+
+// permissionsToGraphQL converts the permissions granted to a role into GraphQL enum values
+func permissionsToGraphQL(role participant.Role) []model.EventPermission {
+	perms := permission.Permissions(role)
+	result := make([]model.EventPermission, 0, len(perms))
+	for _, p := range perms {
+		result = append(result, model.EventPermission(p))
+	}
+	return result
+}
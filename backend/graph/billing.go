@@ -0,0 +1,30 @@
+package graph
+
+import (
+	"context"
+	"errors"
+
+	"github.com/matsuokashuhei/morrow-backend/graph/model"
+	"github.com/matsuokashuhei/morrow-backend/internal/billing"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
+)
+
+// CreateCheckoutSession starts the purchase of a paid plan for the viewer
+func (r *Resolver) CreateCheckoutSession(ctx context.Context, plan string) (*model.CheckoutSession, error) {
+	userID, ok := viewer.UserID(ctx)
+	if !ok {
+		return nil, newError(ctx, ErrCodeUnauthenticated, "authentication is required to buy a plan")
+	}
+	if r.Billing == nil {
+		return nil, newError(ctx, ErrCodeBadRequest, "billing is not configured")
+	}
+
+	session, err := r.Billing.Checkout(ctx, userID, plan)
+	if errors.Is(err, billing.ErrPlanNotForSale) {
+		return nil, newError(ctx, ErrCodeBadRequest, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &model.CheckoutSession{URL: session.URL}, nil
+}
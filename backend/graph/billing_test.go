@@ -0,0 +1,118 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent/billingevent"
+	"github.com/matsuokashuhei/morrow-backend/ent/subscription"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+	"github.com/matsuokashuhei/morrow-backend/graph/model"
+	"github.com/matsuokashuhei/morrow-backend/internal/billing"
+	"github.com/matsuokashuhei/morrow-backend/internal/entitlement"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBilling(t *testing.T) {
+	client := setupTestDB(t)
+	defer teardownTestDB(t, client)
+	background := context.Background()
+	require.NoError(t, entitlement.EnsurePlans(background, client))
+
+	// 決済プロバイダーの代わりに Checkout Session を返すだけのサーバー
+	provider := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"id": "cs_1", "url": "https://checkout.example/cs_1"})
+	}))
+	defer provider.Close()
+
+	const secret = "whsec_test"
+	service := billing.NewService(client, billing.NewStripe(provider.URL, "sk_test", secret), billing.Options{
+		Prices: map[string]string{entitlement.PlanPro: "price_pro"},
+	})
+	resolver := &Resolver{Client: client, Billing: service}
+
+	buyer, err := resolver.CreateUser(background, model.CreateUserInput{Email: "buyer@example.com", Name: "Buyer"})
+	require.NoError(t, err)
+	buyerID, err := strconv.Atoi(buyer.ID)
+	require.NoError(t, err)
+	ctx := viewer.NewContext(background, &viewer.Viewer{Subject: "buyer", UserID: buyerID})
+
+	webhook := func(id, eventType string, created time.Time, object string) (bool, error) {
+		payload := []byte(fmt.Sprintf(`{"id":%q,"type":%q,"created":%d,"data":{"object":%s}}`, id, eventType, created.Unix(), object))
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		header := http.Header{}
+		header.Set(billing.StripeSignatureHeader, "t="+timestamp+",v1="+billing.StripeSignature(secret, timestamp, payload))
+		return service.HandleWebhook(background, payload, header)
+	}
+	planOf := func() string {
+		p, err := entitlement.PlanOf(background, client, buyerID)
+		require.NoError(t, err)
+		return p.Key
+	}
+	subscriptionObject := fmt.Sprintf(`{"id":"sub_1","customer":"cus_1","metadata":{"user_id":"%d","plan":"pro"}}`, buyerID)
+	created := time.Now().Add(-time.Hour)
+
+	t.Run("creates a checkout session", func(t *testing.T) {
+		session, err := resolver.CreateCheckoutSession(ctx, entitlement.PlanPro)
+		require.NoError(t, err)
+		assert.Equal(t, "https://checkout.example/cs_1", session.URL)
+
+		_, err = resolver.CreateCheckoutSession(ctx, entitlement.PlanTeam)
+		assert.Error(t, err)
+	})
+
+	t.Run("subscription created upgrades the plan once", func(t *testing.T) {
+		applied, err := webhook("evt_created", "customer.subscription.created", created, subscriptionObject)
+		require.NoError(t, err)
+		assert.True(t, applied)
+		assert.Equal(t, entitlement.PlanPro, planOf())
+
+		// 同じWebhookの再送は台帳に二重に記録しない
+		applied, err = webhook("evt_created", "customer.subscription.created", created, subscriptionObject)
+		require.NoError(t, err)
+		assert.False(t, applied)
+
+		count, err := client.BillingEvent.Query().Where(billingevent.HasUserWith(user.IDEQ(buyerID))).Count(background)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("renewal extends the period", func(t *testing.T) {
+		periodEnd := time.Now().Add(30 * 24 * time.Hour).Truncate(time.Second)
+		invoice := fmt.Sprintf(`{"customer":"cus_1","subscription":"sub_1","billing_reason":"subscription_cycle","lines":{"data":[{"period":{"end":%d}}]}}`, periodEnd.Unix())
+		applied, err := webhook("evt_renewed", "invoice.paid", created.Add(time.Minute), invoice)
+		require.NoError(t, err)
+		assert.True(t, applied)
+
+		sub, err := client.Subscription.Query().Where(subscription.HasUserWith(user.IDEQ(buyerID))).Only(background)
+		require.NoError(t, err)
+		require.NotNil(t, sub.CurrentPeriodEnd)
+		assert.True(t, periodEnd.Equal(*sub.CurrentPeriodEnd))
+	})
+
+	t.Run("cancellation returns to the free plan", func(t *testing.T) {
+		applied, err := webhook("evt_cancelled", "customer.subscription.deleted", created.Add(2*time.Minute), subscriptionObject)
+		require.NoError(t, err)
+		assert.True(t, applied)
+		assert.Equal(t, entitlement.PlanFree, planOf())
+
+		// 順序が入れ替わって遅れて届いた更新は反映しない
+		applied, err = webhook("evt_late", "customer.subscription.created", created.Add(time.Minute), subscriptionObject)
+		require.NoError(t, err)
+		assert.True(t, applied)
+		assert.Equal(t, entitlement.PlanFree, planOf())
+	})
+
+	t.Run("rejects unsigned webhooks", func(t *testing.T) {
+		_, err := service.HandleWebhook(background, []byte(`{"id":"evt_forged"}`), http.Header{})
+		assert.ErrorIs(t, err, billing.ErrInvalidSignature)
+	})
+}
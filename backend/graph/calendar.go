@@ -0,0 +1,119 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/graph/model"
+	"github.com/matsuokashuhei/morrow-backend/internal/calendar"
+	"github.com/matsuokashuhei/morrow-backend/internal/feedtoken"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
+)
+
+// maxCalendarImportSize caps the size of .ics files accepted by importCalendar
+const maxCalendarImportSize = 2 << 20
+
+// CreateCalendarFeed issues a secret feed URL for the viewer's events.
+// Issuing a new URL revokes the previous one, since only the latest token hash is stored.
+func (r *Resolver) CreateCalendarFeed(ctx context.Context) (*model.CalendarFeed, error) {
+	userID, ok := viewer.UserID(ctx)
+	if !ok {
+		return nil, newError(ctx, ErrCodeUnauthenticated, "authentication is required to create a calendar feed")
+	}
+
+	token, hash, err := feedtoken.Generate()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.Client.User.UpdateOneID(userID).SetCalendarTokenHash(hash).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to create calendar feed: %w", err)
+	}
+	return &model.CalendarFeed{URL: feedtoken.URL(r.PublicURL, token)}, nil
+}
+
+// RevokeCalendarFeed invalidates the viewer's feed URL
+func (r *Resolver) RevokeCalendarFeed(ctx context.Context) (bool, error) {
+	userID, ok := viewer.UserID(ctx)
+	if !ok {
+		return false, newError(ctx, ErrCodeUnauthenticated, "authentication is required to revoke a calendar feed")
+	}
+
+	if err := r.Client.User.UpdateOneID(userID).ClearCalendarTokenHash().Exec(ctx); err != nil {
+		return false, fmt.Errorf("failed to revoke calendar feed: %w", err)
+	}
+	return true, nil
+}
+
+// ImportCalendar creates or updates the viewer's events from an uploaded .ics file
+func (r *Resolver) ImportCalendar(ctx context.Context, file graphql.Upload, visibility *model.EventVisibility) (*model.ImportCalendarResult, error) {
+	userID, ok := viewer.UserID(ctx)
+	if !ok {
+		return nil, newError(ctx, ErrCodeUnauthenticated, "authentication is required to import a calendar")
+	}
+	if file.Size > maxCalendarImportSize {
+		return nil, newError(ctx, ErrCodeBadRequest, fmt.Sprintf("file must be at most %d bytes", maxCalendarImportSize))
+	}
+
+	opts := calendar.ImportOptions{CreatorID: userID, Visibility: event.VisibilityPrivate}
+	if visibility != nil {
+		opts.Visibility = event.Visibility(*visibility)
+	}
+
+	items, err := calendar.Import(ctx, r.Client, io.LimitReader(file.File, maxCalendarImportSize), opts)
+	if errors.Is(err, calendar.ErrInvalidCalendar) || errors.Is(err, calendar.ErrTooManyEvents) {
+		return nil, newError(ctx, ErrCodeBadRequest, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := r.importedEvents(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+
+	created, updated, skipped := calendar.Counts(items)
+	result := &model.ImportCalendarResult{
+		Created: int32(created),
+		Updated: int32(updated),
+		Skipped: int32(skipped),
+		Items:   make([]*model.ImportCalendarItem, 0, len(items)),
+	}
+	for _, item := range items {
+		result.Items = append(result.Items, &model.ImportCalendarItem{
+			UID:     optionalString(item.UID),
+			Summary: optionalString(item.Summary),
+			Status:  model.ImportStatus(item.Status),
+			Event:   events[item.EventID],
+			Reason:  optionalString(item.Reason),
+		})
+	}
+	return result, nil
+}
+
+// importedEvents loads the events referenced by an import report in one query
+func (r *Resolver) importedEvents(ctx context.Context, items []calendar.ImportItem) (map[int]*model.Event, error) {
+	var ids []int
+	for _, item := range items {
+		if item.EventID != 0 {
+			ids = append(ids, item.EventID)
+		}
+	}
+	events, err := r.Client.Event.Query().
+		Where(event.IDIn(ids...)).
+		WithCreator().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get imported events: %w", err)
+	}
+
+	result := make(map[int]*model.Event, len(events))
+	for _, e := range events {
+		result[e.ID] = entEventToGraphQLSimple(e)
+	}
+	return result, nil
+}
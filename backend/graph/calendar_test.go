@@ -0,0 +1,103 @@
+package graph
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/matsuokashuhei/morrow-backend/graph/model"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const importTestCalendar = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:retro@example.com\r\n" +
+	"SUMMARY:Retrospective\r\n" +
+	"DTSTART;TZID=Asia/Tokyo:20251031T170000\r\n" +
+	"DTEND;TZID=Asia/Tokyo:20251031T180000\r\n" +
+	"RRULE:FREQ=MONTHLY;BYDAY=-1FR\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:no-start@example.com\r\n" +
+	"SUMMARY:Broken\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func calendarUpload(content string) graphql.Upload {
+	return graphql.Upload{
+		File:        strings.NewReader(content),
+		Filename:    "calendar.ics",
+		Size:        int64(len(content)),
+		ContentType: "text/calendar",
+	}
+}
+
+func TestImportCalendar(t *testing.T) {
+	client := setupTestDB(t)
+	defer teardownTestDB(t, client)
+
+	resolver := &Resolver{Client: client}
+	owner, err := resolver.CreateUser(context.Background(), model.CreateUserInput{
+		Email: "importer@example.com",
+		Name:  "Importer",
+	})
+	require.NoError(t, err)
+	ownerID, err := strconv.Atoi(owner.ID)
+	require.NoError(t, err)
+	ctx := viewer.NewContext(context.Background(), &viewer.Viewer{Subject: "importer", UserID: ownerID})
+
+	t.Run("creates events and reports skipped entries", func(t *testing.T) {
+		result, err := resolver.ImportCalendar(ctx, calendarUpload(importTestCalendar), nil)
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), result.Created)
+		assert.Equal(t, int32(1), result.Skipped)
+		require.Len(t, result.Items, 2)
+
+		created := result.Items[0]
+		assert.Equal(t, model.ImportStatusCreated, created.Status)
+		require.NotNil(t, created.Event)
+		assert.Equal(t, "Retrospective", created.Event.Title)
+		assert.Equal(t, "2025-10-31T08:00:00Z", created.Event.StartTime)
+		assert.Equal(t, "Asia/Tokyo", *created.Event.TimeZone)
+		assert.Equal(t, "FREQ=MONTHLY;BYDAY=-1FR", *created.Event.RecurrenceRule)
+		assert.Equal(t, model.EventVisibilityPrivate, created.Event.Visibility)
+
+		assert.Equal(t, model.ImportStatusSkipped, result.Items[1].Status)
+		assert.NotNil(t, result.Items[1].Reason)
+	})
+
+	t.Run("re-import skips unchanged events", func(t *testing.T) {
+		result, err := resolver.ImportCalendar(ctx, calendarUpload(importTestCalendar), nil)
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), result.Created)
+		assert.Equal(t, int32(2), result.Skipped)
+		assert.Equal(t, "unchanged", *result.Items[0].Reason)
+	})
+
+	t.Run("re-import updates changed events", func(t *testing.T) {
+		changed := strings.Replace(importTestCalendar, "SUMMARY:Retrospective", "SUMMARY:Sprint retrospective", 1)
+		result, err := resolver.ImportCalendar(ctx, calendarUpload(changed), nil)
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), result.Updated)
+		assert.Equal(t, "Sprint retrospective", result.Items[0].Event.Title)
+
+		events, err := resolver.Events(ctx)
+		require.NoError(t, err)
+		assert.Len(t, events, 1)
+	})
+
+	t.Run("rejects files that are not calendars", func(t *testing.T) {
+		_, err := resolver.ImportCalendar(ctx, calendarUpload("not a calendar"), nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		_, err := resolver.ImportCalendar(context.Background(), calendarUpload(importTestCalendar), nil)
+		assert.Error(t, err)
+	})
+}
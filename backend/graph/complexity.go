@@ -0,0 +1,191 @@
+package graph
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/matsuokashuhei/morrow-backend/graph/model"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// listSize is the number of items assumed for lists without pagination arguments
+const listSize = 20
+
+// QueryLimits caps the cost of operations. Anonymous callers get their own,
+// usually lower, limits. Zero disables a limit.
+type QueryLimits struct {
+	MaxComplexity          int
+	MaxDepth               int
+	AnonymousMaxComplexity int
+	AnonymousMaxDepth      int
+}
+
+// newComplexityRoot sets the cost of the fields returning several items: a
+// connection costs its page size times its selection, and a plain list
+// listSize times its selection. Other fields cost 1 plus their selection.
+func newComplexityRoot() ComplexityRoot {
+	var c ComplexityRoot
+
+	c.Event.Messages = func(childComplexity int, first *int32, _ *string, last *int32, _ *string) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+	c.Event.Activity = func(childComplexity int, first *int32, _ *string, last *int32, _ *string) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+	c.Query.AuditLog = func(childComplexity int, first *int32, _ *string, last *int32, _ *string, _ *model.AuditLogFilter) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+
+	c.Query.Events = listComplexity
+	c.Query.Users = listComplexity
+	c.Query.Participants = listComplexity
+	c.Event.Participants = listComplexity
+	c.Event.Reactions = listComplexity
+	c.User.CreatedEvents = listComplexity
+	c.User.Participants = listComplexity
+	c.Message.Attachments = listComplexity
+	c.Message.Reactions = listComplexity
+	return c
+}
+
+// connectionComplexity charges the number of items the resolver will load, as capped by newPageArgs
+func connectionComplexity(childComplexity int, first, last *int32) int {
+	size := defaultPageSize
+	switch {
+	case first != nil:
+		size = int(*first)
+	case last != nil:
+		size = int(*last)
+	}
+	size = min(max(size, 0), maxPageSize)
+	return safeAdd(1, safeMul(size, childComplexity))
+}
+
+func listComplexity(childComplexity int) int {
+	return safeAdd(1, safeMul(listSize, childComplexity))
+}
+
+// safeMul and safeAdd saturate instead of overflowing on absurd queries
+func safeMul(a, b int) int {
+	if a != 0 && b > math.MaxInt/a {
+		return math.MaxInt
+	}
+	return a * b
+}
+
+func safeAdd(a, b int) int {
+	if a > math.MaxInt-b {
+		return math.MaxInt
+	}
+	return a + b
+}
+
+// QueryCost is reported in the "cost" extension of responses
+type QueryCost struct {
+	Complexity    int `json:"complexity"`
+	MaxComplexity int `json:"maxComplexity"`
+	Depth         int `json:"depth"`
+	MaxDepth      int `json:"maxDepth"`
+}
+
+// queryLimits is a gqlgen extension rejecting operations deeper or more complex
+// than the limits of the caller before any resolver runs, and reporting the cost
+// of accepted ones in the response extensions
+type queryLimits struct {
+	limits QueryLimits
+	es     graphql.ExecutableSchema
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+	graphql.ResponseInterceptor
+} = &queryLimits{}
+
+const queryLimitsExtension = "QueryLimits"
+
+// ExtensionName implements graphql.HandlerExtension
+func (*queryLimits) ExtensionName() string {
+	return queryLimitsExtension
+}
+
+// Validate implements graphql.HandlerExtension
+func (q *queryLimits) Validate(es graphql.ExecutableSchema) error {
+	q.es = es
+	return nil
+}
+
+// MutateOperationContext implements graphql.OperationContextMutator
+func (q *queryLimits) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	op := opCtx.Doc.Operations.ForName(opCtx.OperationName)
+	if op == nil {
+		return nil
+	}
+
+	cost := &QueryCost{MaxComplexity: q.limits.MaxComplexity, MaxDepth: q.limits.MaxDepth}
+	if v, ok := viewer.FromContext(ctx); !ok || v.Subject == "" {
+		cost.MaxComplexity, cost.MaxDepth = q.limits.AnonymousMaxComplexity, q.limits.AnonymousMaxDepth
+	}
+
+	// 深さを先に確認し、再帰的なクエリのコストを計算しないようにする
+	cost.Depth = selectionDepth(op.SelectionSet)
+	if cost.MaxDepth > 0 && cost.Depth > cost.MaxDepth {
+		err := newError(ctx, ErrCodeDepthLimit, "operation is nested too deeply")
+		err.Extensions["depth"] = cost.Depth
+		err.Extensions["maxDepth"] = cost.MaxDepth
+		return err
+	}
+	cost.Complexity = complexity.Calculate(ctx, q.es, op, opCtx.Variables)
+	if cost.MaxComplexity > 0 && cost.Complexity > cost.MaxComplexity {
+		err := newError(ctx, ErrCodeComplexityLimit, "operation is too complex, select fewer fields or smaller pages")
+		err.Extensions["complexity"] = cost.Complexity
+		err.Extensions["maxComplexity"] = cost.MaxComplexity
+		return err
+	}
+
+	opCtx.Stats.SetExtension(queryLimitsExtension, cost)
+	return nil
+}
+
+// InterceptResponse implements graphql.ResponseInterceptor
+func (*queryLimits) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	resp := next(ctx)
+	if resp == nil || !graphql.HasOperationContext(ctx) {
+		return resp
+	}
+	cost, ok := graphql.GetOperationContext(ctx).Stats.GetExtension(queryLimitsExtension).(*QueryCost)
+	if !ok {
+		return resp
+	}
+	if resp.Extensions == nil {
+		resp.Extensions = map[string]any{}
+	}
+	resp.Extensions["cost"] = cost
+	return resp
+}
+
+// selectionDepth returns how deeply fields are nested. Introspection fields
+// are not counted since the schema is not recursive data.
+func selectionDepth(selectionSet ast.SelectionSet) int {
+	depth := 0
+	for _, selection := range selectionSet {
+		switch s := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+			depth = max(depth, 1+selectionDepth(s.SelectionSet))
+		case *ast.FragmentSpread:
+			// 断片の循環は検証で拒否されている
+			depth = max(depth, selectionDepth(s.Definition.SelectionSet))
+		case *ast.InlineFragment:
+			depth = max(depth, selectionDepth(s.SelectionSet))
+		}
+	}
+	return depth
+}
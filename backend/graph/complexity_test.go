@@ -0,0 +1,88 @@
+package graph
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectionComplexity(t *testing.T) {
+	first, huge := int32(10), int32(1000)
+	assert.Equal(t, 1+defaultPageSize*3, connectionComplexity(3, nil, nil))
+	assert.Equal(t, 31, connectionComplexity(3, &first, nil))
+	assert.Equal(t, 31, connectionComplexity(3, nil, &first))
+	// newPageArgs と同じく maxPageSize で頭打ちにする
+	assert.Equal(t, 1+maxPageSize*3, connectionComplexity(3, &huge, nil))
+	assert.Equal(t, math.MaxInt, listComplexity(math.MaxInt/2))
+}
+
+func TestQueryLimits(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		if c.GetHeader("Authorization") != "" {
+			c.Request = c.Request.WithContext(viewer.NewContext(c.Request.Context(), &viewer.Viewer{Subject: "sub-1"}))
+		}
+	})
+	// 制限を超えた操作はリゾルバーを実行しないのでデータベースは不要
+	router.POST("/graphql", GraphQLHandler(nil, HandlerOptions{QueryLimits: QueryLimits{
+		MaxComplexity:          10000,
+		MaxDepth:               6,
+		AnonymousMaxComplexity: 100,
+		AnonymousMaxDepth:      4,
+	}}))
+
+	post := func(query string, authenticated bool) map[string]any {
+		body, _ := json.Marshal(map[string]string{"query": query})
+		req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body)))
+		req.Header.Set("Content-Type", "application/json")
+		if authenticated {
+			req.Header.Set("Authorization", "Bearer token")
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		var resp map[string]any
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		return resp
+	}
+	errorExtensions := func(t *testing.T, resp map[string]any) map[string]any {
+		require.Len(t, resp["errors"], 1)
+		return resp["errors"].([]any)[0].(map[string]any)["extensions"].(map[string]any)
+	}
+
+	t.Run("cost is reported", func(t *testing.T) {
+		resp := post("{ __typename }", false)
+		assert.Nil(t, resp["errors"])
+		cost := resp["extensions"].(map[string]any)["cost"].(map[string]any)
+		assert.Equal(t, float64(1), cost["complexity"])
+		assert.Equal(t, float64(100), cost["maxComplexity"])
+		assert.Equal(t, float64(4), cost["maxDepth"])
+	})
+
+	t.Run("recursive queries are too deep", func(t *testing.T) {
+		query := "{ events { participants { user { participants { event { id } } } } } }"
+		ext := errorExtensions(t, post(query, false))
+		assert.Equal(t, ErrCodeDepthLimit, ext["code"])
+		assert.Equal(t, float64(6), ext["depth"])
+
+		// 認証済みの呼び出しには深さの上限が緩いが、一覧の積でコストが上限を超える
+		ext = errorExtensions(t, post(query, true))
+		assert.Equal(t, ErrCodeComplexityLimit, ext["code"])
+		assert.Equal(t, float64(1+20*(1+20*(1+(1+20*(1+1))))), ext["complexity"])
+	})
+
+	t.Run("connections cost their page size", func(t *testing.T) {
+		ext := errorExtensions(t, post(`{ event(id: "1") { messages(first: 100) { edges { cursor } } } }`, false))
+		assert.Equal(t, ErrCodeComplexityLimit, ext["code"])
+		assert.Equal(t, float64(1+1+100*(1+1)), ext["complexity"])
+		assert.Equal(t, float64(100), ext["maxComplexity"])
+	})
+}
@@ -0,0 +1,142 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/plan"
+	"github.com/matsuokashuhei/morrow-backend/ent/subscription"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+	"github.com/matsuokashuhei/morrow-backend/graph/model"
+	"github.com/matsuokashuhei/morrow-backend/internal/entitlement"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
+)
+
+// requireAdmin checks that the viewer is an administrator
+func (r *Resolver) requireAdmin(ctx context.Context) error {
+	if _, ok := viewer.UserID(ctx); !ok {
+		return newError(ctx, ErrCodeUnauthenticated, "authentication is required for administrative operations")
+	}
+	isAdmin, err := r.isAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return newError(ctx, ErrCodeForbidden, "administrator privileges are required")
+	}
+	return nil
+}
+
+// isAdmin reports whether the viewer is an authenticated administrator
+func (r *Resolver) isAdmin(ctx context.Context) (bool, error) {
+	userID, ok := viewer.UserID(ctx)
+	if !ok {
+		return false, nil
+	}
+	isAdmin, err := r.Client.User.Query().Where(user.IDEQ(userID), user.IsAdmin(true)).Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check administrator: %w", err)
+	}
+	return isAdmin, nil
+}
+
+// Plans lists the plans users can subscribe to
+func (r *Resolver) Plans(ctx context.Context) ([]*model.Plan, error) {
+	plans, err := r.Client.Plan.Query().Order(ent.Asc(plan.FieldID)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plans: %w", err)
+	}
+	result := make([]*model.Plan, 0, len(plans))
+	for _, p := range plans {
+		result = append(result, entPlanToGraphQL(p))
+	}
+	return result, nil
+}
+
+// SetUserPlan subscribes a user to a plan, replacing the current one
+func (r *Resolver) SetUserPlan(ctx context.Context, userID string, planKey string) (*model.Entitlements, error) {
+	if err := r.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	id, err := strconv.Atoi(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	p, err := r.Client.Plan.Query().Where(plan.KeyEQ(planKey)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, newError(ctx, ErrCodeBadRequest, fmt.Sprintf("unknown plan %q", planKey))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plan: %w", err)
+	}
+
+	current, err := r.Client.Subscription.Query().
+		Where(subscription.HasUserWith(user.IDEQ(id))).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		err = r.Client.Subscription.Create().
+			SetUserID(id).
+			SetPlan(p).
+			SetStatus(subscription.StatusActive).
+			Exec(ctx)
+	case err == nil:
+		err = current.Update().
+			SetPlan(p).
+			SetStatus(subscription.StatusActive).
+			Exec(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to change plan: %w", err)
+	}
+	return r.entitlementsOf(ctx, id)
+}
+
+// Entitlements resolves Viewer.entitlements
+func (r *viewerResolver) Entitlements(ctx context.Context, obj *model.Viewer) (*model.Entitlements, error) {
+	userID, err := strconv.Atoi(obj.User.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+	return r.entitlementsOf(ctx, userID)
+}
+
+// entitlementsOf builds the entitlements of a user
+func (r *Resolver) entitlementsOf(ctx context.Context, userID int) (*model.Entitlements, error) {
+	p, err := entitlement.PlanOf(ctx, r.Client, userID)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := entitlement.UsageOf(ctx, r.Client, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &model.Entitlements{
+		Plan:              entPlanToGraphQL(p),
+		ActiveEvents:      int32(usage.ActiveEvents),
+		AttachmentStorage: int(usage.AttachmentStorage),
+	}, nil
+}
+
+func entPlanToGraphQL(p *ent.Plan) *model.Plan {
+	result := &model.Plan{
+		Key:  p.Key,
+		Name: p.Name,
+	}
+	if p.MaxActiveEvents != nil {
+		v := int32(*p.MaxActiveEvents)
+		result.MaxActiveEvents = &v
+	}
+	if p.MaxParticipantsPerEvent != nil {
+		v := int32(*p.MaxParticipantsPerEvent)
+		result.MaxParticipantsPerEvent = &v
+	}
+	if p.MaxAttachmentStorage != nil {
+		v := int(*p.MaxAttachmentStorage)
+		result.MaxAttachmentStorage = &v
+	}
+	return result
+}
@@ -0,0 +1,92 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/graph/model"
+	"github.com/matsuokashuhei/morrow-backend/internal/entitlement"
+	"github.com/matsuokashuhei/morrow-backend/internal/softdelete"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntitlements(t *testing.T) {
+	client := setupTestDB(t)
+	defer teardownTestDB(t, client)
+	entitlement.Register(client)
+	softdelete.Register(client)
+	require.NoError(t, entitlement.EnsurePlans(context.Background(), client))
+
+	resolver := &Resolver{Client: client}
+	background := context.Background()
+	owner, err := resolver.CreateUser(background, model.CreateUserInput{Email: "free@example.com", Name: "Free User"})
+	require.NoError(t, err)
+	admin, err := resolver.CreateUser(background, model.CreateUserInput{Email: "admin@example.com", Name: "Admin"})
+	require.NoError(t, err)
+	adminID, err := strconv.Atoi(admin.ID)
+	require.NoError(t, err)
+	require.NoError(t, client.User.UpdateOneID(adminID).SetIsAdmin(true).Exec(background))
+
+	start := time.Now().Add(24 * time.Hour)
+	createEvent := func(i int) error {
+		_, err := resolver.CreateEvent(background, model.CreateEventInput{
+			Title:     fmt.Sprintf("Event %d", i),
+			StartTime: start.Format(time.RFC3339),
+			EndTime:   start.Add(time.Hour).Format(time.RFC3339),
+			CreatorID: owner.ID,
+		})
+		return err
+	}
+
+	t.Run("free plan limits active events", func(t *testing.T) {
+		for i := 0; i < *entitlement.Catalog[0].MaxActiveEvents; i++ {
+			require.NoError(t, createEvent(i))
+		}
+
+		err := createEvent(99)
+		var quota *entitlement.QuotaError
+		require.True(t, errors.As(err, &quota), "expected quota error, got %v", err)
+		assert.Equal(t, entitlement.QuotaActiveEvents, quota.Quota)
+		assert.Equal(t, entitlement.PlanFree, quota.Plan)
+	})
+
+	ownerID, err := strconv.Atoi(owner.ID)
+	require.NoError(t, err)
+	ownerCtx := viewer.NewContext(background, &viewer.Viewer{Subject: "free", UserID: ownerID})
+
+	t.Run("restored events count against the quota", func(t *testing.T) {
+		trashed, err := client.Event.Query().Where(event.TitleEQ("Event 0")).OnlyID(background)
+		require.NoError(t, err)
+		_, err = resolver.DeleteEvent(ownerCtx, strconv.Itoa(trashed))
+		require.NoError(t, err)
+		// ゴミ箱に移した分だけ新しく作れる
+		require.NoError(t, createEvent(98))
+
+		_, err = resolver.RestoreEvent(ownerCtx, strconv.Itoa(trashed))
+		var quota *entitlement.QuotaError
+		require.True(t, errors.As(err, &quota), "expected quota error, got %v", err)
+		assert.Equal(t, entitlement.QuotaActiveEvents, quota.Quota)
+	})
+
+	t.Run("only administrators change plans", func(t *testing.T) {
+		_, err := resolver.SetUserPlan(ownerCtx, owner.ID, entitlement.PlanPro)
+		assert.Error(t, err)
+	})
+
+	t.Run("upgraded users can create more events", func(t *testing.T) {
+		adminCtx := viewer.NewContext(background, &viewer.Viewer{Subject: "admin", UserID: adminID})
+		result, err := resolver.SetUserPlan(adminCtx, owner.ID, entitlement.PlanPro)
+		require.NoError(t, err)
+		assert.Equal(t, entitlement.PlanPro, result.Plan.Key)
+		assert.Equal(t, int32(*entitlement.Catalog[0].MaxActiveEvents), result.ActiveEvents)
+
+		require.NoError(t, createEvent(100))
+	})
+}
@@ -0,0 +1,75 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/matsuokashuhei/morrow-backend/internal/entitlement"
+	"github.com/matsuokashuhei/morrow-backend/internal/logging"
+	"github.com/matsuokashuhei/morrow-backend/internal/requestmeta"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Error codes exposed to clients in the "code" extension of GraphQL errors
+const (
+	ErrCodeUnauthenticated = "UNAUTHENTICATED"
+	ErrCodeForbidden       = "FORBIDDEN"
+	ErrCodeBadRequest      = "BAD_REQUEST"
+	ErrCodeQuotaExceeded   = "QUOTA_EXCEEDED"
+	ErrCodeRateLimited     = "RATE_LIMITED"
+	ErrCodeComplexityLimit = "COMPLEXITY_LIMIT_EXCEEDED"
+	ErrCodeDepthLimit      = "DEPTH_LIMIT_EXCEEDED"
+	ErrCodeInternal        = "INTERNAL_ERROR"
+)
+
+// newError builds a GraphQL error carrying a machine readable code
+func newError(ctx context.Context, code, message string) *gqlerror.Error {
+	return &gqlerror.Error{
+		Message: message,
+		Path:    graphql.GetPath(ctx),
+		Extensions: map[string]any{
+			"code": code,
+		},
+	}
+}
+
+// errorPresenter adds error codes to errors raised below the resolvers, such as
+// quota errors of ent hooks, and the request ID to every error so that users
+// can quote it when reporting a problem
+func errorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := presentError(ctx, err)
+	if meta, ok := requestmeta.FromContext(ctx); ok {
+		if gqlErr.Extensions == nil {
+			gqlErr.Extensions = map[string]any{}
+		}
+		gqlErr.Extensions["requestId"] = meta.RequestID
+	}
+	return gqlErr
+}
+
+func presentError(ctx context.Context, err error) *gqlerror.Error {
+	var quota *entitlement.QuotaError
+	if errors.As(err, &quota) {
+		gqlErr := newError(ctx, ErrCodeQuotaExceeded, quota.Error())
+		gqlErr.Extensions["quota"] = quota.Quota
+		gqlErr.Extensions["plan"] = quota.Plan
+		gqlErr.Extensions["limit"] = quota.Limit
+		return gqlErr
+	}
+	// gqlgen はリゾルバーのエラーを gqlerror.Error で包む。newError で作ったもの以外の、
+	// リゾルバーや Ent が返したエラーはリクエストIDと合わせて記録する
+	var gqlErr *gqlerror.Error
+	if errors.As(err, &gqlErr) && gqlErr.Err != nil && !errors.As(gqlErr.Err, new(*gqlerror.Error)) {
+		logging.FromContext(ctx).WithError(gqlErr.Err).WithField("path", gqlErr.Path.String()).Warn("GraphQL resolver error")
+	}
+	return graphql.DefaultErrorPresenter(ctx, err)
+}
+
+// recoverPanic logs a panic of a resolver with the request logger and hides its details from the client
+func recoverPanic(ctx context.Context, err any) error {
+	logging.FromContext(ctx).WithField("panic", fmt.Sprint(err)).WithField("stack", string(debug.Stack())).Error("GraphQL resolver panicked")
+	return newError(ctx, ErrCodeInternal, "internal system error")
+}
@@ -0,0 +1,62 @@
+package graph
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/matsuokashuhei/morrow-backend/internal/entitlement"
+	"github.com/matsuokashuhei/morrow-backend/internal/logging"
+	"github.com/matsuokashuhei/morrow-backend/internal/requestmeta"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestErrorPresenter(t *testing.T) {
+	var buf bytes.Buffer
+	logger := logrus.New()
+	logger.SetOutput(&buf)
+	logger.SetFormatter(&logrus.JSONFormatter{})
+
+	ctx := requestmeta.NewContext(context.Background(), &requestmeta.Meta{RequestID: "req-1"})
+	ctx = logging.NewContext(ctx, logger.WithField("request_id", "req-1"))
+	path := ast.Path{ast.PathName("createEvent")}
+
+	t.Run("resolver errors carry the request ID and are logged", func(t *testing.T) {
+		buf.Reset()
+		gqlErr := errorPresenter(ctx, graphql.ErrorOnPath(graphql.WithPathContext(ctx, graphql.NewPathWithField("createEvent")), errors.New("failed to create event: connection reset")))
+
+		assert.Equal(t, "req-1", gqlErr.Extensions["requestId"])
+		assert.Equal(t, path, gqlErr.Path)
+		assert.Contains(t, buf.String(), `"request_id":"req-1"`)
+		assert.Contains(t, buf.String(), "connection reset")
+	})
+
+	t.Run("coded errors keep their extensions and are not logged", func(t *testing.T) {
+		buf.Reset()
+		gqlErr := errorPresenter(ctx, newError(ctx, ErrCodeForbidden, "forbidden"))
+
+		assert.Equal(t, ErrCodeForbidden, gqlErr.Extensions["code"])
+		assert.Equal(t, "req-1", gqlErr.Extensions["requestId"])
+		assert.Empty(t, buf.String())
+	})
+
+	t.Run("quota errors", func(t *testing.T) {
+		gqlErr := errorPresenter(ctx, &entitlement.QuotaError{Quota: "events", Plan: "free", Limit: 10})
+
+		assert.Equal(t, ErrCodeQuotaExceeded, gqlErr.Extensions["code"])
+		assert.Equal(t, "req-1", gqlErr.Extensions["requestId"])
+	})
+
+	t.Run("panics are hidden from clients", func(t *testing.T) {
+		buf.Reset()
+		gqlErr := errorPresenter(ctx, recoverPanic(ctx, "nil map"))
+
+		assert.Equal(t, "internal system error", gqlErr.Message)
+		assert.Equal(t, ErrCodeInternal, gqlErr.Extensions["code"])
+		assert.Contains(t, buf.String(), "nil map")
+	})
+}
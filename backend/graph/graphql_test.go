@@ -2,14 +2,19 @@ package graph
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"testing"
 
 	"github.com/matsuokashuhei/morrow-backend/ent"
 	"github.com/matsuokashuhei/morrow-backend/graph/model"
+	"github.com/matsuokashuhei/morrow-backend/internal/softdelete"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 
 	_ "github.com/lib/pq"
 )
@@ -57,11 +62,31 @@ func setupTestDB(t *testing.T) *ent.Client {
 
 // teardownTestDB cleans up the test database
 func teardownTestDB(t *testing.T, client *ent.Client) {
-	// Clean up test data
-	ctx := context.Background()
+	// Clean up test data (permanently, even when soft delete is registered)
+	ctx := softdelete.Skip(context.Background())
 
 	// Delete in correct order to respect foreign key constraints
-	_, err := client.Participant.Delete().Exec(ctx)
+	_, err := client.ReadCursor.Delete().Exec(ctx)
+	if err != nil {
+		t.Logf("Warning: failed to delete read cursors: %v", err)
+	}
+
+	_, err = client.Reaction.Delete().Exec(ctx)
+	if err != nil {
+		t.Logf("Warning: failed to delete reactions: %v", err)
+	}
+
+	_, err = client.Attachment.Delete().Exec(ctx)
+	if err != nil {
+		t.Logf("Warning: failed to delete attachments: %v", err)
+	}
+
+	_, err = client.Message.Delete().Exec(ctx)
+	if err != nil {
+		t.Logf("Warning: failed to delete messages: %v", err)
+	}
+
+	_, err = client.Participant.Delete().Exec(ctx)
 	if err != nil {
 		t.Logf("Warning: failed to delete participants: %v", err)
 	}
@@ -71,11 +96,36 @@ func teardownTestDB(t *testing.T, client *ent.Client) {
 		t.Logf("Warning: failed to delete events: %v", err)
 	}
 
+	_, err = client.BillingEvent.Delete().Exec(ctx)
+	if err != nil {
+		t.Logf("Warning: failed to delete billing events: %v", err)
+	}
+
+	_, err = client.Subscription.Delete().Exec(ctx)
+	if err != nil {
+		t.Logf("Warning: failed to delete subscriptions: %v", err)
+	}
+
+	_, err = client.DataExport.Delete().Exec(ctx)
+	if err != nil {
+		t.Logf("Warning: failed to delete data exports: %v", err)
+	}
+
 	_, err = client.User.Delete().Exec(ctx)
 	if err != nil {
 		t.Logf("Warning: failed to delete users: %v", err)
 	}
 
+	_, err = client.Plan.Delete().Exec(ctx)
+	if err != nil {
+		t.Logf("Warning: failed to delete plans: %v", err)
+	}
+
+	_, err = client.AuditEntry.Delete().Exec(ctx)
+	if err != nil {
+		t.Logf("Warning: failed to delete audit entries: %v", err)
+	}
+
 	err = client.Close()
 	if err != nil {
 		t.Logf("Warning: failed to close database: %v", err)
@@ -86,7 +136,8 @@ func TestUserMutations(t *testing.T) {
 	client := setupTestDB(t)
 	defer teardownTestDB(t, client)
 
-	resolver := &Resolver{Client: client}
+	// 匿名リクエストで呼ぶので MVP モードを有効にする
+	resolver := &Resolver{Client: client, AnonymousAccess: true}
 	ctx := context.Background()
 
 	t.Run("CreateUser", func(t *testing.T) {
@@ -139,8 +190,11 @@ func TestUserMutations(t *testing.T) {
 		createdUser, err := resolver.CreateUser(ctx, createInput)
 		require.NoError(t, err)
 
-		// Then delete it
-		deleted, err := resolver.DeleteUser(ctx, createdUser.ID)
+		// Then delete it as the user themselves
+		userID, err := strconv.Atoi(createdUser.ID)
+		require.NoError(t, err)
+		selfCtx := viewer.NewContext(ctx, &viewer.Viewer{Subject: "delete", UserID: userID})
+		deleted, err := resolver.DeleteUser(selfCtx, createdUser.ID, nil)
 		require.NoError(t, err)
 		assert.True(t, deleted)
 
@@ -154,7 +208,8 @@ func TestEventMutations(t *testing.T) {
 	client := setupTestDB(t)
 	defer teardownTestDB(t, client)
 
-	resolver := &Resolver{Client: client}
+	// 匿名リクエストで呼ぶので MVP モードを有効にする
+	resolver := &Resolver{Client: client, AnonymousAccess: true}
 	ctx := context.Background()
 
 	// First create a user (creator)
@@ -240,7 +295,8 @@ func TestParticipantMutations(t *testing.T) {
 	client := setupTestDB(t)
 	defer teardownTestDB(t, client)
 
-	resolver := &Resolver{Client: client}
+	// 匿名リクエストで呼ぶので MVP モードを有効にする
+	resolver := &Resolver{Client: client, AnonymousAccess: true}
 	ctx := context.Background()
 
 	// Setup: Create a user and event
@@ -305,6 +361,29 @@ func TestParticipantMutations(t *testing.T) {
 		assert.Equal(t, createdParticipant.Role, updatedParticipant.Role) // Role should remain unchanged
 	})
 
+	t.Run("the owner role cannot be assigned", func(t *testing.T) {
+		owner := model.ParticipantRoleOwner
+		_, err := resolver.CreateParticipant(ctx, model.CreateParticipantInput{
+			Role:    &owner,
+			UserID:  user.ID,
+			EventID: event.ID,
+		})
+		assertErrorCode(t, err, ErrCodeBadRequest)
+
+		created, err := resolver.CreateParticipant(ctx, model.CreateParticipantInput{
+			Role:    participantRolePtr(model.ParticipantRoleEditor),
+			UserID:  user.ID,
+			EventID: event.ID,
+		})
+		require.NoError(t, err)
+		_, err = resolver.UpdateParticipant(ctx, created.ID, model.UpdateParticipantInput{Role: &owner})
+		assertErrorCode(t, err, ErrCodeBadRequest)
+
+		p, err := resolver.Participant(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, model.ParticipantRoleEditor, p.Role)
+	})
+
 	t.Run("DeleteParticipant", func(t *testing.T) {
 		// First create a participant
 		createInput := model.CreateParticipantInput{
@@ -327,6 +406,14 @@ func TestParticipantMutations(t *testing.T) {
 	})
 }
 
+// assertErrorCode checks the code extension of a GraphQL error returned by a resolver
+func assertErrorCode(t *testing.T, err error, code string) {
+	t.Helper()
+	var gqlErr *gqlerror.Error
+	require.True(t, errors.As(err, &gqlErr), "expected GraphQL error, got %v", err)
+	assert.Equal(t, code, gqlErr.Extensions["code"])
+}
+
 // Helper function to create string pointer
 func stringPtr(s string) *string {
 	return &s
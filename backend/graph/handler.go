@@ -1,44 +1,118 @@
 package graph
 
 import (
+	"net/http"
+	"time"
+
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/lru"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
 	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/internal/billing"
+	"github.com/matsuokashuhei/morrow-backend/internal/invitation"
+	"github.com/matsuokashuhei/morrow-backend/internal/metrics"
+	"github.com/matsuokashuhei/morrow-backend/internal/pubsub"
+	"github.com/matsuokashuhei/morrow-backend/internal/ratelimit"
+	"github.com/matsuokashuhei/morrow-backend/internal/storage"
+	"github.com/matsuokashuhei/morrow-backend/internal/tracing"
 	"github.com/vektah/gqlparser/v2/ast"
 )
 
+// multipartOverhead leaves room for the operations and map parts of multipart requests
+const multipartOverhead = 1 << 20
+
+// HandlerOptions holds the dependencies of the GraphQL handler besides the Ent client
+type HandlerOptions struct {
+	// Storage may be nil, in which case upload mutations return an error
+	Storage   storage.Storage
+	Limits    UploadLimits
+	PublicURL string
+	// Invitations may be nil, in which case no invitation emails are sent
+	Invitations *invitation.Sender
+	// Billing may be nil, in which case paid plans cannot be bought
+	Billing *billing.Service
+	// TrashRetention defaults to softdelete.DefaultRetention when zero
+	TrashRetention time.Duration
+	// AccountDeletionGracePeriod defaults to account.DefaultGracePeriod when zero
+	AccountDeletionGracePeriod time.Duration
+	// AnonymousAccess lets requests without a token pass the event permission checks and read event chats
+	AnonymousAccess bool
+	// Introspection allows clients to query the schema, which tools such as the playground need
+	Introspection bool
+	// QueryLimits caps the depth and complexity of operations
+	QueryLimits QueryLimits
+	// RateLimiter may be nil, in which case operations are not limited
+	RateLimiter *ratelimit.Limiter
+}
+
 // GraphQLHandler creates a GraphQL handler for the Gin router
-func GraphQLHandler(client *ent.Client) gin.HandlerFunc {
+func GraphQLHandler(client *ent.Client, opts HandlerOptions) gin.HandlerFunc {
 	// Create resolver with Ent client
 	resolver := &Resolver{
-		Client: client,
+		Client:         client,
+		PubSub:         pubsub.NewBroker(),
+		Storage:        opts.Storage,
+		Limits:         opts.Limits,
+		PublicURL:      opts.PublicURL,
+		Invitations:    opts.Invitations,
+		Billing:        opts.Billing,
+		TrashRetention: opts.TrashRetention,
+
+		AccountDeletionGracePeriod: opts.AccountDeletionGracePeriod,
+		AnonymousAccess:            opts.AnonymousAccess,
 	}
 
 	// Create GraphQL server
 	srv := handler.New(NewExecutableSchema(Config{
-		Resolvers: resolver,
+		Resolvers:  NewResolverRoot(resolver),
+		Complexity: newComplexityRoot(),
+		Directives: DirectiveRoot{
+			HasEventRole: resolver.HasEventRole,
+		},
 	}))
 
+	srv.SetErrorPresenter(errorPresenter)
+	srv.SetRecoverFunc(recoverPanic)
+
 	// Add transports
 	srv.AddTransport(transport.Options{})
 	srv.AddTransport(transport.GET{})
 	srv.AddTransport(transport.POST{})
-	srv.AddTransport(transport.MultipartForm{})
+	srv.AddTransport(transport.MultipartForm{
+		MaxUploadSize: max(opts.Limits.MaxAvatarSize, opts.Limits.MaxAttachmentSize) + multipartOverhead,
+	})
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		Upgrader: websocket.Upgrader{
+			// Cross-origin requests are already filtered by the CORS middleware
+			CheckOrigin: func(r *http.Request) bool { return true },
+		},
+	})
 
 	// Add cache
 	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
 
 	// Add extensions
-	srv.Use(extension.Introspection{})
+	if opts.Introspection {
+		srv.Use(extension.Introspection{})
+	}
+	srv.Use(&metrics.GraphQL{})
+	srv.Use(tracing.GraphQL{})
+	srv.Use(rateLimit{limiter: opts.RateLimiter})
+	srv.Use(&queryLimits{limits: opts.QueryLimits})
 	srv.Use(extension.AutomaticPersistedQuery{
 		Cache: lru.New[string](100),
 	})
 
-	return gin.WrapH(srv)
+	return func(c *gin.Context) {
+		// 拡張からレート制限のヘッダーを設定できるようにする
+		ctx := ratelimit.WithResponseHeader(c.Request.Context(), c.Writer.Header())
+		srv.ServeHTTP(c.Writer, c.Request.WithContext(ctx))
+	}
 }
 
 // PlaygroundHandler creates a GraphQL playground handler
@@ -0,0 +1,335 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/message"
+	"github.com/matsuokashuhei/morrow-backend/ent/participant"
+	"github.com/matsuokashuhei/morrow-backend/graph/model"
+	"github.com/matsuokashuhei/morrow-backend/internal/permission"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
+)
+
+// messageCursorKind is the prefix of message connection cursors
+const messageCursorKind = "message"
+
+// messageTopic returns the pubsub topic carrying new messages of an event chat
+func messageTopic(eventID int) string {
+	return fmt.Sprintf("event:%d:messages", eventID)
+}
+
+// Helper function to convert Ent Message to GraphQL Message
+func entMessageToGraphQL(m *ent.Message) *model.Message {
+	var author *model.User
+	if m.Edges.Author != nil {
+		author = entUserToGraphQL(m.Edges.Author)
+	}
+
+	var ev *model.Event
+	if m.Edges.Event != nil {
+		ev = entEventToGraphQLSimple(m.Edges.Event)
+	}
+
+	result := &model.Message{
+		ID:        strconv.Itoa(m.ID),
+		Body:      m.Body,
+		CreatedAt: m.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: m.UpdatedAt.Format(time.RFC3339),
+		Author:    author,
+		Event:     ev,
+	}
+	if m.EditedAt != nil {
+		editedAt := m.EditedAt.Format(time.RFC3339)
+		result.EditedAt = &editedAt
+	}
+	if m.DeletedAt != nil {
+		// 削除済みメッセージは履歴として残すが本文は返さない
+		deletedAt := m.DeletedAt.Format(time.RFC3339)
+		result.DeletedAt = &deletedAt
+		result.Body = ""
+	}
+	return result
+}
+
+// requireChatMember ensures the viewer is authenticated and may take part in the event chat.
+// It returns the viewer's user ID and role on the event.
+func (r *Resolver) requireChatMember(ctx context.Context, eventID int) (int, participant.Role, error) {
+	userID, ok := viewer.UserID(ctx)
+	if !ok {
+		return 0, "", newError(ctx, ErrCodeUnauthenticated, "authentication is required to use event chat")
+	}
+	role, err := r.viewerRole(ctx, eventID)
+	if err != nil {
+		return 0, "", err
+	}
+	if role == "" {
+		return 0, "", newError(ctx, ErrCodeForbidden, "only accepted participants can use this event chat")
+	}
+	return userID, role, nil
+}
+
+// canReadChat checks read access to the event chat.
+// Anonymous viewers are allowed only when AnonymousAccess is enabled.
+func (r *Resolver) canReadChat(ctx context.Context, eventID int) error {
+	if _, ok := viewer.FromContext(ctx); !ok && r.AnonymousAccess {
+		return nil
+	}
+	_, _, err := r.requireChatMember(ctx, eventID)
+	return err
+}
+
+// isAuthor reports whether the user wrote the message. Messages of closed accounts have no author.
+func isAuthor(m *ent.Message, userID int) bool {
+	return m.Edges.Author != nil && m.Edges.Author.ID == userID
+}
+
+// getMessage loads a message together with its author and event
+func (r *Resolver) getMessage(ctx context.Context, id string) (*ent.Message, error) {
+	messageID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid message ID: %w", err)
+	}
+
+	m, err := r.Client.Message.Query().
+		Where(message.IDEQ(messageID)).
+		WithAuthor().
+		WithEvent().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+	return m, nil
+}
+
+// SendMessage posts a message to the event chat
+func (r *Resolver) SendMessage(ctx context.Context, eventID string, body string) (*model.Message, error) {
+	id, err := strconv.Atoi(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event ID: %w", err)
+	}
+
+	authorID, _, err := r.requireChatMember(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, newError(ctx, ErrCodeBadRequest, "message body must not be empty")
+	}
+
+	created, err := r.Client.Message.
+		Create().
+		SetBody(body).
+		SetEventID(id).
+		SetAuthorID(authorID).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send message: %w", err)
+	}
+
+	m, err := r.getMessage(ctx, strconv.Itoa(created.ID))
+	if err != nil {
+		return nil, err
+	}
+	result := entMessageToGraphQL(m)
+	r.PubSub.Publish(messageTopic(id), result)
+	return result, nil
+}
+
+// EditMessage changes the body of a message. Only the author can edit a message.
+func (r *Resolver) EditMessage(ctx context.Context, id string, body string) (*model.Message, error) {
+	m, err := r.getMessage(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, _, err := r.requireChatMember(ctx, m.Edges.Event.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthor(m, userID) {
+		return nil, newError(ctx, ErrCodeForbidden, "only the author can edit this message")
+	}
+	if m.DeletedAt != nil {
+		return nil, newError(ctx, ErrCodeBadRequest, "deleted messages cannot be edited")
+	}
+
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, newError(ctx, ErrCodeBadRequest, "message body must not be empty")
+	}
+
+	if _, err := m.Update().SetBody(body).SetEditedAt(time.Now()).Save(ctx); err != nil {
+		return nil, fmt.Errorf("failed to edit message: %w", err)
+	}
+
+	m, err = r.getMessage(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return entMessageToGraphQL(m), nil
+}
+
+// DeleteMessage hides a message while keeping it in the chat history.
+// Authors can delete their own messages; moderators can delete any message.
+func (r *Resolver) DeleteMessage(ctx context.Context, id string) (bool, error) {
+	m, err := r.getMessage(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	userID, role, err := r.requireChatMember(ctx, m.Edges.Event.ID)
+	if err != nil {
+		return false, err
+	}
+	if !isAuthor(m, userID) && !permission.Allows(role, permission.ModerateChat) {
+		return false, newError(ctx, ErrCodeForbidden, fmt.Sprintf("permission %q is required to delete other participants' messages", permission.ModerateChat))
+	}
+	if m.DeletedAt != nil {
+		return true, nil
+	}
+
+	if err := m.Update().SetDeletedAt(time.Now()).Exec(ctx); err != nil {
+		return false, fmt.Errorf("failed to delete message: %w", err)
+	}
+	return true, nil
+}
+
+// Messages resolves Event.messages as a cursor paginated connection ordered from oldest to newest
+func (r *eventResolver) Messages(ctx context.Context, obj *model.Event, first *int32, after *string, last *int32, before *string) (*model.MessageConnection, error) {
+	eventID, err := strconv.Atoi(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event ID: %w", err)
+	}
+	if err := r.canReadChat(ctx, eventID); err != nil {
+		return nil, err
+	}
+
+	args, err := newPageArgs(messageCursorKind, first, after, last, before)
+	if err != nil {
+		return nil, newError(ctx, ErrCodeBadRequest, err.Error())
+	}
+
+	query := r.Client.Message.Query().
+		Where(message.HasEventWith(event.IDEQ(eventID)))
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count messages: %w", err)
+	}
+
+	if args.after != nil {
+		query = query.Where(message.IDGT(*args.after))
+	}
+	if args.before != nil {
+		query = query.Where(message.IDLT(*args.before))
+	}
+	if args.backward {
+		query = query.Order(ent.Desc(message.FieldID))
+	} else {
+		query = query.Order(ent.Asc(message.FieldID))
+	}
+
+	messages, err := query.
+		Limit(args.limit + 1).
+		WithAuthor().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get messages: %w", err)
+	}
+
+	hasMore := len(messages) > args.limit
+	if hasMore {
+		messages = messages[:args.limit]
+	}
+	if args.backward {
+		for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+			messages[i], messages[j] = messages[j], messages[i]
+		}
+	}
+
+	conn := &model.MessageConnection{
+		Edges:      make([]*model.MessageEdge, 0, len(messages)),
+		PageInfo:   &model.PageInfo{},
+		TotalCount: int32(totalCount),
+	}
+	for _, m := range messages {
+		node := entMessageToGraphQL(m)
+		node.Event = obj
+		conn.Edges = append(conn.Edges, &model.MessageEdge{
+			Node:   node,
+			Cursor: encodeCursor(messageCursorKind, m.ID),
+		})
+	}
+	// メッセージごとに集計しないよう、ページ分のリアクションをまとめて読み込む
+	if len(messages) > 0 && selectsField(ctx, "edges", "node", "reactions") {
+		ids := make([]int, len(messages))
+		for i, m := range messages {
+			ids[i] = m.ID
+		}
+		reactions, err := r.summarizeMessageReactions(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		for i, edge := range conn.Edges {
+			edge.Node.Reactions = reactions[messages[i].ID]
+		}
+	}
+	if args.backward {
+		conn.PageInfo.HasPreviousPage = hasMore
+		conn.PageInfo.HasNextPage = args.before != nil
+	} else {
+		conn.PageInfo.HasNextPage = hasMore
+		conn.PageInfo.HasPreviousPage = args.after != nil
+	}
+	if len(conn.Edges) > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[len(conn.Edges)-1].Cursor
+	}
+	return conn, nil
+}
+
+// MessageAdded streams messages posted to the event chat after the subscription starts
+func (r *Resolver) MessageAdded(ctx context.Context, eventID string) (<-chan *model.Message, error) {
+	id, err := strconv.Atoi(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event ID: %w", err)
+	}
+	if err := r.canReadChat(ctx, id); err != nil {
+		return nil, err
+	}
+
+	events, unsubscribe := r.PubSub.Subscribe(messageTopic(id))
+	out := make(chan *model.Message, 1)
+	go func() {
+		defer close(out)
+		defer unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-events:
+				if !ok {
+					return
+				}
+				m, ok := msg.(*model.Message)
+				if !ok {
+					continue
+				}
+				select {
+				case out <- m:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
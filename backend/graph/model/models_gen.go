@@ -14,14 +14,80 @@ type Node interface {
 	GetID() string
 }
 
+type Attachment struct {
+	ID           string  `json:"id"`
+	ContentType  string  `json:"contentType"`
+	Size         int32   `json:"size"`
+	Width        int32   `json:"width"`
+	Height       int32   `json:"height"`
+	URL          string  `json:"url"`
+	ThumbnailURL *string `json:"thumbnailUrl,omitempty"`
+	CreatedAt    string  `json:"createdAt"`
+}
+
+func (Attachment) IsNode()            {}
+func (this Attachment) GetID() string { return this.ID }
+
+type AuditChange struct {
+	Field  string  `json:"field"`
+	Before *string `json:"before,omitempty"`
+	After  *string `json:"after,omitempty"`
+}
+
+type AuditEntry struct {
+	ID           string         `json:"id"`
+	ActorID      *string        `json:"actorId,omitempty"`
+	ActorSubject *string        `json:"actorSubject,omitempty"`
+	EntityType   string         `json:"entityType"`
+	EntityID     string         `json:"entityId"`
+	Operation    AuditOperation `json:"operation"`
+	Changes      []*AuditChange `json:"changes"`
+	RequestID    *string        `json:"requestId,omitempty"`
+	IP           *string        `json:"ip,omitempty"`
+	CreatedAt    string         `json:"createdAt"`
+}
+
+func (AuditEntry) IsNode()            {}
+func (this AuditEntry) GetID() string { return this.ID }
+
+type AuditEntryConnection struct {
+	Edges      []*AuditEntryEdge `json:"edges"`
+	PageInfo   *PageInfo         `json:"pageInfo"`
+	TotalCount int32             `json:"totalCount"`
+}
+
+type AuditEntryEdge struct {
+	Node   *AuditEntry `json:"node"`
+	Cursor string      `json:"cursor"`
+}
+
+type AuditLogFilter struct {
+	EntityType *string         `json:"entityType,omitempty"`
+	EntityID   *string         `json:"entityId,omitempty"`
+	ActorID    *string         `json:"actorId,omitempty"`
+	Operation  *AuditOperation `json:"operation,omitempty"`
+	Since      *string         `json:"since,omitempty"`
+	Until      *string         `json:"until,omitempty"`
+}
+
+type CalendarFeed struct {
+	URL string `json:"url"`
+}
+
+type CheckoutSession struct {
+	URL string `json:"url"`
+}
+
 type CreateEventInput struct {
-	Title       string           `json:"title"`
-	Description *string          `json:"description,omitempty"`
-	StartTime   string           `json:"startTime"`
-	EndTime     string           `json:"endTime"`
-	Emoji       *string          `json:"emoji,omitempty"`
-	Visibility  *EventVisibility `json:"visibility,omitempty"`
-	CreatorID   string           `json:"creatorId"`
+	Title          string           `json:"title"`
+	Description    *string          `json:"description,omitempty"`
+	StartTime      string           `json:"startTime"`
+	EndTime        string           `json:"endTime"`
+	TimeZone       *string          `json:"timeZone,omitempty"`
+	RecurrenceRule *string          `json:"recurrenceRule,omitempty"`
+	Emoji          *string          `json:"emoji,omitempty"`
+	Visibility     *EventVisibility `json:"visibility,omitempty"`
+	CreatorID      string           `json:"creatorId"`
 }
 
 type CreateParticipantInput struct {
@@ -38,49 +104,199 @@ type CreateUserInput struct {
 	CognitoID *string `json:"cognitoId,omitempty"`
 }
 
+type DataExport struct {
+	ID          string           `json:"id"`
+	Status      DataExportStatus `json:"status"`
+	Size        int              `json:"size"`
+	CreatedAt   string           `json:"createdAt"`
+	CompletedAt *string          `json:"completedAt,omitempty"`
+	ExpiresAt   *string          `json:"expiresAt,omitempty"`
+	DownloadURL *string          `json:"downloadUrl,omitempty"`
+}
+
+type Entitlements struct {
+	Plan              *Plan `json:"plan"`
+	ActiveEvents      int32 `json:"activeEvents"`
+	AttachmentStorage int   `json:"attachmentStorage"`
+}
+
 type Event struct {
-	ID           string          `json:"id"`
-	Title        string          `json:"title"`
-	Description  *string         `json:"description,omitempty"`
-	StartTime    string          `json:"startTime"`
-	EndTime      string          `json:"endTime"`
-	Emoji        *string         `json:"emoji,omitempty"`
-	Visibility   EventVisibility `json:"visibility"`
-	CreatedAt    string          `json:"createdAt"`
-	UpdatedAt    string          `json:"updatedAt"`
-	Creator      *User           `json:"creator"`
-	Participants []*Participant  `json:"participants"`
+	ID             string                   `json:"id"`
+	Title          string                   `json:"title"`
+	Description    *string                  `json:"description,omitempty"`
+	StartTime      string                   `json:"startTime"`
+	EndTime        string                   `json:"endTime"`
+	TimeZone       *string                  `json:"timeZone,omitempty"`
+	RecurrenceRule *string                  `json:"recurrenceRule,omitempty"`
+	Emoji          *string                  `json:"emoji,omitempty"`
+	Visibility     EventVisibility          `json:"visibility"`
+	CreatedAt      string                   `json:"createdAt"`
+	UpdatedAt      string                   `json:"updatedAt"`
+	Creator        *User                    `json:"creator"`
+	Participants   []*Participant           `json:"participants"`
+	Messages       *MessageConnection       `json:"messages"`
+	Reactions      []*ReactionSummary       `json:"reactions"`
+	UnreadCount    int32                    `json:"unreadCount"`
+	Activity       *EventActivityConnection `json:"activity"`
 }
 
 func (Event) IsNode()            {}
 func (this Event) GetID() string { return this.ID }
 
+type EventActivity struct {
+	ID          string         `json:"id"`
+	Kind        ActivityKind   `json:"kind"`
+	Message     string         `json:"message"`
+	Actor       *User          `json:"actor,omitempty"`
+	Participant *User          `json:"participant,omitempty"`
+	Changes     []*AuditChange `json:"changes"`
+	CreatedAt   string         `json:"createdAt"`
+}
+
+func (EventActivity) IsNode()            {}
+func (this EventActivity) GetID() string { return this.ID }
+
+type EventActivityConnection struct {
+	Edges      []*EventActivityEdge `json:"edges"`
+	PageInfo   *PageInfo            `json:"pageInfo"`
+	TotalCount int32                `json:"totalCount"`
+}
+
+type EventActivityEdge struct {
+	Node   *EventActivity `json:"node"`
+	Cursor string         `json:"cursor"`
+}
+
+type ImportCalendarItem struct {
+	UID     *string      `json:"uid,omitempty"`
+	Summary *string      `json:"summary,omitempty"`
+	Status  ImportStatus `json:"status"`
+	Event   *Event       `json:"event,omitempty"`
+	Reason  *string      `json:"reason,omitempty"`
+}
+
+type ImportCalendarResult struct {
+	Created int32                 `json:"created"`
+	Updated int32                 `json:"updated"`
+	Skipped int32                 `json:"skipped"`
+	Items   []*ImportCalendarItem `json:"items"`
+}
+
+type Message struct {
+	ID          string              `json:"id"`
+	Body        string              `json:"body"`
+	EditedAt    *string             `json:"editedAt,omitempty"`
+	DeletedAt   *string             `json:"deletedAt,omitempty"`
+	CreatedAt   string              `json:"createdAt"`
+	UpdatedAt   string              `json:"updatedAt"`
+	Author      *User               `json:"author,omitempty"`
+	Event       *Event              `json:"event"`
+	Attachments []*Attachment       `json:"attachments"`
+	Reactions   []*ReactionSummary  `json:"reactions"`
+	ReadBy      *ReadReceiptSummary `json:"readBy"`
+}
+
+func (Message) IsNode()            {}
+func (this Message) GetID() string { return this.ID }
+
+type MessageConnection struct {
+	Edges      []*MessageEdge `json:"edges"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+	TotalCount int32          `json:"totalCount"`
+}
+
+type MessageEdge struct {
+	Node   *Message `json:"node"`
+	Cursor string   `json:"cursor"`
+}
+
 type Mutation struct {
 }
 
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor,omitempty"`
+	EndCursor       *string `json:"endCursor,omitempty"`
+}
+
 type Participant struct {
-	ID        string            `json:"id"`
-	Role      ParticipantRole   `json:"role"`
-	Status    ParticipantStatus `json:"status"`
-	JoinedAt  string            `json:"joinedAt"`
-	UpdatedAt string            `json:"updatedAt"`
-	User      *User             `json:"user"`
-	Event     *Event            `json:"event"`
+	ID          string            `json:"id"`
+	Role        ParticipantRole   `json:"role"`
+	Status      ParticipantStatus `json:"status"`
+	Permissions []EventPermission `json:"permissions"`
+	JoinedAt    string            `json:"joinedAt"`
+	UpdatedAt   string            `json:"updatedAt"`
+	User        *User             `json:"user"`
+	Event       *Event            `json:"event"`
 }
 
 func (Participant) IsNode()            {}
 func (this Participant) GetID() string { return this.ID }
 
+type Plan struct {
+	Key                     string `json:"key"`
+	Name                    string `json:"name"`
+	MaxActiveEvents         *int32 `json:"maxActiveEvents,omitempty"`
+	MaxParticipantsPerEvent *int32 `json:"maxParticipantsPerEvent,omitempty"`
+	MaxAttachmentStorage    *int   `json:"maxAttachmentStorage,omitempty"`
+}
+
 type Query struct {
 }
 
+type ReactionInput struct {
+	MessageID *string `json:"messageId,omitempty"`
+	EventID   *string `json:"eventId,omitempty"`
+	Emoji     string  `json:"emoji"`
+}
+
+type ReactionSummary struct {
+	Emoji            string `json:"emoji"`
+	Count            int32  `json:"count"`
+	ViewerHasReacted bool   `json:"viewerHasReacted"`
+}
+
+type ReactionUpdate struct {
+	EventID   string             `json:"eventId"`
+	MessageID *string            `json:"messageId,omitempty"`
+	Reactions []*ReactionSummary `json:"reactions"`
+}
+
+type ReadReceiptSummary struct {
+	Count int32   `json:"count"`
+	Users []*User `json:"users"`
+}
+
+type Subscription struct {
+}
+
+type Trash struct {
+	Events []*TrashedEvent `json:"events"`
+	Users  []*TrashedUser  `json:"users"`
+}
+
+type TrashedEvent struct {
+	Event     *Event `json:"event"`
+	DeletedAt string `json:"deletedAt"`
+	PurgeAt   string `json:"purgeAt"`
+}
+
+type TrashedUser struct {
+	User      *User  `json:"user"`
+	DeletedAt string `json:"deletedAt"`
+	PurgeAt   string `json:"purgeAt"`
+}
+
 type UpdateEventInput struct {
-	Title       *string          `json:"title,omitempty"`
-	Description *string          `json:"description,omitempty"`
-	StartTime   *string          `json:"startTime,omitempty"`
-	EndTime     *string          `json:"endTime,omitempty"`
-	Emoji       *string          `json:"emoji,omitempty"`
-	Visibility  *EventVisibility `json:"visibility,omitempty"`
+	Title          *string          `json:"title,omitempty"`
+	Description    *string          `json:"description,omitempty"`
+	StartTime      *string          `json:"startTime,omitempty"`
+	EndTime        *string          `json:"endTime,omitempty"`
+	TimeZone       *string          `json:"timeZone,omitempty"`
+	RecurrenceRule *string          `json:"recurrenceRule,omitempty"`
+	Emoji          *string          `json:"emoji,omitempty"`
+	Visibility     *EventVisibility `json:"visibility,omitempty"`
 }
 
 type UpdateParticipantInput struct {
@@ -109,6 +325,266 @@ type User struct {
 func (User) IsNode()            {}
 func (this User) GetID() string { return this.ID }
 
+type Viewer struct {
+	User                       *User         `json:"user"`
+	HasCalendarFeed            bool          `json:"hasCalendarFeed"`
+	UnreadCount                int32         `json:"unreadCount"`
+	Entitlements               *Entitlements `json:"entitlements"`
+	AccountDeletionScheduledAt *string       `json:"accountDeletionScheduledAt,omitempty"`
+}
+
+type ActivityKind string
+
+const (
+	ActivityKindCreated                ActivityKind = "created"
+	ActivityKindRescheduled            ActivityKind = "rescheduled"
+	ActivityKindRenamed                ActivityKind = "renamed"
+	ActivityKindVisibilityChanged      ActivityKind = "visibility_changed"
+	ActivityKindDetailsChanged         ActivityKind = "details_changed"
+	ActivityKindParticipantInvited     ActivityKind = "participant_invited"
+	ActivityKindParticipantJoined      ActivityKind = "participant_joined"
+	ActivityKindParticipantDeclined    ActivityKind = "participant_declined"
+	ActivityKindParticipantRoleChanged ActivityKind = "participant_role_changed"
+	ActivityKindParticipantRemoved     ActivityKind = "participant_removed"
+)
+
+var AllActivityKind = []ActivityKind{
+	ActivityKindCreated,
+	ActivityKindRescheduled,
+	ActivityKindRenamed,
+	ActivityKindVisibilityChanged,
+	ActivityKindDetailsChanged,
+	ActivityKindParticipantInvited,
+	ActivityKindParticipantJoined,
+	ActivityKindParticipantDeclined,
+	ActivityKindParticipantRoleChanged,
+	ActivityKindParticipantRemoved,
+}
+
+func (e ActivityKind) IsValid() bool {
+	switch e {
+	case ActivityKindCreated, ActivityKindRescheduled, ActivityKindRenamed, ActivityKindVisibilityChanged, ActivityKindDetailsChanged, ActivityKindParticipantInvited, ActivityKindParticipantJoined, ActivityKindParticipantDeclined, ActivityKindParticipantRoleChanged, ActivityKindParticipantRemoved:
+		return true
+	}
+	return false
+}
+
+func (e ActivityKind) String() string {
+	return string(e)
+}
+
+func (e *ActivityKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ActivityKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ActivityKind", str)
+	}
+	return nil
+}
+
+func (e ActivityKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ActivityKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ActivityKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type AuditOperation string
+
+const (
+	AuditOperationCreate AuditOperation = "create"
+	AuditOperationUpdate AuditOperation = "update"
+	AuditOperationDelete AuditOperation = "delete"
+)
+
+var AllAuditOperation = []AuditOperation{
+	AuditOperationCreate,
+	AuditOperationUpdate,
+	AuditOperationDelete,
+}
+
+func (e AuditOperation) IsValid() bool {
+	switch e {
+	case AuditOperationCreate, AuditOperationUpdate, AuditOperationDelete:
+		return true
+	}
+	return false
+}
+
+func (e AuditOperation) String() string {
+	return string(e)
+}
+
+func (e *AuditOperation) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuditOperation(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuditOperation", str)
+	}
+	return nil
+}
+
+func (e AuditOperation) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AuditOperation) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AuditOperation) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type DataExportStatus string
+
+const (
+	DataExportStatusPending    DataExportStatus = "pending"
+	DataExportStatusProcessing DataExportStatus = "processing"
+	DataExportStatusReady      DataExportStatus = "ready"
+	DataExportStatusFailed     DataExportStatus = "failed"
+	DataExportStatusExpired    DataExportStatus = "expired"
+)
+
+var AllDataExportStatus = []DataExportStatus{
+	DataExportStatusPending,
+	DataExportStatusProcessing,
+	DataExportStatusReady,
+	DataExportStatusFailed,
+	DataExportStatusExpired,
+}
+
+func (e DataExportStatus) IsValid() bool {
+	switch e {
+	case DataExportStatusPending, DataExportStatusProcessing, DataExportStatusReady, DataExportStatusFailed, DataExportStatusExpired:
+		return true
+	}
+	return false
+}
+
+func (e DataExportStatus) String() string {
+	return string(e)
+}
+
+func (e *DataExportStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DataExportStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DataExportStatus", str)
+	}
+	return nil
+}
+
+func (e DataExportStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DataExportStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DataExportStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type EventPermission string
+
+const (
+	EventPermissionEditDetails        EventPermission = "edit_details"
+	EventPermissionInvite             EventPermission = "invite"
+	EventPermissionRemoveParticipants EventPermission = "remove_participants"
+	EventPermissionModerateChat       EventPermission = "moderate_chat"
+	EventPermissionManageRoles        EventPermission = "manage_roles"
+	EventPermissionDelete             EventPermission = "delete"
+)
+
+var AllEventPermission = []EventPermission{
+	EventPermissionEditDetails,
+	EventPermissionInvite,
+	EventPermissionRemoveParticipants,
+	EventPermissionModerateChat,
+	EventPermissionManageRoles,
+	EventPermissionDelete,
+}
+
+func (e EventPermission) IsValid() bool {
+	switch e {
+	case EventPermissionEditDetails, EventPermissionInvite, EventPermissionRemoveParticipants, EventPermissionModerateChat, EventPermissionManageRoles, EventPermissionDelete:
+		return true
+	}
+	return false
+}
+
+func (e EventPermission) String() string {
+	return string(e)
+}
+
+func (e *EventPermission) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EventPermission(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EventPermission", str)
+	}
+	return nil
+}
+
+func (e EventPermission) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *EventPermission) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e EventPermission) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type EventVisibility string
 
 const (
@@ -166,21 +642,82 @@ func (e EventVisibility) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type ImportStatus string
+
+const (
+	ImportStatusCreated ImportStatus = "created"
+	ImportStatusUpdated ImportStatus = "updated"
+	ImportStatusSkipped ImportStatus = "skipped"
+)
+
+var AllImportStatus = []ImportStatus{
+	ImportStatusCreated,
+	ImportStatusUpdated,
+	ImportStatusSkipped,
+}
+
+func (e ImportStatus) IsValid() bool {
+	switch e {
+	case ImportStatusCreated, ImportStatusUpdated, ImportStatusSkipped:
+		return true
+	}
+	return false
+}
+
+func (e ImportStatus) String() string {
+	return string(e)
+}
+
+func (e *ImportStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ImportStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ImportStatus", str)
+	}
+	return nil
+}
+
+func (e ImportStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ImportStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ImportStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type ParticipantRole string
 
 const (
-	ParticipantRoleOwner  ParticipantRole = "owner"
-	ParticipantRoleViewer ParticipantRole = "viewer"
+	ParticipantRoleOwner     ParticipantRole = "owner"
+	ParticipantRoleEditor    ParticipantRole = "editor"
+	ParticipantRoleModerator ParticipantRole = "moderator"
+	ParticipantRoleViewer    ParticipantRole = "viewer"
 )
 
 var AllParticipantRole = []ParticipantRole{
 	ParticipantRoleOwner,
+	ParticipantRoleEditor,
+	ParticipantRoleModerator,
 	ParticipantRoleViewer,
 }
 
 func (e ParticipantRole) IsValid() bool {
 	switch e {
-	case ParticipantRoleOwner, ParticipantRoleViewer:
+	case ParticipantRoleOwner, ParticipantRoleEditor, ParticipantRoleModerator, ParticipantRoleViewer:
 		return true
 	}
 	return false
@@ -0,0 +1,116 @@
+package graph
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+const (
+	// defaultPageSize is used when a connection is queried without first or last
+	defaultPageSize = 50
+	// maxPageSize caps first and last to keep connection queries cheap
+	maxPageSize = 100
+)
+
+// encodeCursor builds an opaque Relay cursor from an entity type and ID
+func encodeCursor(kind string, id int) string {
+	return base64.StdEncoding.EncodeToString([]byte(kind + ":" + strconv.Itoa(id)))
+}
+
+// decodeCursor extracts the ID from a cursor created by encodeCursor
+func decodeCursor(kind, cursor string) (int, error) {
+	raw, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cursor: %w", err)
+	}
+	prefix, id, ok := strings.Cut(string(raw), ":")
+	if !ok || prefix != kind {
+		return 0, fmt.Errorf("invalid cursor: not a %s cursor", kind)
+	}
+	n, err := strconv.Atoi(id)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cursor: %w", err)
+	}
+	return n, nil
+}
+
+// pageArgs holds the validated Relay pagination arguments of a connection field
+type pageArgs struct {
+	limit    int
+	backward bool
+	after    *int
+	before   *int
+}
+
+// newPageArgs validates Relay arguments. Without first or last the newest
+// defaultPageSize items are returned, which suits chat-like histories.
+func newPageArgs(kind string, first *int32, after *string, last *int32, before *string) (pageArgs, error) {
+	if first != nil && last != nil {
+		return pageArgs{}, fmt.Errorf("first and last cannot be used together")
+	}
+
+	args := pageArgs{limit: defaultPageSize, backward: true}
+	if first != nil {
+		if *first < 0 {
+			return pageArgs{}, fmt.Errorf("first must be a non-negative integer")
+		}
+		args.limit = int(*first)
+		args.backward = false
+	}
+	if last != nil {
+		if *last < 0 {
+			return pageArgs{}, fmt.Errorf("last must be a non-negative integer")
+		}
+		args.limit = int(*last)
+	}
+	if args.limit > maxPageSize {
+		args.limit = maxPageSize
+	}
+
+	if after != nil {
+		id, err := decodeCursor(kind, *after)
+		if err != nil {
+			return pageArgs{}, err
+		}
+		args.after = &id
+		if last == nil {
+			args.backward = false
+		}
+	}
+	if before != nil {
+		id, err := decodeCursor(kind, *before)
+		if err != nil {
+			return pageArgs{}, err
+		}
+		args.before = &id
+	}
+	return args, nil
+}
+
+// selectsField reports whether the selection of the field being resolved
+// includes the nested field at path, such as "edges", "node", "reactions",
+// so that connections can preload what their nodes need
+func selectsField(ctx context.Context, path ...string) bool {
+	if !graphql.HasOperationContext(ctx) || graphql.GetFieldContext(ctx) == nil {
+		return false
+	}
+	return selects(graphql.GetOperationContext(ctx), graphql.CollectFieldsCtx(ctx, nil), path)
+}
+
+func selects(opCtx *graphql.OperationContext, fields []graphql.CollectedField, path []string) bool {
+	if len(path) == 0 {
+		return true
+	}
+	// 別名で同じフィールドを複数回選択している場合も探す
+	for _, f := range fields {
+		if f.Name == path[0] && selects(opCtx, graphql.CollectFields(opCtx, f.Selections, nil), path[1:]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	cursor := encodeCursor(messageCursorKind, 42)
+
+	id, err := decodeCursor(messageCursorKind, cursor)
+	require.NoError(t, err)
+	assert.Equal(t, 42, id)
+
+	_, err = decodeCursor("activity", cursor)
+	assert.Error(t, err)
+
+	_, err = decodeCursor(messageCursorKind, "not-base64!")
+	assert.Error(t, err)
+}
+
+func TestNewPageArgs(t *testing.T) {
+	int32Ptr := func(n int32) *int32 { return &n }
+
+	t.Run("defaults to the newest page", func(t *testing.T) {
+		args, err := newPageArgs(messageCursorKind, nil, nil, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, defaultPageSize, args.limit)
+		assert.True(t, args.backward)
+	})
+
+	t.Run("first paginates forward after the cursor", func(t *testing.T) {
+		after := encodeCursor(messageCursorKind, 10)
+		args, err := newPageArgs(messageCursorKind, int32Ptr(5), &after, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 5, args.limit)
+		assert.False(t, args.backward)
+		require.NotNil(t, args.after)
+		assert.Equal(t, 10, *args.after)
+	})
+
+	t.Run("limit is capped", func(t *testing.T) {
+		args, err := newPageArgs(messageCursorKind, nil, nil, int32Ptr(10000), nil)
+		require.NoError(t, err)
+		assert.Equal(t, maxPageSize, args.limit)
+	})
+
+	t.Run("first and last are exclusive", func(t *testing.T) {
+		_, err := newPageArgs(messageCursorKind, int32Ptr(1), nil, int32Ptr(1), nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("negative values are rejected", func(t *testing.T) {
+		_, err := newPageArgs(messageCursorKind, int32Ptr(-1), nil, nil, nil)
+		assert.Error(t, err)
+	})
+}
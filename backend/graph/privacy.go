@@ -0,0 +1,147 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/dataexport"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+	"github.com/matsuokashuhei/morrow-backend/graph/model"
+	"github.com/matsuokashuhei/morrow-backend/internal/account"
+	idataexport "github.com/matsuokashuhei/morrow-backend/internal/dataexport"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
+)
+
+// requireViewerID returns the user ID of the authenticated viewer
+func requireViewerID(ctx context.Context, action string) (int, error) {
+	userID, ok := viewer.UserID(ctx)
+	if !ok {
+		return 0, newError(ctx, ErrCodeUnauthenticated, "authentication is required to "+action)
+	}
+	return userID, nil
+}
+
+// accountDeletionGracePeriod returns how long a deletion request can be canceled
+func (r *Resolver) accountDeletionGracePeriod() time.Duration {
+	if r.AccountDeletionGracePeriod > 0 {
+		return r.AccountDeletionGracePeriod
+	}
+	return account.DefaultGracePeriod
+}
+
+// entDataExportToGraphQL converts an export and signs its download URL while it is ready
+func (r *Resolver) entDataExportToGraphQL(ctx context.Context, e *ent.DataExport) (*model.DataExport, error) {
+	result := &model.DataExport{
+		ID:        strconv.Itoa(e.ID),
+		Status:    model.DataExportStatus(e.Status),
+		Size:      int(e.Size),
+		CreatedAt: e.CreatedAt.Format(time.RFC3339),
+	}
+	if e.CompletedAt != nil {
+		completedAt := e.CompletedAt.Format(time.RFC3339)
+		result.CompletedAt = &completedAt
+	}
+	if e.ExpiresAt != nil {
+		expiresAt := e.ExpiresAt.Format(time.RFC3339)
+		result.ExpiresAt = &expiresAt
+	}
+	if e.Status == dataexport.StatusReady && e.Key != "" && e.ExpiresAt != nil {
+		// リンクはzipの削除より先に失効させる
+		ttl := min(signedURLTTL, time.Until(*e.ExpiresAt))
+		if ttl > 0 && r.Storage != nil {
+			url, err := r.Storage.SignedURL(ctx, e.Key, ttl)
+			if err != nil {
+				return nil, fmt.Errorf("failed to sign URL: %w", err)
+			}
+			result.DownloadURL = &url
+		}
+	}
+	return result, nil
+}
+
+// DataExports lists the viewer's data exports, newest first
+func (r *Resolver) DataExports(ctx context.Context) ([]*model.DataExport, error) {
+	userID, ok := viewer.UserID(ctx)
+	if !ok {
+		return []*model.DataExport{}, nil
+	}
+	exports, err := r.Client.DataExport.Query().
+		Where(dataexport.HasUserWith(user.IDEQ(userID))).
+		Order(ent.Desc(dataexport.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data exports: %w", err)
+	}
+	result := make([]*model.DataExport, 0, len(exports))
+	for _, e := range exports {
+		converted, err := r.entDataExportToGraphQL(ctx, e)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, converted)
+	}
+	return result, nil
+}
+
+// RequestDataExport queues a zip of the viewer's personal data for the background worker
+func (r *Resolver) RequestDataExport(ctx context.Context) (*model.DataExport, error) {
+	userID, err := requireViewerID(ctx, "export your data")
+	if err != nil {
+		return nil, err
+	}
+	if r.Storage == nil {
+		return nil, newError(ctx, ErrCodeBadRequest, "data exports are not enabled on this server")
+	}
+
+	// 作成中のエクスポートがあれば新しく作らずにそれを返す (止まったものは除く)
+	existing, err := r.Client.DataExport.Query().
+		Where(
+			dataexport.HasUserWith(user.IDEQ(userID)),
+			idataexport.InProgress(time.Now()),
+		).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get data export: %w", err)
+	}
+	if existing != nil {
+		return r.entDataExportToGraphQL(ctx, existing)
+	}
+
+	created, err := r.Client.DataExport.Create().SetUserID(userID).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request data export: %w", err)
+	}
+	return r.entDataExportToGraphQL(ctx, created)
+}
+
+// DeleteMyAccount schedules the viewer's account for deletion after the grace period
+func (r *Resolver) DeleteMyAccount(ctx context.Context) (*model.Viewer, error) {
+	userID, err := requireViewerID(ctx, "delete your account")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := account.ScheduleDeletion(ctx, r.Client, userID, r.accountDeletionGracePeriod()); err != nil {
+		return nil, err
+	}
+	return r.Viewer(ctx)
+}
+
+// CancelAccountDeletion keeps the viewer's account during the grace period
+func (r *Resolver) CancelAccountDeletion(ctx context.Context) (*model.Viewer, error) {
+	userID, err := requireViewerID(ctx, "cancel the deletion of your account")
+	if err != nil {
+		return nil, err
+	}
+	err = account.CancelDeletion(ctx, r.Client, userID)
+	if errors.Is(err, account.ErrNotScheduled) {
+		return nil, newError(ctx, ErrCodeBadRequest, "the account is not scheduled for deletion")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.Viewer(ctx)
+}
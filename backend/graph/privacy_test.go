@@ -0,0 +1,175 @@
+package graph
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/auditentry"
+	entdataexport "github.com/matsuokashuhei/morrow-backend/ent/dataexport"
+	"github.com/matsuokashuhei/morrow-backend/ent/message"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+	"github.com/matsuokashuhei/morrow-backend/graph/model"
+	"github.com/matsuokashuhei/morrow-backend/internal/account"
+	"github.com/matsuokashuhei/morrow-backend/internal/audit"
+	"github.com/matsuokashuhei/morrow-backend/internal/dataexport"
+	"github.com/matsuokashuhei/morrow-backend/internal/softdelete"
+	"github.com/matsuokashuhei/morrow-backend/internal/storage"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPersonalData(t *testing.T) {
+	client := setupTestDB(t)
+	defer teardownTestDB(t, client)
+	softdelete.Register(client)
+	audit.Register(client)
+
+	store, err := storage.NewLocal(t.TempDir(), "http://localhost:8080", []byte("secret"))
+	require.NoError(t, err)
+	resolver := &Resolver{Client: client, Storage: store}
+	background := context.Background()
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	alice, err := resolver.CreateUser(background, model.CreateUserInput{Email: "privacy-alice@example.com", Name: "Alice"})
+	require.NoError(t, err)
+	bob, err := resolver.CreateUser(background, model.CreateUserInput{Email: "privacy-bob@example.com", Name: "Bob"})
+	require.NoError(t, err)
+	aliceID, err := strconv.Atoi(alice.ID)
+	require.NoError(t, err)
+	aliceCtx := viewer.NewContext(background, &viewer.Viewer{Subject: "alice", UserID: aliceID})
+
+	e, err := resolver.CreateEvent(background, model.CreateEventInput{
+		Title:     "Book club",
+		StartTime: "2025-12-31T15:00:00Z",
+		EndTime:   "2025-12-31T16:00:00Z",
+		CreatorID: bob.ID,
+	})
+	require.NoError(t, err)
+	eventID, err := strconv.Atoi(e.ID)
+	require.NoError(t, err)
+	_, err = client.Message.Create().SetBody("Hello from Alice").SetEventID(eventID).SetAuthorID(aliceID).Save(background)
+	require.NoError(t, err)
+
+	t.Run("data exports are built in the background", func(t *testing.T) {
+		_, err := resolver.RequestDataExport(background)
+		assert.Error(t, err)
+
+		requested, err := resolver.RequestDataExport(aliceCtx)
+		require.NoError(t, err)
+		assert.Equal(t, model.DataExportStatusPending, requested.Status)
+		assert.Nil(t, requested.DownloadURL)
+
+		again, err := resolver.RequestDataExport(aliceCtx)
+		require.NoError(t, err)
+		assert.Equal(t, requested.ID, again.ID)
+
+		built, err := dataexport.NewWorker(client, store, time.Hour, time.Minute, logger).Process(background)
+		require.NoError(t, err)
+		assert.Equal(t, 1, built)
+
+		exports, err := resolver.DataExports(aliceCtx)
+		require.NoError(t, err)
+		require.Len(t, exports, 1)
+		assert.Equal(t, model.DataExportStatusReady, exports[0].Status)
+		assert.Positive(t, exports[0].Size)
+		assert.NotNil(t, exports[0].DownloadURL)
+	})
+
+	t.Run("abandoned exports are failed and requested again", func(t *testing.T) {
+		stuck, err := client.DataExport.Create().
+			SetUserID(aliceID).
+			SetStatus(entdataexport.StatusProcessing).
+			SetClaimedAt(time.Now().Add(-2 * dataexport.ProcessingTimeout)).
+			Save(background)
+		require.NoError(t, err)
+		// claimed_at を記録する前から作成中のまま止まっていたもの
+		unclaimed, err := client.DataExport.Create().
+			SetUserID(aliceID).
+			SetStatus(entdataexport.StatusProcessing).
+			Save(background)
+		require.NoError(t, err)
+
+		requested, err := resolver.RequestDataExport(aliceCtx)
+		require.NoError(t, err)
+		assert.NotEqual(t, strconv.Itoa(stuck.ID), requested.ID)
+		assert.NotEqual(t, strconv.Itoa(unclaimed.ID), requested.ID)
+
+		built, err := dataexport.NewWorker(client, store, time.Hour, time.Minute, logger).Process(background)
+		require.NoError(t, err)
+		assert.Equal(t, 1, built)
+
+		stuck, err = client.DataExport.Get(background, stuck.ID)
+		require.NoError(t, err)
+		assert.Equal(t, entdataexport.StatusFailed, stuck.Status)
+		assert.NotEmpty(t, stuck.Error)
+		unclaimed, err = client.DataExport.Get(background, unclaimed.ID)
+		require.NoError(t, err)
+		assert.Equal(t, entdataexport.StatusFailed, unclaimed.Status)
+	})
+
+	t.Run("account deletion can be canceled during the grace period", func(t *testing.T) {
+		v, err := resolver.DeleteMyAccount(aliceCtx)
+		require.NoError(t, err)
+		require.NotNil(t, v.AccountDeletionScheduledAt)
+
+		closed, err := account.NewCloser(client, store, nil, time.Minute, logger).Process(background)
+		require.NoError(t, err)
+		assert.Zero(t, closed)
+
+		v, err = resolver.CancelAccountDeletion(aliceCtx)
+		require.NoError(t, err)
+		assert.Nil(t, v.AccountDeletionScheduledAt)
+
+		// 別のサーバーが一覧を取得した後に取り消されても閉じない
+		err = account.NewCloser(client, store, nil, time.Minute, logger).Close(background, aliceID)
+		assert.ErrorIs(t, err, account.ErrNotDue)
+
+		_, err = resolver.CancelAccountDeletion(aliceCtx)
+		assert.Error(t, err)
+	})
+
+	t.Run("closed accounts are anonymized and keep their messages", func(t *testing.T) {
+		expired := &Resolver{Client: client, Storage: store, AccountDeletionGracePeriod: time.Nanosecond}
+		_, err := expired.DeleteMyAccount(aliceCtx)
+		require.NoError(t, err)
+
+		closed, err := account.NewCloser(client, store, nil, time.Minute, logger).Process(background)
+		require.NoError(t, err)
+		assert.Equal(t, 1, closed)
+
+		u, err := client.User.Query().Where(user.IDEQ(aliceID)).Only(softdelete.Skip(background))
+		require.NoError(t, err)
+		assert.Equal(t, account.DeletedName, u.Name)
+		assert.NotEqual(t, "privacy-alice@example.com", u.Email)
+		assert.Empty(t, u.CognitoID)
+		assert.NotNil(t, u.DeletedAt)
+
+		m, err := client.Message.Query().Where(message.BodyEQ("Hello from Alice")).WithAuthor().Only(background)
+		require.NoError(t, err)
+		assert.Nil(t, m.Edges.Author)
+
+		exports, err := client.DataExport.Query().Count(background)
+		require.NoError(t, err)
+		assert.Zero(t, exports)
+
+		entries, err := client.AuditEntry.Query().
+			Where(auditentry.EntityTypeEQ(ent.TypeUser), auditentry.EntityIDEQ(aliceID)).
+			All(background)
+		require.NoError(t, err)
+		require.NotEmpty(t, entries)
+		for _, e := range entries {
+			for _, c := range e.Changes {
+				assert.NotEqual(t, "privacy-alice@example.com", c.Before, c.Field)
+				assert.NotEqual(t, "privacy-alice@example.com", c.After, c.Field)
+				assert.NotEqual(t, "Alice", c.Before, c.Field)
+			}
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package graph
+
+import (
+	"context"
+	"math"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/matsuokashuhei/morrow-backend/internal/logging"
+	"github.com/matsuokashuhei/morrow-backend/internal/ratelimit"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// rateLimit is a gqlgen extension counting queries and mutations against the
+// graphql budget, and mutations against the mutation budget as well. Rejected
+// operations return a RATE_LIMITED error without running any resolver.
+// Subscriptions are not counted since they are long lived.
+type rateLimit struct {
+	limiter *ratelimit.Limiter
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+} = rateLimit{}
+
+// ExtensionName implements graphql.HandlerExtension
+func (rateLimit) ExtensionName() string {
+	return "RateLimit"
+}
+
+// Validate implements graphql.HandlerExtension
+func (rateLimit) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptResponse implements graphql.ResponseInterceptor
+func (r rateLimit) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+	op := graphql.GetOperationContext(ctx).Operation
+	if op == nil || op.Operation == ast.Subscription {
+		return next(ctx)
+	}
+
+	key := ratelimit.Key(ctx)
+	res := r.limiter.Allow(ctx, ratelimit.BudgetGraphQL, key)
+	// 全体の予算で拒否したときはミューテーションの予算を消費しない
+	if res.Allowed && op.Operation == ast.Mutation {
+		res = ratelimit.Tighter(res, r.limiter.Allow(ctx, ratelimit.BudgetMutation, key))
+	}
+	if h, ok := ratelimit.ResponseHeader(ctx); ok {
+		ratelimit.SetHeaders(h, res)
+	}
+	if res.Allowed {
+		return next(ctx)
+	}
+
+	logging.FromContext(ctx).WithField("operation", op.Name).Warn("GraphQL rate limit exceeded")
+	err := newError(ctx, ErrCodeRateLimited, "too many requests, please retry later")
+	err.Extensions["retryAfter"] = int(math.Ceil(res.RetryAfter.Seconds()))
+	graphql.AddError(ctx, err)
+	return &graphql.Response{Errors: graphql.GetErrors(ctx)}
+}
@@ -0,0 +1,64 @@
+package graph
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/matsuokashuhei/morrow-backend/internal/middleware"
+	"github.com/matsuokashuhei/morrow-backend/internal/ratelimit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	limiter := ratelimit.New(ratelimit.NewMemoryStore(), map[string]ratelimit.Limit{
+		ratelimit.BudgetGraphQL:  {Burst: 3, Period: time.Minute},
+		ratelimit.BudgetMutation: {Burst: 1, Period: time.Minute},
+	})
+	router := gin.New()
+	router.Use(middleware.RequestMeta())
+	// __typename だけの操作はリゾルバーもデータベースも使わない
+	router.POST("/graphql", GraphQLHandler(nil, HandlerOptions{RateLimiter: limiter}))
+
+	post := func(query string) (*httptest.ResponseRecorder, map[string]any) {
+		body, _ := json.Marshal(map[string]string{"query": query})
+		req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body)))
+		req.Header.Set("Content-Type", "application/json")
+		req.RemoteAddr = "192.0.2.1:1234"
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		var resp map[string]any
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		return w, resp
+	}
+
+	w, resp := post("mutation { __typename }")
+	assert.Nil(t, resp["errors"])
+	assert.Equal(t, "1", w.Header().Get(ratelimit.HeaderLimit))
+	assert.Equal(t, "0", w.Header().Get(ratelimit.HeaderRemaining))
+
+	w, resp = post("mutation { __typename }")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "60", w.Header().Get("Retry-After"))
+	require.Len(t, resp["errors"], 1)
+	ext := resp["errors"].([]any)[0].(map[string]any)["extensions"].(map[string]any)
+	assert.Equal(t, ErrCodeRateLimited, ext["code"])
+	assert.Equal(t, float64(60), ext["retryAfter"])
+	assert.NotEmpty(t, ext["requestId"])
+	assert.Nil(t, resp["data"])
+
+	// クエリはミューテーションの予算を使わない
+	w, resp = post("{ __typename }")
+	assert.Nil(t, resp["errors"])
+	assert.Equal(t, "3", w.Header().Get(ratelimit.HeaderLimit))
+	assert.Equal(t, "0", w.Header().Get(ratelimit.HeaderRemaining))
+
+	_, resp = post("{ __typename }")
+	require.Len(t, resp["errors"], 1)
+}
@@ -0,0 +1,290 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/message"
+	"github.com/matsuokashuhei/morrow-backend/ent/predicate"
+	"github.com/matsuokashuhei/morrow-backend/ent/reaction"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+	"github.com/matsuokashuhei/morrow-backend/graph/model"
+	"github.com/matsuokashuhei/morrow-backend/internal/emoji"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
+)
+
+// reactionTopic returns the pubsub topic carrying reaction changes of an event and its chat
+func reactionTopic(eventID int) string {
+	return fmt.Sprintf("event:%d:reactions", eventID)
+}
+
+// reactionTarget identifies the message or event a reaction is attached to
+type reactionTarget struct {
+	eventID   int
+	messageID *int
+}
+
+// predicate restricts a reaction query to the target
+func (t reactionTarget) predicate() predicate.Reaction {
+	if t.messageID != nil {
+		return reaction.HasMessageWith(message.IDEQ(*t.messageID))
+	}
+	return reaction.HasEventWith(event.IDEQ(t.eventID))
+}
+
+// resolveReactionTarget validates ReactionInput and loads the event the target belongs to
+func (r *Resolver) resolveReactionTarget(ctx context.Context, input model.ReactionInput) (reactionTarget, error) {
+	if (input.MessageID == nil) == (input.EventID == nil) {
+		return reactionTarget{}, newError(ctx, ErrCodeBadRequest, "exactly one of messageId and eventId must be set")
+	}
+
+	if input.EventID != nil {
+		eventID, err := strconv.Atoi(*input.EventID)
+		if err != nil {
+			return reactionTarget{}, fmt.Errorf("invalid event ID: %w", err)
+		}
+		return reactionTarget{eventID: eventID}, nil
+	}
+
+	m, err := r.getMessage(ctx, *input.MessageID)
+	if err != nil {
+		return reactionTarget{}, err
+	}
+	if m.DeletedAt != nil {
+		return reactionTarget{}, newError(ctx, ErrCodeBadRequest, "deleted messages cannot be reacted to")
+	}
+	return reactionTarget{eventID: m.Edges.Event.ID, messageID: &m.ID}, nil
+}
+
+// reactionRow is a row of the reaction aggregates
+type reactionRow struct {
+	MessageID int    `json:"message_reactions"`
+	Emoji     string `json:"emoji"`
+	Count     int    `json:"count"`
+	Reacted   bool   `json:"reacted"`
+}
+
+// viewerReacted aggregates whether the viewer is among the users who reacted
+func viewerReacted(viewerID int) ent.AggregateFunc {
+	return func(s *sql.Selector) string {
+		return sql.As(fmt.Sprintf("BOOL_OR(%s = %d)", s.C(reaction.UserColumn), viewerID), "reacted")
+	}
+}
+
+// summarizeReactions aggregates the reactions on the target per emoji in a single GROUP BY query
+func (r *Resolver) summarizeReactions(ctx context.Context, target reactionTarget) ([]*model.ReactionSummary, error) {
+	viewerID, _ := viewer.UserID(ctx)
+
+	var rows []reactionRow
+	err := r.Client.Reaction.Query().
+		Where(target.predicate()).
+		GroupBy(reaction.FieldEmoji).
+		Aggregate(ent.Count(), viewerReacted(viewerID)).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate reactions: %w", err)
+	}
+	return reactionSummaries(rows), nil
+}
+
+// summarizeMessageReactions aggregates the reactions of a page of messages in a
+// single GROUP BY query. Every message gets a summary, empty when nobody reacted.
+func (r *Resolver) summarizeMessageReactions(ctx context.Context, messageIDs []int) (map[int][]*model.ReactionSummary, error) {
+	viewerID, _ := viewer.UserID(ctx)
+
+	var rows []reactionRow
+	err := r.Client.Reaction.Query().
+		Where(reaction.HasMessageWith(message.IDIn(messageIDs...))).
+		GroupBy(reaction.MessageColumn, reaction.FieldEmoji).
+		Aggregate(ent.Count(), viewerReacted(viewerID)).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate reactions: %w", err)
+	}
+
+	byMessage := make(map[int][]reactionRow, len(messageIDs))
+	for _, row := range rows {
+		byMessage[row.MessageID] = append(byMessage[row.MessageID], row)
+	}
+	result := make(map[int][]*model.ReactionSummary, len(messageIDs))
+	for _, id := range messageIDs {
+		result[id] = reactionSummaries(byMessage[id])
+	}
+	return result, nil
+}
+
+// reactionSummaries orders the aggregates of a message or event
+func reactionSummaries(rows []reactionRow) []*model.ReactionSummary {
+	// 多い順、同数の場合は絵文字順で安定させる
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Count != rows[j].Count {
+			return rows[i].Count > rows[j].Count
+		}
+		return rows[i].Emoji < rows[j].Emoji
+	})
+
+	result := make([]*model.ReactionSummary, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, &model.ReactionSummary{
+			Emoji:            row.Emoji,
+			Count:            int32(row.Count),
+			ViewerHasReacted: row.Reacted,
+		})
+	}
+	return result
+}
+
+// reactionUpdate builds the ReactionUpdate payload for the target as seen by the viewer in ctx
+func (r *Resolver) reactionUpdate(ctx context.Context, target reactionTarget) (*model.ReactionUpdate, error) {
+	reactions, err := r.summarizeReactions(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	update := &model.ReactionUpdate{
+		EventID:   strconv.Itoa(target.eventID),
+		Reactions: reactions,
+	}
+	if target.messageID != nil {
+		messageID := strconv.Itoa(*target.messageID)
+		update.MessageID = &messageID
+	}
+	return update, nil
+}
+
+// AddReaction adds the viewer's emoji stamp to a message or event. Adding an existing reaction is a no-op.
+func (r *Resolver) AddReaction(ctx context.Context, input model.ReactionInput) (*model.ReactionUpdate, error) {
+	target, err := r.resolveReactionTarget(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	userID, _, err := r.requireChatMember(ctx, target.eventID)
+	if err != nil {
+		return nil, err
+	}
+	if err := emoji.Validate(input.Emoji); err != nil {
+		return nil, newError(ctx, ErrCodeBadRequest, err.Error())
+	}
+
+	exists, err := r.Client.Reaction.Query().
+		Where(target.predicate(), reaction.HasUserWith(user.IDEQ(userID)), reaction.EmojiEQ(input.Emoji)).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check reaction: %w", err)
+	}
+	if !exists {
+		create := r.Client.Reaction.
+			Create().
+			SetEmoji(input.Emoji).
+			SetUserID(userID)
+		if target.messageID != nil {
+			create = create.SetMessageID(*target.messageID)
+		} else {
+			create = create.SetEventID(target.eventID)
+		}
+		// 同時リクエストで一意制約に違反した場合は既に追加済みとして扱う
+		if _, err := create.Save(ctx); err != nil && !ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("failed to add reaction: %w", err)
+		}
+		r.PubSub.Publish(reactionTopic(target.eventID), target)
+	}
+
+	return r.reactionUpdate(ctx, target)
+}
+
+// RemoveReaction removes the viewer's emoji stamp from a message or event
+func (r *Resolver) RemoveReaction(ctx context.Context, input model.ReactionInput) (*model.ReactionUpdate, error) {
+	target, err := r.resolveReactionTarget(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	userID, _, err := r.requireChatMember(ctx, target.eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := r.Client.Reaction.Delete().
+		Where(target.predicate(), reaction.HasUserWith(user.IDEQ(userID)), reaction.EmojiEQ(input.Emoji)).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove reaction: %w", err)
+	}
+	if deleted > 0 {
+		r.PubSub.Publish(reactionTopic(target.eventID), target)
+	}
+
+	return r.reactionUpdate(ctx, target)
+}
+
+// Reactions resolves Event.reactions
+func (r *eventResolver) Reactions(ctx context.Context, obj *model.Event) ([]*model.ReactionSummary, error) {
+	eventID, err := strconv.Atoi(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event ID: %w", err)
+	}
+	if err := r.canReadChat(ctx, eventID); err != nil {
+		return nil, err
+	}
+	return r.summarizeReactions(ctx, reactionTarget{eventID: eventID})
+}
+
+// Reactions resolves Message.reactions, unless Event.messages already loaded them for the page
+func (r *messageResolver) Reactions(ctx context.Context, obj *model.Message) ([]*model.ReactionSummary, error) {
+	if obj.Reactions != nil {
+		return obj.Reactions, nil
+	}
+	messageID, err := strconv.Atoi(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid message ID: %w", err)
+	}
+	// Message.reactions is only reachable through a chat the viewer can already read
+	return r.summarizeReactions(ctx, reactionTarget{messageID: &messageID})
+}
+
+// ReactionUpdated streams reaction changes on the event and its messages.
+// Summaries are recomputed per subscriber so that viewerHasReacted reflects the subscriber.
+func (r *Resolver) ReactionUpdated(ctx context.Context, eventID string) (<-chan *model.ReactionUpdate, error) {
+	id, err := strconv.Atoi(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event ID: %w", err)
+	}
+	if err := r.canReadChat(ctx, id); err != nil {
+		return nil, err
+	}
+
+	events, unsubscribe := r.PubSub.Subscribe(reactionTopic(id))
+	out := make(chan *model.ReactionUpdate, 1)
+	go func() {
+		defer close(out)
+		defer unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-events:
+				if !ok {
+					return
+				}
+				target, ok := msg.(reactionTarget)
+				if !ok {
+					continue
+				}
+				update, err := r.reactionUpdate(ctx, target)
+				if err != nil {
+					continue
+				}
+				select {
+				case out <- update:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
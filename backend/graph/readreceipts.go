@@ -0,0 +1,236 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/message"
+	"github.com/matsuokashuhei/morrow-backend/ent/participant"
+	"github.com/matsuokashuhei/morrow-backend/ent/predicate"
+	"github.com/matsuokashuhei/morrow-backend/ent/readcursor"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+	"github.com/matsuokashuhei/morrow-backend/graph/model"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
+)
+
+// readByUsersLimit caps the number of readers returned by Message.readBy
+const readByUsersLimit = 20
+
+// unreadBy matches visible messages written by others that the user has not read yet.
+// The read cursor is looked up in a correlated subquery so that counts stay a single SQL query.
+func unreadBy(userID int) predicate.Message {
+	return message.And(
+		message.DeletedAtIsNil(),
+		message.Not(message.HasAuthorWith(user.IDEQ(userID))),
+		func(s *sql.Selector) {
+			t := sql.Table(readcursor.Table)
+			cursor := sql.Select(t.C(readcursor.FieldLastReadMessageID)).
+				From(t).
+				Where(sql.And(
+					sql.ColumnsEQ(t.C(readcursor.EventColumn), s.C(message.EventColumn)),
+					sql.EQ(t.C(readcursor.UserColumn), userID),
+				))
+			s.Where(sql.P(func(b *sql.Builder) {
+				b.Ident(s.C(message.FieldID)).WriteOp(sql.OpGT).WriteString("COALESCE((").Join(cursor).WriteString("), 0)")
+			}))
+		},
+	)
+}
+
+// chatMemberOf matches events the user created or accepted to join
+func chatMemberOf(userID int) predicate.Event {
+	return event.Or(
+		event.HasCreatorWith(user.IDEQ(userID)),
+		event.HasParticipantsWith(
+			participant.HasUserWith(user.IDEQ(userID)),
+			participant.StatusEQ(participant.StatusAccepted),
+		),
+	)
+}
+
+// Viewer returns the authenticated caller
+func (r *Resolver) Viewer(ctx context.Context) (*model.Viewer, error) {
+	userID, ok := viewer.UserID(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	u, err := r.Client.User.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get viewer: %w", err)
+	}
+	result := &model.Viewer{
+		User:            entUserToGraphQL(u),
+		HasCalendarFeed: u.CalendarTokenHash != nil,
+	}
+	if u.DeletionScheduledAt != nil {
+		scheduledAt := u.DeletionScheduledAt.Format(time.RFC3339)
+		result.AccountDeletionScheduledAt = &scheduledAt
+	}
+	return result, nil
+}
+
+// MarkChatRead moves the viewer's read cursor of the event chat forward
+func (r *Resolver) MarkChatRead(ctx context.Context, eventID string, upTo *string) (*model.Event, error) {
+	id, err := strconv.Atoi(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event ID: %w", err)
+	}
+	userID, _, err := r.requireChatMember(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	inEvent := message.HasEventWith(event.IDEQ(id))
+	var lastRead int
+	if upTo != nil {
+		lastRead, err = strconv.Atoi(*upTo)
+		if err != nil {
+			return nil, fmt.Errorf("invalid message ID: %w", err)
+		}
+		exists, err := r.Client.Message.Query().Where(message.IDEQ(lastRead), inEvent).Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get message: %w", err)
+		}
+		if !exists {
+			return nil, newError(ctx, ErrCodeBadRequest, "message does not belong to this event")
+		}
+	} else {
+		lastRead, err = r.Client.Message.Query().Where(inEvent).Order(ent.Desc(message.FieldID)).FirstID(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get latest message: %w", err)
+		}
+	}
+
+	if err := r.advanceReadCursor(ctx, userID, id, lastRead); err != nil {
+		return nil, err
+	}
+	return r.Event(ctx, eventID)
+}
+
+// advanceReadCursor stores the read position, never moving an existing cursor backwards
+func (r *Resolver) advanceReadCursor(ctx context.Context, userID, eventID, lastRead int) error {
+	ofViewer := readcursor.And(
+		readcursor.HasUserWith(user.IDEQ(userID)),
+		readcursor.HasEventWith(event.IDEQ(eventID)),
+	)
+
+	updated, err := r.Client.ReadCursor.Update().
+		Where(ofViewer, readcursor.LastReadMessageIDLT(lastRead)).
+		SetLastReadMessageID(lastRead).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update read cursor: %w", err)
+	}
+	if updated > 0 {
+		return nil
+	}
+
+	exists, err := r.Client.ReadCursor.Query().Where(ofViewer).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get read cursor: %w", err)
+	}
+	if exists {
+		// 既に先まで読んでいる場合は既読日時のみ更新する
+		if err := r.Client.ReadCursor.Update().Where(ofViewer).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update read cursor: %w", err)
+		}
+		return nil
+	}
+
+	err = r.Client.ReadCursor.Create().
+		SetUserID(userID).
+		SetEventID(eventID).
+		SetLastReadMessageID(lastRead).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		// 同時に作成された場合はもう一度前進を試みる
+		return r.Client.ReadCursor.Update().
+			Where(ofViewer, readcursor.LastReadMessageIDLT(lastRead)).
+			SetLastReadMessageID(lastRead).
+			Exec(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create read cursor: %w", err)
+	}
+	return nil
+}
+
+// UnreadCount resolves Event.unreadCount for the viewer
+func (r *eventResolver) UnreadCount(ctx context.Context, obj *model.Event) (int32, error) {
+	userID, ok := viewer.UserID(ctx)
+	if !ok {
+		return 0, nil
+	}
+	eventID, err := strconv.Atoi(obj.ID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid event ID: %w", err)
+	}
+
+	count, err := r.Client.Message.Query().
+		Where(message.HasEventWith(event.IDEQ(eventID)), unreadBy(userID)).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unread messages: %w", err)
+	}
+	return int32(count), nil
+}
+
+// ReadBy resolves Message.readBy from the read cursors of the message's event
+func (r *messageResolver) ReadBy(ctx context.Context, obj *model.Message) (*model.ReadReceiptSummary, error) {
+	messageID, err := strconv.Atoi(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid message ID: %w", err)
+	}
+
+	query := r.Client.ReadCursor.Query().
+		Where(
+			readcursor.HasEventWith(event.HasMessagesWith(message.IDEQ(messageID))),
+			readcursor.LastReadMessageIDGTE(messageID),
+			readcursor.Not(readcursor.HasUserWith(user.HasMessagesWith(message.IDEQ(messageID)))),
+		)
+
+	count, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count readers: %w", err)
+	}
+
+	cursors, err := query.
+		Order(ent.Desc(readcursor.FieldReadAt)).
+		Limit(readByUsersLimit).
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get readers: %w", err)
+	}
+
+	users := make([]*model.User, 0, len(cursors))
+	for _, c := range cursors {
+		users = append(users, entUserToGraphQL(c.Edges.User))
+	}
+	return &model.ReadReceiptSummary{
+		Count: int32(count),
+		Users: users,
+	}, nil
+}
+
+// UnreadCount resolves Viewer.unreadCount across every chat the viewer takes part in
+func (r *viewerResolver) UnreadCount(ctx context.Context, obj *model.Viewer) (int32, error) {
+	userID, err := strconv.Atoi(obj.User.ID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	count, err := r.Client.Message.Query().
+		Where(message.HasEventWith(chatMemberOf(userID)), unreadBy(userID)).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unread messages: %w", err)
+	}
+	return int32(count), nil
+}
@@ -0,0 +1,20 @@
+package graph
+
+import (
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/matsuokashuhei/morrow-backend/ent/message"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnreadByBuildsSingleQuery(t *testing.T) {
+	s := sql.Dialect(dialect.Postgres).Select("*").From(sql.Table(message.Table))
+	unreadBy(7)(s)
+
+	query, args := s.Query()
+	assert.Contains(t, query, `"messages"."id" > COALESCE((SELECT "read_cursors"."last_read_message_id" FROM "read_cursors" WHERE "read_cursors"."event_read_cursors" = "messages"."event_messages" AND "read_cursors"."user_read_cursors" = $2), 0)`)
+	assert.Contains(t, query, `"messages"."deleted_at" IS NULL`)
+	assert.Contains(t, args, 7)
+}
@@ -1,6 +1,14 @@
 package graph
 
-import "github.com/matsuokashuhei/morrow-backend/ent"
+import (
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/internal/billing"
+	"github.com/matsuokashuhei/morrow-backend/internal/invitation"
+	"github.com/matsuokashuhei/morrow-backend/internal/pubsub"
+	"github.com/matsuokashuhei/morrow-backend/internal/storage"
+)
 
 // This file will not be regenerated automatically.
 //
@@ -8,4 +16,20 @@ import "github.com/matsuokashuhei/morrow-backend/ent"
 
 type Resolver struct {
 	Client *ent.Client
+	PubSub *pubsub.Broker
+	// Storage holds uploaded files. Uploads are disabled when nil.
+	Storage storage.Storage
+	Limits  UploadLimits
+	// PublicURL is the base URL of the API used to build links such as calendar feeds
+	PublicURL string
+	// Invitations emails iTIP invitations to participants. It is disabled when nil.
+	Invitations *invitation.Sender
+	// Billing sells paid plans. It is disabled when nil.
+	Billing *billing.Service
+	// TrashRetention is how long deleted events and users can be restored (softdelete.DefaultRetention when zero)
+	TrashRetention time.Duration
+	// AccountDeletionGracePeriod is how long account deletion can be canceled (account.DefaultGracePeriod when zero)
+	AccountDeletionGracePeriod time.Duration
+	// AnonymousAccess lets requests without a token pass the event permission checks and read event chats, for local development
+	AnonymousAccess bool
 }
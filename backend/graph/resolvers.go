@@ -2,6 +2,7 @@ package graph
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
@@ -9,7 +10,11 @@ import (
 	"github.com/matsuokashuhei/morrow-backend/ent"
 	"github.com/matsuokashuhei/morrow-backend/ent/event"
 	"github.com/matsuokashuhei/morrow-backend/ent/participant"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
 	"github.com/matsuokashuhei/morrow-backend/graph/model"
+	"github.com/matsuokashuhei/morrow-backend/internal/invitation"
+	"github.com/matsuokashuhei/morrow-backend/internal/permission"
+	"github.com/matsuokashuhei/morrow-backend/internal/softdelete"
 )
 
 // Helper function to convert Ent User to GraphQL User
@@ -25,6 +30,22 @@ func entUserToGraphQL(u *ent.User) *model.User {
 	}
 }
 
+// optionalString maps the zero value of an optional ent field to null
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// optionalInput treats an empty string input like an omitted one
+func optionalInput(s *string) *string {
+	if s == nil || *s == "" {
+		return nil
+	}
+	return s
+}
+
 // Helper function to convert Ent Event to GraphQL Event
 func entEventToGraphQL(e *ent.Event) *model.Event {
 	var creator *model.User
@@ -38,17 +59,19 @@ func entEventToGraphQL(e *ent.Event) *model.Event {
 	}
 
 	return &model.Event{
-		ID:           strconv.Itoa(e.ID),
-		Title:        e.Title,
-		Description:  &e.Description,
-		StartTime:    e.StartTime.Format(time.RFC3339),
-		EndTime:      e.EndTime.Format(time.RFC3339),
-		Emoji:        &e.Emoji,
-		Visibility:   model.EventVisibility(e.Visibility),
-		CreatedAt:    e.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:    e.UpdatedAt.Format(time.RFC3339),
-		Creator:      creator,
-		Participants: participants,
+		ID:             strconv.Itoa(e.ID),
+		Title:          e.Title,
+		Description:    &e.Description,
+		StartTime:      e.StartTime.Format(time.RFC3339),
+		EndTime:        e.EndTime.Format(time.RFC3339),
+		TimeZone:       optionalString(e.TimeZone),
+		RecurrenceRule: optionalString(e.RecurrenceRule),
+		Emoji:          &e.Emoji,
+		Visibility:     model.EventVisibility(e.Visibility),
+		CreatedAt:      e.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      e.UpdatedAt.Format(time.RFC3339),
+		Creator:        creator,
+		Participants:   participants,
 	}
 }
 
@@ -60,17 +83,19 @@ func entEventToGraphQLSimple(e *ent.Event) *model.Event {
 	}
 
 	return &model.Event{
-		ID:           strconv.Itoa(e.ID),
-		Title:        e.Title,
-		Description:  &e.Description,
-		StartTime:    e.StartTime.Format(time.RFC3339),
-		EndTime:      e.EndTime.Format(time.RFC3339),
-		Emoji:        &e.Emoji,
-		Visibility:   model.EventVisibility(e.Visibility),
-		CreatedAt:    e.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:    e.UpdatedAt.Format(time.RFC3339),
-		Creator:      creator,
-		Participants: nil, // Avoid circular reference
+		ID:             strconv.Itoa(e.ID),
+		Title:          e.Title,
+		Description:    &e.Description,
+		StartTime:      e.StartTime.Format(time.RFC3339),
+		EndTime:        e.EndTime.Format(time.RFC3339),
+		TimeZone:       optionalString(e.TimeZone),
+		RecurrenceRule: optionalString(e.RecurrenceRule),
+		Emoji:          &e.Emoji,
+		Visibility:     model.EventVisibility(e.Visibility),
+		CreatedAt:      e.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      e.UpdatedAt.Format(time.RFC3339),
+		Creator:        creator,
+		Participants:   nil, // Avoid circular reference
 	}
 }
 
@@ -90,18 +115,23 @@ func entParticipantToGraphQL(p *ent.Participant) *model.Participant {
 	}
 
 	return &model.Participant{
-		ID:        strconv.Itoa(p.ID),
-		Role:      model.ParticipantRole(p.Role),
-		Status:    model.ParticipantStatus(p.Status),
-		JoinedAt:  p.JoinedAt.Format(time.RFC3339),
-		UpdatedAt: p.UpdatedAt.Format(time.RFC3339),
-		User:      user,
-		Event:     event,
+		ID:          strconv.Itoa(p.ID),
+		Role:        model.ParticipantRole(p.Role),
+		Status:      model.ParticipantStatus(p.Status),
+		Permissions: permissionsToGraphQL(p.Role),
+		JoinedAt:    p.JoinedAt.Format(time.RFC3339),
+		UpdatedAt:   p.UpdatedAt.Format(time.RFC3339),
+		User:        user,
+		Event:       event,
 	}
 }
 
 // Mutation resolvers
 func (r *Resolver) CreateUser(ctx context.Context, input model.CreateUserInput) (*model.User, error) {
+	if err := rejectStorageRef(ctx, input.AvatarURL); err != nil {
+		return nil, err
+	}
+
 	u, err := r.Client.User.
 		Create().
 		SetEmail(input.Email).
@@ -109,6 +139,9 @@ func (r *Resolver) CreateUser(ctx context.Context, input model.CreateUserInput)
 		SetNillableAvatarURL(input.AvatarURL).
 		SetNillableCognitoID(input.CognitoID).
 		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, newError(ctx, ErrCodeBadRequest, "the email address or Cognito ID is already in use")
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
@@ -120,6 +153,9 @@ func (r *Resolver) UpdateUser(ctx context.Context, id string, input model.Update
 	if err != nil {
 		return nil, fmt.Errorf("invalid user ID: %w", err)
 	}
+	if err := rejectStorageRef(ctx, input.AvatarURL); err != nil {
+		return nil, err
+	}
 
 	update := r.Client.User.UpdateOneID(userID)
 	if input.Name != nil {
@@ -133,21 +169,56 @@ func (r *Resolver) UpdateUser(ctx context.Context, id string, input model.Update
 	}
 
 	u, err := update.Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, newError(ctx, ErrCodeBadRequest, "the Cognito ID is already in use")
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to update user: %w", err)
 	}
 	return entUserToGraphQL(u), nil
 }
 
-func (r *Resolver) DeleteUser(ctx context.Context, id string) (bool, error) {
+func (r *Resolver) DeleteUser(ctx context.Context, id string, transferEventsTo *string) (bool, error) {
 	userID, err := strconv.Atoi(id)
 	if err != nil {
 		return false, fmt.Errorf("invalid user ID: %w", err)
 	}
+	transferTo := 0
+	if transferEventsTo != nil {
+		transferTo, err = strconv.Atoi(*transferEventsTo)
+		if err != nil {
+			return false, fmt.Errorf("invalid user ID: %w", err)
+		}
+	}
+	if err := r.authorizeUserDeletion(ctx, userID, transferTo); err != nil {
+		return false, err
+	}
 
-	err = r.Client.User.DeleteOneID(userID).Exec(ctx)
-	if err != nil {
-		return false, fmt.Errorf("failed to delete user: %w", err)
+	// ゴミ箱に移すイベントの参加者は取得できなくなるので、取り消しの通知は先に用意しておく
+	var cancellations []*invitation.Outbox
+	if transferTo == 0 {
+		eventIDs, err := r.Client.Event.Query().
+			Where(event.HasCreatorWith(user.IDEQ(userID))).
+			IDs(ctx)
+		if err != nil {
+			return false, fmt.Errorf("failed to get events: %w", err)
+		}
+		for _, eventID := range eventIDs {
+			cancellations = append(cancellations, r.Invitations.CancelEvent(ctx, eventID))
+		}
+	}
+
+	_, err = softdelete.DeleteUser(ctx, r.Client, userID, transferTo)
+	switch {
+	case errors.Is(err, softdelete.ErrUserNotFound):
+		return false, newError(ctx, ErrCodeBadRequest, "user not found")
+	case errors.Is(err, softdelete.ErrInvalidTransfer):
+		return false, newError(ctx, ErrCodeBadRequest, err.Error())
+	case err != nil:
+		return false, err
+	}
+	for _, cancellation := range cancellations {
+		cancellation.Send(ctx)
 	}
 	return true, nil
 }
@@ -174,6 +245,8 @@ func (r *Resolver) CreateEvent(ctx context.Context, input model.CreateEventInput
 		SetNillableDescription(input.Description).
 		SetStartTime(startTime).
 		SetEndTime(endTime).
+		SetNillableTimeZone(optionalInput(input.TimeZone)).
+		SetNillableRecurrenceRule(optionalInput(input.RecurrenceRule)).
 		SetNillableEmoji(input.Emoji).
 		SetCreatorID(creatorID)
 
@@ -197,6 +270,12 @@ func (r *Resolver) UpdateEvent(ctx context.Context, id string, input model.Updat
 	}
 
 	update := r.Client.Event.UpdateOneID(eventID)
+	// 招待状の内容が変わる更新ではSEQUENCEを進め、カレンダーアプリに新しい版として扱わせる
+	rescheduled := input.Title != nil || input.Description != nil || input.StartTime != nil || input.EndTime != nil ||
+		input.TimeZone != nil || input.RecurrenceRule != nil
+	if rescheduled {
+		update = update.AddSequence(1)
+	}
 	if input.Title != nil {
 		update = update.SetTitle(*input.Title)
 	}
@@ -217,6 +296,20 @@ func (r *Resolver) UpdateEvent(ctx context.Context, id string, input model.Updat
 		}
 		update = update.SetEndTime(endTime)
 	}
+	if input.TimeZone != nil {
+		if *input.TimeZone == "" {
+			update = update.ClearTimeZone()
+		} else {
+			update = update.SetTimeZone(*input.TimeZone)
+		}
+	}
+	if input.RecurrenceRule != nil {
+		if *input.RecurrenceRule == "" {
+			update = update.ClearRecurrenceRule()
+		} else {
+			update = update.SetRecurrenceRule(*input.RecurrenceRule)
+		}
+	}
 	if input.Emoji != nil {
 		update = update.SetNillableEmoji(input.Emoji)
 	}
@@ -228,6 +321,9 @@ func (r *Resolver) UpdateEvent(ctx context.Context, id string, input model.Updat
 	if err != nil {
 		return nil, fmt.Errorf("failed to update event: %w", err)
 	}
+	if rescheduled {
+		r.Invitations.Update(ctx, e.ID).Send(ctx)
+	}
 	return entEventToGraphQL(e), nil
 }
 
@@ -237,10 +333,13 @@ func (r *Resolver) DeleteEvent(ctx context.Context, id string) (bool, error) {
 		return false, fmt.Errorf("invalid event ID: %w", err)
 	}
 
+	// 削除すると参加者を取得できなくなるので、取り消しの通知は先に用意しておく
+	cancellation := r.Invitations.CancelEvent(ctx, eventID)
 	err = r.Client.Event.DeleteOneID(eventID).Exec(ctx)
 	if err != nil {
 		return false, fmt.Errorf("failed to delete event: %w", err)
 	}
+	cancellation.Send(ctx)
 	return true, nil
 }
 
@@ -255,6 +354,18 @@ func (r *Resolver) CreateParticipant(ctx context.Context, input model.CreatePart
 		return nil, fmt.Errorf("invalid event ID: %w", err)
 	}
 
+	if err := r.authorizeEvent(ctx, eventID, permission.Invite); err != nil {
+		return nil, err
+	}
+	if input.Role != nil && *input.Role != model.ParticipantRoleViewer {
+		if err := assignableRole(ctx, *input.Role); err != nil {
+			return nil, err
+		}
+		if err := r.authorizeEvent(ctx, eventID, permission.ManageRoles); err != nil {
+			return nil, err
+		}
+	}
+
 	create := r.Client.Participant.
 		Create().
 		SetUserID(userID).
@@ -276,6 +387,7 @@ func (r *Resolver) CreateParticipant(ctx context.Context, input model.CreatePart
 	if err != nil {
 		return nil, fmt.Errorf("failed to create participant: %w", err)
 	}
+	r.Invitations.Invite(ctx, eventID, userID).Send(ctx)
 	return entParticipantToGraphQL(p), nil
 }
 
@@ -285,6 +397,29 @@ func (r *Resolver) UpdateParticipant(ctx context.Context, id string, input model
 		return nil, fmt.Errorf("invalid participant ID: %w", err)
 	}
 
+	target, err := r.Client.Participant.Query().
+		Where(participant.IDEQ(participantID)).
+		WithUser().
+		WithEvent().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get participant: %w", err)
+	}
+	if input.Role != nil {
+		if err := assignableRole(ctx, *input.Role); err != nil {
+			return nil, err
+		}
+		if err := r.authorizeEvent(ctx, target.Edges.Event.ID, permission.ManageRoles); err != nil {
+			return nil, err
+		}
+	}
+	// 参加者本人は自分の参加状態を変更できる
+	if input.Status != nil && !isViewer(ctx, target.Edges.User.ID) {
+		if err := r.authorizeEvent(ctx, target.Edges.Event.ID, permission.Invite); err != nil {
+			return nil, err
+		}
+	}
+
 	update := r.Client.Participant.UpdateOneID(participantID)
 	if input.Role != nil {
 		update = update.SetRole(participant.Role(*input.Role))
@@ -306,10 +441,27 @@ func (r *Resolver) DeleteParticipant(ctx context.Context, id string) (bool, erro
 		return false, fmt.Errorf("invalid participant ID: %w", err)
 	}
 
+	target, err := r.Client.Participant.Query().
+		Where(participant.IDEQ(participantID)).
+		WithUser().
+		WithEvent().
+		Only(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get participant: %w", err)
+	}
+	// 参加者本人はいつでもイベントから抜けられる
+	if !isViewer(ctx, target.Edges.User.ID) {
+		if err := r.authorizeEvent(ctx, target.Edges.Event.ID, permission.RemoveParticipants); err != nil {
+			return false, err
+		}
+	}
+
+	cancellation := r.Invitations.CancelParticipant(ctx, target.Edges.Event.ID, target.Edges.User.ID)
 	err = r.Client.Participant.DeleteOneID(participantID).Exec(ctx)
 	if err != nil {
 		return false, fmt.Errorf("failed to delete participant: %w", err)
 	}
+	cancellation.Send(ctx)
 	return true, nil
 }
 
@@ -407,5 +559,25 @@ func (r *Resolver) Participants(ctx context.Context) ([]*model.Participant, erro
 }
 
 // Resolver interface implementations
-func (r *Resolver) Mutation() MutationResolver { return r }
-func (r *Resolver) Query() QueryResolver       { return r }
+func (r *Resolver) Mutation() MutationResolver         { return r }
+func (r *Resolver) Query() QueryResolver               { return r }
+func (r *Resolver) Subscription() SubscriptionResolver { return r }
+
+// resolverRoot exposes the object field resolvers. They are kept off Resolver
+// because their names clash with query resolvers such as Resolver.Event.
+type resolverRoot struct {
+	*Resolver
+}
+
+// NewResolverRoot returns the ResolverRoot passed to NewExecutableSchema
+func NewResolverRoot(r *Resolver) ResolverRoot { return resolverRoot{r} }
+
+func (r resolverRoot) Event() EventResolver     { return &eventResolver{r.Resolver} }
+func (r resolverRoot) Message() MessageResolver { return &messageResolver{r.Resolver} }
+func (r resolverRoot) User() UserResolver       { return &userResolver{r.Resolver} }
+func (r resolverRoot) Viewer() ViewerResolver   { return &viewerResolver{r.Resolver} }
+
+type eventResolver struct{ *Resolver }
+type messageResolver struct{ *Resolver }
+type userResolver struct{ *Resolver }
+type viewerResolver struct{ *Resolver }
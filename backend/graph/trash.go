@@ -0,0 +1,184 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+	"github.com/matsuokashuhei/morrow-backend/graph/model"
+	"github.com/matsuokashuhei/morrow-backend/internal/entitlement"
+	"github.com/matsuokashuhei/morrow-backend/internal/softdelete"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
+)
+
+// trashRetention returns how long deleted rows can be restored
+func (r *Resolver) trashRetention() time.Duration {
+	if r.TrashRetention > 0 {
+		return r.TrashRetention
+	}
+	return softdelete.DefaultRetention
+}
+
+// Trash lists the deleted events and users that can still be restored
+func (r *Resolver) Trash(ctx context.Context) (*model.Trash, error) {
+	trash := &model.Trash{
+		Events: []*model.TrashedEvent{},
+		Users:  []*model.TrashedUser{},
+	}
+	if _, ok := viewer.FromContext(ctx); !ok {
+		return nil, newError(ctx, ErrCodeUnauthenticated, "authentication is required to view the trash")
+	}
+	admin, err := r.isAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, known := viewer.UserID(ctx)
+	if !admin && !known {
+		return trash, nil
+	}
+
+	retention := r.trashRetention()
+	cutoff := time.Now().Add(-retention)
+	all := softdelete.Skip(ctx)
+
+	query := r.Client.Event.Query().
+		Where(event.DeletedAtGT(cutoff)).
+		WithCreator().
+		Order(ent.Desc(event.FieldDeletedAt), ent.Desc(event.FieldID))
+	if !admin {
+		query = query.Where(event.HasCreatorWith(user.IDEQ(userID)))
+	}
+	events, err := query.All(all)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted events: %w", err)
+	}
+	for _, e := range events {
+		trash.Events = append(trash.Events, &model.TrashedEvent{
+			Event:     entEventToGraphQL(e),
+			DeletedAt: e.DeletedAt.Format(time.RFC3339),
+			PurgeAt:   softdelete.PurgeAt(*e.DeletedAt, retention).Format(time.RFC3339),
+		})
+	}
+
+	if !admin {
+		return trash, nil
+	}
+	users, err := r.Client.User.Query().
+		Where(user.DeletedAtGT(cutoff)).
+		Order(ent.Desc(user.FieldDeletedAt), ent.Desc(user.FieldID)).
+		All(all)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted users: %w", err)
+	}
+	for _, u := range users {
+		trash.Users = append(trash.Users, &model.TrashedUser{
+			User:      entUserToGraphQL(u),
+			DeletedAt: u.DeletedAt.Format(time.RFC3339),
+			PurgeAt:   softdelete.PurgeAt(*u.DeletedAt, retention).Format(time.RFC3339),
+		})
+	}
+	return trash, nil
+}
+
+// RestoreEvent moves an event back from the trash
+func (r *Resolver) RestoreEvent(ctx context.Context, id string) (*model.Event, error) {
+	eventID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event ID: %w", err)
+	}
+	if _, ok := viewer.UserID(ctx); !ok {
+		return nil, newError(ctx, ErrCodeUnauthenticated, "authentication is required to restore events")
+	}
+	all := softdelete.Skip(ctx)
+
+	e, err := r.Client.Event.Query().
+		Where(event.IDEQ(eventID), event.DeletedAtNotNil()).
+		WithCreator().
+		Only(all)
+	if ent.IsNotFound(err) {
+		return nil, newError(ctx, ErrCodeBadRequest, "event is not in the trash")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted event: %w", err)
+	}
+	// 削除されたイベントには役割を確認できないため、作成者か管理者だけが復元できる
+	if e.Edges.Creator == nil || !isViewer(ctx, e.Edges.Creator.ID) {
+		admin, err := r.isAdmin(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !admin {
+			return nil, newError(ctx, ErrCodeForbidden, "only the creator can restore the event")
+		}
+	}
+	if !softdelete.Restorable(e.DeletedAt, r.trashRetention(), time.Now()) {
+		return nil, newError(ctx, ErrCodeBadRequest, "the event can no longer be restored")
+	}
+	// 作成者がゴミ箱にいる間はイベントだけを戻さず、ユーザーの復元で一緒に戻す
+	if e.Edges.Creator != nil && e.Edges.Creator.DeletedAt != nil {
+		return nil, newError(ctx, ErrCodeBadRequest, "restore the creator of the event first")
+	}
+	// 終了していないイベントは作成と同じく作成者のプランの上限に数える
+	if e.Edges.Creator != nil && e.EndTime.After(time.Now()) {
+		if err := entitlement.CheckActiveEvents(ctx, r.Client, e.Edges.Creator.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	// 取り消しの通知を受け取ったカレンダーアプリに新しい版として送り直す
+	restored, err := r.Client.Event.UpdateOneID(eventID).
+		ClearDeletedAt().
+		AddSequence(1).
+		Save(all)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore event: %w", err)
+	}
+	r.Invitations.Update(ctx, eventID).Send(ctx)
+	restored.Edges.Creator = e.Edges.Creator
+	return entEventToGraphQL(restored), nil
+}
+
+// RestoreUser moves a user back from the trash. The events trashed with the
+// user come back without a quota check, since they were within the plan before.
+func (r *Resolver) RestoreUser(ctx context.Context, id string) (*model.User, error) {
+	userID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+	if err := r.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	u, err := r.Client.User.Query().
+		Where(user.IDEQ(userID), user.DeletedAtNotNil()).
+		Only(softdelete.Skip(ctx))
+	if ent.IsNotFound(err) {
+		return nil, newError(ctx, ErrCodeBadRequest, "user is not in the trash")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted user: %w", err)
+	}
+	if !softdelete.Restorable(u.DeletedAt, r.trashRetention(), time.Now()) {
+		return nil, newError(ctx, ErrCodeBadRequest, "the user can no longer be restored")
+	}
+
+	restored, eventIDs, err := softdelete.RestoreUser(ctx, r.Client, userID)
+	if errors.Is(err, softdelete.ErrUserNotFound) {
+		return nil, newError(ctx, ErrCodeBadRequest, "user is not in the trash")
+	}
+	if errors.Is(err, softdelete.ErrIdentityInUse) {
+		return nil, newError(ctx, ErrCodeBadRequest, "another user now uses the email address or Cognito ID of this user")
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, eventID := range eventIDs {
+		r.Invitations.Update(ctx, eventID).Send(ctx)
+	}
+	return entUserToGraphQL(restored), nil
+}
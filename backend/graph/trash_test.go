@@ -0,0 +1,320 @@
+package graph
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent/billingevent"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/message"
+	"github.com/matsuokashuhei/morrow-backend/ent/participant"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+	"github.com/matsuokashuhei/morrow-backend/graph/model"
+	"github.com/matsuokashuhei/morrow-backend/internal/softdelete"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrash(t *testing.T) {
+	client := setupTestDB(t)
+	defer teardownTestDB(t, client)
+	softdelete.Register(client)
+
+	resolver := &Resolver{Client: client}
+	background := context.Background()
+	owner, err := resolver.CreateUser(background, model.CreateUserInput{Email: "trash-owner@example.com", Name: "Owner"})
+	require.NoError(t, err)
+	other, err := resolver.CreateUser(background, model.CreateUserInput{Email: "trash-other@example.com", Name: "Other"})
+	require.NoError(t, err)
+	ownerID, err := strconv.Atoi(owner.ID)
+	require.NoError(t, err)
+	otherID, err := strconv.Atoi(other.ID)
+	require.NoError(t, err)
+	ownerCtx := viewer.NewContext(background, &viewer.Viewer{Subject: "owner", UserID: ownerID})
+	otherCtx := viewer.NewContext(background, &viewer.Viewer{Subject: "other", UserID: otherID})
+
+	e, err := resolver.CreateEvent(ownerCtx, model.CreateEventInput{
+		Title:     "Countdown",
+		StartTime: "2025-12-31T15:00:00Z",
+		EndTime:   "2025-12-31T16:00:00Z",
+		CreatorID: owner.ID,
+	})
+	require.NoError(t, err)
+	_, err = resolver.DeleteEvent(ownerCtx, e.ID)
+	require.NoError(t, err)
+
+	t.Run("deleted events are hidden", func(t *testing.T) {
+		found, err := resolver.Event(ownerCtx, e.ID)
+		assert.Error(t, err)
+		assert.Nil(t, found)
+
+		_, err = resolver.DeleteEvent(ownerCtx, e.ID)
+		assert.Error(t, err)
+	})
+
+	t.Run("trash lists the viewer's deleted events", func(t *testing.T) {
+		trash, err := resolver.Trash(ownerCtx)
+		require.NoError(t, err)
+		require.Len(t, trash.Events, 1)
+		assert.Equal(t, e.ID, trash.Events[0].Event.ID)
+		assert.Empty(t, trash.Users)
+
+		trash, err = resolver.Trash(otherCtx)
+		require.NoError(t, err)
+		assert.Empty(t, trash.Events)
+	})
+
+	t.Run("only the creator restores the event", func(t *testing.T) {
+		_, err := resolver.RestoreEvent(otherCtx, e.ID)
+		assert.Error(t, err)
+
+		restored, err := resolver.RestoreEvent(ownerCtx, e.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Countdown", restored.Title)
+
+		found, err := resolver.Event(ownerCtx, e.ID)
+		require.NoError(t, err)
+		assert.NotNil(t, found)
+	})
+
+	t.Run("expired events cannot be restored and are purged", func(t *testing.T) {
+		_, err := resolver.DeleteEvent(ownerCtx, e.ID)
+		require.NoError(t, err)
+
+		expired := &Resolver{Client: client, TrashRetention: time.Nanosecond}
+		_, err = expired.RestoreEvent(ownerCtx, e.ID)
+		assert.Error(t, err)
+
+		logger := logrus.New()
+		logger.SetOutput(io.Discard)
+		purged, err := softdelete.NewPurger(client, 0, time.Hour, logger).Purge(background)
+		require.NoError(t, err)
+		assert.Equal(t, 1, purged)
+
+		exists, err := client.Event.Query().Exist(softdelete.Skip(background))
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("administrators restore users", func(t *testing.T) {
+		_, err := resolver.DeleteUser(otherCtx, other.ID, nil)
+		require.NoError(t, err)
+
+		_, err = resolver.RestoreUser(ownerCtx, other.ID)
+		assert.Error(t, err)
+
+		require.NoError(t, client.User.UpdateOneID(ownerID).SetIsAdmin(true).Exec(background))
+		trash, err := resolver.Trash(ownerCtx)
+		require.NoError(t, err)
+		require.Len(t, trash.Users, 1)
+
+		restored, err := resolver.RestoreUser(ownerCtx, other.ID)
+		require.NoError(t, err)
+		assert.Equal(t, other.ID, restored.ID)
+	})
+
+	t.Run("trashed users free their email address", func(t *testing.T) {
+		_, err := resolver.DeleteUser(ownerCtx, other.ID, nil)
+		require.NoError(t, err)
+
+		_, err = resolver.CreateUser(background, model.CreateUserInput{Email: "trash-other@example.com", Name: "Other again"})
+		require.NoError(t, err)
+		_, err = resolver.CreateUser(background, model.CreateUserInput{Email: "trash-other@example.com", Name: "Duplicate"})
+		assertErrorCode(t, err, ErrCodeBadRequest)
+
+		_, err = resolver.RestoreUser(ownerCtx, other.ID)
+		assertErrorCode(t, err, ErrCodeBadRequest)
+	})
+
+	t.Run("purging a user keeps their billing events", func(t *testing.T) {
+		require.NoError(t, client.BillingEvent.Create().
+			SetProvider("stripe").
+			SetProviderEventID("evt_trash_other").
+			SetType(billingevent.TypeSubscriptionCreated).
+			SetPayload("{}").
+			SetOccurredAt(time.Now()).
+			SetUserID(otherID).
+			Exec(background))
+
+		logger := logrus.New()
+		logger.SetOutput(io.Discard)
+		_, err := softdelete.NewPurger(client, 0, time.Hour, logger).Purge(background)
+		require.NoError(t, err)
+
+		exists, err := client.User.Query().Where(user.IDEQ(otherID)).Exist(softdelete.Skip(background))
+		require.NoError(t, err)
+		assert.False(t, exists)
+		ledger, err := client.BillingEvent.Query().
+			Where(billingevent.ProviderEventIDEQ("evt_trash_other")).
+			Only(background)
+		require.NoError(t, err)
+		detached, err := ledger.QueryUser().Exist(softdelete.Skip(background))
+		require.NoError(t, err)
+		assert.False(t, detached)
+	})
+}
+
+func TestTrashRequiresViewer(t *testing.T) {
+	// 匿名リクエストは MVP モードでもデータベースを見る前に拒否される
+	resolver := &Resolver{AnonymousAccess: true}
+	ctx := context.Background()
+
+	_, err := resolver.Trash(ctx)
+	assertErrorCode(t, err, ErrCodeUnauthenticated)
+	_, err = resolver.RestoreEvent(ctx, "1")
+	assertErrorCode(t, err, ErrCodeUnauthenticated)
+	_, err = resolver.RestoreUser(ctx, "1")
+	assertErrorCode(t, err, ErrCodeUnauthenticated)
+	_, err = resolver.DeleteUser(ctx, "1", nil)
+	assertErrorCode(t, err, ErrCodeUnauthenticated)
+}
+
+func TestDeleteUserCascades(t *testing.T) {
+	client := setupTestDB(t)
+	defer teardownTestDB(t, client)
+	softdelete.Register(client)
+
+	// 参加者の追加は匿名リクエストで行うので MVP モードを有効にする
+	resolver := &Resolver{Client: client, AnonymousAccess: true}
+	ctx := context.Background()
+	newUser := func(name string) *model.User {
+		u, err := resolver.CreateUser(ctx, model.CreateUserInput{Email: name + "@example.com", Name: name})
+		require.NoError(t, err)
+		return u
+	}
+	newEvent := func(title string, creator *model.User) int {
+		e, err := resolver.CreateEvent(ctx, model.CreateEventInput{
+			Title:     title,
+			StartTime: "2025-12-31T15:00:00Z",
+			EndTime:   "2025-12-31T16:00:00Z",
+			CreatorID: creator.ID,
+		})
+		require.NoError(t, err)
+		id, err := strconv.Atoi(e.ID)
+		require.NoError(t, err)
+		return id
+	}
+	join := func(eventID int, u *model.User) {
+		_, err := resolver.CreateParticipant(ctx, model.CreateParticipantInput{UserID: u.ID, EventID: strconv.Itoa(eventID)})
+		require.NoError(t, err)
+	}
+	participants := func(eventID int) int {
+		n, err := client.Participant.Query().Where(participant.HasEventWith(event.IDEQ(eventID))).Count(softdelete.Skip(ctx))
+		require.NoError(t, err)
+		return n
+	}
+	creatorOf := func(eventID int) int {
+		u, err := client.Event.Query().Where(event.IDEQ(eventID)).QueryCreator().Only(softdelete.Skip(ctx))
+		require.NoError(t, err)
+		return u.ID
+	}
+
+	alice := newUser("cascade-alice")
+	bob := newUser("cascade-bob")
+	carol := newUser("cascade-carol")
+	bobID, err := strconv.Atoi(bob.ID)
+	require.NoError(t, err)
+	admin := newUser("cascade-admin")
+	adminID, err := strconv.Atoi(admin.ID)
+	require.NoError(t, err)
+	require.NoError(t, client.User.UpdateOneID(adminID).SetIsAdmin(true).Exec(ctx))
+	adminCtx := viewer.NewContext(ctx, &viewer.Viewer{Subject: "admin", UserID: adminID})
+
+	t.Run("events are transferred to the new owner", func(t *testing.T) {
+		owned := newEvent("Handover", alice)
+		shared := newEvent("Shared", bob)
+		join(owned, carol)
+		join(shared, alice)
+
+		_, err := resolver.DeleteUser(adminCtx, alice.ID, &alice.ID)
+		assert.Error(t, err)
+
+		_, err = resolver.DeleteUser(adminCtx, alice.ID, &bob.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bobID, creatorOf(owned))
+		assert.Equal(t, 1, participants(owned))
+		// 削除したユーザーの参加情報だけが消える
+		assert.Equal(t, 0, participants(shared))
+
+		_, err = resolver.Event(ctx, strconv.Itoa(owned))
+		assert.NoError(t, err)
+	})
+
+	t.Run("events are trashed and restored with their creator", func(t *testing.T) {
+		trashed := newEvent("Farewell", carol)
+		join(trashed, bob)
+		_, err := client.Message.Create().SetBody("See you").SetEventID(trashed).SetAuthorID(bobID).Save(ctx)
+		require.NoError(t, err)
+
+		_, err = resolver.DeleteUser(adminCtx, carol.ID, nil)
+		require.NoError(t, err)
+
+		// ゴミ箱にあるイベントの参加者とメッセージは表示されない
+		_, err = resolver.Event(ctx, strconv.Itoa(trashed))
+		assert.Error(t, err)
+		visible, err := client.Participant.Query().Where(participant.HasEventWith(event.IDEQ(trashed))).Count(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, visible)
+		messages, err := client.Message.Query().Where(message.HasEventWith(event.IDEQ(trashed))).Count(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, messages)
+
+		_, err = resolver.RestoreEvent(adminCtx, strconv.Itoa(trashed))
+		assert.Error(t, err)
+
+		_, err = resolver.RestoreUser(adminCtx, carol.ID)
+		require.NoError(t, err)
+		_, err = resolver.Event(ctx, strconv.Itoa(trashed))
+		assert.NoError(t, err)
+		assert.Equal(t, 1, participants(trashed))
+	})
+
+	t.Run("purging an event removes its participants and messages", func(t *testing.T) {
+		_, err := resolver.DeleteUser(adminCtx, carol.ID, nil)
+		require.NoError(t, err)
+
+		logger := logrus.New()
+		logger.SetOutput(io.Discard)
+		_, err = softdelete.NewPurger(client, 0, time.Hour, logger).Purge(ctx)
+		require.NoError(t, err)
+
+		all := softdelete.Skip(ctx)
+		exists, err := client.User.Query().Where(user.EmailEQ("cascade-carol@example.com")).Exist(all)
+		require.NoError(t, err)
+		assert.False(t, exists)
+		exists, err = client.Message.Query().Where(message.BodyEQ("See you")).Exist(all)
+		require.NoError(t, err)
+		assert.False(t, exists)
+		exists, err = client.Participant.Query().Where(participant.HasUserWith(user.IDEQ(bobID))).Exist(all)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("users hand over events only to accepted participants", func(t *testing.T) {
+		dave := newUser("cascade-dave")
+		daveID, err := strconv.Atoi(dave.ID)
+		require.NoError(t, err)
+		daveCtx := viewer.NewContext(ctx, &viewer.Viewer{Subject: "dave", UserID: daveID})
+
+		_, err = resolver.DeleteUser(daveCtx, bob.ID, nil)
+		assertErrorCode(t, err, ErrCodeForbidden)
+
+		handover := newEvent("Consent", dave)
+		_, err = resolver.DeleteUser(daveCtx, dave.ID, &bob.ID)
+		assertErrorCode(t, err, ErrCodeForbidden)
+
+		require.NoError(t, client.Participant.Create().
+			SetEventID(handover).
+			SetUserID(bobID).
+			SetStatus(participant.StatusAccepted).
+			Exec(ctx))
+		_, err = resolver.DeleteUser(daveCtx, dave.ID, &bob.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bobID, creatorOf(handover))
+	})
+}
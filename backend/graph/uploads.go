@@ -0,0 +1,277 @@
+package graph
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/attachment"
+	"github.com/matsuokashuhei/morrow-backend/ent/message"
+	"github.com/matsuokashuhei/morrow-backend/graph/model"
+	"github.com/matsuokashuhei/morrow-backend/internal/media"
+	"github.com/matsuokashuhei/morrow-backend/internal/storage"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
+)
+
+const (
+	// avatarSize is the longest side of stored avatars in pixels
+	avatarSize = 256
+	// thumbnailSize is the longest side of attachment thumbnails in pixels
+	thumbnailSize = 480
+	// signedURLTTL is how long download URLs returned by the API stay valid
+	signedURLTTL = time.Hour
+)
+
+// UploadLimits caps the size of uploaded files in bytes
+type UploadLimits struct {
+	MaxAvatarSize     int64
+	MaxAttachmentSize int64
+}
+
+// requireStorage fails when no storage backend is configured
+func (r *Resolver) requireStorage(ctx context.Context) error {
+	if r.Storage == nil {
+		return newError(ctx, ErrCodeBadRequest, "file uploads are not enabled on this server")
+	}
+	return nil
+}
+
+// readUpload reads and validates an uploaded image
+func readUpload(ctx context.Context, file graphql.Upload, limit int64) (*media.Image, error) {
+	if file.Size > limit {
+		return nil, newError(ctx, ErrCodeBadRequest, fmt.Sprintf("file must be at most %d bytes", limit))
+	}
+	img, err := media.ReadImage(file.File, limit)
+	switch {
+	case errors.Is(err, media.ErrTooLarge):
+		return nil, newError(ctx, ErrCodeBadRequest, fmt.Sprintf("file must be at most %d bytes", limit))
+	case errors.Is(err, media.ErrUnsupportedType):
+		return nil, newError(ctx, ErrCodeBadRequest, err.Error())
+	case err != nil:
+		return nil, err
+	}
+	return img, nil
+}
+
+// newObjectKey builds a random, unguessable object key under prefix
+func newObjectKey(prefix, ext string) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate object key: %w", err)
+	}
+	return prefix + "/" + hex.EncodeToString(b) + ext, nil
+}
+
+// putImage stores img under a new key below prefix and returns the key
+func (r *Resolver) putImage(ctx context.Context, prefix string, img *media.Image) (string, error) {
+	key, err := newObjectKey(prefix, img.Extension())
+	if err != nil {
+		return "", err
+	}
+	if err := r.Storage.Put(ctx, key, bytes.NewReader(img.Data), int64(len(img.Data)), img.ContentType); err != nil {
+		return "", fmt.Errorf("failed to store file: %w", err)
+	}
+	return key, nil
+}
+
+// signedURL returns a download URL for an object key
+func (r *Resolver) signedURL(ctx context.Context, key string) (string, error) {
+	if r.Storage == nil {
+		return "", fmt.Errorf("storage is not configured")
+	}
+	url, err := r.Storage.SignedURL(ctx, key, signedURLTTL)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign URL: %w", err)
+	}
+	return url, nil
+}
+
+// rejectStorageRef prevents clients from pointing URLs at arbitrary stored objects
+func rejectStorageRef(ctx context.Context, value *string) error {
+	if value != nil && strings.HasPrefix(*value, storage.RefPrefix) {
+		return newError(ctx, ErrCodeBadRequest, "avatarUrl must be an external URL; use uploadAvatar to upload an image")
+	}
+	return nil
+}
+
+// entAttachmentToGraphQL converts an attachment and signs its download URLs
+func (r *Resolver) entAttachmentToGraphQL(ctx context.Context, a *ent.Attachment) (*model.Attachment, error) {
+	url, err := r.signedURL(ctx, a.Key)
+	if err != nil {
+		return nil, err
+	}
+	result := &model.Attachment{
+		ID:          strconv.Itoa(a.ID),
+		ContentType: a.ContentType,
+		Size:        int32(a.Size),
+		Width:       int32(a.Width),
+		Height:      int32(a.Height),
+		URL:         url,
+		CreatedAt:   a.CreatedAt.Format(time.RFC3339),
+	}
+	if a.ThumbnailKey != "" {
+		thumbnailURL, err := r.signedURL(ctx, a.ThumbnailKey)
+		if err != nil {
+			return nil, err
+		}
+		result.ThumbnailURL = &thumbnailURL
+	}
+	return result, nil
+}
+
+// UploadAvatar replaces the viewer's avatar with a resized copy of the uploaded image
+func (r *Resolver) UploadAvatar(ctx context.Context, file graphql.Upload) (*model.User, error) {
+	userID, ok := viewer.UserID(ctx)
+	if !ok {
+		return nil, newError(ctx, ErrCodeUnauthenticated, "authentication is required to upload an avatar")
+	}
+	if err := r.requireStorage(ctx); err != nil {
+		return nil, err
+	}
+
+	img, err := readUpload(ctx, file, r.Limits.MaxAvatarSize)
+	if err != nil {
+		return nil, err
+	}
+	img, err = media.Resize(img, avatarSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resize avatar: %w", err)
+	}
+
+	u, err := r.Client.User.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	key, err := r.putImage(ctx, fmt.Sprintf("avatars/%d", userID), img)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := u.Update().SetAvatarURL(storage.Ref(key)).Save(ctx)
+	if err != nil {
+		_ = r.Storage.Delete(ctx, key)
+		return nil, fmt.Errorf("failed to update avatar: %w", err)
+	}
+
+	// 以前にアップロードした画像は不要になるので削除する
+	if oldKey, ok := storage.KeyFromRef(u.AvatarURL); ok {
+		_ = r.Storage.Delete(ctx, oldKey)
+	}
+	return entUserToGraphQL(updated), nil
+}
+
+// AddMessageAttachment attaches an uploaded image and its thumbnail to a message. Only the author can attach files.
+func (r *Resolver) AddMessageAttachment(ctx context.Context, messageID string, file graphql.Upload) (*model.Attachment, error) {
+	m, err := r.getMessage(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+	userID, _, err := r.requireChatMember(ctx, m.Edges.Event.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthor(m, userID) {
+		return nil, newError(ctx, ErrCodeForbidden, "only the author can attach files to this message")
+	}
+	if m.DeletedAt != nil {
+		return nil, newError(ctx, ErrCodeBadRequest, "files cannot be attached to deleted messages")
+	}
+	if err := r.requireStorage(ctx); err != nil {
+		return nil, err
+	}
+
+	img, err := readUpload(ctx, file, r.Limits.MaxAttachmentSize)
+	if err != nil {
+		return nil, err
+	}
+	thumbnail, err := media.Resize(img, thumbnailSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create thumbnail: %w", err)
+	}
+
+	prefix := fmt.Sprintf("attachments/%d/%d", m.Edges.Event.ID, m.ID)
+	key, err := r.putImage(ctx, prefix, img)
+	if err != nil {
+		return nil, err
+	}
+	thumbnailKey, err := r.putImage(ctx, prefix, thumbnail)
+	if err != nil {
+		_ = r.Storage.Delete(ctx, key)
+		return nil, err
+	}
+
+	a, err := r.Client.Attachment.
+		Create().
+		SetKey(key).
+		SetThumbnailKey(thumbnailKey).
+		SetContentType(img.ContentType).
+		SetSize(int64(len(img.Data))).
+		SetWidth(img.Width).
+		SetHeight(img.Height).
+		SetMessageID(m.ID).
+		SetUploaderID(userID).
+		Save(ctx)
+	if err != nil {
+		// DBへの保存に失敗した場合はオブジェクトを残さない
+		_ = r.Storage.Delete(ctx, key)
+		_ = r.Storage.Delete(ctx, thumbnailKey)
+		return nil, fmt.Errorf("failed to save attachment: %w", err)
+	}
+	return r.entAttachmentToGraphQL(ctx, a)
+}
+
+// Attachments resolves Message.attachments. Attachments of deleted messages are hidden with the body.
+func (r *messageResolver) Attachments(ctx context.Context, obj *model.Message) ([]*model.Attachment, error) {
+	if obj.DeletedAt != nil {
+		return []*model.Attachment{}, nil
+	}
+	messageID, err := strconv.Atoi(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid message ID: %w", err)
+	}
+
+	attachments, err := r.Client.Attachment.Query().
+		Where(attachment.HasMessageWith(message.IDEQ(messageID))).
+		Order(ent.Asc(attachment.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachments: %w", err)
+	}
+
+	result := make([]*model.Attachment, 0, len(attachments))
+	for _, a := range attachments {
+		converted, err := r.entAttachmentToGraphQL(ctx, a)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, converted)
+	}
+	return result, nil
+}
+
+// AvatarURL resolves User.avatarUrl, signing avatars kept in storage
+func (r *userResolver) AvatarURL(ctx context.Context, obj *model.User) (*string, error) {
+	if obj.AvatarURL == nil {
+		return nil, nil
+	}
+	key, ok := storage.KeyFromRef(*obj.AvatarURL)
+	if !ok {
+		return obj.AvatarURL, nil
+	}
+	if r.Storage == nil {
+		return nil, nil
+	}
+	url, err := r.signedURL(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return &url, nil
+}
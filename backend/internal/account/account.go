@@ -0,0 +1,260 @@
+// Package account closes the accounts of users who asked to delete them.
+// Closing waits for a grace period during which the request can be canceled.
+// Closed accounts lose their profile and sign-in identity, their uploaded files
+// are removed and their chat messages stay in the events without an author.
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/attachment"
+	"github.com/matsuokashuhei/morrow-backend/ent/dataexport"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/message"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+	"github.com/matsuokashuhei/morrow-backend/internal/audit"
+	"github.com/matsuokashuhei/morrow-backend/internal/invitation"
+	"github.com/matsuokashuhei/morrow-backend/internal/metrics"
+	"github.com/matsuokashuhei/morrow-backend/internal/softdelete"
+	"github.com/matsuokashuhei/morrow-backend/internal/storage"
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultGracePeriod is how long a deletion request can be canceled when no grace period is configured
+const DefaultGracePeriod = 14 * 24 * time.Hour
+
+// DeletedName replaces the name of closed accounts
+const DeletedName = "Deleted user"
+
+var (
+	// ErrNotScheduled is returned when canceling the deletion of an account that is not scheduled for deletion
+	ErrNotScheduled = errors.New("account deletion is not scheduled")
+	// ErrNotDue is returned when closing an account whose grace period has not passed,
+	// which includes accounts that were canceled or closed by another server in the meantime
+	ErrNotDue = errors.New("account is not due for closure")
+)
+
+// ScheduleDeletion schedules the account of a user for deletion after the grace period and returns when it is deleted.
+// Requesting again keeps the original schedule.
+func ScheduleDeletion(ctx context.Context, client *ent.Client, userID int, grace time.Duration) (time.Time, error) {
+	u, err := client.User.Get(ctx, userID)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get user: %w", err)
+	}
+	if u.DeletionScheduledAt != nil {
+		return *u.DeletionScheduledAt, nil
+	}
+	at := time.Now().Add(grace)
+	if err := client.User.UpdateOneID(userID).SetDeletionScheduledAt(at).Exec(ctx); err != nil {
+		return time.Time{}, fmt.Errorf("failed to schedule account deletion: %w", err)
+	}
+	return at, nil
+}
+
+// CancelDeletion cancels a scheduled account deletion
+func CancelDeletion(ctx context.Context, client *ent.Client, userID int) error {
+	n, err := client.User.Update().
+		Where(user.IDEQ(userID), user.DeletionScheduledAtNotNil()).
+		ClearDeletionScheduledAt().
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to cancel account deletion: %w", err)
+	}
+	if n == 0 {
+		return ErrNotScheduled
+	}
+	return nil
+}
+
+// Closer closes the accounts whose grace period has passed
+type Closer struct {
+	client      *ent.Client
+	storage     storage.Storage
+	invitations *invitation.Sender
+	interval    time.Duration
+	logger      *logrus.Logger
+}
+
+// NewCloser returns a closer checking for accounts to close every interval.
+// st and invitations may be nil, in which case no files are removed and no cancellations are sent.
+func NewCloser(client *ent.Client, st storage.Storage, invitations *invitation.Sender, interval time.Duration, logger *logrus.Logger) *Closer {
+	return &Closer{
+		client:      client,
+		storage:     st,
+		invitations: invitations,
+		interval:    interval,
+		logger:      logger,
+	}
+}
+
+// Run closes due accounts immediately and then every interval until ctx is canceled
+func (c *Closer) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		start := time.Now()
+		n, err := c.Process(ctx)
+		metrics.ObserveJob("account_closure", start, n, err)
+		if err != nil {
+			c.logger.WithError(err).Error("Failed to close accounts")
+		} else if n > 0 {
+			c.logger.WithField("count", n).Info("Closed accounts")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Process closes the accounts whose grace period has passed and returns how many were closed.
+// Accounts that fail to close are logged and retried on the next run.
+func (c *Closer) Process(ctx context.Context) (int, error) {
+	userIDs, err := c.client.User.Query().
+		Where(user.DeletionScheduledAtLTE(time.Now())).
+		IDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get accounts to close: %w", err)
+	}
+	metrics.SetJobPending("account_closure", len(userIDs))
+	closed := 0
+	for _, id := range userIDs {
+		err := c.Close(ctx, id)
+		if errors.Is(err, ErrNotDue) {
+			continue
+		}
+		if err != nil {
+			c.logger.WithError(err).WithField("user_id", id).Warn("Failed to close account")
+			continue
+		}
+		closed++
+	}
+	return closed, nil
+}
+
+// Close closes an account in one transaction. The profile and sign-in identity
+// are anonymized and redacted from the audit log, chat messages lose their
+// author, uploaded files and data exports are removed and the user is moved to
+// the trash together with their events like softdelete.DeleteUser.
+// It returns ErrNotDue unless the account is still scheduled and due for closure.
+func (c *Closer) Close(ctx context.Context, userID int) error {
+	all := softdelete.Skip(ctx)
+	u, err := c.client.User.Get(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	keys, err := c.objectKeys(all, u)
+	if err != nil {
+		return err
+	}
+	// ゴミ箱に移すと参加者を取得できなくなるので、取り消しの通知は先に用意しておく
+	eventIDs, err := c.client.Event.Query().Where(event.HasCreatorWith(user.IDEQ(userID))).IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get events: %w", err)
+	}
+	cancellations := make([]*invitation.Outbox, 0, len(eventIDs))
+	for _, id := range eventIDs {
+		cancellations = append(cancellations, c.invitations.CancelEvent(ctx, id))
+	}
+
+	tx, err := c.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// 削除予定のままのアカウントだけを匿名にすることで、複数のサーバーで同時に閉じたり
+	// 取り消された退会を進めたりしない (取り消しの通知も送らない)
+	n, err := tx.User.Update().
+		Where(user.IDEQ(userID), user.DeletionScheduledAtLTE(time.Now())).
+		SetName(DeletedName).
+		SetEmail(fmt.Sprintf("deleted-%d@users.invalid", userID)).
+		ClearAvatarURL().
+		ClearCognitoID().
+		ClearCalendarTokenHash().
+		ClearDeletionScheduledAt().
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to anonymize user: %w", err)
+	}
+	if n == 0 {
+		return ErrNotDue
+	}
+	// チャットの履歴はゴミ箱にあるイベントのものも含めて匿名にする
+	if err := tx.Message.Update().
+		Where(message.HasAuthorWith(user.IDEQ(userID))).
+		ClearAuthor().
+		Exec(all); err != nil {
+		return fmt.Errorf("failed to anonymize messages: %w", err)
+	}
+	if _, err := tx.Attachment.Delete().
+		Where(attachment.HasUploaderWith(user.IDEQ(userID))).
+		Exec(all); err != nil {
+		return fmt.Errorf("failed to delete attachments: %w", err)
+	}
+	if _, err := tx.DataExport.Delete().
+		Where(dataexport.HasUserWith(user.IDEQ(userID))).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete data exports: %w", err)
+	}
+	if _, err := softdelete.DeleteUserTx(ctx, tx, userID, 0); err != nil {
+		return err
+	}
+	// 匿名化の記録も含め、監査ログに残った以前の個人情報を消す
+	if err := audit.RedactUser(ctx, tx.Client(), userID); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit account closure: %w", err)
+	}
+
+	for _, cancellation := range cancellations {
+		cancellation.Send(ctx)
+	}
+	// ファイルはトランザクションの外にあるため、コミット後に削除する
+	for _, key := range keys {
+		if err := c.storage.Delete(ctx, key); err != nil {
+			c.logger.WithError(err).WithField("key", key).Warn("Failed to delete file of closed account")
+		}
+	}
+	return nil
+}
+
+// objectKeys returns the stored files of a user: the avatar, attachments with their thumbnails and data exports
+func (c *Closer) objectKeys(ctx context.Context, u *ent.User) ([]string, error) {
+	if c.storage == nil {
+		return nil, nil
+	}
+	var keys []string
+	if key, ok := storage.KeyFromRef(u.AvatarURL); ok {
+		keys = append(keys, key)
+	}
+	attachments, err := c.client.Attachment.Query().
+		Where(attachment.HasUploaderWith(user.IDEQ(u.ID))).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachments: %w", err)
+	}
+	for _, a := range attachments {
+		keys = append(keys, a.Key)
+		if a.ThumbnailKey != "" {
+			keys = append(keys, a.ThumbnailKey)
+		}
+	}
+	exports, err := c.client.DataExport.Query().
+		Where(dataexport.HasUserWith(user.IDEQ(u.ID)), dataexport.KeyNEQ("")).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data exports: %w", err)
+	}
+	for _, e := range exports {
+		keys = append(keys, e.Key)
+	}
+	return keys, nil
+}
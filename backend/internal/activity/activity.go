@@ -0,0 +1,442 @@
+// Package activity turns the audit entries of an event and its participants
+// into a human readable timeline, and replays them to restore earlier
+// versions of the event's details.
+package activity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/auditentry"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/participant"
+	"github.com/matsuokashuhei/morrow-backend/ent/predicate"
+	"github.com/matsuokashuhei/morrow-backend/ent/schema"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+)
+
+// Kind classifies an activity
+type Kind string
+
+const (
+	KindCreated                Kind = "created"
+	KindRescheduled            Kind = "rescheduled"
+	KindRenamed                Kind = "renamed"
+	KindVisibilityChanged      Kind = "visibility_changed"
+	KindDetailsChanged         Kind = "details_changed"
+	KindParticipantInvited     Kind = "participant_invited"
+	KindParticipantJoined      Kind = "participant_joined"
+	KindParticipantDeclined    Kind = "participant_declined"
+	KindParticipantRoleChanged Kind = "participant_role_changed"
+	KindParticipantRemoved     Kind = "participant_removed"
+)
+
+// DetailFields are the event fields restored by Version
+var DetailFields = []string{
+	event.FieldTitle,
+	event.FieldDescription,
+	event.FieldStartTime,
+	event.FieldEndTime,
+	event.FieldTimeZone,
+	event.FieldRecurrenceRule,
+	event.FieldEmoji,
+	event.FieldVisibility,
+}
+
+// ErrUnknownVersion is returned by Version for entries that are not a version of the event
+var ErrUnknownVersion = errors.New("activity is not a version of the event")
+
+// unknownParticipant names participants whose user has been deleted
+const unknownParticipant = "a participant"
+
+// bookkeeping lists fields that change with every update and mean nothing to participants
+var bookkeeping = map[string]bool{
+	event.FieldUpdatedAt: true,
+	event.FieldCreatedAt: true,
+	event.FieldSequence:  true,
+	event.FieldIcalUID:   true,
+	"creator":            true,
+	"event":              true,
+	"user":               true,
+}
+
+// Activity is one entry of an event timeline
+type Activity struct {
+	Entry   *ent.AuditEntry
+	Kind    Kind
+	Message string
+	// Actor is the user who made the change, nil when unknown or deleted
+	Actor *ent.User
+	// User is the participant the activity is about
+	User *ent.User
+	// Changes are the changed fields, without bookkeeping fields
+	Changes []schema.FieldChange
+}
+
+// shownEventFields are the event fields whose changes renderEvent describes
+var shownEventFields = []string{
+	event.FieldStartTime,
+	event.FieldEndTime,
+	event.FieldTitle,
+	event.FieldVisibility,
+	event.FieldDescription,
+	event.FieldTimeZone,
+	event.FieldRecurrenceRule,
+	event.FieldEmoji,
+}
+
+// Timeline queries the audit entries shown in the timeline of the event, so
+// that it can be counted and paginated in the database
+func Timeline(client *ent.Client, eventID int) *ent.AuditEntryQuery {
+	return client.AuditEntry.Query().
+		Where(
+			auditentry.EventIDEQ(eventID),
+			auditentry.EntityTypeIn(ent.TypeEvent, ent.TypeParticipant),
+			Shown(),
+		)
+}
+
+// Shown matches the audit entries that Render describes
+func Shown() predicate.AuditEntry {
+	eventChanges := make([]predicate.AuditEntry, len(shownEventFields))
+	for i, f := range shownEventFields {
+		eventChanges[i] = changesContain(schema.FieldChange{Field: f})
+	}
+	return auditentry.Or(
+		auditentry.And(
+			auditentry.EntityTypeEQ(ent.TypeEvent),
+			auditentry.OperationEQ(auditentry.OperationCreate),
+		),
+		auditentry.And(
+			auditentry.EntityTypeEQ(ent.TypeEvent),
+			auditentry.OperationEQ(auditentry.OperationUpdate),
+			auditentry.Or(eventChanges...),
+		),
+		auditentry.And(
+			auditentry.EntityTypeEQ(ent.TypeParticipant),
+			auditentry.OperationIn(auditentry.OperationCreate, auditentry.OperationDelete),
+		),
+		auditentry.And(
+			auditentry.EntityTypeEQ(ent.TypeParticipant),
+			auditentry.OperationEQ(auditentry.OperationUpdate),
+			auditentry.Or(
+				changesContain(schema.FieldChange{Field: participant.FieldRole}),
+				changesContain(schema.FieldChange{Field: participant.FieldStatus, After: participant.StatusAccepted.String()}),
+				changesContain(schema.FieldChange{Field: participant.FieldStatus, After: participant.StatusDeclined.String()}),
+			),
+		),
+	)
+}
+
+// changesContain matches entries with a change like c, comparing only the set keys
+func changesContain(c schema.FieldChange) predicate.AuditEntry {
+	return predicate.AuditEntry(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(auditentry.FieldChanges), []schema.FieldChange{c}))
+	})
+}
+
+// Describe renders a page of timeline entries. Times are written in loc.
+func Describe(ctx context.Context, client *ent.Client, entries []*ent.AuditEntry, loc *time.Location) ([]*Activity, error) {
+	// 参加者の記録は、作成・削除時に保存したユーザーIDか現在の参加者から対象ユーザーを求める
+	var participantIDs []int
+	for _, e := range entries {
+		if e.EntityType == ent.TypeParticipant {
+			participantIDs = append(participantIDs, e.EntityID)
+		}
+	}
+	participantUsers := make(map[int]int)
+	if len(participantIDs) > 0 {
+		// ページ外の作成・削除の記録も参照する
+		memberships, err := client.AuditEntry.Query().
+			Where(
+				auditentry.EntityTypeEQ(ent.TypeParticipant),
+				auditentry.EntityIDIn(participantIDs...),
+				auditentry.OperationIn(auditentry.OperationCreate, auditentry.OperationDelete),
+			).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get participant history: %w", err)
+		}
+		for _, e := range memberships {
+			for _, c := range e.Changes {
+				if c.Field != "user" {
+					continue
+				}
+				if id, ok := intValue(c.After); ok {
+					participantUsers[e.EntityID] = id
+				} else if id, ok := intValue(c.Before); ok {
+					participantUsers[e.EntityID] = id
+				}
+			}
+		}
+	}
+	var missing []int
+	for _, id := range participantIDs {
+		if _, ok := participantUsers[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		participants, err := client.Participant.Query().
+			Where(participant.IDIn(missing...)).
+			WithUser().
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get participants: %w", err)
+		}
+		for _, p := range participants {
+			if p.Edges.User != nil {
+				participantUsers[p.ID] = p.Edges.User.ID
+			}
+		}
+	}
+
+	userIDs := make([]int, 0, len(participantUsers))
+	for _, id := range participantUsers {
+		userIDs = append(userIDs, id)
+	}
+	for _, e := range entries {
+		if e.ActorID != nil {
+			userIDs = append(userIDs, *e.ActorID)
+		}
+	}
+	users := make(map[int]*ent.User)
+	if len(userIDs) > 0 {
+		found, err := client.User.Query().Where(user.IDIn(userIDs...)).All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get users: %w", err)
+		}
+		for _, u := range found {
+			users[u.ID] = u
+		}
+	}
+
+	result := make([]*Activity, 0, len(entries))
+	for _, e := range entries {
+		var subject *ent.User
+		if id, ok := participantUsers[e.EntityID]; ok && e.EntityType == ent.TypeParticipant {
+			subject = users[id]
+		}
+		var actor *ent.User
+		if e.ActorID != nil {
+			actor = users[*e.ActorID]
+		}
+		if a := Render(e, actor, subject, loc); a != nil {
+			result = append(result, a)
+		}
+	}
+	return result, nil
+}
+
+// Render describes one audit entry, or returns nil when it has nothing to show
+func Render(e *ent.AuditEntry, actor, subject *ent.User, loc *time.Location) *Activity {
+	a := &Activity{Entry: e, Actor: actor, User: subject}
+	for _, c := range e.Changes {
+		if !bookkeeping[c.Field] {
+			a.Changes = append(a.Changes, c)
+		}
+	}
+
+	actorName := nameOf(actor, "Someone")
+	switch e.EntityType {
+	case ent.TypeEvent:
+		return renderEvent(a, actorName, loc)
+	case ent.TypeParticipant:
+		// 本人による操作かどうかで文面を変える
+		self := actor != nil && subject != nil && actor.ID == subject.ID
+		return renderParticipant(a, actorName, nameOf(subject, unknownParticipant), self)
+	}
+	return nil
+}
+
+func renderEvent(a *Activity, actorName string, loc *time.Location) *Activity {
+	switch a.Entry.Operation {
+	case auditentry.OperationCreate:
+		a.Kind = KindCreated
+		a.Message = actorName + " created the event"
+		return a
+	case auditentry.OperationUpdate:
+	default:
+		return nil
+	}
+
+	changes := byField(a.Changes)
+	var parts []string
+	var details []string
+	if c, ok := changes[event.FieldStartTime]; ok {
+		a.Kind = KindRescheduled
+		parts = append(parts, fmt.Sprintf("moved the event from %s to %s", formatTime(c.Before, loc), formatTime(c.After, loc)))
+	} else if c, ok := changes[event.FieldEndTime]; ok {
+		a.Kind = KindRescheduled
+		parts = append(parts, fmt.Sprintf("changed the end time from %s to %s", formatTime(c.Before, loc), formatTime(c.After, loc)))
+	}
+	if c, ok := changes[event.FieldTitle]; ok {
+		setKind(a, KindRenamed)
+		parts = append(parts, fmt.Sprintf("renamed the event from %q to %q", stringValue(c.Before), stringValue(c.After)))
+	}
+	if c, ok := changes[event.FieldVisibility]; ok {
+		setKind(a, KindVisibilityChanged)
+		parts = append(parts, fmt.Sprintf("changed the visibility from %s to %s", stringValue(c.Before), stringValue(c.After)))
+	}
+	// Shown と一致させるため shownEventFields を変えたらここも変える
+	for _, f := range []string{event.FieldDescription, event.FieldTimeZone, event.FieldRecurrenceRule, event.FieldEmoji} {
+		if _, ok := changes[f]; ok {
+			details = append(details, strings.ReplaceAll(f, "_", " "))
+		}
+	}
+	if len(details) > 0 {
+		setKind(a, KindDetailsChanged)
+		parts = append(parts, "updated the "+join(details))
+	}
+	if len(parts) == 0 {
+		return nil
+	}
+	a.Message = actorName + " " + join(parts)
+	return a
+}
+
+func renderParticipant(a *Activity, actorName, subjectName string, self bool) *Activity {
+	changes := byField(a.Changes)
+	// 文頭に置く場合の表記
+	leadName := subjectName
+	if leadName == unknownParticipant {
+		leadName = "A participant"
+	}
+	switch a.Entry.Operation {
+	case auditentry.OperationCreate:
+		if self {
+			a.Kind = KindParticipantJoined
+			a.Message = leadName + " joined the event"
+		} else {
+			a.Kind = KindParticipantInvited
+			a.Message = actorName + " invited " + subjectName
+		}
+	case auditentry.OperationDelete:
+		a.Kind = KindParticipantRemoved
+		if self {
+			a.Message = leadName + " left the event"
+		} else {
+			a.Message = actorName + " removed " + subjectName
+		}
+	case auditentry.OperationUpdate:
+		if c, ok := changes[participant.FieldStatus]; ok {
+			switch stringValue(c.After) {
+			case participant.StatusAccepted.String():
+				a.Kind = KindParticipantJoined
+				a.Message = leadName + " accepted the invitation"
+				return a
+			case participant.StatusDeclined.String():
+				a.Kind = KindParticipantDeclined
+				a.Message = leadName + " declined the invitation"
+				return a
+			}
+		}
+		if c, ok := changes[participant.FieldRole]; ok {
+			a.Kind = KindParticipantRoleChanged
+			a.Message = fmt.Sprintf("%s made %s %s", actorName, subjectName, withArticle(stringValue(c.After)))
+			return a
+		}
+		return nil
+	default:
+		return nil
+	}
+	return a
+}
+
+// Version returns the event's details as they were right after the entry with the given ID.
+// entries must be the audit entries of the event itself, oldest first.
+// Fields absent from the result were never changed and keep their current value.
+func Version(entries []*ent.AuditEntry, entryID int) (map[string]any, error) {
+	target := -1
+	for i, e := range entries {
+		if e.ID == entryID && e.EntityType == ent.TypeEvent && e.Operation != auditentry.OperationDelete {
+			target = i
+			break
+		}
+	}
+	if target < 0 {
+		return nil, ErrUnknownVersion
+	}
+
+	restorable := make(map[string]bool, len(DetailFields))
+	for _, f := range DetailFields {
+		restorable[f] = true
+	}
+	version := make(map[string]any)
+	// 対象までの変更は変更後の値を、それ以降に初めて変わったフィールドは変更前の値を採用する
+	for i, e := range entries {
+		for _, c := range e.Changes {
+			if !restorable[c.Field] {
+				continue
+			}
+			if i <= target {
+				version[c.Field] = c.After
+			} else if _, seen := version[c.Field]; !seen {
+				version[c.Field] = c.Before
+			}
+		}
+	}
+	return version, nil
+}
+
+func setKind(a *Activity, kind Kind) {
+	if a.Kind == "" {
+		a.Kind = kind
+	}
+}
+
+func byField(changes []schema.FieldChange) map[string]schema.FieldChange {
+	result := make(map[string]schema.FieldChange, len(changes))
+	for _, c := range changes {
+		result[c.Field] = c
+	}
+	return result
+}
+
+func nameOf(u *ent.User, fallback string) string {
+	if u == nil || u.Name == "" {
+		return fallback
+	}
+	return u.Name
+}
+
+// join lists items as "a", "a and b" or "a, b and c"
+func join(items []string) string {
+	if len(items) <= 1 {
+		return strings.Join(items, "")
+	}
+	return strings.Join(items[:len(items)-1], ", ") + " and " + items[len(items)-1]
+}
+
+func withArticle(word string) string {
+	if word != "" && strings.ContainsRune("aeiou", rune(word[0])) {
+		return "an " + word
+	}
+	return "a " + word
+}
+
+// formatTime writes an audited timestamp like "Fri, Oct 31 17:00" in loc
+func formatTime(v any, loc *time.Location) string {
+	t, err := time.Parse(time.RFC3339Nano, stringValue(v))
+	if err != nil {
+		return "an unknown time"
+	}
+	return t.In(loc).Format("Mon, Jan 2 15:04")
+}
+
+func stringValue(v any) string {
+	s, _ := v.(string)
+	return s
+}
+
+// intValue reads an ID stored as a JSON number
+func intValue(v any) (int, bool) {
+	f, ok := v.(float64)
+	return int(f), ok
+}
@@ -0,0 +1,159 @@
+package activity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/auditentry"
+	"github.com/matsuokashuhei/morrow-backend/ent/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	alice := &ent.User{ID: 1, Name: "Alice"}
+	bob := &ent.User{ID: 2, Name: "Bob"}
+
+	tests := []struct {
+		name    string
+		entry   *ent.AuditEntry
+		actor   *ent.User
+		subject *ent.User
+		kind    Kind
+		message string
+	}{
+		{
+			name:    "created",
+			entry:   &ent.AuditEntry{EntityType: ent.TypeEvent, Operation: auditentry.OperationCreate},
+			actor:   alice,
+			kind:    KindCreated,
+			message: "Alice created the event",
+		},
+		{
+			name: "rescheduled and renamed",
+			entry: &ent.AuditEntry{EntityType: ent.TypeEvent, Operation: auditentry.OperationUpdate, Changes: []schema.FieldChange{
+				{Field: "start_time", Before: "2025-10-31T08:00:00Z", After: "2025-11-01T08:00:00Z"},
+				{Field: "title", Before: "Retro", After: "Sprint retro"},
+				{Field: "updated_at", Before: "2025-10-01T00:00:00Z", After: "2025-10-02T00:00:00Z"},
+			}},
+			actor:   alice,
+			kind:    KindRescheduled,
+			message: `Alice moved the event from Fri, Oct 31 17:00 to Sat, Nov 1 17:00 and renamed the event from "Retro" to "Sprint retro"`,
+		},
+		{
+			name: "details changed by an unknown actor",
+			entry: &ent.AuditEntry{EntityType: ent.TypeEvent, Operation: auditentry.OperationUpdate, Changes: []schema.FieldChange{
+				{Field: "description", After: "Agenda"},
+				{Field: "emoji", Before: "🎉"},
+			}},
+			kind:    KindDetailsChanged,
+			message: "Someone updated the description and emoji",
+		},
+		{
+			name:    "invited",
+			entry:   &ent.AuditEntry{EntityType: ent.TypeParticipant, Operation: auditentry.OperationCreate},
+			actor:   alice,
+			subject: bob,
+			kind:    KindParticipantInvited,
+			message: "Alice invited Bob",
+		},
+		{
+			name: "declined",
+			entry: &ent.AuditEntry{EntityType: ent.TypeParticipant, Operation: auditentry.OperationUpdate, Changes: []schema.FieldChange{
+				{Field: "status", Before: "pending", After: "declined"},
+			}},
+			actor:   bob,
+			subject: bob,
+			kind:    KindParticipantDeclined,
+			message: "Bob declined the invitation",
+		},
+		{
+			name: "role changed",
+			entry: &ent.AuditEntry{EntityType: ent.TypeParticipant, Operation: auditentry.OperationUpdate, Changes: []schema.FieldChange{
+				{Field: "role", Before: "viewer", After: "editor"},
+			}},
+			actor:   alice,
+			subject: bob,
+			kind:    KindParticipantRoleChanged,
+			message: "Alice made Bob an editor",
+		},
+		{
+			name:    "left",
+			entry:   &ent.AuditEntry{EntityType: ent.TypeParticipant, Operation: auditentry.OperationDelete},
+			actor:   bob,
+			subject: bob,
+			kind:    KindParticipantRemoved,
+			message: "Bob left the event",
+		},
+		{
+			name: "accepted by a deleted user",
+			entry: &ent.AuditEntry{EntityType: ent.TypeParticipant, Operation: auditentry.OperationUpdate, Changes: []schema.FieldChange{
+				{Field: "status", Before: "pending", After: "accepted"},
+			}},
+			kind:    KindParticipantJoined,
+			message: "A participant accepted the invitation",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := Render(tt.entry, tt.actor, tt.subject, tokyo)
+			require.NotNil(t, a)
+			assert.Equal(t, tt.kind, a.Kind)
+			assert.Equal(t, tt.message, a.Message)
+		})
+	}
+
+	t.Run("bookkeeping-only updates are hidden", func(t *testing.T) {
+		entry := &ent.AuditEntry{EntityType: ent.TypeEvent, Operation: auditentry.OperationUpdate, Changes: []schema.FieldChange{
+			{Field: "sequence", Before: float64(1), After: float64(2)},
+		}}
+		assert.Nil(t, Render(entry, alice, nil, tokyo))
+	})
+}
+
+func TestVersion(t *testing.T) {
+	entries := []*ent.AuditEntry{
+		{ID: 1, EntityType: ent.TypeEvent, Operation: auditentry.OperationCreate, Changes: []schema.FieldChange{
+			{Field: "title", After: "Retro"},
+			{Field: "start_time", After: "2025-10-31T08:00:00Z"},
+		}},
+		{ID: 2, EntityType: ent.TypeEvent, Operation: auditentry.OperationUpdate, Changes: []schema.FieldChange{
+			{Field: "title", Before: "Retro", After: "Sprint retro"},
+		}},
+		{ID: 3, EntityType: ent.TypeEvent, Operation: auditentry.OperationUpdate, Changes: []schema.FieldChange{
+			{Field: "start_time", Before: "2025-10-31T08:00:00Z", After: "2025-11-01T08:00:00Z"},
+			{Field: "emoji", Before: "🎉"},
+			{Field: "sequence", Before: float64(0), After: float64(1)},
+		}},
+	}
+
+	version, err := Version(entries, 2)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"title":      "Sprint retro",
+		"start_time": "2025-10-31T08:00:00Z",
+		"emoji":      "🎉",
+	}, version)
+
+	_, err = Version(entries, 99)
+	assert.ErrorIs(t, err, ErrUnknownVersion)
+}
+
+func TestShownEventFields(t *testing.T) {
+	// Shown がデータベースで選ぶ記録と Render が表示する記録を一致させる
+	for _, f := range shownEventFields {
+		e := &ent.AuditEntry{EntityType: ent.TypeEvent, Operation: auditentry.OperationUpdate, Changes: []schema.FieldChange{
+			{Field: f, Before: "a", After: "b"},
+		}}
+		assert.NotNil(t, Render(e, nil, nil, time.UTC), f)
+	}
+	e := &ent.AuditEntry{EntityType: ent.TypeEvent, Operation: auditentry.OperationUpdate, Changes: []schema.FieldChange{
+		{Field: "updated_at", Before: "a", After: "b"},
+		{Field: "sequence", Before: 1.0, After: 2.0},
+	}}
+	assert.Nil(t, Render(e, nil, nil, time.UTC))
+}
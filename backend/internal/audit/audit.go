@@ -0,0 +1,338 @@
+// Package audit records who created, changed or deleted users, events and
+// participants. The hooks write one AuditEntry per affected row in the same
+// client (and transaction) as the audited mutation.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/auditentry"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/participant"
+	"github.com/matsuokashuhei/morrow-backend/ent/schema"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+	"github.com/matsuokashuhei/morrow-backend/internal/requestmeta"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
+)
+
+// Redacted replaces the values of sensitive fields in audit entries
+const Redacted = "[REDACTED]"
+
+// sensitiveFields lists the fields whose values must not be written to the audit log
+var sensitiveFields = map[string]map[string]bool{
+	ent.TypeUser: {user.FieldCalendarTokenHash: true},
+}
+
+// personalFields lists the user fields that are redacted from the audit log when the account is closed
+var personalFields = map[string]bool{
+	user.FieldEmail:     true,
+	user.FieldName:      true,
+	user.FieldAvatarURL: true,
+	user.FieldCognitoID: true,
+}
+
+// Register installs the audit hooks on the client
+func Register(client *ent.Client) {
+	client.User.Use(Hook())
+	client.Event.Use(Hook())
+	client.Participant.Use(Hook())
+}
+
+// mutation is implemented by the generated mutations of the audited entities
+type mutation interface {
+	ent.Mutation
+	ID() (int, bool)
+	IDs(ctx context.Context) ([]int, error)
+	Client() *ent.Client
+}
+
+// Hook records an audit entry for every row created, updated or deleted by the mutation
+func Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			am, ok := m.(mutation)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			// 更新・削除は実行前の値を保持しておく
+			var before map[int]map[string]any
+			var ids []int
+			if !m.Op().Is(ent.OpCreate) {
+				var err error
+				ids, err = am.IDs(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("audit: failed to get affected IDs: %w", err)
+				}
+				if len(ids) == 0 {
+					return next.Mutate(ctx, m)
+				}
+				before, err = snapshots(ctx, am.Client(), m.Type(), ids)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			if m.Op().Is(ent.OpCreate) {
+				id, ok := am.ID()
+				if !ok {
+					return v, nil
+				}
+				ids = []int{id}
+			}
+
+			builders := make([]*ent.AuditEntryCreate, 0, len(ids))
+			for _, id := range ids {
+				op, changes := diff(m, before[id])
+				// 値が変わらない更新は記録しない
+				if op == auditentry.OperationUpdate && len(changes) == 0 {
+					continue
+				}
+				create := newEntry(ctx, am.Client(), m.Type(), id, op, changes)
+				if eventID, ok := eventOf(m, id, before[id]); ok {
+					create.SetEventID(eventID)
+				}
+				builders = append(builders, create)
+			}
+			if len(builders) == 0 {
+				return v, nil
+			}
+			if err := am.Client().AuditEntry.CreateBulk(builders...).Exec(ctx); err != nil {
+				return nil, fmt.Errorf("audit: failed to record %s of %s: %w", m.Op(), m.Type(), err)
+			}
+			return v, nil
+		})
+	}
+}
+
+// RedactUser replaces the personal values in the audit entries of a user, keeping which fields changed and when.
+// Call it after the last change to the user, within the same transaction.
+func RedactUser(ctx context.Context, client *ent.Client, userID int) error {
+	entries, err := client.AuditEntry.Query().
+		Where(auditentry.EntityTypeEQ(ent.TypeUser), auditentry.EntityIDEQ(userID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("audit: failed to get entries of user: %w", err)
+	}
+	for _, e := range entries {
+		redacted := false
+		for i, c := range e.Changes {
+			if !personalFields[c.Field] {
+				continue
+			}
+			e.Changes[i].Before, e.Changes[i].After = redact(c.Before), redact(c.After)
+			redacted = true
+		}
+		if !redacted {
+			continue
+		}
+		if err := client.AuditEntry.UpdateOne(e).SetChanges(e.Changes).Exec(ctx); err != nil {
+			return fmt.Errorf("audit: failed to redact entry %d: %w", e.ID, err)
+		}
+	}
+	return nil
+}
+
+// newEntry prepares an entry attributed to the viewer and request of ctx
+func newEntry(ctx context.Context, client *ent.Client, entityType string, id int, op auditentry.Operation, changes []schema.FieldChange) *ent.AuditEntryCreate {
+	create := client.AuditEntry.Create().
+		SetEntityType(entityType).
+		SetEntityID(id).
+		SetOperation(op).
+		SetChanges(changes)
+	if v, ok := viewer.FromContext(ctx); ok {
+		create.SetActorSubject(v.Subject)
+		if v.UserID != 0 {
+			create.SetActorID(v.UserID)
+		}
+	}
+	if meta, ok := requestmeta.FromContext(ctx); ok {
+		create.SetRequestID(meta.RequestID).SetIP(meta.IP)
+	}
+	return create
+}
+
+// eventOf returns the event a row of the mutation belongs to, so that its entries show up in the event activity
+func eventOf(m ent.Mutation, id int, before map[string]any) (int, bool) {
+	switch m := m.(type) {
+	case *ent.EventMutation:
+		return id, true
+	case *ent.ParticipantMutation:
+		if eventID, ok := m.EventID(); ok {
+			return eventID, true
+		}
+		if eventID, ok := before["event"].(float64); ok {
+			return int(eventID), true
+		}
+	}
+	return 0, false
+}
+
+// diff lists the changes the mutation made to a row whose previous values are before
+func diff(m ent.Mutation, before map[string]any) (auditentry.Operation, []schema.FieldChange) {
+	sensitive := sensitiveFields[m.Type()]
+	var changes []schema.FieldChange
+	add := func(name string, old, new any) {
+		if sameValue(old, new) {
+			return
+		}
+		if sensitive[name] {
+			old, new = redact(old), redact(new)
+		}
+		changes = append(changes, schema.FieldChange{Field: name, Before: old, After: new})
+	}
+
+	if m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+		names := make([]string, 0, len(before))
+		for name := range before {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			add(name, before[name], nil)
+		}
+		return auditentry.OperationDelete, changes
+	}
+
+	for _, name := range m.Fields() {
+		value, _ := m.Field(name)
+		add(name, before[name], normalize(value))
+	}
+	for _, name := range m.ClearedFields() {
+		add(name, before[name], nil)
+	}
+	// エッジは外部キーの付け替えとして記録する
+	for _, name := range m.AddedEdges() {
+		ids := m.AddedIDs(name)
+		if len(ids) == 1 {
+			add(name, nil, normalize(ids[0]))
+		} else {
+			add(name, nil, normalize(ids))
+		}
+	}
+	for _, name := range m.ClearedEdges() {
+		if len(m.AddedIDs(name)) == 0 {
+			changes = append(changes, schema.FieldChange{Field: name})
+		}
+	}
+
+	if m.Op().Is(ent.OpCreate) {
+		return auditentry.OperationCreate, changes
+	}
+	return auditentry.OperationUpdate, changes
+}
+
+// snapshots loads the current field values of the rows, keyed by ID
+func snapshots(ctx context.Context, client *ent.Client, entityType string, ids []int) (map[int]map[string]any, error) {
+	var rows any
+	var err error
+	switch entityType {
+	case ent.TypeUser:
+		rows, err = client.User.Query().Where(user.IDIn(ids...)).All(ctx)
+	case ent.TypeEvent:
+		rows, err = client.Event.Query().Where(event.IDIn(ids...)).All(ctx)
+	case ent.TypeParticipant:
+		// 削除後も誰がどのイベントから外れたか分かるよう、エッジのIDも保持する
+		rows, err = client.Participant.Query().Where(participant.IDIn(ids...)).WithEvent().WithUser().All(ctx)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("audit: failed to load %s before change: %w", entityType, err)
+	}
+
+	// エンティティのJSON表現を使うため、Sensitiveなフィールドは含まれない
+	var values []map[string]any
+	if err := roundTrip(rows, &values); err != nil {
+		return nil, fmt.Errorf("audit: failed to encode %s: %w", entityType, err)
+	}
+	result := make(map[int]map[string]any, len(values))
+	for _, v := range values {
+		id, ok := v["id"].(float64)
+		if !ok {
+			continue
+		}
+		delete(v, "id")
+		edges, _ := v["edges"].(map[string]any)
+		delete(v, "edges")
+		for name, edge := range edges {
+			if e, ok := edge.(map[string]any); ok && e["id"] != nil {
+				v[name] = e["id"]
+			}
+		}
+		result[int(id)] = v
+	}
+	return result, nil
+}
+
+// normalize converts a mutation value to its JSON form so that it compares with snapshots
+func normalize(value any) any {
+	if t, ok := value.(time.Time); ok {
+		value = t.UTC()
+	}
+	var out any
+	if err := roundTrip(value, &out); err != nil {
+		return fmt.Sprint(value)
+	}
+	return out
+}
+
+func roundTrip(in, out any) error {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, out)
+}
+
+// sameValue reports whether two normalized values are equal, treating timestamps as instants.
+// Snapshots omit zero values (the entities are encoded with omitempty), so nil equals a zero value.
+func sameValue(a, b any) bool {
+	if reflect.DeepEqual(a, b) {
+		return true
+	}
+	if a == nil {
+		return isZero(b)
+	}
+	if b == nil {
+		return isZero(a)
+	}
+	as, aok := a.(string)
+	bs, bok := b.(string)
+	if !aok || !bok {
+		return false
+	}
+	at, aerr := time.Parse(time.RFC3339Nano, as)
+	bt, berr := time.Parse(time.RFC3339Nano, bs)
+	return aerr == nil && berr == nil && at.Equal(bt)
+}
+
+func isZero(v any) bool {
+	switch v := v.(type) {
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	}
+	return false
+}
+
+func redact(value any) any {
+	if value == nil {
+		return nil
+	}
+	return Redacted
+}
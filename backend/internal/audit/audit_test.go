@@ -0,0 +1,85 @@
+package audit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/auditentry"
+	"github.com/matsuokashuhei/morrow-backend/ent/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	client := ent.NewClient()
+	start := time.Date(2025, 10, 20, 9, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+
+	t.Run("create records set fields and edges", func(t *testing.T) {
+		m := client.Event.Create().
+			SetTitle("Standup").
+			SetStartTime(start).
+			SetCreatorID(7).
+			Mutation()
+
+		op, changes := diff(m, nil)
+		assert.Equal(t, auditentry.OperationCreate, op)
+		assert.Contains(t, changes, schema.FieldChange{Field: "title", After: "Standup"})
+		assert.Contains(t, changes, schema.FieldChange{Field: "start_time", After: "2025-10-20T00:00:00Z"})
+		assert.Contains(t, changes, schema.FieldChange{Field: "creator", After: float64(7)})
+	})
+
+	t.Run("update skips unchanged values", func(t *testing.T) {
+		m := client.Event.UpdateOneID(1).
+			SetTitle("Daily standup").
+			SetStartTime(start).
+			ClearDescription().
+			Mutation()
+
+		op, changes := diff(m, map[string]any{
+			"title":       "Standup",
+			"start_time":  "2025-10-20T09:00:00+09:00",
+			"description": "Sync",
+		})
+		assert.Equal(t, auditentry.OperationUpdate, op)
+		assert.ElementsMatch(t, []schema.FieldChange{
+			{Field: "title", Before: "Standup", After: "Daily standup"},
+			{Field: "description", Before: "Sync"},
+		}, changes)
+	})
+
+	t.Run("sensitive fields are redacted", func(t *testing.T) {
+		m := client.User.UpdateOneID(1).SetCalendarTokenHash("secret").Mutation()
+
+		_, changes := diff(m, map[string]any{})
+		assert.Equal(t, []schema.FieldChange{{Field: "calendar_token_hash", After: Redacted}}, changes)
+	})
+
+	t.Run("delete records previous values", func(t *testing.T) {
+		// 削除ビルダーはミューテーションを公開しないため、操作種別だけ差し替える
+		m := deleteMutation{client.Participant.UpdateOneID(1).Mutation()}
+
+		op, changes := diff(m, map[string]any{"status": "accepted", "role": "attendee"})
+		assert.Equal(t, auditentry.OperationDelete, op)
+		assert.Equal(t, []schema.FieldChange{
+			{Field: "role", Before: "attendee"},
+			{Field: "status", Before: "accepted"},
+		}, changes)
+	})
+}
+
+type deleteMutation struct {
+	*ent.ParticipantMutation
+}
+
+func (deleteMutation) Op() ent.Op { return ent.OpDeleteOne }
+
+func TestSameValue(t *testing.T) {
+	assert.True(t, sameValue("a", "a"))
+	assert.True(t, sameValue(nil, nil))
+	assert.True(t, sameValue("2025-10-20T09:00:00+09:00", "2025-10-20T00:00:00Z"))
+	assert.False(t, sameValue("a", "b"))
+	assert.False(t, sameValue(nil, "a"))
+	assert.True(t, sameValue(nil, ""))
+	assert.True(t, sameValue(false, nil))
+	assert.False(t, sameValue(float64(1), "1"))
+}
@@ -0,0 +1,67 @@
+// Package billing sells paid plans through a payment provider: it creates
+// checkout sessions and reconciles subscriptions from provider webhooks.
+package billing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+var (
+	// ErrInvalidSignature is returned for webhooks whose signature does not verify
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	// ErrInvalidPayload is returned for webhooks that cannot be decoded
+	ErrInvalidPayload = errors.New("invalid webhook payload")
+)
+
+// Provider is implemented by payment providers
+type Provider interface {
+	// Name identifies the provider in the billing ledger
+	Name() string
+	// CreateCheckoutSession starts a hosted checkout for a subscription
+	CreateCheckoutSession(ctx context.Context, req CheckoutRequest) (*CheckoutSession, error)
+	// ParseWebhook verifies the signature of a webhook and decodes it
+	ParseWebhook(payload []byte, header http.Header) (*Event, error)
+}
+
+// CheckoutRequest describes the subscription a user wants to buy
+type CheckoutRequest struct {
+	UserID     int
+	Email      string
+	PlanKey    string
+	PriceID    string
+	SuccessURL string
+	CancelURL  string
+}
+
+// CheckoutSession is a hosted checkout page of the provider
+type CheckoutSession struct {
+	ID  string
+	URL string
+}
+
+// EventType is the provider independent kind of a webhook
+type EventType string
+
+const (
+	SubscriptionCreated   EventType = "subscription_created"
+	SubscriptionRenewed   EventType = "subscription_renewed"
+	SubscriptionCancelled EventType = "subscription_cancelled"
+)
+
+// Event is a webhook decoded by a Provider
+type Event struct {
+	// ID is the provider's event ID, used to process each webhook once
+	ID string
+	// Type is empty for webhooks Morrow does not act on
+	Type EventType
+	// UserID is taken from the metadata set at checkout, or 0 when the webhook does not carry it
+	UserID         int
+	PlanKey        string
+	CustomerID     string
+	SubscriptionID string
+	PeriodEnd      time.Time
+	OccurredAt     time.Time
+}
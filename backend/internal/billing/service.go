@@ -0,0 +1,275 @@
+package billing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/billingevent"
+	"github.com/matsuokashuhei/morrow-backend/ent/hook"
+	"github.com/matsuokashuhei/morrow-backend/ent/plan"
+	"github.com/matsuokashuhei/morrow-backend/ent/subscription"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+	"github.com/matsuokashuhei/morrow-backend/internal/config"
+)
+
+var (
+	// ErrPlanNotForSale is returned when checking out a plan without a price
+	ErrPlanNotForSale = errors.New("plan is not available for purchase")
+	// ErrUnknownCustomer is returned for webhooks that cannot be matched to a user
+	ErrUnknownCustomer = errors.New("webhook does not match any user")
+	// ErrUnknownPlan is returned for webhooks naming a plan that does not exist
+	ErrUnknownPlan = errors.New("webhook names an unknown plan")
+)
+
+// Options configures a Service
+type Options struct {
+	// Prices maps plan keys to the provider's price IDs. Plans without a price cannot be bought.
+	Prices     map[string]string
+	SuccessURL string
+	CancelURL  string
+}
+
+// Service creates checkout sessions and applies webhooks to subscriptions
+type Service struct {
+	client   *ent.Client
+	provider Provider
+	opts     Options
+}
+
+func NewService(client *ent.Client, provider Provider, opts Options) *Service {
+	return &Service{client: client, provider: provider, opts: opts}
+}
+
+// New creates the service for the configured provider. It returns nil when billing is disabled.
+func New(cfg *config.Config, client *ent.Client) (*Service, error) {
+	switch cfg.BillingProvider {
+	case "":
+		return nil, nil
+	case "stripe":
+		prices, err := ParsePrices(cfg.BillingPrices)
+		if err != nil {
+			return nil, err
+		}
+		provider := NewStripe(cfg.BillingAPIURL, cfg.BillingAPIKey, cfg.BillingWebhookSecret)
+		return NewService(client, provider, Options{
+			Prices:     prices,
+			SuccessURL: cfg.BillingSuccessURL,
+			CancelURL:  cfg.BillingCancelURL,
+		}), nil
+	default:
+		return nil, fmt.Errorf("unknown billing provider %q", cfg.BillingProvider)
+	}
+}
+
+// ParsePrices parses a list of plan=price pairs, e.g. "pro=price_123,team=price_456"
+func ParsePrices(s string) (map[string]string, error) {
+	prices := map[string]string{}
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, price, ok := strings.Cut(pair, "=")
+		if !ok || key == "" || price == "" {
+			return nil, fmt.Errorf("invalid billing price %q, expected plan=price", pair)
+		}
+		prices[strings.TrimSpace(key)] = strings.TrimSpace(price)
+	}
+	return prices, nil
+}
+
+// Register makes the billing ledger append-only
+func Register(client *ent.Client) {
+	client.BillingEvent.Use(hook.Reject(ent.OpUpdate | ent.OpUpdateOne | ent.OpDelete | ent.OpDeleteOne))
+}
+
+// Checkout starts a checkout of the plan for the user and returns the hosted checkout session
+func (s *Service) Checkout(ctx context.Context, userID int, planKey string) (*CheckoutSession, error) {
+	price, ok := s.opts.Prices[planKey]
+	if !ok {
+		return nil, ErrPlanNotForSale
+	}
+	exists, err := s.client.Plan.Query().Where(plan.KeyEQ(planKey)).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plan: %w", err)
+	}
+	if !exists {
+		return nil, ErrPlanNotForSale
+	}
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return s.provider.CreateCheckoutSession(ctx, CheckoutRequest{
+		UserID:     userID,
+		Email:      u.Email,
+		PlanKey:    planKey,
+		PriceID:    price,
+		SuccessURL: s.opts.SuccessURL,
+		CancelURL:  s.opts.CancelURL,
+	})
+}
+
+// HandleWebhook verifies a webhook, records it in the ledger and updates the user's subscription.
+// It reports whether the webhook was applied; duplicates and event types Morrow does not act on are
+// acknowledged without changes.
+func (s *Service) HandleWebhook(ctx context.Context, payload []byte, header http.Header) (bool, error) {
+	ev, err := s.provider.ParseWebhook(payload, header)
+	if err != nil {
+		return false, err
+	}
+	if ev.Type == "" {
+		return false, nil
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	processed, err := tx.BillingEvent.Query().Where(billingevent.ProviderEventIDEQ(ev.ID)).Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check billing event: %w", err)
+	}
+	if processed {
+		return false, nil
+	}
+
+	userID, err := resolveUser(ctx, tx.Client(), ev)
+	if err != nil {
+		return false, err
+	}
+
+	ledger := tx.BillingEvent.Create().
+		SetProvider(s.provider.Name()).
+		SetProviderEventID(ev.ID).
+		SetType(billingevent.Type(ev.Type)).
+		SetPlanKey(ev.PlanKey).
+		SetProviderSubscriptionID(ev.SubscriptionID).
+		SetPayload(string(payload)).
+		SetOccurredAt(ev.OccurredAt).
+		SetUserID(userID)
+	if !ev.PeriodEnd.IsZero() {
+		ledger = ledger.SetPeriodEnd(ev.PeriodEnd)
+	}
+	if err := ledger.Exec(ctx); err != nil {
+		// 同じWebhookが並行して届いた場合は先に処理した側に任せる
+		if ent.IsConstraintError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to record billing event: %w", err)
+	}
+
+	if err := applyEvent(ctx, tx.Client(), userID, ev); err != nil {
+		return false, err
+	}
+	if err := tx.Commit(); err != nil {
+		if ent.IsConstraintError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to commit billing event: %w", err)
+	}
+	return true, nil
+}
+
+// resolveUser finds the user of a webhook by its metadata, or by the provider subscription ID
+func resolveUser(ctx context.Context, client *ent.Client, ev *Event) (int, error) {
+	if ev.UserID != 0 {
+		exists, err := client.User.Query().Where(user.IDEQ(ev.UserID)).Exist(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get user: %w", err)
+		}
+		if exists {
+			return ev.UserID, nil
+		}
+	}
+	if ev.SubscriptionID == "" {
+		return 0, ErrUnknownCustomer
+	}
+	userID, err := client.Subscription.Query().
+		Where(subscription.ProviderSubscriptionIDEQ(ev.SubscriptionID)).
+		QueryUser().
+		OnlyID(ctx)
+	if ent.IsNotFound(err) {
+		return 0, ErrUnknownCustomer
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get subscription: %w", err)
+	}
+	return userID, nil
+}
+
+// applyEvent updates the user's subscription. Events older than the last applied one are only recorded.
+func applyEvent(ctx context.Context, client *ent.Client, userID int, ev *Event) error {
+	current, err := client.Subscription.Query().
+		Where(subscription.HasUserWith(user.IDEQ(userID))).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("failed to get subscription: %w", err)
+	}
+	if current != nil && current.LastEventAt != nil && ev.OccurredAt.Before(*current.LastEventAt) {
+		return nil
+	}
+
+	var p *ent.Plan
+	if ev.PlanKey != "" {
+		p, err = client.Plan.Query().Where(plan.KeyEQ(ev.PlanKey)).Only(ctx)
+		if ent.IsNotFound(err) {
+			return ErrUnknownPlan
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get plan: %w", err)
+		}
+	}
+
+	status := subscription.StatusActive
+	if ev.Type == SubscriptionCancelled {
+		// 別の契約に切り替え済みの場合、古い契約の解約は反映しない
+		if current == nil || (current.ProviderSubscriptionID != nil && *current.ProviderSubscriptionID != ev.SubscriptionID) {
+			return nil
+		}
+		status = subscription.StatusCanceled
+	}
+
+	if current == nil {
+		if p == nil {
+			return ErrUnknownPlan
+		}
+		create := client.Subscription.Create().
+			SetUserID(userID).
+			SetPlan(p).
+			SetStatus(status).
+			SetProviderCustomerID(ev.CustomerID).
+			SetProviderSubscriptionID(ev.SubscriptionID).
+			SetLastEventAt(ev.OccurredAt)
+		if !ev.PeriodEnd.IsZero() {
+			create = create.SetCurrentPeriodEnd(ev.PeriodEnd)
+		}
+		if err := create.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to create subscription: %w", err)
+		}
+		return nil
+	}
+
+	update := current.Update().
+		SetStatus(status).
+		SetProviderCustomerID(ev.CustomerID).
+		SetProviderSubscriptionID(ev.SubscriptionID).
+		SetLastEventAt(ev.OccurredAt)
+	if p != nil {
+		update = update.SetPlan(p)
+	}
+	if !ev.PeriodEnd.IsZero() {
+		update = update.SetCurrentPeriodEnd(ev.PeriodEnd)
+	}
+	if err := update.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update subscription: %w", err)
+	}
+	return nil
+}
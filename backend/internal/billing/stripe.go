@@ -0,0 +1,236 @@
+package billing
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// StripeSignatureHeader carries the signature of Stripe webhooks
+	StripeSignatureHeader = "Stripe-Signature"
+	// stripeTolerance is the maximum age of a webhook signature, to limit replays
+	stripeTolerance = 5 * time.Minute
+)
+
+// Stripe implements Provider with the Stripe REST API.
+// BaseURL can point to a fake server in tests.
+type Stripe struct {
+	BaseURL       string
+	APIKey        string
+	WebhookSecret string
+	HTTPClient    *http.Client
+	// now is replaceable in tests
+	now func() time.Time
+}
+
+func NewStripe(baseURL, apiKey, webhookSecret string) *Stripe {
+	return &Stripe{
+		BaseURL:       strings.TrimSuffix(baseURL, "/"),
+		APIKey:        apiKey,
+		WebhookSecret: webhookSecret,
+		HTTPClient:    &http.Client{Timeout: 30 * time.Second},
+		now:           time.Now,
+	}
+}
+
+func (s *Stripe) Name() string {
+	return "stripe"
+}
+
+// CreateCheckoutSession creates a Checkout Session in subscription mode.
+// The user ID and plan are stored in the subscription metadata so that webhooks can be matched to the user.
+func (s *Stripe) CreateCheckoutSession(ctx context.Context, req CheckoutRequest) (*CheckoutSession, error) {
+	userID := strconv.Itoa(req.UserID)
+	form := url.Values{
+		"mode":                                 {"subscription"},
+		"line_items[0][price]":                 {req.PriceID},
+		"line_items[0][quantity]":              {"1"},
+		"success_url":                          {req.SuccessURL},
+		"cancel_url":                           {req.CancelURL},
+		"client_reference_id":                  {userID},
+		"subscription_data[metadata][user_id]": {userID},
+		"subscription_data[metadata][plan]":    {req.PlanKey},
+	}
+	if req.Email != "" {
+		form.Set("customer_email", req.Email)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, s.BaseURL+"/v1/checkout/sessions", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.SetBasicAuth(s.APIKey, "")
+	httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.HTTPClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create checkout session: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checkout session: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Error struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		_ = json.Unmarshal(body, &apiErr)
+		return nil, fmt.Errorf("failed to create checkout session: %s: %s", resp.Status, apiErr.Error.Message)
+	}
+
+	var session struct {
+		ID  string `json:"id"`
+		URL string `json:"url"`
+	}
+	if err := json.Unmarshal(body, &session); err != nil {
+		return nil, fmt.Errorf("failed to decode checkout session: %w", err)
+	}
+	return &CheckoutSession{ID: session.ID, URL: session.URL}, nil
+}
+
+// stripeEvent is the envelope of Stripe webhooks
+type stripeEvent struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Created int64  `json:"created"`
+	Data    struct {
+		Object json.RawMessage `json:"object"`
+	} `json:"data"`
+}
+
+type stripeSubscription struct {
+	ID               string            `json:"id"`
+	Customer         string            `json:"customer"`
+	CurrentPeriodEnd int64             `json:"current_period_end"`
+	Metadata         map[string]string `json:"metadata"`
+}
+
+type stripeInvoice struct {
+	Customer            string `json:"customer"`
+	Subscription        string `json:"subscription"`
+	BillingReason       string `json:"billing_reason"`
+	SubscriptionDetails struct {
+		Metadata map[string]string `json:"metadata"`
+	} `json:"subscription_details"`
+	Lines struct {
+		Data []struct {
+			Period struct {
+				End int64 `json:"end"`
+			} `json:"period"`
+		} `json:"data"`
+	} `json:"lines"`
+}
+
+// ParseWebhook verifies the Stripe-Signature header and decodes the subscription lifecycle events
+func (s *Stripe) ParseWebhook(payload []byte, header http.Header) (*Event, error) {
+	if err := s.verify(payload, header.Get(StripeSignatureHeader)); err != nil {
+		return nil, err
+	}
+
+	var raw stripeEvent
+	if err := json.Unmarshal(payload, &raw); err != nil || raw.ID == "" {
+		return nil, ErrInvalidPayload
+	}
+	ev := &Event{ID: raw.ID, OccurredAt: time.Unix(raw.Created, 0).UTC()}
+
+	switch raw.Type {
+	case "customer.subscription.created", "customer.subscription.deleted":
+		var sub stripeSubscription
+		if err := json.Unmarshal(raw.Data.Object, &sub); err != nil {
+			return nil, ErrInvalidPayload
+		}
+		ev.Type = SubscriptionCreated
+		if raw.Type == "customer.subscription.deleted" {
+			ev.Type = SubscriptionCancelled
+		}
+		ev.CustomerID, ev.SubscriptionID = sub.Customer, sub.ID
+		ev.PeriodEnd = unixTime(sub.CurrentPeriodEnd)
+		ev.UserID, ev.PlanKey = fromMetadata(sub.Metadata)
+	case "invoice.paid":
+		var inv stripeInvoice
+		if err := json.Unmarshal(raw.Data.Object, &inv); err != nil {
+			return nil, ErrInvalidPayload
+		}
+		// 初回の請求は customer.subscription.created で処理済み
+		if inv.BillingReason != "subscription_cycle" || inv.Subscription == "" {
+			return ev, nil
+		}
+		ev.Type = SubscriptionRenewed
+		ev.CustomerID, ev.SubscriptionID = inv.Customer, inv.Subscription
+		if len(inv.Lines.Data) > 0 {
+			ev.PeriodEnd = unixTime(inv.Lines.Data[0].Period.End)
+		}
+		ev.UserID, ev.PlanKey = fromMetadata(inv.SubscriptionDetails.Metadata)
+	}
+	return ev, nil
+}
+
+// verify checks a Stripe-Signature header of the form "t=<unix>,v1=<hex hmac>"
+func (s *Stripe) verify(payload []byte, signature string) error {
+	var (
+		timestamp  string
+		signatures []string
+	)
+	for _, part := range strings.Split(signature, ",") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || len(signatures) == 0 {
+		return ErrInvalidSignature
+	}
+	if age := s.now().Sub(time.Unix(unix, 0)); age > stripeTolerance || age < -stripeTolerance {
+		return ErrInvalidSignature
+	}
+
+	expected := StripeSignature(s.WebhookSecret, timestamp, payload)
+	for _, sig := range signatures {
+		if hmac.Equal([]byte(sig), []byte(expected)) {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// StripeSignature computes the v1 signature of a webhook payload
+func StripeSignature(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func fromMetadata(metadata map[string]string) (int, string) {
+	userID, _ := strconv.Atoi(metadata["user_id"])
+	return userID, metadata["plan"]
+}
+
+func unixTime(sec int64) time.Time {
+	if sec == 0 {
+		return time.Time{}
+	}
+	return time.Unix(sec, 0).UTC()
+}
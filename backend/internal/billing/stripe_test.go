@@ -0,0 +1,133 @@
+package billing
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testWebhookSecret = "whsec_test"
+
+// fakeStripe serves the Checkout Sessions endpoint of the Stripe API
+func fakeStripe(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key, _, _ := r.BasicAuth()
+		if r.URL.Path != "/v1/checkout/sessions" || key != "sk_test" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(w).Encode(map[string]any{"error": map[string]string{"message": "invalid API key"}})
+			return
+		}
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "subscription", r.Form.Get("mode"))
+		assert.Equal(t, "price_pro", r.Form.Get("line_items[0][price]"))
+		assert.Equal(t, "42", r.Form.Get("subscription_data[metadata][user_id]"))
+		assert.Equal(t, "pro", r.Form.Get("subscription_data[metadata][plan]"))
+		assert.Equal(t, "alice@example.com", r.Form.Get("customer_email"))
+		_ = json.NewEncoder(w).Encode(map[string]string{"id": "cs_1", "url": "https://checkout.example/cs_1"})
+	}))
+}
+
+func signedHeader(payload []byte, at time.Time) http.Header {
+	timestamp := strconv.FormatInt(at.Unix(), 10)
+	h := http.Header{}
+	h.Set(StripeSignatureHeader, "t="+timestamp+",v1="+StripeSignature(testWebhookSecret, timestamp, payload))
+	return h
+}
+
+func TestStripe_CreateCheckoutSession(t *testing.T) {
+	server := fakeStripe(t)
+	defer server.Close()
+
+	session, err := NewStripe(server.URL, "sk_test", testWebhookSecret).CreateCheckoutSession(context.Background(), CheckoutRequest{
+		UserID:  42,
+		Email:   "alice@example.com",
+		PlanKey: "pro",
+		PriceID: "price_pro",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "cs_1", session.ID)
+	assert.Equal(t, "https://checkout.example/cs_1", session.URL)
+
+	_, err = NewStripe(server.URL, "sk_wrong", testWebhookSecret).CreateCheckoutSession(context.Background(), CheckoutRequest{})
+	assert.ErrorContains(t, err, "invalid API key")
+}
+
+func TestStripe_ParseWebhook(t *testing.T) {
+	s := NewStripe("", "sk_test", testWebhookSecret)
+	now := time.Unix(1760000000, 0)
+	s.now = func() time.Time { return now }
+
+	t.Run("subscription created", func(t *testing.T) {
+		payload := []byte(`{"id":"evt_1","type":"customer.subscription.created","created":1760000000,
+			"data":{"object":{"id":"sub_1","customer":"cus_1","current_period_end":1762592000,
+			"metadata":{"user_id":"42","plan":"pro"}}}}`)
+		ev, err := s.ParseWebhook(payload, signedHeader(payload, now))
+		require.NoError(t, err)
+		assert.Equal(t, &Event{
+			ID:             "evt_1",
+			Type:           SubscriptionCreated,
+			UserID:         42,
+			PlanKey:        "pro",
+			CustomerID:     "cus_1",
+			SubscriptionID: "sub_1",
+			PeriodEnd:      time.Unix(1762592000, 0).UTC(),
+			OccurredAt:     now.UTC(),
+		}, ev)
+	})
+
+	t.Run("renewal invoice", func(t *testing.T) {
+		payload := []byte(`{"id":"evt_2","type":"invoice.paid","created":1760000000,
+			"data":{"object":{"customer":"cus_1","subscription":"sub_1","billing_reason":"subscription_cycle",
+			"lines":{"data":[{"period":{"end":1765184000}}]}}}}`)
+		ev, err := s.ParseWebhook(payload, signedHeader(payload, now))
+		require.NoError(t, err)
+		assert.Equal(t, SubscriptionRenewed, ev.Type)
+		assert.Equal(t, "sub_1", ev.SubscriptionID)
+		assert.Equal(t, time.Unix(1765184000, 0).UTC(), ev.PeriodEnd)
+	})
+
+	t.Run("subscription deleted", func(t *testing.T) {
+		payload := []byte(`{"id":"evt_3","type":"customer.subscription.deleted","created":1760000000,
+			"data":{"object":{"id":"sub_1","customer":"cus_1","metadata":{"user_id":"42","plan":"pro"}}}}`)
+		ev, err := s.ParseWebhook(payload, signedHeader(payload, now))
+		require.NoError(t, err)
+		assert.Equal(t, SubscriptionCancelled, ev.Type)
+	})
+
+	t.Run("unhandled types are decoded without a type", func(t *testing.T) {
+		payload := []byte(`{"id":"evt_4","type":"customer.created","created":1760000000,"data":{"object":{}}}`)
+		ev, err := s.ParseWebhook(payload, signedHeader(payload, now))
+		require.NoError(t, err)
+		assert.Empty(t, ev.Type)
+	})
+
+	t.Run("rejects invalid signatures", func(t *testing.T) {
+		payload := []byte(`{"id":"evt_5","type":"customer.subscription.created","created":1760000000}`)
+
+		tampered := signedHeader(payload, now)
+		_, err := s.ParseWebhook([]byte(`{"id":"evt_6"}`), tampered)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+
+		_, err = s.ParseWebhook(payload, signedHeader(payload, now.Add(-time.Hour)))
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+
+		_, err = s.ParseWebhook(payload, http.Header{})
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+}
+
+func TestParsePrices(t *testing.T) {
+	prices, err := ParsePrices("pro=price_1, team=price_2")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"pro": "price_1", "team": "price_2"}, prices)
+
+	_, err = ParsePrices("pro")
+	assert.Error(t, err)
+}
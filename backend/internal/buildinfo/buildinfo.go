@@ -0,0 +1,55 @@
+// Package buildinfo describes the running binary. Release builds set the
+// variables with the linker:
+//
+//	go build -ldflags "-X github.com/matsuokashuhei/morrow-backend/internal/buildinfo.Commit=$(git rev-parse HEAD) \
+//	  -X github.com/matsuokashuhei/morrow-backend/internal/buildinfo.BuildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+// Set at build time with -ldflags "-X ..."
+var (
+	// Version is the release of the API
+	Version = "0.1.0"
+	// Commit is the git SHA the binary was built from
+	Commit = ""
+	// BuildTime is when the binary was built, in RFC 3339
+	BuildTime = ""
+)
+
+// Info is reported by the health endpoints
+type Info struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildTime string `json:"build_time"`
+	GoVersion string `json:"go_version"`
+}
+
+// Get returns the build information. Without ldflags the commit and time
+// recorded by the go command are used, which are present when the binary was
+// built inside a git checkout.
+func Get() Info {
+	info := Info{
+		Version:   Version,
+		Commit:    Commit,
+		BuildTime: BuildTime,
+		GoVersion: runtime.Version(),
+	}
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		for _, s := range bi.Settings {
+			switch {
+			case s.Key == "vcs.revision" && info.Commit == "":
+				info.Commit = s.Value
+			case s.Key == "vcs.time" && info.BuildTime == "":
+				info.BuildTime = s.Value
+			}
+		}
+	}
+	if info.Commit == "" {
+		info.Commit = "unknown"
+	}
+	return info
+}
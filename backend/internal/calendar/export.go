@@ -0,0 +1,97 @@
+// Package calendar converts Morrow events to and from iCalendar.
+package calendar
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/participant"
+	"github.com/matsuokashuhei/morrow-backend/internal/ical"
+)
+
+// ProdID identifies Morrow as the producer of iCalendar documents
+const ProdID = "-//Morrow//Morrow Calendar//EN"
+
+// uidSuffix is appended to event IDs to build the UIDs of exported events
+const uidSuffix = "@morrow"
+
+// EventUID returns the iCalendar UID of an exported event
+func EventUID(id int) string {
+	return strconv.Itoa(id) + uidSuffix
+}
+
+// EventIDFromUID extracts the event ID from a UID created by EventUID
+func EventIDFromUID(uid string) (int, bool) {
+	raw, ok := strings.CutSuffix(uid, uidSuffix)
+	if !ok {
+		return 0, false
+	}
+	id, err := strconv.Atoi(raw)
+	return id, err == nil
+}
+
+// Summary returns the SUMMARY of an event: its title prefixed with the emoji
+func Summary(e *ent.Event) string {
+	if e.Emoji == "" {
+		return e.Title
+	}
+	return e.Emoji + " " + e.Title
+}
+
+// WithEdges loads the relations rendered as ORGANIZER and ATTENDEE by ToICal
+func WithEdges(q *ent.EventQuery) *ent.EventQuery {
+	return q.
+		WithCreator().
+		WithParticipants(func(pq *ent.ParticipantQuery) {
+			pq.WithUser().Order(ent.Asc(participant.FieldID))
+		})
+}
+
+// ToICal converts an event loaded by WithEdges
+func ToICal(e *ent.Event) ical.Event {
+	result := ical.Event{
+		UID:          EventUID(e.ID),
+		Sequence:     e.Sequence,
+		Summary:      Summary(e),
+		Description:  e.Description,
+		Start:        e.StartTime,
+		End:          e.EndTime,
+		TimeZone:     e.TimeZone,
+		RRule:        e.RecurrenceRule,
+		Created:      e.CreatedAt,
+		LastModified: e.UpdatedAt,
+	}
+	if e.Edges.Creator != nil {
+		result.Organizer = &ical.Person{Name: e.Edges.Creator.Name, Email: e.Edges.Creator.Email}
+	}
+	for _, p := range e.Edges.Participants {
+		if p.Edges.User == nil {
+			continue
+		}
+		result.Attendees = append(result.Attendees, ical.Attendee{
+			Person:   ical.Person{Name: p.Edges.User.Name, Email: p.Edges.User.Email},
+			Role:     attendeeRole(p.Role),
+			PartStat: partStat(p.Status),
+		})
+	}
+	return result
+}
+
+func attendeeRole(role participant.Role) string {
+	if role == participant.RoleOwner {
+		return ical.RoleChair
+	}
+	return ical.RoleReqParticipant
+}
+
+func partStat(status participant.Status) string {
+	switch status {
+	case participant.StatusAccepted:
+		return ical.PartStatAccepted
+	case participant.StatusDeclined:
+		return ical.PartStatDeclined
+	default:
+		return ical.PartStatNeedsAction
+	}
+}
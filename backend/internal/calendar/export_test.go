@@ -0,0 +1,63 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/participant"
+	"github.com/matsuokashuhei/morrow-backend/internal/ical"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToICal(t *testing.T) {
+	start := time.Date(2025, 12, 24, 18, 0, 0, 0, time.UTC)
+	e := ToICal(&ent.Event{
+		ID:             7,
+		Title:          "Launch",
+		Emoji:          "🚀",
+		StartTime:      start,
+		EndTime:        start.Add(time.Hour),
+		TimeZone:       "Asia/Tokyo",
+		RecurrenceRule: "FREQ=YEARLY",
+		Edges: ent.EventEdges{
+			Creator: &ent.User{ID: 1, Name: "Alice", Email: "alice@example.com"},
+			Participants: []*ent.Participant{
+				{
+					Role:   participant.RoleViewer,
+					Status: participant.StatusPending,
+					Edges:  ent.ParticipantEdges{User: &ent.User{ID: 2, Name: "Bob", Email: "bob@example.com"}},
+				},
+				{
+					Role:   participant.RoleOwner,
+					Status: participant.StatusDeclined,
+					Edges:  ent.ParticipantEdges{User: &ent.User{ID: 3, Name: "Carol", Email: "carol@example.com"}},
+				},
+			},
+		},
+	})
+
+	assert.Equal(t, "7@morrow", e.UID)
+	assert.Equal(t, "🚀 Launch", e.Summary)
+	assert.Equal(t, "Asia/Tokyo", e.TimeZone)
+	assert.Equal(t, "FREQ=YEARLY", e.RRule)
+	require.NotNil(t, e.Organizer)
+	assert.Equal(t, "alice@example.com", e.Organizer.Email)
+	require.Len(t, e.Attendees, 2)
+	assert.Equal(t, ical.PartStatNeedsAction, e.Attendees[0].PartStat)
+	assert.Equal(t, ical.RoleReqParticipant, e.Attendees[0].Role)
+	assert.Equal(t, ical.PartStatDeclined, e.Attendees[1].PartStat)
+	assert.Equal(t, ical.RoleChair, e.Attendees[1].Role)
+}
+
+func TestEventUID(t *testing.T) {
+	id, ok := EventIDFromUID(EventUID(42))
+	assert.True(t, ok)
+	assert.Equal(t, 42, id)
+
+	_, ok = EventIDFromUID("42@example.com")
+	assert.False(t, ok)
+	_, ok = EventIDFromUID("abc@morrow")
+	assert.False(t, ok)
+}
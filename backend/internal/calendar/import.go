@@ -0,0 +1,239 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+	"github.com/matsuokashuhei/morrow-backend/internal/entitlement"
+	"github.com/matsuokashuhei/morrow-backend/internal/ical"
+	"github.com/matsuokashuhei/morrow-backend/internal/softdelete"
+)
+
+// MaxImportEvents caps the number of VEVENTs accepted in one import
+const MaxImportEvents = 1000
+
+// untitled is used for VEVENTs without SUMMARY, since events require a title
+const untitled = "Untitled"
+
+var (
+	// ErrInvalidCalendar is returned when the file is not a readable iCalendar document
+	ErrInvalidCalendar = errors.New("invalid iCalendar file")
+	// ErrTooManyEvents is returned when the file has more than MaxImportEvents events
+	ErrTooManyEvents = fmt.Errorf("calendar contains more than %d events", MaxImportEvents)
+)
+
+// ImportStatus is the outcome of importing one VEVENT
+type ImportStatus string
+
+const (
+	ImportCreated ImportStatus = "created"
+	ImportUpdated ImportStatus = "updated"
+	ImportSkipped ImportStatus = "skipped"
+)
+
+// ImportOptions controls how imported events are created
+type ImportOptions struct {
+	// CreatorID is the user who owns the imported events
+	CreatorID int
+	// Visibility of newly created events. Existing events keep their visibility.
+	Visibility event.Visibility
+}
+
+// ImportItem reports the outcome for one VEVENT of the file
+type ImportItem struct {
+	UID     string
+	Summary string
+	Status  ImportStatus
+	// EventID is set for created and updated items
+	EventID int
+	// Reason explains why an item was skipped
+	Reason string
+}
+
+// Import creates or updates the creator's events from an iCalendar document.
+// Events are matched by UID, so importing the same file twice updates the events
+// created the first time instead of duplicating them. Events exported by Morrow
+// are matched to the original event when the creator owns it.
+// All changes are applied in one transaction.
+func Import(ctx context.Context, client *ent.Client, r io.Reader, opts ImportOptions) ([]ImportItem, error) {
+	parsed, err := ical.Parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCalendar, err)
+	}
+	if len(parsed) > MaxImportEvents {
+		return nil, ErrTooManyEvents
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	items := make([]ImportItem, 0, len(parsed))
+	seen := map[string]bool{}
+	for _, p := range parsed {
+		item := ImportItem{UID: p.UID, Summary: p.Summary}
+		switch {
+		case p.Err != nil:
+			item.Status, item.Reason = ImportSkipped, p.Err.Error()
+		case p.UID != "" && seen[p.UID]:
+			item.Status, item.Reason = ImportSkipped, "duplicate UID in file"
+		default:
+			if err := importEvent(ctx, tx.Client(), p.Event, opts, &item); err != nil {
+				return nil, err
+			}
+		}
+		if p.UID != "" {
+			seen[p.UID] = true
+		}
+		items = append(items, item)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit import: %w", err)
+	}
+	return items, nil
+}
+
+// importEvent creates or updates the event for one VEVENT and fills in item
+func importEvent(ctx context.Context, client *ent.Client, e ical.Event, opts ImportOptions, item *ImportItem) error {
+	existing, err := findImported(ctx, client, e.UID, opts.CreatorID)
+	if err != nil {
+		return err
+	}
+	// ゴミ箱にあるイベントはUIDの一意制約に残っているため、作り直さずに復元を促す
+	if existing != nil && existing.DeletedAt != nil {
+		item.Status, item.Reason = ImportSkipped, "event is in the trash"
+		return nil
+	}
+
+	title := e.Summary
+	if title == "" {
+		title = untitled
+	}
+
+	if existing == nil {
+		create := client.Event.Create().
+			SetTitle(title).
+			SetDescription(e.Description).
+			SetStartTime(e.Start).
+			SetEndTime(e.End).
+			SetVisibility(opts.Visibility).
+			SetCreatorID(opts.CreatorID)
+		if e.TimeZone != "" {
+			create = create.SetTimeZone(e.TimeZone)
+		}
+		if e.RRule != "" {
+			create = create.SetRecurrenceRule(e.RRule)
+		}
+		if e.UID != "" {
+			create = create.SetIcalUID(e.UID)
+		}
+		created, err := create.Save(ctx)
+		var quota *entitlement.QuotaError
+		if ent.IsValidationError(err) || errors.As(err, &quota) {
+			item.Status, item.Reason = ImportSkipped, err.Error()
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create event: %w", err)
+		}
+		item.Status, item.EventID = ImportCreated, created.ID
+		return nil
+	}
+
+	item.EventID = existing.ID
+	// 書き出したイベントは絵文字付きのSUMMARYになっているので、その場合はタイトルを変更しない
+	if title == Summary(existing) {
+		title = existing.Title
+	}
+	if unchanged(existing, title, e) {
+		item.Status, item.Reason = ImportSkipped, "unchanged"
+		return nil
+	}
+
+	update := existing.Update().
+		SetTitle(title).
+		SetDescription(e.Description).
+		SetStartTime(e.Start).
+		SetEndTime(e.End).
+		AddSequence(1)
+	if e.TimeZone == "" {
+		update = update.ClearTimeZone()
+	} else {
+		update = update.SetTimeZone(e.TimeZone)
+	}
+	if e.RRule == "" {
+		update = update.ClearRecurrenceRule()
+	} else {
+		update = update.SetRecurrenceRule(e.RRule)
+	}
+	err = update.Exec(ctx)
+	if ent.IsValidationError(err) {
+		item.Status, item.Reason = ImportSkipped, err.Error()
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update event: %w", err)
+	}
+	item.Status = ImportUpdated
+	return nil
+}
+
+// findImported looks up the creator's event matching uid, or returns nil
+func findImported(ctx context.Context, client *ent.Client, uid string, creatorID int) (*ent.Event, error) {
+	if uid == "" {
+		return nil, nil
+	}
+	ofCreator := event.HasCreatorWith(user.IDEQ(creatorID))
+
+	if id, ok := EventIDFromUID(uid); ok {
+		e, err := client.Event.Query().Where(event.IDEQ(id), ofCreator).Only(ctx)
+		if err == nil {
+			return e, nil
+		}
+		if !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get event: %w", err)
+		}
+	}
+
+	e, err := client.Event.Query().Where(event.IcalUIDEQ(uid), ofCreator).Only(softdelete.Skip(ctx))
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get imported event: %w", err)
+	}
+	return e, nil
+}
+
+// unchanged reports whether importing e would not modify existing
+func unchanged(existing *ent.Event, title string, e ical.Event) bool {
+	return existing.Title == title &&
+		existing.Description == e.Description &&
+		existing.StartTime.Equal(e.Start) &&
+		existing.EndTime.Equal(e.End) &&
+		existing.TimeZone == e.TimeZone &&
+		existing.RecurrenceRule == e.RRule
+}
+
+// Counts tallies the items per status
+func Counts(items []ImportItem) (created, updated, skipped int) {
+	for _, item := range items {
+		switch item.Status {
+		case ImportCreated:
+			created++
+		case ImportUpdated:
+			updated++
+		default:
+			skipped++
+		}
+	}
+	return created, updated, skipped
+}
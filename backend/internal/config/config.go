@@ -1,10 +1,24 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/internal/ratelimit"
 )
 
+// Production is the environment in which insecure defaults are rejected
+const Production = "production"
+
+// sslModes are the PostgreSQL sslmode values supported by lib/pq
+var sslModes = []string{"disable", "require", "verify-ca", "verify-full"}
+
 type Config struct {
 	Port   string
 	DBHost string
@@ -13,23 +27,166 @@ type Config struct {
 	DBUser string
 	DBPass string
 	Env    string
+
+	// Database TLS and connection pool. Zero pool values keep the database/sql defaults.
+	DBSSLMode         string
+	DBSSLRootCert     string
+	DBConnectTimeout  time.Duration
+	DBMaxOpenConns    int
+	DBMaxIdleConns    int
+	DBConnMaxLifetime time.Duration
+	DBConnMaxIdleTime time.Duration
+
+	// PublicURL is the base URL of this API as seen by clients (used in calendar feed links)
+	PublicURL string
+
+	// HTTP server timeouts (zero disables the timeout)
+	ReadTimeout     time.Duration
+	WriteTimeout    time.Duration
+	IdleTimeout     time.Duration
+	ShutdownTimeout time.Duration
+	// DrainDelay is how long /readyz fails before the server stops accepting
+	// connections on shutdown, so that load balancers stop sending requests first
+	DrainDelay time.Duration
+	// HealthCheckTimeout bounds each dependency check of /readyz and /startupz
+	HealthCheckTimeout time.Duration
+
+	// CORSAllowedOrigins are the browser origins allowed to call the API.
+	// Wildcard subdomain patterns such as https://*.example.com are allowed.
+	CORSAllowedOrigins []string
+
+	// HSTSMaxAge enables Strict-Transport-Security when positive
+	HSTSMaxAge time.Duration
+	// FrameAncestors are the origins allowed to embed the widget routes
+	FrameAncestors []string
+	// SessionCookie names the cookie of cookie-based sessions; requests carrying it are checked for CSRF
+	SessionCookie string
+
+	// Identity provider whose tokens authenticate users (empty until sign-in is enabled)
+	AuthIssuer   string
+	AuthJWKSURL  string
+	AuthAudience string
+
+	// File storage (avatars and chat attachments)
+	StorageDriver     string
+	StorageLocalDir   string
+	StorageBaseURL    string
+	StorageSigningKey string
+	S3Endpoint        string
+	S3Region          string
+	S3Bucket          string
+	S3AccessKey       string
+	S3SecretKey       string
+	S3UsePathStyle    bool
+
+	// Upload size limits in bytes
+	MaxAvatarSize     int64
+	MaxAttachmentSize int64
+
+	// Outgoing email (calendar invitations)
+	MailDriver   string
+	MailFrom     string
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+
+	// IMIPInboundSecret authenticates iMIP replies forwarded by the inbound mail gateway
+	IMIPInboundSecret string
+
+	// Subscription billing (empty provider disables paid plans)
+	BillingProvider      string
+	BillingAPIURL        string
+	BillingAPIKey        string
+	BillingWebhookSecret string
+	// BillingPrices maps plans to provider price IDs, e.g. "pro=price_123,team=price_456"
+	BillingPrices     string
+	BillingSuccessURL string
+	BillingCancelURL  string
+
+	// Deleted events and users can be restored during TrashRetention and are purged afterwards
+	TrashRetention     time.Duration
+	TrashPurgeInterval time.Duration
+
+	// Data exports can be downloaded during DataExportRetention.
+	// Accounts are closed AccountDeletionGracePeriod after their deletion is requested.
+	// JobInterval is how often both are checked in the background.
+	DataExportRetention        time.Duration
+	AccountDeletionGracePeriod time.Duration
+	JobInterval                time.Duration
+
+	// Prometheus metrics. With MetricsPort set they are served on that port only,
+	// otherwise on /metrics of the API port, requiring MetricsToken as a bearer token when set.
+	MetricsEnabled bool
+	MetricsPort    string
+	MetricsToken   string
+
+	// OpenTelemetry tracing. TracingExporter is "otlp", "stdout" for local
+	// testing, or empty to disable it. TracingSampleRatio is the share of new traces recorded.
+	TracingExporter    string
+	TracingEndpoint    string
+	TracingServiceName string
+	TracingSampleRatio float64
+
+	// Rate limits, written as "60/1m" (60 requests per minute) or empty to
+	// disable a budget. RateLimitStore is "memory" for a single server,
+	// "postgres" to share the limits between servers, or empty to disable them.
+	RateLimitStore     string
+	RateLimitGraphQL   string
+	RateLimitMutations string
+	RateLimitAuth      string
+	RateLimitPublic    string
+
+	// GraphQL operation limits, with lower ones for unauthenticated callers.
+	// Complexity counts the fields an operation may load; zero disables a limit.
+	GraphQLMaxComplexity          int
+	GraphQLMaxDepth               int
+	GraphQLAnonymousMaxComplexity int
+	GraphQLAnonymousMaxDepth      int
+
+	// Feature flags
+	GraphQLPlayground    bool
+	GraphQLIntrospection bool
+	// DevelopmentAuth accepts the fixed "development-token" bearer token
+	DevelopmentAuth bool
+	// AnonymousAccess lets requests without a token pass the event permission checks and read event chats
+	AnonymousAccess bool
+
+	// sources records where each setting came from, keyed by its file key
+	sources map[string]source
+	// loadErr is reported by Validate when New could not read the configuration
+	loadErr error
 }
 
+// New loads the configuration from the defaults, the YAML file named by
+// CONFIG_FILE and the environment. Errors are reported by Validate.
 func New() *Config {
-	return &Config{
-		Port:   getEnv("PORT", "8080"),
-		DBHost: getEnv("DB_HOST", "localhost"),
-		DBPort: getEnv("DB_PORT", "5432"),
-		DBName: getEnv("DB_NAME", "morrow_dev"),
-		DBUser: getEnv("DB_USER", "morrow_user"),
-		DBPass: getEnv("DB_PASSWORD", "morrow_password"),
-		Env:    getEnv("GO_ENV", "development"),
-	}
+	cfg, err := Load(os.Getenv("CONFIG_FILE"))
+	cfg.loadErr = err
+	return cfg
 }
 
 func (c *Config) DatabaseURL() string {
-	return fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable",
-		c.DBUser, c.DBPass, c.DBHost, c.DBPort, c.DBName)
+	sslMode := c.DBSSLMode
+	if sslMode == "" {
+		sslMode = "disable"
+	}
+	query := url.Values{"sslmode": {sslMode}}
+	if c.DBSSLRootCert != "" {
+		query.Set("sslrootcert", c.DBSSLRootCert)
+	}
+	if c.DBConnectTimeout > 0 {
+		// lib/pq は秒単位で指定する
+		query.Set("connect_timeout", strconv.Itoa(max(1, int(c.DBConnectTimeout/time.Second))))
+	}
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(c.DBUser, c.DBPass),
+		Host:     c.DBHost + ":" + c.DBPort,
+		Path:     "/" + c.DBName,
+		RawQuery: query.Encode(),
+	}
+	return u.String()
 }
 
 func (c *Config) IsDevelopment() bool {
@@ -53,6 +210,9 @@ func (c *Config) DatabaseName() string {
 
 // Validate validates the configuration
 func (c *Config) Validate() error {
+	if c.loadErr != nil {
+		return c.loadErr
+	}
 	if c.Port == "" {
 		return fmt.Errorf("port is required")
 	}
@@ -71,12 +231,219 @@ func (c *Config) Validate() error {
 	if c.DBPass == "" {
 		return fmt.Errorf("database password is required")
 	}
+	if c.DBSSLMode != "" && !slices.Contains(sslModes, c.DBSSLMode) {
+		return fmt.Errorf("unknown database SSL mode %q", c.DBSSLMode)
+	}
+	if c.DBConnectTimeout < 0 || c.DBConnMaxLifetime < 0 || c.DBConnMaxIdleTime < 0 {
+		return fmt.Errorf("database timeouts must not be negative")
+	}
+	if c.DBMaxOpenConns < 0 || c.DBMaxIdleConns < 0 {
+		return fmt.Errorf("database pool sizes must not be negative")
+	}
+	if c.DBMaxOpenConns > 0 && c.DBMaxIdleConns > c.DBMaxOpenConns {
+		return fmt.Errorf("database max idle connections must not exceed max open connections")
+	}
+	if c.ReadTimeout < 0 || c.WriteTimeout < 0 || c.IdleTimeout < 0 || c.ShutdownTimeout < 0 || c.DrainDelay < 0 {
+		return fmt.Errorf("server timeouts must not be negative")
+	}
+	if c.HealthCheckTimeout < 0 {
+		return fmt.Errorf("health check timeout must not be negative")
+	}
+	for _, origin := range c.CORSAllowedOrigins {
+		if origin == "*" {
+			// 認証情報付きのリクエストを許可するため、ワイルドカードは使えない
+			return fmt.Errorf("CORS origins must be listed explicitly instead of *")
+		}
+		if err := validateOrigin(origin); err != nil {
+			return fmt.Errorf("invalid CORS origin: %w", err)
+		}
+	}
+	if c.HSTSMaxAge < 0 {
+		return fmt.Errorf("HSTS max age must not be negative")
+	}
+	for _, origin := range c.FrameAncestors {
+		if err := validateOrigin(origin); err != nil {
+			return fmt.Errorf("invalid frame ancestor: %w", err)
+		}
+	}
+	if (c.AuthIssuer == "") != (c.AuthJWKSURL == "") {
+		return fmt.Errorf("auth issuer and JWKS URL must be set together")
+	}
+	if c.AuthJWKSURL != "" {
+		if err := validateURL(c.AuthJWKSURL); err != nil {
+			return fmt.Errorf("invalid auth JWKS URL: %w", err)
+		}
+	}
+	switch c.StorageDriver {
+	case "":
+		// ファイルアップロードを無効にする
+	case "local":
+		if c.StorageLocalDir == "" {
+			return fmt.Errorf("storage local directory is required")
+		}
+		if c.StorageSigningKey == "" {
+			return fmt.Errorf("storage signing key is required")
+		}
+	case "s3":
+		if c.S3Bucket == "" {
+			return fmt.Errorf("S3 bucket is required")
+		}
+		if c.S3AccessKey == "" || c.S3SecretKey == "" {
+			return fmt.Errorf("S3 credentials are required")
+		}
+	default:
+		return fmt.Errorf("unknown storage driver %q", c.StorageDriver)
+	}
+	if c.MaxAvatarSize < 0 || c.MaxAttachmentSize < 0 {
+		return fmt.Errorf("upload size limits must not be negative")
+	}
+	switch c.MailDriver {
+	case "":
+		// メール送信を無効にする
+	case "log", "smtp":
+		if c.MailFrom == "" {
+			return fmt.Errorf("mail from address is required")
+		}
+		if c.MailDriver == "smtp" && (c.SMTPHost == "" || c.SMTPPort == "") {
+			return fmt.Errorf("SMTP host and port are required")
+		}
+	default:
+		return fmt.Errorf("unknown mail driver %q", c.MailDriver)
+	}
+	switch c.BillingProvider {
+	case "":
+		// 有料プランの販売を無効にする
+	case "stripe":
+		if c.BillingAPIKey == "" || c.BillingWebhookSecret == "" {
+			return fmt.Errorf("billing API key and webhook secret are required")
+		}
+		if c.BillingPrices == "" {
+			return fmt.Errorf("billing prices are required")
+		}
+	default:
+		return fmt.Errorf("unknown billing provider %q", c.BillingProvider)
+	}
+	if c.TrashRetention < 0 {
+		return fmt.Errorf("trash retention must not be negative")
+	}
+	if c.TrashPurgeInterval < 0 {
+		return fmt.Errorf("trash purge interval must not be negative")
+	}
+	if c.DataExportRetention < 0 {
+		return fmt.Errorf("data export retention must not be negative")
+	}
+	if c.AccountDeletionGracePeriod < 0 {
+		return fmt.Errorf("account deletion grace period must not be negative")
+	}
+	if c.JobInterval < 0 {
+		return fmt.Errorf("job interval must not be negative")
+	}
+	if c.MetricsEnabled && c.MetricsPort != "" && c.MetricsPort == c.Port {
+		return fmt.Errorf("metrics port must differ from the API port")
+	}
+	switch c.TracingExporter {
+	case "", "otlp", "stdout":
+	default:
+		return fmt.Errorf("unknown tracing exporter %q", c.TracingExporter)
+	}
+	if c.TracingEndpoint != "" {
+		if err := validateURL(c.TracingEndpoint); err != nil {
+			return fmt.Errorf("invalid tracing endpoint: %w", err)
+		}
+	}
+	if c.TracingSampleRatio < 0 || c.TracingSampleRatio > 1 {
+		return fmt.Errorf("tracing sample ratio must be between 0 and 1")
+	}
+	switch c.RateLimitStore {
+	case "", "memory", "postgres":
+	default:
+		return fmt.Errorf("unknown rate limit store %q", c.RateLimitStore)
+	}
+	if _, err := c.RateLimits(); err != nil {
+		return err
+	}
+	if c.GraphQLMaxComplexity < 0 || c.GraphQLMaxDepth < 0 || c.GraphQLAnonymousMaxComplexity < 0 || c.GraphQLAnonymousMaxDepth < 0 {
+		return fmt.Errorf("GraphQL complexity and depth limits must not be negative")
+	}
+	if c.Env == Production {
+		return c.validateProduction()
+	}
 	return nil
 }
 
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
+// RateLimits returns the limit of each rate limit budget
+func (c *Config) RateLimits() (map[string]ratelimit.Limit, error) {
+	limits := map[string]ratelimit.Limit{}
+	for budget, value := range map[string]string{
+		ratelimit.BudgetGraphQL:  c.RateLimitGraphQL,
+		ratelimit.BudgetMutation: c.RateLimitMutations,
+		ratelimit.BudgetAuth:     c.RateLimitAuth,
+		ratelimit.BudgetPublic:   c.RateLimitPublic,
+	} {
+		limit, err := ratelimit.ParseLimit(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s rate limit: %w", budget, err)
+		}
+		limits[budget] = limit
 	}
-	return defaultValue
+	return limits, nil
+}
+
+// validateProduction rejects the settings whose defaults are only safe for local development
+func (c *Config) validateProduction() error {
+	var errs []error
+	for _, s := range c.settings() {
+		if !s.insecureDefault || c.sources[s.key].kind != sourceDefault {
+			continue
+		}
+		// 使わない設定の既定値は問題にしない
+		if s.key == "storage.signing_key" && c.StorageDriver != "local" {
+			continue
+		}
+		errs = append(errs, fmt.Errorf("%s (%s) must be set explicitly in production", s.key, s.env))
+	}
+	if c.DevelopmentAuth {
+		errs = append(errs, fmt.Errorf("features.development_auth (DEVELOPMENT_AUTH) must be disabled in production"))
+	}
+	if c.AnonymousAccess {
+		errs = append(errs, fmt.Errorf("features.anonymous_access (ANONYMOUS_ACCESS) must be disabled in production"))
+	}
+	if c.MetricsEnabled && c.MetricsPort == "" && c.MetricsToken == "" {
+		errs = append(errs, fmt.Errorf("metrics must be served on metrics.port (METRICS_PORT) or protected by metrics.token (METRICS_TOKEN) in production"))
+	}
+	if c.AuthJWKSURL != "" {
+		if u, err := url.Parse(c.AuthJWKSURL); err == nil && u.Scheme != "https" {
+			errs = append(errs, fmt.Errorf("auth.jwks_url (AUTH_JWKS_URL) must use https in production"))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// validateOrigin checks that s is an origin such as https://app.example.com,
+// or a pattern matching its subdomains such as https://*.example.com
+func validateOrigin(s string) error {
+	if err := validateURL(s); err != nil {
+		return err
+	}
+	u, _ := url.Parse(s)
+	if (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("%q is not an origin", s)
+	}
+	if rest, ok := strings.CutPrefix(u.Hostname(), "*."); (ok && (rest == "" || strings.Contains(rest, "*"))) ||
+		(!ok && strings.Contains(u.Host, "*")) {
+		return fmt.Errorf("%q may only use * as its first label, e.g. https://*.example.com", s)
+	}
+	return nil
+}
+
+// validateURL checks that s is an absolute http or https URL
+func validateURL(s string) error {
+	u, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%q is not an http or https URL", s)
+	}
+	return nil
 }
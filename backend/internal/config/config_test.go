@@ -1,10 +1,14 @@
 package config
 
 import (
+	"bytes"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNew(t *testing.T) {
@@ -28,6 +32,17 @@ func TestNew(t *testing.T) {
 	assert.Equal(t, "morrow_user", cfg.DBUser)
 	assert.Equal(t, "morrow_password", cfg.DBPass)
 	assert.Equal(t, "development", cfg.Env)
+	assert.Equal(t, "http://localhost:8080", cfg.PublicURL)
+	assert.Equal(t, "local", cfg.StorageDriver)
+	assert.Equal(t, int64(5<<20), cfg.MaxAvatarSize)
+	assert.Equal(t, int64(10<<20), cfg.MaxAttachmentSize)
+	assert.Equal(t, "log", cfg.MailDriver)
+	assert.Equal(t, "587", cfg.SMTPPort)
+	assert.Equal(t, 30*24*time.Hour, cfg.TrashRetention)
+	assert.Equal(t, time.Hour, cfg.TrashPurgeInterval)
+	assert.Equal(t, 7*24*time.Hour, cfg.DataExportRetention)
+	assert.Equal(t, 14*24*time.Hour, cfg.AccountDeletionGracePeriod)
+	assert.Equal(t, time.Minute, cfg.JobInterval)
 }
 
 func TestNewWithEnvironmentVariables(t *testing.T) {
@@ -115,6 +130,22 @@ func TestConfig_Validate(t *testing.T) {
 		{"missing db name", &Config{Port: "8080", DBHost: "host", DBPort: "5432", DBUser: "user", DBPass: "pass"}},
 		{"missing db user", &Config{Port: "8080", DBHost: "host", DBPort: "5432", DBName: "db", DBPass: "pass"}},
 		{"missing db password", &Config{Port: "8080", DBHost: "host", DBPort: "5432", DBName: "db", DBUser: "user"}},
+		{"unknown storage driver", &Config{Port: "8080", DBHost: "host", DBPort: "5432", DBName: "db", DBUser: "user", DBPass: "pass", StorageDriver: "ftp"}},
+		{"missing s3 bucket", &Config{Port: "8080", DBHost: "host", DBPort: "5432", DBName: "db", DBUser: "user", DBPass: "pass", StorageDriver: "s3", S3AccessKey: "key", S3SecretKey: "secret"}},
+		{"missing storage signing key", &Config{Port: "8080", DBHost: "host", DBPort: "5432", DBName: "db", DBUser: "user", DBPass: "pass", StorageDriver: "local", StorageLocalDir: "/tmp"}},
+		{"unknown mail driver", &Config{Port: "8080", DBHost: "host", DBPort: "5432", DBName: "db", DBUser: "user", DBPass: "pass", MailDriver: "pigeon", MailFrom: "a@example.com"}},
+		{"unknown billing provider", &Config{Port: "8080", DBHost: "host", DBPort: "5432", DBName: "db", DBUser: "user", DBPass: "pass", BillingProvider: "paypal"}},
+		{"missing billing webhook secret", &Config{Port: "8080", DBHost: "host", DBPort: "5432", DBName: "db", DBUser: "user", DBPass: "pass", BillingProvider: "stripe", BillingAPIKey: "sk_test", BillingPrices: "pro=price_1"}},
+		{"negative trash retention", &Config{Port: "8080", DBHost: "host", DBPort: "5432", DBName: "db", DBUser: "user", DBPass: "pass", TrashRetention: -time.Hour}},
+		{"negative account deletion grace period", &Config{Port: "8080", DBHost: "host", DBPort: "5432", DBName: "db", DBUser: "user", DBPass: "pass", AccountDeletionGracePeriod: -time.Hour}},
+		{"unknown tracing exporter", &Config{Port: "8080", DBHost: "host", DBPort: "5432", DBName: "db", DBUser: "user", DBPass: "pass", TracingExporter: "jaeger"}},
+		{"invalid tracing endpoint", &Config{Port: "8080", DBHost: "host", DBPort: "5432", DBName: "db", DBUser: "user", DBPass: "pass", TracingExporter: "otlp", TracingEndpoint: "otel-collector:4318"}},
+		{"tracing sample ratio above 1", &Config{Port: "8080", DBHost: "host", DBPort: "5432", DBName: "db", DBUser: "user", DBPass: "pass", TracingSampleRatio: 1.5}},
+		{"unknown rate limit store", &Config{Port: "8080", DBHost: "host", DBPort: "5432", DBName: "db", DBUser: "user", DBPass: "pass", RateLimitStore: "redis"}},
+		{"negative GraphQL depth limit", &Config{Port: "8080", DBHost: "host", DBPort: "5432", DBName: "db", DBUser: "user", DBPass: "pass", GraphQLAnonymousMaxDepth: -1}},
+		{"invalid rate limit", &Config{Port: "8080", DBHost: "host", DBPort: "5432", DBName: "db", DBUser: "user", DBPass: "pass", RateLimitMutations: "60 per minute"}},
+		{"negative drain delay", &Config{Port: "8080", DBHost: "host", DBPort: "5432", DBName: "db", DBUser: "user", DBPass: "pass", DrainDelay: -time.Second}},
+		{"missing smtp host", &Config{Port: "8080", DBHost: "host", DBPort: "5432", DBName: "db", DBUser: "user", DBPass: "pass", MailDriver: "smtp", MailFrom: "a@example.com", SMTPPort: "587"}},
 	}
 
 	for _, tt := range tests {
@@ -123,3 +154,160 @@ func TestConfig_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+server:
+  port: 9000
+  cors_allowed_origins: [https://app.example.com]
+database:
+  host: db.internal
+  user: file_user
+  max_open_conns: 50
+features:
+  graphql_playground: false
+`), 0o600))
+	secret := filepath.Join(dir, "db_password")
+	require.NoError(t, os.WriteFile(secret, []byte("s3cret\n"), 0o600))
+
+	t.Setenv("DB_USER", "env_user")
+	t.Setenv("DB_PASSWORD_FILE", secret)
+	t.Setenv("HTTP_READ_TIMEOUT", "5s")
+
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	// 設定ファイルは既定値を、環境変数は設定ファイルを上書きする
+	assert.Equal(t, "9000", cfg.Port)
+	assert.Equal(t, "db.internal", cfg.DBHost)
+	assert.Equal(t, "env_user", cfg.DBUser)
+	assert.Equal(t, "s3cret", cfg.DBPass)
+	assert.Equal(t, 50, cfg.DBMaxOpenConns)
+	assert.Equal(t, 5, cfg.DBMaxIdleConns)
+	assert.Equal(t, 5*time.Second, cfg.ReadTimeout)
+	assert.Equal(t, []string{"https://app.example.com"}, cfg.CORSAllowedOrigins)
+	assert.False(t, cfg.GraphQLPlayground)
+	assert.True(t, cfg.GraphQLIntrospection)
+	assert.Equal(t, 1.0, cfg.TracingSampleRatio)
+
+	t.Run("the example file is valid", func(t *testing.T) {
+		cfg, err := Load("../../config.example.yaml")
+		require.NoError(t, err)
+		assert.Equal(t, "verify-full", cfg.DBSSLMode)
+		assert.Equal(t, 0.1, cfg.TracingSampleRatio)
+	})
+
+	t.Run("both a variable and its file are rejected", func(t *testing.T) {
+		t.Setenv("DB_PASSWORD", "other")
+		_, err := Load("")
+		assert.ErrorContains(t, err, "DB_PASSWORD_FILE")
+	})
+
+	t.Run("unknown keys are rejected", func(t *testing.T) {
+		bad := filepath.Join(dir, "typo.yaml")
+		require.NoError(t, os.WriteFile(bad, []byte("database:\n  hots: db\n"), 0o600))
+		_, err := Load(bad)
+		assert.ErrorContains(t, err, "database.hots")
+	})
+
+	t.Run("invalid values are rejected", func(t *testing.T) {
+		t.Setenv("DB_MAX_OPEN_CONNS", "many")
+		_, err := Load("")
+		assert.ErrorContains(t, err, "DB_MAX_OPEN_CONNS")
+	})
+
+	t.Run("New reports load errors from Validate", func(t *testing.T) {
+		t.Setenv("CONFIG_FILE", filepath.Join(dir, "missing.yaml"))
+		assert.Error(t, New().Validate())
+	})
+}
+
+func TestConfig_ValidateProduction(t *testing.T) {
+	t.Setenv("GO_ENV", Production)
+
+	cfg, err := Load("")
+	require.NoError(t, err)
+	err = cfg.Validate()
+	require.Error(t, err)
+	for _, env := range []string{"DB_PASSWORD", "DB_SSLMODE", "CORS_ORIGINS", "STORAGE_SIGNING_KEY", "GRAPHQL_PLAYGROUND", "GRAPHQL_INTROSPECTION", "DEVELOPMENT_AUTH", "METRICS_PORT"} {
+		assert.ErrorContains(t, err, env)
+	}
+
+	t.Setenv("DB_PASSWORD", "strong-password")
+	t.Setenv("DB_SSLMODE", "verify-full")
+	t.Setenv("CORS_ORIGINS", "https://app.example.com")
+	t.Setenv("STORAGE_SIGNING_KEY", "random-signing-key")
+	t.Setenv("GRAPHQL_PLAYGROUND", "false")
+	t.Setenv("GRAPHQL_INTROSPECTION", "false")
+	t.Setenv("DEVELOPMENT_AUTH", "false")
+	t.Setenv("METRICS_PORT", "9090")
+	cfg, err = Load("")
+	require.NoError(t, err)
+	assert.NoError(t, cfg.Validate())
+
+	t.Run("metrics on the API port need a token", func(t *testing.T) {
+		t.Setenv("METRICS_PORT", "")
+		t.Setenv("METRICS_TOKEN", "scrape-token")
+		cfg, err := Load("")
+		require.NoError(t, err)
+		assert.NoError(t, cfg.Validate())
+	})
+
+	t.Run("anonymous access must be disabled", func(t *testing.T) {
+		t.Setenv("ANONYMOUS_ACCESS", "true")
+		cfg, err := Load("")
+		require.NoError(t, err)
+		assert.ErrorContains(t, cfg.Validate(), "ANONYMOUS_ACCESS")
+	})
+
+	t.Run("JWKS must be fetched over https", func(t *testing.T) {
+		t.Setenv("AUTH_ISSUER", "https://issuer.example.com")
+		t.Setenv("AUTH_JWKS_URL", "http://issuer.example.com/.well-known/jwks.json")
+		cfg, err := Load("")
+		require.NoError(t, err)
+		assert.ErrorContains(t, cfg.Validate(), "AUTH_JWKS_URL")
+	})
+
+	t.Run("wildcard subdomain CORS origins are allowed", func(t *testing.T) {
+		t.Setenv("CORS_ORIGINS", "https://*.example.com,https://example.com")
+		cfg, err := Load("")
+		require.NoError(t, err)
+		assert.NoError(t, cfg.Validate())
+	})
+
+	invalidOrigins := []string{"https://app.example.com/path", "https://app.*.example.com", "https://*example.com", "https://*."}
+	for _, origin := range invalidOrigins {
+		t.Run("invalid origin "+origin, func(t *testing.T) {
+			t.Setenv("CORS_ORIGINS", origin)
+			cfg, err := Load("")
+			require.NoError(t, err)
+			assert.Error(t, cfg.Validate())
+		})
+	}
+
+	t.Run("wildcard CORS origins are rejected", func(t *testing.T) {
+		t.Setenv("CORS_ORIGINS", "*")
+		cfg, err := Load("")
+		require.NoError(t, err)
+		assert.Error(t, cfg.Validate())
+	})
+}
+
+func TestConfig_WriteRedacted(t *testing.T) {
+	t.Setenv("DB_PASSWORD", "s3cret")
+	t.Setenv("DB_HOST", "db.internal")
+
+	cfg, err := Load("")
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, cfg.WriteRedacted(&buf))
+
+	out := buf.String()
+	assert.NotContains(t, out, "s3cret")
+	assert.Contains(t, out, "password: '"+Redacted+"' # env DB_PASSWORD")
+	assert.Contains(t, out, "host: db.internal # env DB_HOST")
+	assert.Contains(t, out, "name: morrow_dev # default (env DB_NAME)")
+	// 空のシークレットは未設定と分かるようそのまま出す
+	assert.Contains(t, out, `password: "" # default (env SMTP_PASSWORD)`)
+}
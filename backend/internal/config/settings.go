@@ -0,0 +1,437 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Redacted replaces secret values in printed configurations
+const Redacted = "[REDACTED]"
+
+// sourceKind tells which layer a setting was read from
+type sourceKind int
+
+const (
+	sourceDefault sourceKind = iota
+	sourceFile
+	sourceEnv
+	sourceEnvFile
+)
+
+// source is where a setting was read from
+type source struct {
+	kind sourceKind
+	// name is the file or environment variable the value was read from
+	name string
+}
+
+func (s source) String() string {
+	switch s.kind {
+	case sourceFile:
+		return "file " + s.name
+	case sourceEnv:
+		return "env " + s.name
+	case sourceEnvFile:
+		return "env " + s.name + "_FILE"
+	}
+	return "default"
+}
+
+// setting describes one configuration value: its key in the YAML file, the
+// environment variable overriding it and its default
+type setting struct {
+	key string
+	env string
+	// target is a *string, *bool, *int, *int64, *float64, *time.Duration or *[]string field of the Config
+	target any
+	def    string
+	// secret values are redacted when the configuration is printed
+	secret bool
+	// insecureDefault settings must be set explicitly in production
+	insecureDefault bool
+}
+
+// settings lists every setting in the order they are printed.
+// Keys are dotted paths in the YAML file, e.g. database.host is
+//
+//	database:
+//	  host: localhost
+func (c *Config) settings() []setting {
+	return []setting{
+		{key: "env", env: "GO_ENV", target: &c.Env, def: "development"},
+
+		{key: "server.port", env: "PORT", target: &c.Port, def: "8080"},
+		{key: "server.public_url", env: "PUBLIC_URL", target: &c.PublicURL, def: "http://localhost:8080"},
+		{key: "server.read_timeout", env: "HTTP_READ_TIMEOUT", target: &c.ReadTimeout, def: "30s"},
+		{key: "server.write_timeout", env: "HTTP_WRITE_TIMEOUT", target: &c.WriteTimeout, def: "30s"},
+		{key: "server.idle_timeout", env: "HTTP_IDLE_TIMEOUT", target: &c.IdleTimeout, def: "120s"},
+		{key: "server.shutdown_timeout", env: "HTTP_SHUTDOWN_TIMEOUT", target: &c.ShutdownTimeout, def: "30s"},
+		{key: "server.drain_delay", env: "HTTP_DRAIN_DELAY", target: &c.DrainDelay, def: "0s"},
+		{key: "server.health_check_timeout", env: "HEALTH_CHECK_TIMEOUT", target: &c.HealthCheckTimeout, def: "2s"},
+		{key: "server.cors_allowed_origins", env: "CORS_ORIGINS", target: &c.CORSAllowedOrigins,
+			def: "http://localhost:3000,http://localhost:8081,http://localhost:19000,http://localhost:19006", insecureDefault: true},
+		{key: "server.hsts_max_age", env: "HSTS_MAX_AGE", target: &c.HSTSMaxAge, def: "0s"},
+		{key: "server.frame_ancestors", env: "FRAME_ANCESTORS", target: &c.FrameAncestors},
+		{key: "server.session_cookie", env: "SESSION_COOKIE", target: &c.SessionCookie},
+
+		{key: "database.host", env: "DB_HOST", target: &c.DBHost, def: "localhost"},
+		{key: "database.port", env: "DB_PORT", target: &c.DBPort, def: "5432"},
+		{key: "database.name", env: "DB_NAME", target: &c.DBName, def: "morrow_dev"},
+		{key: "database.user", env: "DB_USER", target: &c.DBUser, def: "morrow_user"},
+		{key: "database.password", env: "DB_PASSWORD", target: &c.DBPass, def: "morrow_password", secret: true, insecureDefault: true},
+		{key: "database.ssl_mode", env: "DB_SSLMODE", target: &c.DBSSLMode, def: "disable", insecureDefault: true},
+		{key: "database.ssl_root_cert", env: "DB_SSLROOTCERT", target: &c.DBSSLRootCert},
+		{key: "database.connect_timeout", env: "DB_CONNECT_TIMEOUT", target: &c.DBConnectTimeout, def: "10s"},
+		{key: "database.max_open_conns", env: "DB_MAX_OPEN_CONNS", target: &c.DBMaxOpenConns, def: "25"},
+		{key: "database.max_idle_conns", env: "DB_MAX_IDLE_CONNS", target: &c.DBMaxIdleConns, def: "5"},
+		{key: "database.conn_max_lifetime", env: "DB_CONN_MAX_LIFETIME", target: &c.DBConnMaxLifetime, def: "30m"},
+		{key: "database.conn_max_idle_time", env: "DB_CONN_MAX_IDLE_TIME", target: &c.DBConnMaxIdleTime, def: "5m"},
+
+		{key: "auth.issuer", env: "AUTH_ISSUER", target: &c.AuthIssuer},
+		{key: "auth.jwks_url", env: "AUTH_JWKS_URL", target: &c.AuthJWKSURL},
+		{key: "auth.audience", env: "AUTH_AUDIENCE", target: &c.AuthAudience},
+
+		{key: "storage.driver", env: "STORAGE_DRIVER", target: &c.StorageDriver, def: "local"},
+		{key: "storage.local_dir", env: "STORAGE_LOCAL_DIR", target: &c.StorageLocalDir, def: "./uploads"},
+		{key: "storage.base_url", env: "STORAGE_BASE_URL", target: &c.StorageBaseURL, def: "http://localhost:8080"},
+		{key: "storage.signing_key", env: "STORAGE_SIGNING_KEY", target: &c.StorageSigningKey, def: "morrow-dev-signing-key", secret: true, insecureDefault: true},
+		{key: "storage.max_avatar_size", env: "MAX_AVATAR_SIZE", target: &c.MaxAvatarSize, def: "5242880"},
+		{key: "storage.max_attachment_size", env: "MAX_ATTACHMENT_SIZE", target: &c.MaxAttachmentSize, def: "10485760"},
+		{key: "storage.s3.endpoint", env: "S3_ENDPOINT", target: &c.S3Endpoint},
+		{key: "storage.s3.region", env: "S3_REGION", target: &c.S3Region, def: "ap-northeast-1"},
+		{key: "storage.s3.bucket", env: "S3_BUCKET", target: &c.S3Bucket},
+		{key: "storage.s3.access_key_id", env: "S3_ACCESS_KEY_ID", target: &c.S3AccessKey},
+		{key: "storage.s3.secret_access_key", env: "S3_SECRET_ACCESS_KEY", target: &c.S3SecretKey, secret: true},
+		{key: "storage.s3.use_path_style", env: "S3_USE_PATH_STYLE", target: &c.S3UsePathStyle, def: "false"},
+
+		{key: "mail.driver", env: "MAIL_DRIVER", target: &c.MailDriver, def: "log"},
+		{key: "mail.from", env: "MAIL_FROM", target: &c.MailFrom, def: "Morrow <calendar@morrow.local>"},
+		{key: "mail.smtp.host", env: "SMTP_HOST", target: &c.SMTPHost},
+		{key: "mail.smtp.port", env: "SMTP_PORT", target: &c.SMTPPort, def: "587"},
+		{key: "mail.smtp.username", env: "SMTP_USERNAME", target: &c.SMTPUsername},
+		{key: "mail.smtp.password", env: "SMTP_PASSWORD", target: &c.SMTPPassword, secret: true},
+		{key: "mail.imip_inbound_secret", env: "IMIP_INBOUND_SECRET", target: &c.IMIPInboundSecret, secret: true},
+
+		{key: "billing.provider", env: "BILLING_PROVIDER", target: &c.BillingProvider},
+		{key: "billing.api_url", env: "BILLING_API_URL", target: &c.BillingAPIURL, def: "https://api.stripe.com"},
+		{key: "billing.api_key", env: "BILLING_API_KEY", target: &c.BillingAPIKey, secret: true},
+		{key: "billing.webhook_secret", env: "BILLING_WEBHOOK_SECRET", target: &c.BillingWebhookSecret, secret: true},
+		{key: "billing.prices", env: "BILLING_PRICES", target: &c.BillingPrices},
+		{key: "billing.success_url", env: "BILLING_SUCCESS_URL", target: &c.BillingSuccessURL, def: "http://localhost:3000/billing/success"},
+		{key: "billing.cancel_url", env: "BILLING_CANCEL_URL", target: &c.BillingCancelURL, def: "http://localhost:3000/billing/cancel"},
+
+		{key: "jobs.interval", env: "JOB_INTERVAL", target: &c.JobInterval, def: "1m"},
+		{key: "jobs.trash_retention", env: "TRASH_RETENTION", target: &c.TrashRetention, def: "720h"},
+		{key: "jobs.trash_purge_interval", env: "TRASH_PURGE_INTERVAL", target: &c.TrashPurgeInterval, def: "1h"},
+		{key: "jobs.data_export_retention", env: "DATA_EXPORT_RETENTION", target: &c.DataExportRetention, def: "168h"},
+		{key: "jobs.account_deletion_grace_period", env: "ACCOUNT_DELETION_GRACE_PERIOD", target: &c.AccountDeletionGracePeriod, def: "336h"},
+
+		{key: "metrics.enabled", env: "METRICS_ENABLED", target: &c.MetricsEnabled, def: "true"},
+		{key: "metrics.port", env: "METRICS_PORT", target: &c.MetricsPort},
+		{key: "metrics.token", env: "METRICS_TOKEN", target: &c.MetricsToken, secret: true},
+		{key: "tracing.exporter", env: "TRACING_EXPORTER", target: &c.TracingExporter},
+		{key: "tracing.endpoint", env: "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", target: &c.TracingEndpoint},
+		{key: "tracing.service_name", env: "OTEL_SERVICE_NAME", target: &c.TracingServiceName, def: "morrow-backend"},
+		{key: "tracing.sample_ratio", env: "TRACING_SAMPLE_RATIO", target: &c.TracingSampleRatio, def: "1"},
+
+		{key: "ratelimit.store", env: "RATE_LIMIT_STORE", target: &c.RateLimitStore, def: "memory"},
+		{key: "ratelimit.graphql", env: "RATE_LIMIT_GRAPHQL", target: &c.RateLimitGraphQL, def: "600/1m"},
+		{key: "ratelimit.mutations", env: "RATE_LIMIT_MUTATIONS", target: &c.RateLimitMutations, def: "60/1m"},
+		{key: "ratelimit.auth", env: "RATE_LIMIT_AUTH", target: &c.RateLimitAuth, def: "10/1m"},
+		{key: "ratelimit.public", env: "RATE_LIMIT_PUBLIC", target: &c.RateLimitPublic, def: "120/1m"},
+
+		{key: "graphql.max_complexity", env: "GRAPHQL_MAX_COMPLEXITY", target: &c.GraphQLMaxComplexity, def: "10000"},
+		{key: "graphql.max_depth", env: "GRAPHQL_MAX_DEPTH", target: &c.GraphQLMaxDepth, def: "12"},
+		{key: "graphql.anonymous_max_complexity", env: "GRAPHQL_ANONYMOUS_MAX_COMPLEXITY", target: &c.GraphQLAnonymousMaxComplexity, def: "1000"},
+		{key: "graphql.anonymous_max_depth", env: "GRAPHQL_ANONYMOUS_MAX_DEPTH", target: &c.GraphQLAnonymousMaxDepth, def: "8"},
+
+		{key: "features.graphql_playground", env: "GRAPHQL_PLAYGROUND", target: &c.GraphQLPlayground, def: "true", insecureDefault: true},
+		{key: "features.graphql_introspection", env: "GRAPHQL_INTROSPECTION", target: &c.GraphQLIntrospection, def: "true", insecureDefault: true},
+		{key: "features.development_auth", env: "DEVELOPMENT_AUTH", target: &c.DevelopmentAuth, def: "true"},
+		{key: "features.anonymous_access", env: "ANONYMOUS_ACCESS", target: &c.AnonymousAccess, def: "false"},
+	}
+}
+
+// Load reads the configuration in layers: the defaults, then the YAML file
+// at path (skipped when path is empty), then the environment. Every
+// environment variable can instead be given as NAME_FILE naming a file that
+// holds the value, for secrets mounted by the container runtime.
+// The returned configuration is not validated.
+func Load(path string) (*Config, error) {
+	c := &Config{sources: map[string]source{}}
+	settings := c.settings()
+	for _, s := range settings {
+		if err := s.set(s.def); err != nil {
+			return c, fmt.Errorf("invalid default for %s: %w", s.key, err)
+		}
+		c.sources[s.key] = source{kind: sourceDefault}
+	}
+
+	if path != "" {
+		values, err := readFile(path)
+		if err != nil {
+			return c, err
+		}
+		for _, s := range settings {
+			value, ok := values[s.key]
+			if !ok {
+				continue
+			}
+			delete(values, s.key)
+			if err := s.set(value); err != nil {
+				return c, fmt.Errorf("invalid %s in %s: %w", s.key, path, err)
+			}
+			c.sources[s.key] = source{kind: sourceFile, name: path}
+		}
+		// 設定ファイルの綴り間違いを黙って無視しない
+		if len(values) > 0 {
+			unknown := make([]string, 0, len(values))
+			for key := range values {
+				unknown = append(unknown, key)
+			}
+			sort.Strings(unknown)
+			return c, fmt.Errorf("unknown settings in %s: %s", path, strings.Join(unknown, ", "))
+		}
+	}
+
+	for _, s := range settings {
+		value, src, err := lookupEnv(s.env)
+		if err != nil {
+			return c, err
+		}
+		if src.kind == sourceDefault {
+			continue
+		}
+		if err := s.set(value); err != nil {
+			return c, fmt.Errorf("invalid %s: %w", src, err)
+		}
+		c.sources[s.key] = src
+	}
+	return c, nil
+}
+
+// lookupEnv returns the value of an environment variable or of the file named by NAME_FILE.
+// Empty variables are treated as unset.
+func lookupEnv(name string) (string, source, error) {
+	value := os.Getenv(name)
+	file := os.Getenv(name + "_FILE")
+	switch {
+	case value != "" && file != "":
+		return "", source{}, fmt.Errorf("set either %s or %s_FILE, not both", name, name)
+	case value != "":
+		return value, source{kind: sourceEnv, name: name}, nil
+	case file != "":
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return "", source{}, fmt.Errorf("failed to read %s_FILE: %w", name, err)
+		}
+		// シークレットのファイルは末尾に改行が付いていることが多い
+		return strings.TrimRight(string(b), "\r\n"), source{kind: sourceEnvFile, name: name}, nil
+	}
+	return "", source{kind: sourceDefault}, nil
+}
+
+// readFile reads a YAML file into values keyed by dotted paths
+func readFile(path string) (map[string]any, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	var doc map[string]any
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+	values := map[string]any{}
+	flatten("", doc, values)
+	return values, nil
+}
+
+// flatten stores the leaves of a nested mapping under their dotted paths
+func flatten(prefix string, doc map[string]any, values map[string]any) {
+	for key, value := range doc {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		if nested, ok := value.(map[string]any); ok {
+			flatten(key, nested, values)
+			continue
+		}
+		values[key] = value
+	}
+}
+
+// set parses value into the setting's field. value is a string from the
+// environment or a default, or a YAML scalar or list from the file.
+func (s setting) set(value any) error {
+	if list, ok := value.([]any); ok {
+		target, ok := s.target.(*[]string)
+		if !ok {
+			return fmt.Errorf("a single value is expected")
+		}
+		*target = make([]string, 0, len(list))
+		for _, item := range list {
+			*target = append(*target, fmt.Sprint(item))
+		}
+		return nil
+	}
+	text := ""
+	if value != nil {
+		text = fmt.Sprint(value)
+	}
+
+	switch target := s.target.(type) {
+	case *string:
+		*target = text
+	case *bool:
+		if text == "" {
+			*target = false
+			return nil
+		}
+		v, err := strconv.ParseBool(text)
+		if err != nil {
+			return fmt.Errorf("%q is not a boolean", text)
+		}
+		*target = v
+	case *int:
+		if text == "" {
+			*target = 0
+			return nil
+		}
+		v, err := strconv.Atoi(text)
+		if err != nil {
+			return fmt.Errorf("%q is not an integer", text)
+		}
+		*target = v
+	case *int64:
+		if text == "" {
+			*target = 0
+			return nil
+		}
+		v, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%q is not an integer", text)
+		}
+		*target = v
+	case *float64:
+		if text == "" {
+			*target = 0
+			return nil
+		}
+		v, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return fmt.Errorf("%q is not a number", text)
+		}
+		*target = v
+	case *time.Duration:
+		if text == "" {
+			*target = 0
+			return nil
+		}
+		v, err := time.ParseDuration(text)
+		if err != nil {
+			return fmt.Errorf("%q is not a duration such as 30s or 1h", text)
+		}
+		*target = v
+	case *[]string:
+		*target = nil
+		for _, item := range strings.Split(text, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				*target = append(*target, item)
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported setting type %T", s.target)
+	}
+	return nil
+}
+
+// node returns the YAML node of the setting's current value
+func (s setting) node() *yaml.Node {
+	scalar := func(tag, value string) *yaml.Node {
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: value}
+	}
+	switch target := s.target.(type) {
+	case *string:
+		if s.secret && *target != "" {
+			return scalar("!!str", Redacted)
+		}
+		return scalar("!!str", *target)
+	case *bool:
+		return scalar("!!bool", strconv.FormatBool(*target))
+	case *int:
+		return scalar("!!int", strconv.Itoa(*target))
+	case *int64:
+		return scalar("!!int", strconv.FormatInt(*target, 10))
+	case *float64:
+		return scalar("!!float", strconv.FormatFloat(*target, 'g', -1, 64))
+	case *time.Duration:
+		return scalar("!!str", target.String())
+	case *[]string:
+		list := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		for _, item := range *target {
+			list.Content = append(list.Content, scalar("!!str", item))
+		}
+		return list
+	}
+	return scalar("!!null", "")
+}
+
+// WriteRedacted writes the effective configuration as YAML in the layout of
+// the config file. Secrets are replaced with Redacted and every value is
+// annotated with the layer it was read from.
+func (c *Config) WriteRedacted(w io.Writer) error {
+	root := &yaml.Node{Kind: yaml.MappingNode}
+	for _, s := range c.settings() {
+		parent := root
+		parts := strings.Split(s.key, ".")
+		for _, part := range parts[:len(parts)-1] {
+			parent = child(parent, part)
+		}
+		key := &yaml.Node{Kind: yaml.ScalarNode, Value: parts[len(parts)-1]}
+		value := s.node()
+		src := c.sources[s.key]
+		comment := src.String()
+		if src.kind == sourceDefault || src.kind == sourceFile {
+			comment += " (env " + s.env + ")"
+		}
+		// リストの値では項目の後ろではなくキーの後ろに出す
+		if value.Kind == yaml.SequenceNode {
+			key.LineComment = comment
+		} else {
+			value.LineComment = comment
+		}
+		parent.Content = append(parent.Content, key, value)
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{root}}); err != nil {
+		return fmt.Errorf("failed to encode configuration: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return fmt.Errorf("failed to encode configuration: %w", err)
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// child returns the mapping stored under key in parent, adding it when missing
+func child(parent *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(parent.Content); i += 2 {
+		if parent.Content[i].Value == key {
+			return parent.Content[i+1]
+		}
+	}
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	parent.Content = append(parent.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, node)
+	return node
+}
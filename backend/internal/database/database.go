@@ -2,18 +2,28 @@ package database
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	_ "github.com/lib/pq" // PostgreSQLドライバー
 	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/internal/audit"
+	"github.com/matsuokashuhei/morrow-backend/internal/billing"
 	"github.com/matsuokashuhei/morrow-backend/internal/config"
+	"github.com/matsuokashuhei/morrow-backend/internal/entitlement"
+	"github.com/matsuokashuhei/morrow-backend/internal/metrics"
+	"github.com/matsuokashuhei/morrow-backend/internal/softdelete"
+	"github.com/matsuokashuhei/morrow-backend/internal/tracing"
 	"github.com/sirupsen/logrus"
 )
 
 // Client wraps the Ent client with additional functionality
 type Client struct {
 	*ent.Client
+	db     *sql.DB
 	logger *logrus.Logger
 }
 
@@ -28,11 +38,20 @@ func NewClient(cfg *config.Config, logger *logrus.Logger) (*Client, error) {
 		"name": cfg.DatabaseName(),
 	}).Info("Connecting to PostgreSQL database")
 
-	// Entクライアントを作成
-	entClient, err := ent.Open("postgres", dsn)
+	// マイグレーションでも同じ接続プールを使えるよう database/sql から Entクライアントを作成
+	db, err := sql.Open("postgres", dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database connection: %w", err)
 	}
+	// 0 の場合は database/sql の既定値のまま
+	db.SetMaxOpenConns(cfg.DBMaxOpenConns)
+	if cfg.DBMaxIdleConns > 0 {
+		db.SetMaxIdleConns(cfg.DBMaxIdleConns)
+	}
+	db.SetConnMaxLifetime(cfg.DBConnMaxLifetime)
+	db.SetConnMaxIdleTime(cfg.DBConnMaxIdleTime)
+	// クエリの所要時間をメトリクスに、クエリごとのスパンをトレースに記録する
+	entClient := ent.NewClient(ent.Driver(metrics.Driver(tracing.Driver(entsql.OpenDB(dialect.Postgres, db)))))
 
 	// 接続テスト（Entクライアントを使用）
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -46,12 +65,26 @@ func NewClient(cfg *config.Config, logger *logrus.Logger) (*Client, error) {
 
 	logger.Info("Successfully connected to PostgreSQL database")
 
+	// 削除をゴミ箱への移動に置き換える (他のフックが削除を更新として扱えるよう最初に登録する)
+	softdelete.Register(entClient)
+	// プランの上限をすべての作成処理に適用する
+	entitlement.Register(entClient)
+	billing.Register(entClient)
+	// ユーザー・イベント・参加者の変更を監査ログに記録する
+	audit.Register(entClient)
+
 	return &Client{
 		Client: entClient,
+		db:     db,
 		logger: logger,
 	}, nil
 }
 
+// DB returns the underlying connection pool
+func (c *Client) DB() *sql.DB {
+	return c.db
+}
+
 // Close closes the database connection
 func (c *Client) Close() error {
 	if err := c.Client.Close(); err != nil {
@@ -68,21 +101,15 @@ func (c *Client) HealthCheck(ctx context.Context) error {
 	pingCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	// Use a simple transaction to test database connectivity
-	// This should work even if tables don't exist yet
-	tx, err := c.Client.Tx(pingCtx)
-	if err != nil {
+	// トランザクションを開かずに接続だけを確認する（テーブルがなくても動作する）
+	if err := c.db.PingContext(pingCtx); err != nil {
 		return fmt.Errorf("database health check failed: %w", err)
 	}
-	// Immediately rollback since we're just testing connectivity
-	if rollbackErr := tx.Rollback(); rollbackErr != nil {
-		c.logger.WithError(rollbackErr).Debug("Health check transaction rollback note")
-	}
-
 	return nil
 }
 
-// AutoMigrate runs the auto migration tool
+// AutoMigrate creates the schema from the Ent definitions without versioned migrations.
+// Servers use the migrate command instead.
 func (c *Client) AutoMigrate(ctx context.Context) error {
 	c.logger.Info("Starting database migration")
 
@@ -90,6 +117,10 @@ func (c *Client) AutoMigrate(ctx context.Context) error {
 		c.logger.WithError(err).Error("Database migration failed")
 		return fmt.Errorf("failed to create schema resources: %w", err)
 	}
+	if err := entitlement.EnsurePlans(ctx, c.Client); err != nil {
+		c.logger.WithError(err).Error("Failed to create default plans")
+		return err
+	}
 
 	c.logger.Info("Database migration completed successfully")
 	return nil
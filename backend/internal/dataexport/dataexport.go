@@ -0,0 +1,258 @@
+// Package dataexport builds zip archives of everything stored about a user
+// so that they can download their personal data. Archives are built by a
+// background Worker and removed once their retention window has passed.
+package dataexport
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/attachment"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/message"
+	"github.com/matsuokashuhei/morrow-backend/ent/participant"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+	"github.com/matsuokashuhei/morrow-backend/internal/softdelete"
+	"github.com/matsuokashuhei/morrow-backend/internal/storage"
+)
+
+// KeyPrefix is the storage prefix under which archives are stored
+const KeyPrefix = "exports"
+
+// Profile is the user record in profile.json
+type Profile struct {
+	ID                  int        `json:"id"`
+	Email               string     `json:"email"`
+	Name                string     `json:"name"`
+	AvatarURL           string     `json:"avatar_url,omitempty"`
+	CognitoID           string     `json:"cognito_id,omitempty"`
+	Plan                string     `json:"plan,omitempty"`
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+}
+
+// Event is an event created by the user in events.json
+type Event struct {
+	ID             int        `json:"id"`
+	Title          string     `json:"title"`
+	Description    string     `json:"description,omitempty"`
+	StartTime      time.Time  `json:"start_time"`
+	EndTime        time.Time  `json:"end_time"`
+	TimeZone       string     `json:"time_zone,omitempty"`
+	RecurrenceRule string     `json:"recurrence_rule,omitempty"`
+	Visibility     string     `json:"visibility"`
+	Emoji          string     `json:"emoji,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+	DeletedAt      *time.Time `json:"deleted_at,omitempty"`
+}
+
+// Participation is an event the user was invited to in participations.json
+type Participation struct {
+	ID         int       `json:"id"`
+	EventID    int       `json:"event_id"`
+	EventTitle string    `json:"event_title,omitempty"`
+	Role       string    `json:"role"`
+	Status     string    `json:"status"`
+	JoinedAt   time.Time `json:"joined_at"`
+}
+
+// Message is a chat message written by the user in messages.json
+type Message struct {
+	ID          int        `json:"id"`
+	EventID     int        `json:"event_id"`
+	Body        string     `json:"body"`
+	Attachments []string   `json:"attachments,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	EditedAt    *time.Time `json:"edited_at,omitempty"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+}
+
+// Write writes the personal data of a user to w as a zip archive containing
+// profile.json, events.json, participations.json, messages.json and the
+// uploaded files below files/. Events and messages in the trash are included.
+// Files are skipped when st is nil or the object no longer exists.
+func Write(ctx context.Context, client *ent.Client, st storage.Storage, userID int, w io.Writer) error {
+	ctx = softdelete.Skip(ctx)
+	u, err := client.User.Query().
+		Where(user.IDEQ(userID)).
+		WithSubscription(func(q *ent.SubscriptionQuery) { q.WithPlan() }).
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	zw := zip.NewWriter(w)
+	files := map[string]string{}
+
+	profile := Profile{
+		ID:                  u.ID,
+		Email:               u.Email,
+		Name:                u.Name,
+		AvatarURL:           u.AvatarURL,
+		CognitoID:           u.CognitoID,
+		DeletionScheduledAt: u.DeletionScheduledAt,
+		CreatedAt:           u.CreatedAt,
+		UpdatedAt:           u.UpdatedAt,
+	}
+	if s := u.Edges.Subscription; s != nil && s.Edges.Plan != nil {
+		profile.Plan = s.Edges.Plan.Key
+	}
+	if key, ok := storage.KeyFromRef(u.AvatarURL); ok {
+		name := "files/avatar" + path.Ext(key)
+		profile.AvatarURL = name
+		files[name] = key
+	}
+	if err := writeJSON(zw, "profile.json", profile); err != nil {
+		return err
+	}
+
+	created, err := client.Event.Query().
+		Where(event.HasCreatorWith(user.IDEQ(userID))).
+		Order(ent.Asc(event.FieldID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get events: %w", err)
+	}
+	events := make([]Event, 0, len(created))
+	for _, e := range created {
+		events = append(events, Event{
+			ID:             e.ID,
+			Title:          e.Title,
+			Description:    e.Description,
+			StartTime:      e.StartTime,
+			EndTime:        e.EndTime,
+			TimeZone:       e.TimeZone,
+			RecurrenceRule: e.RecurrenceRule,
+			Visibility:     string(e.Visibility),
+			Emoji:          e.Emoji,
+			CreatedAt:      e.CreatedAt,
+			UpdatedAt:      e.UpdatedAt,
+			DeletedAt:      e.DeletedAt,
+		})
+	}
+	if err := writeJSON(zw, "events.json", events); err != nil {
+		return err
+	}
+
+	joined, err := client.Participant.Query().
+		Where(participant.HasUserWith(user.IDEQ(userID))).
+		WithEvent().
+		Order(ent.Asc(participant.FieldID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get participations: %w", err)
+	}
+	participations := make([]Participation, 0, len(joined))
+	for _, p := range joined {
+		record := Participation{
+			ID:       p.ID,
+			Role:     string(p.Role),
+			Status:   string(p.Status),
+			JoinedAt: p.JoinedAt,
+		}
+		if p.Edges.Event != nil {
+			record.EventID = p.Edges.Event.ID
+			record.EventTitle = p.Edges.Event.Title
+		}
+		participations = append(participations, record)
+	}
+	if err := writeJSON(zw, "participations.json", participations); err != nil {
+		return err
+	}
+
+	written, err := client.Message.Query().
+		Where(message.HasAuthorWith(user.IDEQ(userID))).
+		WithEvent().
+		WithAttachments(func(q *ent.AttachmentQuery) {
+			q.Where(attachment.HasUploaderWith(user.IDEQ(userID))).Order(ent.Asc(attachment.FieldID))
+		}).
+		Order(ent.Asc(message.FieldID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get messages: %w", err)
+	}
+	messages := make([]Message, 0, len(written))
+	for _, m := range written {
+		record := Message{
+			ID:        m.ID,
+			Body:      m.Body,
+			CreatedAt: m.CreatedAt,
+			EditedAt:  m.EditedAt,
+			DeletedAt: m.DeletedAt,
+		}
+		if m.Edges.Event != nil {
+			record.EventID = m.Edges.Event.ID
+		}
+		for _, a := range m.Edges.Attachments {
+			name := "files/attachments/" + strconv.Itoa(a.ID) + path.Ext(a.Key)
+			record.Attachments = append(record.Attachments, name)
+			files[name] = a.Key
+		}
+		messages = append(messages, record)
+	}
+	if err := writeJSON(zw, "messages.json", messages); err != nil {
+		return err
+	}
+
+	if st != nil {
+		names := make([]string, 0, len(files))
+		for name := range files {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if err := copyObject(ctx, zw, st, name, files[name]); err != nil {
+				return err
+			}
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("failed to finish archive: %w", err)
+	}
+	return nil
+}
+
+func writeJSON(zw *zip.Writer, name string, v any) error {
+	f, err := zw.Create(name)
+	if err != nil {
+		return fmt.Errorf("failed to add %s: %w", name, err)
+	}
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
+
+func copyObject(ctx context.Context, zw *zip.Writer, st storage.Storage, name, key string) error {
+	r, _, err := st.Get(ctx, key)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", key, err)
+	}
+	defer r.Close()
+
+	// 画像は圧縮済みなので、そのまま格納する
+	f, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store})
+	if err != nil {
+		return fmt.Errorf("failed to add %s: %w", name, err)
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		return fmt.Errorf("failed to copy %s: %w", key, err)
+	}
+	return nil
+}
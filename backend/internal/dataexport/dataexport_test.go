@@ -0,0 +1,61 @@
+package dataexport
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/matsuokashuhei/morrow-backend/ent/dataexport"
+	"github.com/matsuokashuhei/morrow-backend/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveEntries(t *testing.T) {
+	ctx := context.Background()
+	st, err := storage.NewLocal(t.TempDir(), "http://localhost:8080", []byte("secret"))
+	require.NoError(t, err)
+	require.NoError(t, st.Put(ctx, "avatars/1/a.png", strings.NewReader("png"), 3, "image/png"))
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	require.NoError(t, writeJSON(zw, "profile.json", Profile{ID: 1, Email: "a@example.com", Name: "Alice"}))
+	require.NoError(t, copyObject(ctx, zw, st, "files/avatar.png", "avatars/1/a.png"))
+	// 削除済みのファイルは飛ばす
+	require.NoError(t, copyObject(ctx, zw, st, "files/attachments/2.png", "attachments/1/missing.png"))
+	require.NoError(t, zw.Close())
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	contents := map[string]string{}
+	for _, f := range zr.File {
+		r, err := f.Open()
+		require.NoError(t, err)
+		b, err := io.ReadAll(r)
+		require.NoError(t, err)
+		contents[f.Name] = string(b)
+	}
+
+	assert.Len(t, contents, 2)
+	assert.Contains(t, contents["profile.json"], `"email": "a@example.com"`)
+	assert.NotContains(t, contents["profile.json"], "avatar_url")
+	assert.Equal(t, "png", contents["files/avatar.png"])
+}
+
+func TestAbandoned(t *testing.T) {
+	now := time.Date(2025, 12, 24, 12, 0, 0, 0, time.UTC)
+	s := sql.Dialect(dialect.Postgres).Select("*").From(sql.Table(dataexport.Table))
+	abandoned(now)(s)
+	query, args := s.Query()
+
+	// claimed_at が NULL の行も否定を通さずに選ぶ
+	assert.Contains(t, query, `"data_exports"."claimed_at" IS NULL OR "data_exports"."claimed_at" < $2`)
+	assert.NotContains(t, query, "NOT")
+	assert.Equal(t, []any{dataexport.StatusProcessing, now.Add(-ProcessingTimeout)}, args)
+}
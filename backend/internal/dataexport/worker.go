@@ -0,0 +1,238 @@
+package dataexport
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/dataexport"
+	"github.com/matsuokashuhei/morrow-backend/ent/predicate"
+	"github.com/matsuokashuhei/morrow-backend/internal/metrics"
+	"github.com/matsuokashuhei/morrow-backend/internal/storage"
+	"github.com/sirupsen/logrus"
+)
+
+// ProcessingTimeout is how long an export may stay processing. After that the
+// server building it is assumed to have stopped and the export is marked as failed.
+const ProcessingTimeout = time.Hour
+
+var (
+	errUserDeleted = errors.New("the user has been deleted")
+	errAbandoned   = errors.New("the export was not finished in time")
+)
+
+// InProgress matches the exports that are waiting or still being built at now
+func InProgress(now time.Time) predicate.DataExport {
+	return dataexport.Or(
+		dataexport.StatusEQ(dataexport.StatusPending),
+		dataexport.And(
+			dataexport.StatusEQ(dataexport.StatusProcessing),
+			dataexport.ClaimedAtGTE(now.Add(-ProcessingTimeout)),
+		),
+	)
+}
+
+// abandoned matches the exports left processing for longer than ProcessingTimeout at now.
+// Exports claimed before claimed_at was recorded have no claim time and count as abandoned.
+func abandoned(now time.Time) predicate.DataExport {
+	return dataexport.And(
+		dataexport.StatusEQ(dataexport.StatusProcessing),
+		dataexport.Or(
+			dataexport.ClaimedAtIsNil(),
+			dataexport.ClaimedAtLT(now.Add(-ProcessingTimeout)),
+		),
+	)
+}
+
+// Worker builds requested archives and deletes them once they expire
+type Worker struct {
+	client    *ent.Client
+	storage   storage.Storage
+	retention time.Duration
+	interval  time.Duration
+	logger    *logrus.Logger
+}
+
+// NewWorker returns a worker checking for requested exports every interval.
+// Archives can be downloaded during retention after they are built.
+func NewWorker(client *ent.Client, st storage.Storage, retention, interval time.Duration, logger *logrus.Logger) *Worker {
+	return &Worker{
+		client:    client,
+		storage:   st,
+		retention: retention,
+		interval:  interval,
+		logger:    logger,
+	}
+}
+
+// Run processes exports immediately and then every interval until ctx is canceled
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		start := time.Now()
+		n, err := w.Process(ctx)
+		metrics.ObserveJob("data_export", start, n, err)
+		if err != nil {
+			w.logger.WithError(err).Error("Failed to process data exports")
+		} else if n > 0 {
+			w.logger.WithField("count", n).Info("Built data exports")
+		}
+		start = time.Now()
+		err = w.Expire(ctx)
+		metrics.ObserveJob("data_export_expiry", start, 0, err)
+		if err != nil {
+			w.logger.WithError(err).Error("Failed to expire data exports")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Process builds the pending exports and returns how many became ready.
+// Exports that fail, or that another worker left processing for longer than
+// ProcessingTimeout, are marked as failed with the reason.
+func (w *Worker) Process(ctx context.Context) (int, error) {
+	if err := w.failAbandoned(ctx); err != nil {
+		return 0, err
+	}
+	pending, err := w.client.DataExport.Query().
+		Where(dataexport.StatusEQ(dataexport.StatusPending)).
+		WithUser().
+		Order(ent.Asc(dataexport.FieldID)).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get pending exports: %w", err)
+	}
+	metrics.SetJobPending("data_export", len(pending))
+
+	built := 0
+	for _, e := range pending {
+		// 複数のサーバーで動かしても同じエクスポートを二重に作らない
+		claimed, err := w.client.DataExport.Update().
+			Where(dataexport.IDEQ(e.ID), dataexport.StatusEQ(dataexport.StatusPending)).
+			SetStatus(dataexport.StatusProcessing).
+			SetClaimedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return built, fmt.Errorf("failed to claim export: %w", err)
+		}
+		if claimed == 0 {
+			continue
+		}
+
+		// 依頼の後に退会したユーザーのエクスポートは作らない
+		key, size, err := "", int64(0), errUserDeleted
+		if e.Edges.User != nil {
+			key, size, err = w.build(ctx, e.Edges.User.ID)
+		}
+		if err != nil {
+			w.logger.WithError(err).WithField("export_id", e.ID).Warn("Failed to build data export")
+			if err := w.client.DataExport.UpdateOneID(e.ID).
+				SetStatus(dataexport.StatusFailed).
+				SetError(err.Error()).
+				Exec(ctx); err != nil {
+				return built, fmt.Errorf("failed to record export failure: %w", err)
+			}
+			continue
+		}
+
+		now := time.Now()
+		if err := w.client.DataExport.UpdateOneID(e.ID).
+			SetStatus(dataexport.StatusReady).
+			SetKey(key).
+			SetSize(size).
+			SetCompletedAt(now).
+			SetExpiresAt(now.Add(w.retention)).
+			Exec(ctx); err != nil {
+			_ = w.storage.Delete(ctx, key)
+			return built, fmt.Errorf("failed to complete export: %w", err)
+		}
+		built++
+	}
+	return built, nil
+}
+
+// failAbandoned marks the exports whose worker stopped while building them as failed,
+// so that the user can request a new one
+func (w *Worker) failAbandoned(ctx context.Context) error {
+	n, err := w.client.DataExport.Update().
+		Where(abandoned(time.Now())).
+		SetStatus(dataexport.StatusFailed).
+		SetError(errAbandoned.Error()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fail abandoned exports: %w", err)
+	}
+	if n > 0 {
+		w.logger.WithField("count", n).Warn("Marked abandoned data exports as failed")
+	}
+	return nil
+}
+
+// build writes the archive of a user to a temporary file and stores it, returning its key and size
+func (w *Worker) build(ctx context.Context, userID int) (string, int64, error) {
+	// アップロードされたファイルを含むためメモリではなく一時ファイルに書き出す
+	f, err := os.CreateTemp("", "morrow-export-*.zip")
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+
+	if err := Write(ctx, w.client, w.storage, userID, f); err != nil {
+		return "", 0, err
+	}
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to measure archive: %w", err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", 0, fmt.Errorf("failed to rewind archive: %w", err)
+	}
+
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", 0, fmt.Errorf("failed to generate object key: %w", err)
+	}
+	key := fmt.Sprintf("%s/%d/%s.zip", KeyPrefix, userID, hex.EncodeToString(b))
+	if err := w.storage.Put(ctx, key, f, size, "application/zip"); err != nil {
+		return "", 0, fmt.Errorf("failed to store archive: %w", err)
+	}
+	return key, size, nil
+}
+
+// Expire deletes the archives whose retention window has passed
+func (w *Worker) Expire(ctx context.Context) error {
+	expired, err := w.client.DataExport.Query().
+		Where(dataexport.StatusEQ(dataexport.StatusReady), dataexport.ExpiresAtLT(time.Now())).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get expired exports: %w", err)
+	}
+	for _, e := range expired {
+		if err := w.storage.Delete(ctx, e.Key); err != nil {
+			w.logger.WithError(err).WithField("export_id", e.ID).Warn("Failed to delete expired data export")
+			continue
+		}
+		if err := w.client.DataExport.UpdateOneID(e.ID).
+			SetStatus(dataexport.StatusExpired).
+			ClearKey().
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to expire export: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package emoji
+
+import (
+	"errors"
+	"unicode/utf8"
+)
+
+// maxRunes bounds the length of a single emoji sequence.
+// The longest standard sequences (e.g. family with skin tones) stay well below this.
+const maxRunes = 16
+
+var (
+	// ErrEmpty is returned for an empty string
+	ErrEmpty = errors.New("emoji must not be empty")
+	// ErrTooLong is returned when the value is longer than a single emoji sequence
+	ErrTooLong = errors.New("emoji is too long")
+	// ErrInvalid is returned when the value is not exactly one emoji
+	ErrInvalid = errors.New("value is not a single emoji")
+)
+
+// Validate checks that s is a single emoji such as "🎉", "👍🏽", "❤️", "🇯🇵", "1️⃣"
+// or "👨‍👩‍👧": one emoji, optionally joined to others by zero width joiners
+// into one character. Runs of several emoji such as "🎉🎉" are rejected.
+// It is used as the ent validator for Event.emoji and Reaction.emoji.
+func Validate(s string) error {
+	if s == "" {
+		return ErrEmpty
+	}
+	if utf8.RuneCountInString(s) > maxRunes {
+		return ErrTooLong
+	}
+
+	rs := []rune(s)
+	i, ok := element(rs, 0)
+	for ok && i < len(rs) && rs[i] == zeroWidthJoiner {
+		i, ok = element(rs, i+1)
+	}
+	if !ok || i != len(rs) {
+		return ErrInvalid
+	}
+	return nil
+}
+
+// element reads the emoji starting at rs[i], with its modifiers, and returns
+// the index following it
+func element(rs []rune, i int) (int, bool) {
+	if i >= len(rs) {
+		return i, false
+	}
+	r := rs[i]
+	switch {
+	case isRegionalIndicator(r):
+		// 国旗は地域指示子 2 文字で 1 つ
+		if i+1 < len(rs) && isRegionalIndicator(rs[i+1]) {
+			return i + 2, true
+		}
+		return i, false
+	case isKeycapBase(r):
+		i++
+		if i < len(rs) && isVariationSelector(rs[i]) {
+			i++
+		}
+		if i < len(rs) && rs[i] == keycap {
+			return i + 1, true
+		}
+		return i, false
+	case isPictograph(r) && !isSkinTone(r):
+		i++
+		if i < len(rs) && isVariationSelector(rs[i]) {
+			i++
+		}
+		if i < len(rs) && isSkinTone(rs[i]) {
+			i++
+		}
+		if i < len(rs) && isTag(rs[i]) {
+			// 地域旗はタグ文字の列と終端タグで終わる
+			for i < len(rs) && isTag(rs[i]) && rs[i] != cancelTag {
+				i++
+			}
+			if i < len(rs) && rs[i] == cancelTag {
+				return i + 1, true
+			}
+			return i, false
+		}
+		return i, true
+	}
+	return i, false
+}
+
+// ValidateOptional is like Validate but accepts the empty string, for optional fields
+func ValidateOptional(s string) error {
+	if s == "" {
+		return nil
+	}
+	return Validate(s)
+}
+
+const (
+	zeroWidthJoiner = 0x200D
+	keycap          = 0x20E3
+	cancelTag       = 0xE007F
+)
+
+// isPictograph reports whether r can stand on its own as an emoji
+func isPictograph(r rune) bool {
+	switch {
+	case r >= 0x1F000 && r <= 0x1FAFF: // 絵文字・記号
+		return true
+	case r >= 0x2300 && r <= 0x23FF: // その他の技術用記号 (⌚ ⏰ など)
+		return true
+	case r >= 0x2600 && r <= 0x27BF: // その他の記号・装飾記号 (☀ ❤ ✨ など)
+		return true
+	}
+	switch r {
+	case 0x00A9, 0x00AE, 0x203C, 0x2049, 0x2122, 0x2139, 0x24C2, 0x2934, 0x2935, 0x3030, 0x303D, 0x3297, 0x3299,
+		// 矢印・図形のうち絵文字として表示されるもの (↔ ↩ ▶ ◀ ⬆ ⭐ など)
+		0x2194, 0x2195, 0x2196, 0x2197, 0x2198, 0x2199, 0x21A9, 0x21AA,
+		0x25AA, 0x25AB, 0x25B6, 0x25C0, 0x25FB, 0x25FC, 0x25FD, 0x25FE,
+		0x2B05, 0x2B06, 0x2B07, 0x2B1B, 0x2B1C, 0x2B50, 0x2B55:
+		return true
+	}
+	return false
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+// isVariationSelector reports whether r selects the text or emoji presentation
+func isVariationSelector(r rune) bool {
+	return r == 0xFE0E || r == 0xFE0F
+}
+
+func isSkinTone(r rune) bool {
+	return r >= 0x1F3FB && r <= 0x1F3FF
+}
+
+// isTag reports whether r is a tag character of subdivision flags such as 🏴󠁧󠁢󠁳󠁣󠁴󠁿
+func isTag(r rune) bool {
+	return r >= 0xE0020 && r <= 0xE007F
+}
+
+// isKeycapBase reports whether r can start a keycap sequence such as "1️⃣"
+func isKeycapBase(r rune) bool {
+	return (r >= '0' && r <= '9') || r == '#' || r == '*'
+}
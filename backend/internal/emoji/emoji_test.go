@@ -0,0 +1,53 @@
+package emoji
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	valid := []string{
+		"🎉",
+		"👍",
+		"👍🏽",
+		"❤️",
+		"⭐",
+		"👨‍👩‍👧",
+		"🇯🇵",
+		"1️⃣",
+		"🏴󠁧󠁢󠁳󠁣󠁴󠁿",
+		"❤️‍🔥",
+		"🏌🏽‍♂️",
+		"↔️",
+		"▶️",
+		"⬆️",
+	}
+	for _, s := range valid {
+		assert.NoError(t, Validate(s), s)
+	}
+
+	assert.ErrorIs(t, Validate(""), ErrEmpty)
+	assert.ErrorIs(t, Validate("abc"), ErrInvalid)
+	assert.ErrorIs(t, Validate("🎉a"), ErrInvalid)
+	assert.ErrorIs(t, Validate("1"), ErrInvalid)
+	assert.ErrorIs(t, Validate("‍"), ErrInvalid)
+	assert.ErrorIs(t, Validate("🎉‍"), ErrInvalid)
+
+	// 複数の絵文字や、絵文字ではない矢印・図形は 1 つの絵文字ではない
+	assert.ErrorIs(t, Validate("🎉🎉🎉"), ErrInvalid)
+	assert.ErrorIs(t, Validate("👍🏽👍"), ErrInvalid)
+	assert.ErrorIs(t, Validate("🇯🇵🇯🇵"), ErrInvalid)
+	assert.ErrorIs(t, Validate("🇯"), ErrInvalid)
+	assert.ErrorIs(t, Validate("🏽"), ErrInvalid)
+	assert.ErrorIs(t, Validate("→"), ErrInvalid)
+	assert.ErrorIs(t, Validate("■"), ErrInvalid)
+	assert.ErrorIs(t, Validate("1⃣1⃣"), ErrInvalid)
+	assert.ErrorIs(t, Validate("🎉🎉🎉🎉🎉🎉🎉🎉🎉🎉🎉🎉🎉🎉🎉🎉🎉"), ErrTooLong)
+}
+
+func TestValidateOptional(t *testing.T) {
+	assert.NoError(t, ValidateOptional(""))
+	assert.NoError(t, ValidateOptional("🎉"))
+	assert.ErrorIs(t, ValidateOptional("abc"), ErrInvalid)
+}
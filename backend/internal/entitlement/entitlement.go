@@ -0,0 +1,154 @@
+// Package entitlement resolves the plan of each user and enforces its quotas
+// with ent hooks, so that every code path creating events, participants or
+// attachments is covered.
+package entitlement
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/attachment"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/plan"
+	"github.com/matsuokashuhei/morrow-backend/ent/subscription"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+)
+
+// Plan keys of the built-in catalog
+const (
+	PlanFree = "free"
+	PlanPro  = "pro"
+	PlanTeam = "team"
+)
+
+// Quotas checked by the hooks
+const (
+	QuotaActiveEvents         = "ACTIVE_EVENTS"
+	QuotaParticipantsPerEvent = "PARTICIPANTS_PER_EVENT"
+	QuotaAttachmentStorage    = "ATTACHMENT_STORAGE"
+)
+
+// QuotaError is returned when a mutation would exceed a limit of the user's plan
+type QuotaError struct {
+	Quota string
+	Plan  string
+	Limit int64
+}
+
+func (e *QuotaError) Error() string {
+	return fmt.Sprintf("quota %s exceeded: the %s plan allows %d", e.Quota, e.Plan, e.Limit)
+}
+
+func intPtr(v int) *int       { return &v }
+func int64Ptr(v int64) *int64 { return &v }
+
+// Catalog is the set of plans created by EnsurePlans. Limits of existing plans
+// are not overwritten, so they can be tuned in the database.
+var Catalog = []*ent.Plan{
+	{Key: PlanFree, Name: "Free", MaxActiveEvents: intPtr(10), MaxParticipantsPerEvent: intPtr(10), MaxAttachmentStorage: int64Ptr(100 << 20)},
+	{Key: PlanPro, Name: "Pro", MaxActiveEvents: intPtr(200), MaxParticipantsPerEvent: intPtr(100), MaxAttachmentStorage: int64Ptr(10 << 30)},
+	{Key: PlanTeam, Name: "Team", MaxParticipantsPerEvent: intPtr(500), MaxAttachmentStorage: int64Ptr(100 << 30)},
+}
+
+// EnsurePlans creates the plans of Catalog that do not exist yet
+func EnsurePlans(ctx context.Context, client *ent.Client) error {
+	for _, p := range Catalog {
+		exists, err := client.Plan.Query().Where(plan.KeyEQ(p.Key)).Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check plan %s: %w", p.Key, err)
+		}
+		if exists {
+			continue
+		}
+		err = client.Plan.Create().
+			SetKey(p.Key).
+			SetName(p.Name).
+			SetNillableMaxActiveEvents(p.MaxActiveEvents).
+			SetNillableMaxParticipantsPerEvent(p.MaxParticipantsPerEvent).
+			SetNillableMaxAttachmentStorage(p.MaxAttachmentStorage).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create plan %s: %w", p.Key, err)
+		}
+	}
+	return nil
+}
+
+// PlanOf returns the plan the user is entitled to: the plan of an active
+// subscription, or the free plan otherwise.
+func PlanOf(ctx context.Context, client *ent.Client, userID int) (*ent.Plan, error) {
+	p, err := client.Subscription.Query().
+		Where(
+			subscription.HasUserWith(user.IDEQ(userID)),
+			subscription.StatusEQ(subscription.StatusActive),
+		).
+		QueryPlan().
+		Only(ctx)
+	if err == nil {
+		return p, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get subscription plan: %w", err)
+	}
+
+	p, err = client.Plan.Query().Where(plan.KeyEQ(PlanFree)).Only(ctx)
+	if ent.IsNotFound(err) {
+		// プランが未登録の環境でも無料プランの制限を適用する
+		return Catalog[0], nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get free plan: %w", err)
+	}
+	return p, nil
+}
+
+// Usage is the consumption counted against the quotas of a plan
+type Usage struct {
+	ActiveEvents      int
+	AttachmentStorage int64
+}
+
+// UsageOf counts the user's consumption
+func UsageOf(ctx context.Context, client *ent.Client, userID int) (*Usage, error) {
+	active, err := countActiveEvents(ctx, client, userID)
+	if err != nil {
+		return nil, err
+	}
+	storage, err := attachmentStorage(ctx, client, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &Usage{ActiveEvents: active, AttachmentStorage: storage}, nil
+}
+
+// countActiveEvents counts the events created by the user that have not ended
+func countActiveEvents(ctx context.Context, client *ent.Client, userID int) (int, error) {
+	n, err := client.Event.Query().
+		Where(event.HasCreatorWith(user.IDEQ(userID)), event.EndTimeGT(time.Now())).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count active events: %w", err)
+	}
+	return n, nil
+}
+
+// attachmentStorage sums the size of the chat attachments uploaded by the user
+func attachmentStorage(ctx context.Context, client *ent.Client, userID int) (int64, error) {
+	var rows []struct {
+		Sum sql.NullInt64 `json:"sum"`
+	}
+	err := client.Attachment.Query().
+		Where(attachment.HasUploaderWith(user.IDEQ(userID))).
+		Aggregate(ent.Sum(attachment.FieldSize)).
+		Scan(ctx, &rows)
+	if err != nil {
+		return 0, fmt.Errorf("failed to sum attachment storage: %w", err)
+	}
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	return rows[0].Sum.Int64, nil
+}
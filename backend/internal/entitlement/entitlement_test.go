@@ -0,0 +1,28 @@
+package entitlement
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuotaError(t *testing.T) {
+	err := fmt.Errorf("failed to create event: %w", &QuotaError{Quota: QuotaActiveEvents, Plan: PlanFree, Limit: 10})
+
+	var quota *QuotaError
+	assert.True(t, errors.As(err, &quota))
+	assert.Equal(t, "quota ACTIVE_EVENTS exceeded: the free plan allows 10", quota.Error())
+}
+
+func TestCatalog(t *testing.T) {
+	// PlanOfは未登録環境でCatalog[0]を無料プランとして使う
+	assert.Equal(t, PlanFree, Catalog[0].Key)
+
+	keys := map[string]bool{}
+	for _, p := range Catalog {
+		assert.False(t, keys[p.Key], "duplicate plan %s", p.Key)
+		keys[p.Key] = true
+	}
+}
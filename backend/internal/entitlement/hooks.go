@@ -0,0 +1,127 @@
+package entitlement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/hook"
+	"github.com/matsuokashuhei/morrow-backend/ent/participant"
+)
+
+// Register installs the quota hooks on the client
+func Register(client *ent.Client) {
+	client.Event.Use(EventHook())
+	client.Participant.Use(ParticipantHook())
+	client.Attachment.Use(AttachmentHook())
+}
+
+// EventHook limits the number of active events a user can create
+func EventHook() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.EventFunc(func(ctx context.Context, m *ent.EventMutation) (ent.Value, error) {
+			creatorID, ok := m.CreatorID()
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+			// 終了済みのイベント (過去の予定の取り込みなど) は上限に数えない
+			if end, ok := m.EndTime(); ok && !end.After(time.Now()) {
+				return next.Mutate(ctx, m)
+			}
+
+			if err := CheckActiveEvents(ctx, m.Client(), creatorID); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}, ent.OpCreate)
+}
+
+// CheckActiveEvents returns a QuotaError when the user already has as many
+// active events as the plan allows, so that one more cannot be added
+func CheckActiveEvents(ctx context.Context, client *ent.Client, userID int) error {
+	p, err := PlanOf(ctx, client, userID)
+	if err != nil {
+		return err
+	}
+	if p.MaxActiveEvents == nil {
+		return nil
+	}
+	active, err := countActiveEvents(ctx, client, userID)
+	if err != nil {
+		return err
+	}
+	if active >= *p.MaxActiveEvents {
+		return &QuotaError{Quota: QuotaActiveEvents, Plan: p.Key, Limit: int64(*p.MaxActiveEvents)}
+	}
+	return nil
+}
+
+// ParticipantHook limits the number of participants of an event by the plan of its creator
+func ParticipantHook() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.ParticipantFunc(func(ctx context.Context, m *ent.ParticipantMutation) (ent.Value, error) {
+			eventID, ok := m.EventID()
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			client := m.Client()
+			creatorID, err := client.Event.Query().Where(event.IDEQ(eventID)).QueryCreator().OnlyID(ctx)
+			if ent.IsNotFound(err) {
+				// 存在しないイベントは外部キー制約のエラーに任せる
+				return next.Mutate(ctx, m)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to get event creator: %w", err)
+			}
+			p, err := PlanOf(ctx, client, creatorID)
+			if err != nil {
+				return nil, err
+			}
+			if p.MaxParticipantsPerEvent != nil {
+				count, err := client.Participant.Query().
+					Where(participant.HasEventWith(event.IDEQ(eventID))).
+					Count(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to count participants: %w", err)
+				}
+				if count >= *p.MaxParticipantsPerEvent {
+					return nil, &QuotaError{Quota: QuotaParticipantsPerEvent, Plan: p.Key, Limit: int64(*p.MaxParticipantsPerEvent)}
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	}, ent.OpCreate)
+}
+
+// AttachmentHook limits the total size of the chat attachments a user uploads
+func AttachmentHook() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.AttachmentFunc(func(ctx context.Context, m *ent.AttachmentMutation) (ent.Value, error) {
+			uploaderID, ok := m.UploaderID()
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+			size, _ := m.Size()
+
+			client := m.Client()
+			p, err := PlanOf(ctx, client, uploaderID)
+			if err != nil {
+				return nil, err
+			}
+			if p.MaxAttachmentStorage != nil {
+				used, err := attachmentStorage(ctx, client, uploaderID)
+				if err != nil {
+					return nil, err
+				}
+				if used+size > *p.MaxAttachmentStorage {
+					return nil, &QuotaError{Quota: QuotaAttachmentStorage, Plan: p.Key, Limit: *p.MaxAttachmentStorage}
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	}, ent.OpCreate)
+}
@@ -0,0 +1,42 @@
+// Package feedtoken issues the secret tokens embedded in calendar feed URLs.
+package feedtoken
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+// FeedPath is the route prefix of calendar feeds; the URL is FeedPath + token + ".ics"
+const FeedPath = "/api/v1/calendar/"
+
+// Generate returns a new random token and the hash to store in the database.
+// Only the hash is persisted so that a database leak does not expose feed URLs.
+func Generate() (token, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("failed to generate feed token: %w", err)
+	}
+	token = base64.RawURLEncoding.EncodeToString(b)
+	return token, Hash(token), nil
+}
+
+// Hash returns the value stored in users.calendar_token_hash for token
+func Hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// RateLimitKey returns the rate limit key of a feed. Calendar services fetch
+// many users' feeds from a few addresses, so feeds are limited per token rather
+// than per IP, and by hash so that the token is not stored.
+func RateLimitKey(token string) string {
+	return "feed:" + Hash(token)
+}
+
+// URL builds the feed URL for token below baseURL
+func URL(baseURL, token string) string {
+	return baseURL + FeedPath + token + ".ics"
+}
@@ -0,0 +1,25 @@
+package feedtoken
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	token, hash, err := Generate()
+	require.NoError(t, err)
+
+	assert.Len(t, token, 43)
+	assert.Equal(t, Hash(token), hash)
+	assert.NotEqual(t, token, hash)
+
+	other, _, err := Generate()
+	require.NoError(t, err)
+	assert.NotEqual(t, token, other)
+}
+
+func TestURL(t *testing.T) {
+	assert.Equal(t, "https://api.example.com/api/v1/calendar/abc.ics", URL("https://api.example.com", "abc"))
+}
@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/matsuokashuhei/morrow-backend/internal/billing"
+	"github.com/sirupsen/logrus"
+)
+
+// maxWebhookSize limits the size of payment provider webhooks
+const maxWebhookSize = 1 << 20
+
+// BillingHandler receives payment provider webhooks
+type BillingHandler struct {
+	service *billing.Service
+	logger  *logrus.Logger
+}
+
+func NewBillingHandler(service *billing.Service, logger *logrus.Logger) *BillingHandler {
+	return &BillingHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Webhook serves POST /api/v1/billing/webhook
+func (h *BillingHandler) Webhook(c *gin.Context) {
+	payload, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxWebhookSize))
+	if err != nil {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "webhook is too large"})
+		return
+	}
+
+	applied, err := h.service.HandleWebhook(c.Request.Context(), payload, c.Request.Header)
+	switch {
+	case errors.Is(err, billing.ErrInvalidSignature), errors.Is(err, billing.ErrInvalidPayload):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, billing.ErrUnknownCustomer), errors.Is(err, billing.ErrUnknownPlan):
+		// 再送しても解決しないので受理して記録だけ残す
+		h.logger.WithError(err).Warn("Ignored billing webhook")
+		c.JSON(http.StatusOK, gin.H{"status": "ignored"})
+		return
+	case err != nil:
+		h.logger.WithError(err).Error("Failed to process billing webhook")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to process webhook"})
+		return
+	}
+
+	status := "ignored"
+	if applied {
+		status = "applied"
+	}
+	c.JSON(http.StatusOK, gin.H{"status": status})
+}
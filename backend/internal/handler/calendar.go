@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/participant"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+	"github.com/matsuokashuhei/morrow-backend/internal/calendar"
+	"github.com/matsuokashuhei/morrow-backend/internal/database"
+	"github.com/matsuokashuhei/morrow-backend/internal/feedtoken"
+	"github.com/matsuokashuhei/morrow-backend/internal/ical"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
+	"github.com/sirupsen/logrus"
+)
+
+// CalendarHandler exports events as iCalendar documents
+type CalendarHandler struct {
+	dbClient *database.Client
+	logger   *logrus.Logger
+}
+
+func NewCalendarHandler(dbClient *database.Client, logger *logrus.Logger) *CalendarHandler {
+	return &CalendarHandler{
+		dbClient: dbClient,
+		logger:   logger,
+	}
+}
+
+// Event serves GET /api/v1/events/:id.ics
+func (h *CalendarHandler) Event(c *gin.Context) {
+	raw, ok := strings.CutSuffix(c.Param("id"), ".ics")
+	id, err := strconv.Atoi(raw)
+	if !ok || err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "not found"})
+		return
+	}
+	ctx := c.Request.Context()
+
+	e, err := calendar.WithEdges(h.dbClient.Event.Query().Where(event.IDEQ(id))).Only(ctx)
+	if ent.IsNotFound(err) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "event not found"})
+		return
+	}
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get event for calendar export")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get event"})
+		return
+	}
+	// 閲覧できないイベントは存在を明かさない
+	if !canViewEvent(ctx, e) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "event not found"})
+		return
+	}
+
+	h.render(c, &ical.Calendar{
+		ProdID: calendar.ProdID,
+		Name:   e.Title,
+		Events: []ical.Event{calendar.ToICal(e)},
+	})
+}
+
+// Feed serves the secret per-user feed at GET /api/v1/calendar/:token.ics
+func (h *CalendarHandler) Feed(c *gin.Context) {
+	token, ok := strings.CutSuffix(c.Param("token"), ".ics")
+	if !ok || token == "" {
+		c.JSON(http.StatusNotFound, gin.H{"error": "not found"})
+		return
+	}
+	ctx := c.Request.Context()
+
+	u, err := h.dbClient.User.Query().
+		Where(user.CalendarTokenHashEQ(feedtoken.Hash(token))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "calendar feed not found"})
+		return
+	}
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get calendar feed owner")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get calendar feed"})
+		return
+	}
+
+	events, err := calendar.WithEdges(h.dbClient.Event.Query().Where(
+		event.Or(
+			event.HasCreatorWith(user.IDEQ(u.ID)),
+			event.HasParticipantsWith(
+				participant.HasUserWith(user.IDEQ(u.ID)),
+				participant.StatusNEQ(participant.StatusDeclined),
+			),
+		),
+	)).
+		Order(ent.Asc(event.FieldStartTime), ent.Asc(event.FieldID)).
+		All(ctx)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get events for calendar feed")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get events"})
+		return
+	}
+
+	cal := &ical.Calendar{
+		ProdID: calendar.ProdID,
+		Name:   "Morrow",
+		Events: make([]ical.Event, 0, len(events)),
+	}
+	for _, e := range events {
+		cal.Events = append(cal.Events, calendar.ToICal(e))
+	}
+	h.render(c, cal)
+}
+
+// render writes the calendar with an ETag so that polling clients get 304 when nothing changed
+func (h *CalendarHandler) render(c *gin.Context, cal *ical.Calendar) {
+	body, err := cal.Marshal()
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to render calendar")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to render calendar"})
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	c.Header("ETag", etag)
+	c.Header("Cache-Control", "private, no-cache")
+	if etagMatches(c.GetHeader("If-None-Match"), etag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	c.Data(http.StatusOK, ical.ContentType, body)
+}
+
+// etagMatches implements the weak comparison of If-None-Match (RFC 9110 section 13.1.2)
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// canViewEvent allows public events to anyone and other events to their creator and invitees
+func canViewEvent(ctx context.Context, e *ent.Event) bool {
+	if e.Visibility == event.VisibilityPublic {
+		return true
+	}
+	userID, ok := viewer.UserID(ctx)
+	if !ok {
+		return false
+	}
+	if e.Edges.Creator != nil && e.Edges.Creator.ID == userID {
+		return true
+	}
+	for _, p := range e.Edges.Participants {
+		if p.Edges.User != nil && p.Edges.User.ID == userID && p.Status != participant.StatusDeclined {
+			return true
+		}
+	}
+	return false
+}
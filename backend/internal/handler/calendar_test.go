@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/participant"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
+	"github.com/stretchr/testify/assert"
+)
+
+func calendarTestEvent() *ent.Event {
+	start := time.Date(2025, 12, 24, 18, 0, 0, 0, time.UTC)
+	return &ent.Event{
+		ID:             7,
+		Title:          "Launch",
+		Emoji:          "🚀",
+		StartTime:      start,
+		EndTime:        start.Add(time.Hour),
+		TimeZone:       "Asia/Tokyo",
+		RecurrenceRule: "FREQ=YEARLY",
+		Visibility:     event.VisibilityPrivate,
+		Edges: ent.EventEdges{
+			Creator: &ent.User{ID: 1, Name: "Alice", Email: "alice@example.com"},
+			Participants: []*ent.Participant{
+				{
+					Role:   participant.RoleViewer,
+					Status: participant.StatusPending,
+					Edges:  ent.ParticipantEdges{User: &ent.User{ID: 2, Name: "Bob", Email: "bob@example.com"}},
+				},
+				{
+					Role:   participant.RoleEditor,
+					Status: participant.StatusDeclined,
+					Edges:  ent.ParticipantEdges{User: &ent.User{ID: 3, Name: "Carol", Email: "carol@example.com"}},
+				},
+			},
+		},
+	}
+}
+
+func TestCanViewEvent(t *testing.T) {
+	e := calendarTestEvent()
+	ctxFor := func(userID int) context.Context {
+		return viewer.NewContext(context.Background(), &viewer.Viewer{Subject: "test", UserID: userID})
+	}
+
+	assert.False(t, canViewEvent(context.Background(), e), "anonymous")
+	assert.True(t, canViewEvent(ctxFor(1), e), "creator")
+	assert.True(t, canViewEvent(ctxFor(2), e), "invitee")
+	assert.False(t, canViewEvent(ctxFor(3), e), "declined")
+	assert.False(t, canViewEvent(ctxFor(4), e), "stranger")
+
+	e.Visibility = event.VisibilityPublic
+	assert.True(t, canViewEvent(context.Background(), e), "public")
+}
+
+func TestETagMatches(t *testing.T) {
+	etag := `"abc"`
+	assert.True(t, etagMatches(`"abc"`, etag))
+	assert.True(t, etagMatches(`"x", W/"abc"`, etag))
+	assert.True(t, etagMatches(`*`, etag))
+	assert.False(t, etagMatches(``, etag))
+	assert.False(t, etagMatches(`"abcd"`, etag))
+}
@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/matsuokashuhei/morrow-backend/internal/storage"
+	"github.com/sirupsen/logrus"
+)
+
+// FilesHandler serves objects of the local storage through URLs signed by storage.Local
+type FilesHandler struct {
+	store  *storage.Local
+	logger *logrus.Logger
+}
+
+func NewFilesHandler(store *storage.Local, logger *logrus.Logger) *FilesHandler {
+	return &FilesHandler{
+		store:  store,
+		logger: logger,
+	}
+}
+
+// Serve streams the object named by the *key route parameter
+func (h *FilesHandler) Serve(c *gin.Context) {
+	key := strings.TrimPrefix(c.Param("key"), "/")
+
+	if err := h.store.Verify(key, c.Query("expires"), c.Query("signature")); err != nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid or expired download URL"})
+		return
+	}
+
+	body, info, err := h.store.Get(c.Request.Context(), key)
+	if errors.Is(err, storage.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "file not found"})
+		return
+	}
+	if err != nil {
+		h.logger.WithError(err).WithField("key", key).Error("Failed to open file")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to open file"})
+		return
+	}
+	defer body.Close()
+
+	// ブラウザにMIMEタイプを推測させない
+	c.Header("Content-Type", info.ContentType)
+	c.Header("Content-Length", strconv.FormatInt(info.Size, 10))
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Header("Cache-Control", "private, max-age=3600")
+	c.Status(http.StatusOK)
+	if _, err := io.Copy(c.Writer, body); err != nil {
+		h.logger.WithError(err).WithField("key", key).Warn("Failed to stream file")
+	}
+}
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/matsuokashuhei/morrow-backend/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupFilesRouter(t *testing.T) (*gin.Engine, *storage.Local) {
+	gin.SetMode(gin.TestMode)
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+
+	store, err := storage.NewLocal(t.TempDir(), "http://example.com", []byte("test-key"))
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.GET(storage.LocalFilesPath+"*key", NewFilesHandler(store, logger).Serve)
+	return router, store
+}
+
+// requestPath strips the base URL from a signed URL
+func requestPath(t *testing.T, signed string) string {
+	u, err := url.Parse(signed)
+	require.NoError(t, err)
+	return u.RequestURI()
+}
+
+func TestFilesHandler_Serve(t *testing.T) {
+	router, store := setupFilesRouter(t)
+	ctx := context.Background()
+
+	require.NoError(t, store.Put(ctx, "avatars/1/a.png", strings.NewReader("png"), 3, "image/png"))
+	signed, err := store.SignedURL(ctx, "avatars/1/a.png", time.Minute)
+	require.NoError(t, err)
+
+	req, _ := http.NewRequest("GET", requestPath(t, signed), nil)
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "png", resp.Body.String())
+	assert.Equal(t, "image/png", resp.Header().Get("Content-Type"))
+	assert.Equal(t, "nosniff", resp.Header().Get("X-Content-Type-Options"))
+}
+
+func TestFilesHandler_ServeRejectsInvalidSignature(t *testing.T) {
+	router, store := setupFilesRouter(t)
+	ctx := context.Background()
+
+	require.NoError(t, store.Put(ctx, "avatars/1/a.png", strings.NewReader("png"), 3, "image/png"))
+	signed, err := store.SignedURL(ctx, "avatars/1/a.png", time.Minute)
+	require.NoError(t, err)
+
+	// 署名を別のキーに流用できないこと
+	tampered := strings.Replace(requestPath(t, signed), "avatars/1/a.png", "avatars/2/a.png", 1)
+	req, _ := http.NewRequest("GET", tampered, nil)
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+}
+
+func TestFilesHandler_ServeMissingFile(t *testing.T) {
+	router, store := setupFilesRouter(t)
+
+	signed, err := store.SignedURL(context.Background(), "avatars/1/missing.png", time.Minute)
+	require.NoError(t, err)
+
+	req, _ := http.NewRequest("GET", requestPath(t, signed), nil)
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
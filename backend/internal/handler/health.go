@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/matsuokashuhei/morrow-backend/internal/buildinfo"
 	"github.com/matsuokashuhei/morrow-backend/internal/database"
 	"github.com/sirupsen/logrus"
 )
@@ -41,7 +42,7 @@ func (h *HealthHandler) Health(c *gin.Context) {
 	response := gin.H{
 		"status":    "ok",
 		"message":   "Morrow API is running",
-		"version":   "0.1.0",
+		"version":   buildinfo.Version,
 		"timestamp": time.Now().UTC().Format(time.RFC3339),
 		"database": gin.H{
 			"status": dbHealth,
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"net/mail"
+
+	"github.com/gin-gonic/gin"
+	"github.com/matsuokashuhei/morrow-backend/internal/database"
+	"github.com/matsuokashuhei/morrow-backend/internal/ical"
+	"github.com/matsuokashuhei/morrow-backend/internal/invitation"
+	"github.com/sirupsen/logrus"
+)
+
+// IMIPSecretHeader carries the shared secret of the inbound mail gateway
+const IMIPSecretHeader = "X-IMIP-Secret"
+
+// IMIPSenderHeader carries the sender address of the reply email, as verified
+// by the gateway (envelope sender or From header checked with SPF/DKIM/DMARC)
+const IMIPSenderHeader = "X-IMIP-Sender"
+
+// maxReplySize limits the size of iTIP REPLY documents
+const maxReplySize = 1 << 20
+
+// IMIPHandler receives iTIP replies forwarded by the inbound mail gateway
+type IMIPHandler struct {
+	dbClient *database.Client
+	secret   string
+	logger   *logrus.Logger
+}
+
+func NewIMIPHandler(dbClient *database.Client, secret string, logger *logrus.Logger) *IMIPHandler {
+	return &IMIPHandler{
+		dbClient: dbClient,
+		secret:   secret,
+		logger:   logger,
+	}
+}
+
+// Reply serves POST /api/v1/imip/reply. The body is the text/calendar part of
+// the reply email. Only the attendee matching the sender is updated.
+func (h *IMIPHandler) Reply(c *gin.Context) {
+	if h.secret == "" || subtle.ConstantTimeCompare([]byte(c.GetHeader(IMIPSecretHeader)), []byte(h.secret)) != 1 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid inbound secret"})
+		return
+	}
+
+	sender, err := mail.ParseAddress(c.GetHeader(IMIPSenderHeader))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid " + IMIPSenderHeader + " header"})
+		return
+	}
+
+	reply, err := ical.ParseReply(http.MaxBytesReader(c.Writer, c.Request.Body, maxReplySize))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid iTIP reply: " + err.Error()})
+		return
+	}
+
+	_, err = invitation.ApplyReply(c.Request.Context(), h.dbClient.Client, reply, sender.Address)
+	switch {
+	case errors.Is(err, invitation.ErrSenderMismatch):
+		h.logger.WithFields(logrus.Fields{"uid": reply.UID, "sender": sender.Address}).Warn("iTIP reply for another attendee rejected")
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, invitation.ErrUnknownEvent), errors.Is(err, invitation.ErrUnknownAttendee):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, invitation.ErrStaleReply):
+		// 古い版への返信は無視する。ゲートウェイに再送させないよう409を返す
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	case err != nil:
+		h.logger.WithError(err).WithField("uid", reply.UID).Error("Failed to apply iTIP reply")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to apply reply"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"updated": 1})
+}
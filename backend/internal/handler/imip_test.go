@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/matsuokashuhei/morrow-backend/internal/database"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupIMIPRouter(secret string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+
+	router := gin.New()
+	// データベースに届く前に拒否されるリクエストだけを試す
+	router.POST("/api/v1/imip/reply", NewIMIPHandler(&database.Client{}, secret, logger).Reply)
+	return router
+}
+
+func TestIMIPHandler_ReplyRequiresSecret(t *testing.T) {
+	tests := []struct {
+		name   string
+		secret string
+		header string
+	}{
+		{"missing header", "s3cret", ""},
+		{"wrong secret", "s3cret", "guess"},
+		{"no secret configured", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := setupIMIPRouter(tt.secret)
+			req, _ := http.NewRequest("POST", "/api/v1/imip/reply", strings.NewReader("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"))
+			if tt.header != "" {
+				req.Header.Set(IMIPSecretHeader, tt.header)
+			}
+			resp := httptest.NewRecorder()
+			router.ServeHTTP(resp, req)
+
+			assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		})
+	}
+}
+
+func TestIMIPHandler_ReplyRejectsInvalidCalendar(t *testing.T) {
+	router := setupIMIPRouter("s3cret")
+
+	// METHOD:REQUEST is not a reply
+	body := "BEGIN:VCALENDAR\r\nMETHOD:REQUEST\r\nBEGIN:VEVENT\r\nUID:1@morrow\r\n" +
+		"ATTENDEE;PARTSTAT=ACCEPTED:mailto:a@example.com\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+	req, _ := http.NewRequest("POST", "/api/v1/imip/reply", strings.NewReader(body))
+	req.Header.Set(IMIPSecretHeader, "s3cret")
+	req.Header.Set(IMIPSenderHeader, "a@example.com")
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestIMIPHandler_ReplyRequiresSender(t *testing.T) {
+	router := setupIMIPRouter("s3cret")
+
+	body := "BEGIN:VCALENDAR\r\nMETHOD:REPLY\r\nBEGIN:VEVENT\r\nUID:1@morrow\r\n" +
+		"ATTENDEE;PARTSTAT=ACCEPTED:mailto:a@example.com\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+	for _, sender := range []string{"", "not an address"} {
+		req, _ := http.NewRequest("POST", "/api/v1/imip/reply", strings.NewReader(body))
+		req.Header.Set(IMIPSecretHeader, "s3cret")
+		req.Header.Set(IMIPSenderHeader, sender)
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code, sender)
+	}
+}
+
+func TestIMIPHandler_ReplyRejectsOtherAttendees(t *testing.T) {
+	router := setupIMIPRouter("s3cret")
+
+	// 送信者と異なる出席者の返信はデータベースを参照する前に拒否する
+	body := "BEGIN:VCALENDAR\r\nMETHOD:REPLY\r\nBEGIN:VEVENT\r\nUID:1@morrow\r\n" +
+		"ATTENDEE;PARTSTAT=DECLINED:mailto:victim@example.com\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+	req, _ := http.NewRequest("POST", "/api/v1/imip/reply", strings.NewReader(body))
+	req.Header.Set(IMIPSecretHeader, "s3cret")
+	req.Header.Set(IMIPSenderHeader, "Mallory <mallory@example.com>")
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/matsuokashuhei/morrow-backend/internal/health"
+	"github.com/matsuokashuhei/morrow-backend/internal/logging"
+)
+
+// ProbeHandler serves the liveness, readiness and startup probes
+type ProbeHandler struct {
+	probes *health.Probes
+}
+
+func NewProbeHandler(probes *health.Probes) *ProbeHandler {
+	return &ProbeHandler{probes: probes}
+}
+
+// Livez reports that the process is running. It does not check dependencies.
+func (h *ProbeHandler) Livez(c *gin.Context) {
+	h.respond(c, h.probes.Live(c.Request.Context()))
+}
+
+// Readyz reports whether the server can serve requests
+func (h *ProbeHandler) Readyz(c *gin.Context) {
+	h.respond(c, h.probes.Ready(c.Request.Context()))
+}
+
+// Startupz reports whether the server has finished starting
+func (h *ProbeHandler) Startupz(c *gin.Context) {
+	h.respond(c, h.probes.Startup(c.Request.Context()))
+}
+
+func (h *ProbeHandler) respond(c *gin.Context, report *health.Report) {
+	status := http.StatusOK
+	if !report.OK() {
+		status = http.StatusServiceUnavailable
+		for name, res := range report.Checks {
+			if res.Status != health.StatusOK {
+				logging.FromContext(c.Request.Context()).WithField("check", name).WithField("error", res.Error).Warn("Health check failed")
+			}
+		}
+	}
+	// プローブの結果をキャッシュさせない
+	c.Header("Cache-Control", "no-store")
+	c.JSON(status, report)
+}
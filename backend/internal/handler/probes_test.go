@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/matsuokashuhei/morrow-backend/internal/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProbeHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	probes := health.New(0)
+	var dbErr error
+	probes.AddReadiness(health.Check{Name: "database", Run: func(context.Context) error { return dbErr }})
+	probes.MarkStarted()
+
+	h := NewProbeHandler(probes)
+	router := gin.New()
+	router.GET("/livez", h.Livez)
+	router.GET("/readyz", h.Readyz)
+	router.GET("/startupz", h.Startupz)
+
+	get := func(path string) (int, health.Report) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		var report health.Report
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+		assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+		return w.Code, report
+	}
+
+	code, report := get("/readyz")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, health.StatusOK, report.Checks["database"].Status)
+	assert.Equal(t, "0.1.0", report.Build.Version)
+
+	dbErr = errors.New("connection refused")
+	code, report = get("/readyz")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "connection refused", report.Checks["database"].Error)
+
+	// 依存先の障害ではプロセスを再起動させない
+	code, _ = get("/livez")
+	assert.Equal(t, http.StatusOK, code)
+
+	code, _ = get("/startupz")
+	assert.Equal(t, http.StatusOK, code)
+}
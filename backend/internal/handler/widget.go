@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"bytes"
+	"html/template"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/internal/database"
+	"github.com/sirupsen/logrus"
+)
+
+// widgetTemplate is the countdown card shown in the iframe. Styles are inline
+// because the page is served on its own and must not load anything else.
+var widgetTemplate = template.Must(template.New("widget").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+<style>
+body { margin: 0; font-family: system-ui, sans-serif; color: #1f2937; background: #fff; }
+.card { padding: 16px; text-align: center; }
+.emoji { font-size: 32px; }
+.title { margin: 8px 0 4px; font-size: 18px; font-weight: 600; }
+.countdown { font-size: 28px; font-weight: 700; }
+.start { color: #6b7280; font-size: 14px; }
+</style>
+</head>
+<body>
+<div class="card">
+{{if .Emoji}}<div class="emoji">{{.Emoji}}</div>{{end}}
+<div class="title">{{.Title}}</div>
+<div class="countdown">{{.Countdown}}</div>
+<time class="start" datetime="{{.StartISO}}">{{.Start}}</time>
+</div>
+</body>
+</html>
+`))
+
+// widgetData is what the widget template shows of an event
+type widgetData struct {
+	Title     string
+	Emoji     string
+	Countdown string
+	Start     string
+	StartISO  string
+}
+
+// WidgetHandler serves the pages that other sites embed in an iframe
+type WidgetHandler struct {
+	dbClient *database.Client
+	logger   *logrus.Logger
+}
+
+func NewWidgetHandler(dbClient *database.Client, logger *logrus.Logger) *WidgetHandler {
+	return &WidgetHandler{
+		dbClient: dbClient,
+		logger:   logger,
+	}
+}
+
+// Event serves the countdown card of a public event at GET /embed/events/:id
+func (h *WidgetHandler) Event(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "not found"})
+		return
+	}
+
+	// 他のサイトに埋め込まれるため、閲覧者のセッションに関係なく公開イベントだけを表示する
+	e, err := h.dbClient.Event.Query().
+		Where(event.IDEQ(id), event.VisibilityEQ(event.VisibilityPublic)).
+		Only(c.Request.Context())
+	if ent.IsNotFound(err) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "event not found"})
+		return
+	}
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get event for widget")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get event"})
+		return
+	}
+
+	var body bytes.Buffer
+	if err := renderWidget(&body, e, time.Now()); err != nil {
+		h.logger.WithError(err).Error("Failed to render widget")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to render widget"})
+		return
+	}
+	// 残り日数が変わるので短時間だけキャッシュさせる
+	c.Header("Cache-Control", "public, max-age=300")
+	c.Data(http.StatusOK, "text/html; charset=utf-8", body.Bytes())
+}
+
+// renderWidget writes the countdown card of the event as it looks at now
+func renderWidget(w io.Writer, e *ent.Event, now time.Time) error {
+	loc := time.UTC
+	if e.TimeZone != "" {
+		if l, err := time.LoadLocation(e.TimeZone); err == nil {
+			loc = l
+		}
+	}
+	start := e.StartTime.In(loc)
+	return widgetTemplate.Execute(w, widgetData{
+		Title:     e.Title,
+		Emoji:     e.Emoji,
+		Countdown: countdown(now.In(loc), start, e.EndTime.In(loc)),
+		Start:     start.Format("Jan 2, 2006 15:04 MST"),
+		StartISO:  start.Format(time.RFC3339),
+	})
+}
+
+// countdown describes how many calendar days in the event's time zone are left until start
+func countdown(now, start, end time.Time) string {
+	switch {
+	case !now.Before(end):
+		return "Ended"
+	case !now.Before(start):
+		return "Now"
+	}
+	// 日付の差で数えるので、夏時間の切り替えがあっても1日ずれない
+	y, m, d := now.Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	y, m, d = start.Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	switch days := int(day.Sub(today).Hours() / 24); days {
+	case 0:
+		return "Today"
+	case 1:
+		return "1 day to go"
+	default:
+		return strconv.Itoa(days) + " days to go"
+	}
+}
@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderWidget(t *testing.T) {
+	e := calendarTestEvent()
+	e.Title = `<script>alert("x")</script>`
+	now := time.Date(2025, 12, 20, 12, 0, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	require.NoError(t, renderWidget(&buf, e, now))
+	body := buf.String()
+
+	assert.NotContains(t, body, "<script>")
+	assert.Contains(t, body, "&lt;script&gt;")
+	assert.Contains(t, body, "🚀")
+	assert.Contains(t, body, "5 days to go")
+	// 開始日時はイベントのタイムゾーンで表示する
+	assert.Contains(t, body, "Dec 25, 2025 03:00 JST")
+}
+
+func TestCountdown(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	start := time.Date(2025, 12, 25, 3, 0, 0, 0, tokyo)
+	end := start.Add(time.Hour)
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want string
+	}{
+		{"days ahead", time.Date(2025, 12, 20, 23, 0, 0, 0, tokyo), "5 days to go"},
+		{"tomorrow", time.Date(2025, 12, 24, 23, 59, 0, 0, tokyo), "1 day to go"},
+		{"same day", time.Date(2025, 12, 25, 0, 30, 0, 0, tokyo), "Today"},
+		{"in progress", start.Add(time.Minute), "Now"},
+		{"ended", end, "Ended"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, countdown(tt.now, start, end))
+		})
+	}
+}
@@ -0,0 +1,183 @@
+// Package health runs the checks behind the liveness, readiness and startup
+// probes of the API server.
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/internal/buildinfo"
+)
+
+// Statuses reported by probes and checks
+const (
+	StatusOK           = "ok"
+	StatusError        = "error"
+	StatusStarting     = "starting"
+	StatusShuttingDown = "shutting_down"
+)
+
+// DefaultTimeout bounds each check when New is given zero
+const DefaultTimeout = 2 * time.Second
+
+// Check tests one dependency of the server
+type Check struct {
+	Name string
+	// Run returns an error when the dependency does not work
+	Run func(ctx context.Context) error
+	// Optional checks are reported but do not fail the probe, for dependencies
+	// the API can do without for a while, such as sending mail
+	Optional bool
+}
+
+// Result is the outcome of a check
+type Result struct {
+	Status    string  `json:"status"`
+	LatencyMS float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"`
+	Optional  bool    `json:"optional,omitempty"`
+}
+
+// Report is the response body of a probe
+type Report struct {
+	Status    string            `json:"status"`
+	Checks    map[string]Result `json:"checks,omitempty"`
+	Build     buildinfo.Info    `json:"build"`
+	Timestamp string            `json:"timestamp"`
+}
+
+// OK reports whether the probe passed
+func (r *Report) OK() bool {
+	return r.Status == StatusOK
+}
+
+// Probes holds the checks of each probe and the lifecycle of the server.
+// Liveness has no checks: a database outage must not make the orchestrator
+// restart every instance.
+type Probes struct {
+	timeout  time.Duration
+	build    buildinfo.Info
+	started  atomic.Bool
+	draining atomic.Bool
+
+	mu        sync.RWMutex
+	readiness []Check
+	startup   []Check
+}
+
+// New creates probes whose checks each time out after timeout
+func New(timeout time.Duration) *Probes {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Probes{timeout: timeout, build: buildinfo.Get()}
+}
+
+// AddReadiness adds a check that must pass for the server to receive traffic
+func (p *Probes) AddReadiness(c Check) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.readiness = append(p.readiness, c)
+}
+
+// AddStartup adds a check that must pass once before the server is considered started
+func (p *Probes) AddStartup(c Check) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.startup = append(p.startup, c)
+}
+
+// MarkStarted is called when the server has finished initializing and listens
+func (p *Probes) MarkStarted() {
+	p.started.Store(true)
+}
+
+// Drain makes the readiness probe fail so that load balancers stop sending
+// requests before the server shuts down
+func (p *Probes) Drain() {
+	p.draining.Store(true)
+}
+
+// Live reports that the process is running
+func (p *Probes) Live(context.Context) *Report {
+	return p.report(StatusOK, nil)
+}
+
+// Ready runs the readiness checks. It fails without running them until the
+// server has started and once it is draining.
+func (p *Probes) Ready(ctx context.Context) *Report {
+	switch {
+	case p.draining.Load():
+		return p.report(StatusShuttingDown, nil)
+	case !p.started.Load():
+		return p.report(StatusStarting, nil)
+	}
+	p.mu.RLock()
+	checks := p.readiness
+	p.mu.RUnlock()
+	return p.run(ctx, checks)
+}
+
+// Startup runs the startup checks once the server has started
+func (p *Probes) Startup(ctx context.Context) *Report {
+	if !p.started.Load() {
+		return p.report(StatusStarting, nil)
+	}
+	p.mu.RLock()
+	checks := p.startup
+	p.mu.RUnlock()
+	return p.run(ctx, checks)
+}
+
+// run executes checks concurrently and fails when a required one fails
+func (p *Probes) run(ctx context.Context, checks []Check) *Report {
+	results := make([]Result, len(checks))
+	var wg sync.WaitGroup
+	for i, c := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = p.check(ctx, c)
+		}()
+	}
+	wg.Wait()
+
+	status := StatusOK
+	byName := make(map[string]Result, len(checks))
+	for i, c := range checks {
+		byName[c.Name] = results[i]
+		if results[i].Status != StatusOK && !c.Optional {
+			status = StatusError
+		}
+	}
+	return p.report(status, byName)
+}
+
+func (p *Probes) check(ctx context.Context, c Check) Result {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := c.Run(ctx)
+	res := Result{
+		Status:    StatusOK,
+		LatencyMS: float64(time.Since(start).Microseconds()) / 1000,
+		Optional:  c.Optional,
+	}
+	if err != nil {
+		res.Status = StatusError
+		res.Error = err.Error()
+	}
+	return res
+}
+
+func (p *Probes) report(status string, checks map[string]Result) *Report {
+	return &Report{
+		Status:    status,
+		Checks:    checks,
+		Build:     p.build,
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+	}
+}
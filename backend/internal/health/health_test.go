@@ -0,0 +1,59 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProbes(t *testing.T) {
+	probes := New(50 * time.Millisecond)
+	probes.AddStartup(Check{Name: "database", Run: func(context.Context) error { return nil }})
+	probes.AddReadiness(Check{Name: "database", Run: func(context.Context) error { return nil }})
+
+	t.Run("not ready before starting", func(t *testing.T) {
+		assert.True(t, probes.Live(context.Background()).OK())
+		assert.Equal(t, StatusStarting, probes.Ready(context.Background()).Status)
+		assert.Equal(t, StatusStarting, probes.Startup(context.Background()).Status)
+	})
+
+	probes.MarkStarted()
+
+	t.Run("ready after starting", func(t *testing.T) {
+		report := probes.Ready(context.Background())
+		assert.True(t, report.OK())
+		assert.Equal(t, StatusOK, report.Checks["database"].Status)
+		assert.True(t, probes.Startup(context.Background()).OK())
+		assert.NotEmpty(t, report.Build.Version)
+	})
+
+	t.Run("optional checks do not fail the probe", func(t *testing.T) {
+		probes.AddReadiness(Check{Name: "mail_backlog", Run: func(context.Context) error { return errors.New("backlog") }, Optional: true})
+
+		report := probes.Ready(context.Background())
+		assert.True(t, report.OK())
+		assert.Equal(t, Result{Status: StatusError, LatencyMS: report.Checks["mail_backlog"].LatencyMS, Error: "backlog", Optional: true}, report.Checks["mail_backlog"])
+	})
+
+	t.Run("required checks time out", func(t *testing.T) {
+		probes.AddReadiness(Check{Name: "storage", Run: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}})
+
+		report := probes.Ready(context.Background())
+		assert.Equal(t, StatusError, report.Status)
+		assert.Equal(t, context.DeadlineExceeded.Error(), report.Checks["storage"].Error)
+		assert.GreaterOrEqual(t, report.Checks["storage"].LatencyMS, float64(50))
+	})
+
+	t.Run("not ready while draining", func(t *testing.T) {
+		probes.Drain()
+		assert.Equal(t, StatusShuttingDown, probes.Ready(context.Background()).Status)
+		// 終了するまで再起動させない
+		assert.True(t, probes.Live(context.Background()).OK())
+	})
+}
@@ -0,0 +1,272 @@
+// Package ical renders events as RFC 5545 iCalendar documents.
+package ical
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// ContentType is the MIME type of iCalendar documents
+	ContentType = "text/calendar; charset=utf-8"
+	// maxLineOctets is the line length limit of RFC 5545 section 3.1
+	maxLineOctets = 75
+
+	utcFormat   = "20060102T150405Z"
+	localFormat = "20060102T150405"
+)
+
+// Participation statuses of attendees (RFC 5545 section 3.2.12)
+const (
+	PartStatNeedsAction = "NEEDS-ACTION"
+	PartStatAccepted    = "ACCEPTED"
+	PartStatDeclined    = "DECLINED"
+	PartStatTentative   = "TENTATIVE"
+)
+
+// iTIP methods (RFC 5546 section 1.4)
+const (
+	MethodRequest = "REQUEST"
+	MethodReply   = "REPLY"
+	MethodCancel  = "CANCEL"
+)
+
+// StatusCancelled is the STATUS of cancelled events
+const StatusCancelled = "CANCELLED"
+
+// Participation roles of attendees (RFC 5545 section 3.2.16)
+const (
+	RoleChair          = "CHAIR"
+	RoleReqParticipant = "REQ-PARTICIPANT"
+)
+
+// Calendar is a VCALENDAR object
+type Calendar struct {
+	ProdID string
+	// Name is shown by calendar clients as the title of a subscribed feed
+	Name string
+	// Method is the iTIP method (e.g. REQUEST). It is omitted when empty.
+	Method string
+	Events []Event
+}
+
+// Person identifies an organizer or attendee
+type Person struct {
+	Name  string
+	Email string
+}
+
+// Attendee is an ATTENDEE property of an event
+type Attendee struct {
+	Person
+	Role     string
+	PartStat string
+	// RSVP asks the attendee to reply to a REQUEST
+	RSVP bool
+}
+
+// Event is a VEVENT component
+type Event struct {
+	UID         string
+	Sequence    int
+	Summary     string
+	Description string
+	URL         string
+	Start       time.Time
+	End         time.Time
+	// TimeZone is the IANA zone the event is scheduled in. Times are written in UTC when empty.
+	TimeZone string
+	// RRule is the value of the RRULE property, e.g. "FREQ=WEEKLY;COUNT=10"
+	RRule string
+	// Status is the STATUS property, e.g. StatusCancelled. It is omitted when empty.
+	Status       string
+	Created      time.Time
+	LastModified time.Time
+	Organizer    *Person
+	Attendees    []Attendee
+}
+
+// Marshal renders the calendar as an iCalendar document with CRLF line endings.
+// The output only depends on the calendar content, so it can be used to compute ETags.
+func (c *Calendar) Marshal() ([]byte, error) {
+	w := &writer{}
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.prop("PRODID", c.ProdID)
+	w.line("CALSCALE:GREGORIAN")
+	if c.Method != "" {
+		w.prop("METHOD", c.Method)
+	}
+	if c.Name != "" {
+		w.prop("X-WR-CALNAME", escapeText(c.Name))
+	}
+
+	zones, err := c.timezones()
+	if err != nil {
+		return nil, err
+	}
+	for _, tz := range zones {
+		tz.write(w)
+	}
+	for i := range c.Events {
+		c.Events[i].write(w)
+	}
+
+	w.line("END:VCALENDAR")
+	return w.buf.Bytes(), nil
+}
+
+// timezones builds a VTIMEZONE for every zone referenced by the events
+func (c *Calendar) timezones() ([]*timezone, error) {
+	years := map[string][2]int{}
+	for _, e := range c.Events {
+		if e.TimeZone == "" {
+			continue
+		}
+		first, last := e.Start.Year(), e.End.Year()
+		if span, ok := years[e.TimeZone]; ok {
+			first, last = min(first, span[0]), max(last, span[1])
+		}
+		years[e.TimeZone] = [2]int{first, last}
+	}
+
+	names := make([]string, 0, len(years))
+	for name := range years {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	zones := make([]*timezone, 0, len(names))
+	for _, name := range names {
+		loc, err := LoadTimeZone(name)
+		if err != nil {
+			return nil, err
+		}
+		span := years[name]
+		zones = append(zones, newTimezone(loc, span[0], span[1]))
+	}
+	return zones, nil
+}
+
+func (e *Event) write(w *writer) {
+	w.line("BEGIN:VEVENT")
+	w.prop("UID", e.UID)
+	w.prop("DTSTAMP", e.LastModified.UTC().Format(utcFormat))
+	if e.Sequence > 0 {
+		w.prop("SEQUENCE", fmt.Sprint(e.Sequence))
+	}
+	e.writeTime(w, "DTSTART", e.Start)
+	e.writeTime(w, "DTEND", e.End)
+	if e.RRule != "" {
+		w.prop("RRULE", e.RRule)
+	}
+	w.prop("SUMMARY", escapeText(e.Summary))
+	if e.Description != "" {
+		w.prop("DESCRIPTION", escapeText(e.Description))
+	}
+	if e.URL != "" {
+		w.prop("URL", e.URL)
+	}
+	if e.Status != "" {
+		w.prop("STATUS", e.Status)
+	}
+	if e.Organizer != nil {
+		w.prop("ORGANIZER"+cnParam(e.Organizer.Name), mailto(e.Organizer.Email))
+	}
+	for _, a := range e.Attendees {
+		params := cnParam(a.Name)
+		if a.Role != "" {
+			params += ";ROLE=" + a.Role
+		}
+		if a.PartStat != "" {
+			params += ";PARTSTAT=" + a.PartStat
+		}
+		if a.RSVP {
+			params += ";RSVP=TRUE"
+		}
+		w.prop("ATTENDEE"+params, mailto(a.Email))
+	}
+	if !e.Created.IsZero() {
+		w.prop("CREATED", e.Created.UTC().Format(utcFormat))
+	}
+	w.prop("LAST-MODIFIED", e.LastModified.UTC().Format(utcFormat))
+	w.line("END:VEVENT")
+}
+
+// writeTime writes a DATE-TIME property, in the event time zone when one is set
+func (e *Event) writeTime(w *writer, name string, t time.Time) {
+	if e.TimeZone == "" {
+		w.prop(name, t.UTC().Format(utcFormat))
+		return
+	}
+	loc, err := LoadTimeZone(e.TimeZone)
+	if err != nil {
+		// timezones() has already rejected unknown zones
+		w.prop(name, t.UTC().Format(utcFormat))
+		return
+	}
+	w.prop(name+";TZID="+e.TimeZone, t.In(loc).Format(localFormat))
+}
+
+// cnParam renders the CN parameter, quoted because names may contain separators
+func cnParam(name string) string {
+	if name == "" {
+		return ""
+	}
+	// DQUOTEは引用符付きパラメータ値に含められない
+	return `;CN="` + strings.ReplaceAll(name, `"`, "'") + `"`
+}
+
+func mailto(email string) string {
+	return "mailto:" + email
+}
+
+// escapeText escapes a TEXT value (RFC 5545 section 3.3.11)
+func escapeText(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	var b strings.Builder
+	for _, r := range s {
+		switch r {
+		case '\\', ';', ',':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// writer accumulates content lines, folding them at 75 octets
+type writer struct {
+	buf bytes.Buffer
+}
+
+func (w *writer) prop(name, value string) {
+	w.line(name + ":" + value)
+}
+
+func (w *writer) line(s string) {
+	limit := maxLineOctets
+	for len(s) > limit {
+		// マルチバイト文字の途中では折り返さない
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		w.buf.WriteString(s[:cut])
+		w.buf.WriteString("\r\n ")
+		s = s[cut:]
+		// 継続行は先頭の空白を含めて75オクテット
+		limit = maxLineOctets - 1
+	}
+	w.buf.WriteString(s)
+	w.buf.WriteString("\r\n")
+}
@@ -0,0 +1,147 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// unfold joins folded content lines
+func unfold(data []byte) string {
+	return strings.ReplaceAll(string(data), "\r\n ", "")
+}
+
+func sampleEvent() Event {
+	created := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	return Event{
+		UID:          "event-1@morrow",
+		Summary:      "Launch; v1, final",
+		Description:  "Line one\nLine two",
+		Start:        time.Date(2025, 12, 24, 18, 0, 0, 0, time.UTC),
+		End:          time.Date(2025, 12, 24, 20, 0, 0, 0, time.UTC),
+		Created:      created,
+		LastModified: created,
+		Organizer:    &Person{Name: "Alice", Email: "alice@example.com"},
+		Attendees: []Attendee{
+			{Person: Person{Name: "Bob", Email: "bob@example.com"}, Role: RoleReqParticipant, PartStat: PartStatAccepted},
+		},
+	}
+}
+
+func TestMarshal_UTCEvent(t *testing.T) {
+	cal := &Calendar{ProdID: "-//Morrow//Test//EN", Name: "Morrow", Events: []Event{sampleEvent()}}
+	data, err := cal.Marshal()
+	require.NoError(t, err)
+	out := unfold(data)
+
+	assert.True(t, strings.HasPrefix(out, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(out, "END:VCALENDAR\r\n"))
+	assert.Contains(t, out, "DTSTART:20251224T180000Z\r\n")
+	assert.Contains(t, out, "DTEND:20251224T200000Z\r\n")
+	assert.Contains(t, out, `SUMMARY:Launch\; v1\, final`+"\r\n")
+	assert.Contains(t, out, `DESCRIPTION:Line one\nLine two`+"\r\n")
+	assert.Contains(t, out, `ORGANIZER;CN="Alice":mailto:alice@example.com`+"\r\n")
+	assert.Contains(t, out, `ATTENDEE;CN="Bob";ROLE=REQ-PARTICIPANT;PARTSTAT=ACCEPTED:mailto:bob@example.com`+"\r\n")
+	assert.NotContains(t, out, "VTIMEZONE")
+}
+
+func TestMarshal_IsDeterministic(t *testing.T) {
+	cal := &Calendar{ProdID: "-//Morrow//Test//EN", Events: []Event{sampleEvent()}}
+	a, err := cal.Marshal()
+	require.NoError(t, err)
+	b, err := cal.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, a, b)
+}
+
+func TestMarshal_TimeZoneAndRecurrence(t *testing.T) {
+	e := sampleEvent()
+	e.TimeZone = "America/New_York"
+	e.RRule = "FREQ=WEEKLY;BYDAY=MO;COUNT=4"
+	e.Start = time.Date(2025, 7, 7, 13, 0, 0, 0, time.UTC)
+	e.End = e.Start.Add(time.Hour)
+
+	data, err := (&Calendar{ProdID: "-//Morrow//Test//EN", Events: []Event{e}}).Marshal()
+	require.NoError(t, err)
+	out := unfold(data)
+
+	assert.Contains(t, out, "DTSTART;TZID=America/New_York:20250707T090000\r\n")
+	assert.Contains(t, out, "RRULE:FREQ=WEEKLY;BYDAY=MO;COUNT=4\r\n")
+	assert.Contains(t, out, "BEGIN:VTIMEZONE\r\nTZID:America/New_York\r\n")
+	// 2025年3月9日 2:00 EST に夏時間へ切り替わる
+	assert.Contains(t, out, "BEGIN:DAYLIGHT\r\nDTSTART:20250309T020000\r\nTZOFFSETFROM:-0500\r\nTZOFFSETTO:-0400\r\nTZNAME:EDT\r\n")
+	assert.Contains(t, out, "BEGIN:STANDARD\r\nDTSTART:20251102T020000\r\nTZOFFSETFROM:-0400\r\nTZOFFSETTO:-0500\r\n")
+}
+
+func TestMarshal_FixedOffsetZone(t *testing.T) {
+	e := sampleEvent()
+	e.TimeZone = "Asia/Tokyo"
+
+	data, err := (&Calendar{ProdID: "-//Morrow//Test//EN", Events: []Event{e}}).Marshal()
+	require.NoError(t, err)
+	out := unfold(data)
+
+	assert.Contains(t, out, "DTSTART;TZID=Asia/Tokyo:20251225T030000\r\n")
+	assert.Contains(t, out, "BEGIN:STANDARD\r\nDTSTART:19700101T000000\r\nTZOFFSETFROM:+0900\r\nTZOFFSETTO:+0900\r\nTZNAME:JST\r\n")
+}
+
+func TestMarshal_UnknownTimeZone(t *testing.T) {
+	e := sampleEvent()
+	e.TimeZone = "Mars/Olympus"
+	_, err := (&Calendar{Events: []Event{e}}).Marshal()
+	assert.Error(t, err)
+}
+
+func TestWriter_FoldsLongLines(t *testing.T) {
+	w := &writer{}
+	w.prop("SUMMARY", strings.Repeat("あ", 60))
+
+	lines := strings.Split(strings.TrimSuffix(w.buf.String(), "\r\n"), "\r\n")
+	require.Greater(t, len(lines), 1)
+	unfolded := lines[0]
+	for i, line := range lines {
+		assert.LessOrEqual(t, len(line), maxLineOctets)
+		if i > 0 {
+			assert.True(t, strings.HasPrefix(line, " "))
+			unfolded += strings.TrimPrefix(line, " ")
+		}
+	}
+	assert.Equal(t, "SUMMARY:"+strings.Repeat("あ", 60), unfolded)
+}
+
+func TestValidateRRule(t *testing.T) {
+	valid := []string{
+		"FREQ=DAILY",
+		"FREQ=WEEKLY;BYDAY=MO,WE,FR;COUNT=10",
+		"FREQ=MONTHLY;BYDAY=-1FR;UNTIL=20261231T000000Z",
+		"FREQ=YEARLY;INTERVAL=2;BYMONTH=1;BYMONTHDAY=1",
+	}
+	for _, rule := range valid {
+		assert.NoError(t, ValidateRRule(rule), rule)
+	}
+
+	invalid := []string{
+		"",
+		"BYDAY=MO",
+		"FREQ=FORTNIGHTLY",
+		"FREQ=DAILY;COUNT=0",
+		"FREQ=DAILY;COUNT=2;UNTIL=20261231",
+		"FREQ=DAILY;FREQ=WEEKLY",
+		"FREQ=DAILY;UNTIL=20250230",
+		"FREQ=DAILY;X-NAME=1",
+		"FREQ=DAILY\r\nATTENDEE:mailto:x@example.com",
+	}
+	for _, rule := range invalid {
+		assert.Error(t, ValidateRRule(rule), rule)
+	}
+}
+
+func TestValidateTimeZone(t *testing.T) {
+	assert.NoError(t, ValidateTimeZone("Asia/Tokyo"))
+	assert.Error(t, ValidateTimeZone(""))
+	assert.Error(t, ValidateTimeZone("Local"))
+	assert.Error(t, ValidateTimeZone("Not/AZone"))
+}
@@ -0,0 +1,326 @@
+package ical
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParsedEvent is a VEVENT read by Parse. Err is set when the component could
+// not be converted, so callers can report it and continue with the others.
+type ParsedEvent struct {
+	Event
+	// Line is the line of BEGIN:VEVENT in the unfolded document
+	Line int
+	Err  error
+}
+
+// property is a content line split into its parts
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// Parse reads the VEVENT components of an iCalendar document.
+// Other components such as VTIMEZONE and VALARM are ignored; TZID parameters
+// are resolved against the IANA time zone database.
+func Parse(r io.Reader) ([]ParsedEvent, error) {
+	lines, err := unfoldLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		events   []ParsedEvent
+		stack    []string
+		current  []property
+		startAt  int
+		calendar bool
+	)
+	for i, line := range lines {
+		if line == "" {
+			continue
+		}
+		p, err := parseProperty(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		switch p.name {
+		case "BEGIN":
+			name := strings.ToUpper(p.value)
+			if len(stack) == 0 && name != "VCALENDAR" {
+				return nil, fmt.Errorf("line %d: expected BEGIN:VCALENDAR", i+1)
+			}
+			if name == "VCALENDAR" {
+				calendar = true
+			}
+			stack = append(stack, name)
+			if name == "VEVENT" && len(stack) == 2 {
+				current, startAt = nil, i+1
+			}
+		case "END":
+			name := strings.ToUpper(p.value)
+			if len(stack) == 0 || stack[len(stack)-1] != name {
+				return nil, fmt.Errorf("line %d: unexpected END:%s", i+1, p.value)
+			}
+			stack = stack[:len(stack)-1]
+			if name == "VEVENT" && len(stack) == 1 {
+				e, err := buildEvent(current)
+				events = append(events, ParsedEvent{Event: e, Line: startAt, Err: err})
+			}
+		default:
+			// VEVENT直下のプロパティのみを対象にする (VALARMなどは無視)
+			if len(stack) == 2 && stack[1] == "VEVENT" {
+				current = append(current, p)
+			}
+		}
+	}
+
+	if !calendar {
+		return nil, errors.New("not an iCalendar document")
+	}
+	if len(stack) != 0 {
+		return nil, fmt.Errorf("missing END:%s", stack[len(stack)-1])
+	}
+	return events, nil
+}
+
+// unfoldLines splits the document into logical content lines (RFC 5545 section 3.1)
+func unfoldLines(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read calendar: %w", err)
+	}
+	return lines, nil
+}
+
+// parseProperty splits "NAME;PARAM=VALUE:value", honouring quoted parameter values
+func parseProperty(line string) (property, error) {
+	p := property{params: map[string]string{}}
+
+	inQuotes := false
+	end := -1
+	for i := 0; i < len(line) && end < 0; i++ {
+		switch line[i] {
+		case '"':
+			inQuotes = !inQuotes
+		case ':':
+			if !inQuotes {
+				end = i
+			}
+		}
+	}
+	if end < 0 {
+		return p, fmt.Errorf("invalid content line %q", line)
+	}
+	p.value = line[end+1:]
+
+	parts := splitParams(line[:end])
+	p.name = strings.ToUpper(parts[0])
+	for _, param := range parts[1:] {
+		k, v, ok := strings.Cut(param, "=")
+		if !ok {
+			return p, fmt.Errorf("invalid parameter %q", param)
+		}
+		p.params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+	}
+	return p, nil
+}
+
+// splitParams splits on semicolons outside of quotes
+func splitParams(s string) []string {
+	var parts []string
+	inQuotes := false
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			inQuotes = !inQuotes
+		case ';':
+			if !inQuotes {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// buildEvent converts the properties of a VEVENT
+func buildEvent(props []property) (Event, error) {
+	var (
+		e            Event
+		start, end   *property
+		duration     string
+		recurrenceID bool
+		cancelled    bool
+		hasDate      bool
+	)
+	for i := range props {
+		p := &props[i]
+		switch p.name {
+		case "UID":
+			e.UID = p.value
+		case "SUMMARY":
+			e.Summary = unescapeText(p.value)
+		case "DESCRIPTION":
+			e.Description = unescapeText(p.value)
+		case "URL":
+			e.URL = p.value
+		case "DTSTART":
+			start = p
+		case "DTEND":
+			end = p
+		case "DURATION":
+			duration = p.value
+		case "RRULE":
+			e.RRule = p.value
+		case "RECURRENCE-ID":
+			recurrenceID = true
+		case "STATUS":
+			cancelled = strings.EqualFold(p.value, "CANCELLED")
+		case "SEQUENCE":
+			e.Sequence, _ = strconv.Atoi(p.value)
+		}
+	}
+
+	if recurrenceID {
+		return e, errors.New("modified instances of recurring events (RECURRENCE-ID) are not supported")
+	}
+	if cancelled {
+		return e, errors.New("event is cancelled")
+	}
+	if start == nil {
+		return e, errors.New("DTSTART is required")
+	}
+
+	var err error
+	e.Start, hasDate, err = parseDateTime(start)
+	if err != nil {
+		return e, fmt.Errorf("invalid DTSTART: %w", err)
+	}
+	if tzid := start.params["TZID"]; tzid != "" {
+		e.TimeZone = tzid
+	}
+
+	switch {
+	case end != nil:
+		e.End, _, err = parseDateTime(end)
+		if err != nil {
+			return e, fmt.Errorf("invalid DTEND: %w", err)
+		}
+	case duration != "":
+		d, err := parseDuration(duration)
+		if err != nil {
+			return e, fmt.Errorf("invalid DURATION: %w", err)
+		}
+		e.End = e.Start.Add(d)
+	case hasDate:
+		// 終日イベントは1日分とみなす (RFC 5545 section 3.6.1)
+		e.End = e.Start.AddDate(0, 0, 1)
+	default:
+		e.End = e.Start
+	}
+	if e.End.Before(e.Start) {
+		return e, errors.New("DTEND is before DTSTART")
+	}
+
+	if e.RRule != "" {
+		if err := ValidateRRule(e.RRule); err != nil {
+			return e, err
+		}
+	}
+	return e, nil
+}
+
+// parseDateTime parses a DATE or DATE-TIME property value. Floating times
+// without TZID are interpreted as UTC. It reports whether the value is a DATE.
+func parseDateTime(p *property) (time.Time, bool, error) {
+	loc := time.UTC
+	if tzid := p.params["TZID"]; tzid != "" {
+		l, err := LoadTimeZone(tzid)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("unsupported time zone %q", tzid)
+		}
+		loc = l
+	}
+
+	value := p.value
+	switch {
+	case strings.EqualFold(p.params["VALUE"], "DATE") || len(value) == len("20060102"):
+		t, err := time.ParseInLocation("20060102", value, loc)
+		return t, true, err
+	case strings.HasSuffix(value, "Z"):
+		t, err := time.Parse(utcFormat, value)
+		return t, false, err
+	default:
+		t, err := time.ParseInLocation(localFormat, value, loc)
+		return t, false, err
+	}
+}
+
+var durationPattern = regexp.MustCompile(`^([+-])?P(?:(\d+)W|(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?)$`)
+
+// parseDuration parses a DURATION value such as "PT1H30M" or "P2D"
+func parseDuration(value string) (time.Duration, error) {
+	m := durationPattern.FindStringSubmatch(value)
+	if m == nil || value == "P" || strings.HasSuffix(value, "T") {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var d time.Duration
+	for i, unit := range units {
+		if m[i+2] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(m[i+2])
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+		d += time.Duration(n) * unit
+	}
+	if m[1] == "-" {
+		d = -d
+	}
+	return d, nil
+}
+
+// unescapeText reverses escapeText
+func unescapeText(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i == len(s)-1 {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n', 'N':
+			b.WriteByte('\n')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
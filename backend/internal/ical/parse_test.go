@@ -0,0 +1,140 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleCalendar = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"PRODID:-//Example//EN\r\n" +
+	"BEGIN:VTIMEZONE\r\n" +
+	"TZID:Asia/Tokyo\r\n" +
+	"BEGIN:STANDARD\r\n" +
+	"DTSTART:19700101T000000\r\n" +
+	"TZOFFSETFROM:+0900\r\n" +
+	"TZOFFSETTO:+0900\r\n" +
+	"END:STANDARD\r\n" +
+	"END:VTIMEZONE\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:weekly@example.com\r\n" +
+	"SUMMARY:Standup\\, daily\r\n" +
+	"DESCRIPTION:Line one\\nLine two that is long enough to be folded by the\r\n" +
+	"  exporting client\r\n" +
+	"DTSTART;TZID=Asia/Tokyo:20251020T100000\r\n" +
+	"DURATION:PT15M\r\n" +
+	"RRULE:FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"ACTION:DISPLAY\r\n" +
+	"DESCRIPTION:Reminder\r\n" +
+	"END:VALARM\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:holiday@example.com\r\n" +
+	"SUMMARY:Holiday\r\n" +
+	"DTSTART;VALUE=DATE:20251224\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:broken@example.com\r\n" +
+	"SUMMARY:No start\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParse(t *testing.T) {
+	events, err := Parse(strings.NewReader(sampleCalendar))
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+
+	tokyo, err := LoadTimeZone("Asia/Tokyo")
+	require.NoError(t, err)
+
+	standup := events[0]
+	require.NoError(t, standup.Err)
+	assert.Equal(t, "weekly@example.com", standup.UID)
+	assert.Equal(t, "Standup, daily", standup.Summary)
+	assert.Equal(t, "Line one\nLine two that is long enough to be folded by the exporting client", standup.Description)
+	assert.True(t, standup.Start.Equal(time.Date(2025, 10, 20, 10, 0, 0, 0, tokyo)))
+	assert.Equal(t, 15*time.Minute, standup.End.Sub(standup.Start))
+	assert.Equal(t, "Asia/Tokyo", standup.TimeZone)
+	assert.Equal(t, "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR", standup.RRule)
+
+	holiday := events[1]
+	require.NoError(t, holiday.Err)
+	assert.True(t, holiday.Start.Equal(time.Date(2025, 12, 24, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, holiday.End.Equal(time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC)))
+
+	assert.Error(t, events[2].Err)
+	assert.Equal(t, "broken@example.com", events[2].UID)
+}
+
+func TestParse_RoundTrip(t *testing.T) {
+	e := sampleEvent()
+	e.TimeZone = "Europe/Paris"
+	e.RRule = "FREQ=MONTHLY;COUNT=3"
+	data, err := (&Calendar{ProdID: "-//Morrow//Test//EN", Events: []Event{e}}).Marshal()
+	require.NoError(t, err)
+
+	events, err := Parse(strings.NewReader(string(data)))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.NoError(t, events[0].Err)
+	assert.Equal(t, e.UID, events[0].UID)
+	assert.Equal(t, e.Summary, events[0].Summary)
+	assert.Equal(t, e.Description, events[0].Description)
+	assert.True(t, e.Start.Equal(events[0].Start))
+	assert.True(t, e.End.Equal(events[0].End))
+	assert.Equal(t, e.RRule, events[0].RRule)
+}
+
+func TestParse_SkipsUnsupportedEvents(t *testing.T) {
+	doc := "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VEVENT\r\nUID:a\r\nDTSTART;TZID=Tokyo Standard Time:20251020T100000\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nUID:b\r\nRECURRENCE-ID:20251020T100000Z\r\nDTSTART:20251020T100000Z\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nUID:c\r\nDTSTART:20251020T100000Z\r\nDTEND:20251019T100000Z\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nUID:d\r\nDTSTART:20251020T100000Z\r\nRRULE:FREQ=SOMETIMES\r\nEND:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	events, err := Parse(strings.NewReader(doc))
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	for _, e := range events {
+		assert.Error(t, e.Err, e.UID)
+	}
+}
+
+func TestParse_InvalidDocuments(t *testing.T) {
+	invalid := []string{
+		"",
+		"hello world",
+		"BEGIN:VEVENT\r\nEND:VEVENT\r\n",
+		"BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\n",
+		"BEGIN:VCALENDAR\r\nEND:VEVENT\r\n",
+	}
+	for _, doc := range invalid {
+		_, err := Parse(strings.NewReader(doc))
+		assert.Error(t, err, doc)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	cases := map[string]time.Duration{
+		"PT15M":      15 * time.Minute,
+		"P1D":        24 * time.Hour,
+		"P2W":        14 * 24 * time.Hour,
+		"P1DT2H3M4S": 26*time.Hour + 3*time.Minute + 4*time.Second,
+		"-PT1H":      -time.Hour,
+	}
+	for value, want := range cases {
+		got, err := parseDuration(value)
+		require.NoError(t, err, value)
+		assert.Equal(t, want, got, value)
+	}
+	for _, value := range []string{"P", "PT", "1H", "P1H"} {
+		_, err := parseDuration(value)
+		assert.Error(t, err, value)
+	}
+}
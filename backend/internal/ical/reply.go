@@ -0,0 +1,91 @@
+package ical
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Reply is an iTIP REPLY sent back by an attendee's calendar client
+type Reply struct {
+	UID      string
+	Sequence int
+	// Attendees are the attendees whose participation status is replied
+	Attendees []Attendee
+}
+
+// ParseReply reads an iTIP REPLY document (RFC 5546 section 3.2.3).
+// Only the first VEVENT is considered, since Morrow does not send recurrence overrides.
+func ParseReply(r io.Reader) (*Reply, error) {
+	lines, err := unfoldLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		reply  Reply
+		stack  []string
+		method string
+		events int
+	)
+	for i, line := range lines {
+		if line == "" {
+			continue
+		}
+		p, err := parseProperty(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		switch {
+		case p.name == "BEGIN":
+			stack = append(stack, strings.ToUpper(p.value))
+			if len(stack) == 2 && stack[1] == "VEVENT" {
+				events++
+			}
+		case p.name == "END":
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("line %d: unexpected END:%s", i+1, p.value)
+			}
+			stack = stack[:len(stack)-1]
+		case len(stack) == 1 && p.name == "METHOD":
+			method = strings.ToUpper(p.value)
+		case len(stack) == 2 && stack[1] == "VEVENT" && events == 1:
+			switch p.name {
+			case "UID":
+				reply.UID = p.value
+			case "SEQUENCE":
+				reply.Sequence, _ = strconv.Atoi(p.value)
+			case "ATTENDEE":
+				reply.Attendees = append(reply.Attendees, Attendee{
+					Person: Person{
+						Name:  p.params["CN"],
+						Email: trimMailto(p.value),
+					},
+					PartStat: strings.ToUpper(p.params["PARTSTAT"]),
+				})
+			}
+		}
+	}
+
+	if method != MethodReply {
+		return nil, fmt.Errorf("expected METHOD:%s, got %q", MethodReply, method)
+	}
+	if reply.UID == "" {
+		return nil, errors.New("reply has no UID")
+	}
+	if len(reply.Attendees) == 0 {
+		return nil, errors.New("reply has no ATTENDEE")
+	}
+	return &reply, nil
+}
+
+// trimMailto strips the mailto: scheme of a CAL-ADDRESS value
+func trimMailto(address string) string {
+	if len(address) >= len("mailto:") && strings.EqualFold(address[:len("mailto:")], "mailto:") {
+		return address[len("mailto:"):]
+	}
+	return address
+}
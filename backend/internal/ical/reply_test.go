@@ -0,0 +1,64 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReply(t *testing.T) {
+	doc := "BEGIN:VCALENDAR\r\n" +
+		"VERSION:2.0\r\n" +
+		"METHOD:REPLY\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:7@morrow\r\n" +
+		"SEQUENCE:2\r\n" +
+		"ATTENDEE;PARTSTAT=accepted;CN=\"Bob; Jr\":MAILTO:bob@example.com\r\n" +
+		"DTSTART:20251224T180000Z\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	reply, err := ParseReply(strings.NewReader(doc))
+	require.NoError(t, err)
+	assert.Equal(t, "7@morrow", reply.UID)
+	assert.Equal(t, 2, reply.Sequence)
+	require.Len(t, reply.Attendees, 1)
+	assert.Equal(t, "bob@example.com", reply.Attendees[0].Email)
+	assert.Equal(t, "Bob; Jr", reply.Attendees[0].Name)
+	assert.Equal(t, PartStatAccepted, reply.Attendees[0].PartStat)
+}
+
+func TestParseReply_RejectsOtherMethods(t *testing.T) {
+	e := sampleEvent()
+	data, err := (&Calendar{ProdID: "-//Morrow//Test//EN", Method: MethodRequest, Events: []Event{e}}).Marshal()
+	require.NoError(t, err)
+
+	_, err = ParseReply(strings.NewReader(string(data)))
+	assert.Error(t, err)
+}
+
+func TestParseReply_RequiresAttendee(t *testing.T) {
+	doc := "BEGIN:VCALENDAR\r\nMETHOD:REPLY\r\nBEGIN:VEVENT\r\nUID:7@morrow\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+	_, err := ParseReply(strings.NewReader(doc))
+	assert.Error(t, err)
+}
+
+func TestMarshal_Request(t *testing.T) {
+	e := sampleEvent()
+	e.Sequence = 3
+	e.Attendees[0].RSVP = true
+	data, err := (&Calendar{ProdID: "-//Morrow//Test//EN", Method: MethodRequest, Events: []Event{e}}).Marshal()
+	require.NoError(t, err)
+	out := unfold(data)
+
+	assert.Contains(t, out, "METHOD:REQUEST\r\n")
+	assert.Contains(t, out, "SEQUENCE:3\r\n")
+	assert.Contains(t, out, "PARTSTAT=ACCEPTED;RSVP=TRUE:mailto:bob@example.com\r\n")
+
+	e.Status = StatusCancelled
+	data, err = (&Calendar{ProdID: "-//Morrow//Test//EN", Method: MethodCancel, Events: []Event{e}}).Marshal()
+	require.NoError(t, err)
+	assert.Contains(t, unfold(data), "STATUS:CANCELLED\r\n")
+}
@@ -0,0 +1,86 @@
+package ical
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// rruleParts lists the rule parts of RFC 5545 section 3.3.10
+var rruleParts = map[string]*regexp.Regexp{
+	"FREQ":       regexp.MustCompile(`^(SECONDLY|MINUTELY|HOURLY|DAILY|WEEKLY|MONTHLY|YEARLY)$`),
+	"UNTIL":      regexp.MustCompile(`^\d{8}(T\d{6}Z?)?$`),
+	"COUNT":      regexp.MustCompile(`^[1-9]\d*$`),
+	"INTERVAL":   regexp.MustCompile(`^[1-9]\d*$`),
+	"BYSECOND":   numberList,
+	"BYMINUTE":   numberList,
+	"BYHOUR":     numberList,
+	"BYDAY":      regexp.MustCompile(`^([+-]?\d{1,2})?(MO|TU|WE|TH|FR|SA|SU)(,([+-]?\d{1,2})?(MO|TU|WE|TH|FR|SA|SU))*$`),
+	"BYMONTHDAY": signedNumberList,
+	"BYYEARDAY":  signedNumberList,
+	"BYWEEKNO":   signedNumberList,
+	"BYMONTH":    numberList,
+	"BYSETPOS":   signedNumberList,
+	"WKST":       regexp.MustCompile(`^(MO|TU|WE|TH|FR|SA|SU)$`),
+}
+
+var (
+	numberList       = regexp.MustCompile(`^\d{1,3}(,\d{1,3})*$`)
+	signedNumberList = regexp.MustCompile(`^[+-]?\d{1,3}(,[+-]?\d{1,3})*$`)
+)
+
+// ValidateRRule checks the syntax of an RRULE value such as "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10".
+// It is used as an ent validator so that stored rules can be written to feeds verbatim.
+func ValidateRRule(rule string) error {
+	if rule == "" {
+		return fmt.Errorf("recurrence rule must not be empty")
+	}
+
+	seen := map[string]bool{}
+	for _, part := range strings.Split(rule, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return fmt.Errorf("invalid recurrence rule part %q", part)
+		}
+		pattern, known := rruleParts[name]
+		if !known {
+			return fmt.Errorf("unknown recurrence rule part %q", name)
+		}
+		if seen[name] {
+			return fmt.Errorf("recurrence rule part %q is repeated", name)
+		}
+		seen[name] = true
+		if !pattern.MatchString(value) {
+			return fmt.Errorf("invalid value %q for recurrence rule part %s", value, name)
+		}
+		if name == "UNTIL" {
+			if err := validateUntil(value); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !seen["FREQ"] {
+		return fmt.Errorf("recurrence rule requires FREQ")
+	}
+	if seen["UNTIL"] && seen["COUNT"] {
+		return fmt.Errorf("recurrence rule cannot contain both UNTIL and COUNT")
+	}
+	return nil
+}
+
+// validateUntil rejects calendar dates that do not exist, e.g. 20250230
+func validateUntil(value string) error {
+	layout := "20060102"
+	switch len(value) {
+	case len("20060102T150405"):
+		layout = localFormat
+	case len(utcFormat):
+		layout = utcFormat
+	}
+	if _, err := time.Parse(layout, value); err != nil {
+		return fmt.Errorf("invalid UNTIL date %q", value)
+	}
+	return nil
+}
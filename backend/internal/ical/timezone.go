@@ -0,0 +1,133 @@
+package ical
+
+import (
+	"fmt"
+	"sort"
+	"time"
+	_ "time/tzdata" // コンテナにタイムゾーンDBがなくても動作させる
+)
+
+// LoadTimeZone resolves an IANA time zone name. Unlike time.LoadLocation it
+// rejects the empty string and "Local", which depend on the server.
+func LoadTimeZone(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, fmt.Errorf("invalid time zone %q", name)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone %q", name)
+	}
+	return loc, nil
+}
+
+// ValidateTimeZone is an ent validator for IANA time zone names
+func ValidateTimeZone(name string) error {
+	_, err := LoadTimeZone(name)
+	return err
+}
+
+// observance is a STANDARD or DAYLIGHT sub-component of a VTIMEZONE
+type observance struct {
+	daylight   bool
+	start      time.Time // wall clock time in the offset before the transition
+	offsetFrom int
+	offsetTo   int
+	name       string
+}
+
+// timezone is a VTIMEZONE component
+type timezone struct {
+	id          string
+	observances []observance
+}
+
+// newTimezone describes loc with the UTC offset transitions from the year
+// before firstYear (so the observance in effect at the first event is
+// included) through lastYear.
+func newTimezone(loc *time.Location, firstYear, lastYear int) *timezone {
+	tz := &timezone{id: loc.String()}
+
+	from := time.Date(firstYear-1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(lastYear+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for _, t := range transitions(loc, from, to) {
+		name, offset := t.In(loc).Zone()
+		_, before := t.Add(-time.Second).In(loc).Zone()
+		tz.observances = append(tz.observances, observance{
+			daylight:   t.In(loc).IsDST(),
+			start:      t.In(time.FixedZone("", before)),
+			offsetFrom: before,
+			offsetTo:   offset,
+			name:       name,
+		})
+	}
+
+	if len(tz.observances) == 0 {
+		// 期間内に切り替えがないゾーンは固定オフセットとして記述する
+		name, offset := from.In(loc).Zone()
+		tz.observances = append(tz.observances, observance{
+			daylight:   from.In(loc).IsDST(),
+			start:      time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC),
+			offsetFrom: offset,
+			offsetTo:   offset,
+			name:       name,
+		})
+	}
+	return tz
+}
+
+// transitions returns the instants in [from, to) at which the UTC offset of loc changes
+func transitions(loc *time.Location, from, to time.Time) []time.Time {
+	var result []time.Time
+	offsetAt := func(t time.Time) int {
+		_, offset := t.In(loc).Zone()
+		return offset
+	}
+	for day := from; day.Before(to); day = day.AddDate(0, 0, 1) {
+		next := day.AddDate(0, 0, 1)
+		if offsetAt(day) == offsetAt(next) {
+			continue
+		}
+		// 1日の範囲内で切り替え時刻を秒単位で二分探索する
+		lo, hi := day.Unix(), next.Unix()
+		before := offsetAt(day)
+		i := sort.Search(int(hi-lo), func(i int) bool {
+			return offsetAt(time.Unix(lo+int64(i)+1, 0)) != before
+		})
+		result = append(result, time.Unix(lo+int64(i)+1, 0).UTC())
+	}
+	return result
+}
+
+func (tz *timezone) write(w *writer) {
+	w.line("BEGIN:VTIMEZONE")
+	w.prop("TZID", tz.id)
+	for _, o := range tz.observances {
+		kind := "STANDARD"
+		if o.daylight {
+			kind = "DAYLIGHT"
+		}
+		w.line("BEGIN:" + kind)
+		w.prop("DTSTART", o.start.Format(localFormat))
+		w.prop("TZOFFSETFROM", formatOffset(o.offsetFrom))
+		w.prop("TZOFFSETTO", formatOffset(o.offsetTo))
+		if o.name != "" {
+			w.prop("TZNAME", escapeText(o.name))
+		}
+		w.line("END:" + kind)
+	}
+	w.line("END:VTIMEZONE")
+}
+
+// formatOffset renders a UTC offset in seconds as +HHMM or +HHMMSS
+func formatOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+	s := fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds/60%60)
+	if seconds%60 != 0 {
+		s += fmt.Sprintf("%02d", seconds%60)
+	}
+	return s
+}
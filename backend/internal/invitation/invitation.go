@@ -0,0 +1,318 @@
+// Package invitation emails iTIP invitations (RFC 5546) to event participants
+// and applies the replies of their calendar clients.
+package invitation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	netmail "net/mail"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/participant"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+	"github.com/matsuokashuhei/morrow-backend/internal/calendar"
+	"github.com/matsuokashuhei/morrow-backend/internal/ical"
+	"github.com/matsuokashuhei/morrow-backend/internal/mail"
+	"github.com/sirupsen/logrus"
+)
+
+// sendTimeout bounds the delivery of one outbox
+const sendTimeout = time.Minute
+
+var (
+	// ErrUnknownEvent is returned for replies to events that do not exist
+	ErrUnknownEvent = errors.New("reply does not match any event")
+	// ErrUnknownAttendee is returned when the sender of the reply does not participate in the event
+	ErrUnknownAttendee = errors.New("reply does not match any participant")
+	// ErrStaleReply is returned for replies to an older SEQUENCE of the event
+	ErrStaleReply = errors.New("reply is for an outdated version of the event")
+	// ErrSenderMismatch is returned when the sender of the reply is not one of its attendees
+	ErrSenderMismatch = errors.New("reply is not sent by one of its attendees")
+)
+
+// Sender builds and delivers invitation emails.
+// A nil *Sender is valid and sends nothing, which is how invitations are disabled.
+type Sender struct {
+	client *ent.Client
+	mailer mail.Mailer
+	from   *netmail.Address
+	logger *logrus.Logger
+	// pending counts the messages handed to Outbox.Send that are not delivered yet
+	pending atomic.Int64
+}
+
+// NewSender creates a Sender. from is the sender address of the emails and also
+// the ORGANIZER address, so that calendar clients send their replies back to Morrow.
+// It returns nil when mailer is nil.
+func NewSender(client *ent.Client, mailer mail.Mailer, from string, logger *logrus.Logger) (*Sender, error) {
+	if mailer == nil {
+		return nil, nil
+	}
+	address, err := netmail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mail from address: %w", err)
+	}
+	return &Sender{client: client, mailer: mailer, from: address, logger: logger}, nil
+}
+
+// Outbox holds prepared messages until the change they announce has been saved
+type Outbox struct {
+	sender   *Sender
+	messages []*mail.Message
+}
+
+// Send delivers the messages in the background. Failures are logged.
+func (o *Outbox) Send(ctx context.Context) {
+	if o == nil || len(o.messages) == 0 {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	o.sender.pending.Add(int64(len(o.messages)))
+	go func() {
+		ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+		defer cancel()
+		for _, msg := range o.messages {
+			if err := o.sender.mailer.Send(ctx, msg); err != nil {
+				o.sender.logger.WithError(err).WithField("to", msg.To).Error("Failed to send invitation")
+			}
+			o.sender.pending.Add(-1)
+		}
+	}()
+}
+
+// Backlog returns the number of invitation emails waiting to be delivered
+func (s *Sender) Backlog() int64 {
+	if s == nil {
+		return 0
+	}
+	return s.pending.Load()
+}
+
+// Invite prepares a REQUEST for a participant who was just added to an event
+func (s *Sender) Invite(ctx context.Context, eventID, userID int) *Outbox {
+	if s == nil {
+		return nil
+	}
+	return s.prepare(ctx, eventID, func(e *ent.Event) ([]*mail.Message, error) {
+		return s.requests(e, func(p *ent.Participant) bool { return p.Edges.User.ID == userID }, "Invitation")
+	})
+}
+
+// Update prepares a REQUEST with the new details of an event for every participant who has not declined
+func (s *Sender) Update(ctx context.Context, eventID int) *Outbox {
+	if s == nil {
+		return nil
+	}
+	return s.prepare(ctx, eventID, func(e *ent.Event) ([]*mail.Message, error) {
+		return s.requests(e, func(p *ent.Participant) bool { return p.Status != participant.StatusDeclined }, "Updated invitation")
+	})
+}
+
+// CancelEvent prepares a CANCEL for the participants of an event that is about to be deleted
+func (s *Sender) CancelEvent(ctx context.Context, eventID int) *Outbox {
+	if s == nil {
+		return nil
+	}
+	return s.prepare(ctx, eventID, func(e *ent.Event) ([]*mail.Message, error) {
+		return s.cancels(e, func(p *ent.Participant) bool { return p.Status != participant.StatusDeclined })
+	})
+}
+
+// CancelParticipant prepares a CANCEL for a participant who is about to be removed from an event
+func (s *Sender) CancelParticipant(ctx context.Context, eventID, userID int) *Outbox {
+	if s == nil {
+		return nil
+	}
+	return s.prepare(ctx, eventID, func(e *ent.Event) ([]*mail.Message, error) {
+		return s.cancels(e, func(p *ent.Participant) bool { return p.Edges.User.ID == userID })
+	})
+}
+
+// prepare loads the event and builds its messages. Private events are never
+// sent to participants' calendars.
+func (s *Sender) prepare(ctx context.Context, eventID int, build func(e *ent.Event) ([]*mail.Message, error)) *Outbox {
+	e, err := calendar.WithEdges(s.client.Event.Query().Where(event.IDEQ(eventID))).Only(ctx)
+	if err != nil {
+		s.logger.WithError(err).WithField("event_id", eventID).Error("Failed to load event for invitation")
+		return nil
+	}
+	if e.Visibility == event.VisibilityPrivate {
+		return nil
+	}
+	messages, err := build(e)
+	if err != nil {
+		s.logger.WithError(err).WithField("event_id", eventID).Error("Failed to build invitation")
+		return nil
+	}
+	return &Outbox{sender: s, messages: messages}
+}
+
+// requests builds a REQUEST for each selected participant
+func (s *Sender) requests(e *ent.Event, selected func(p *ent.Participant) bool, subject string) ([]*mail.Message, error) {
+	var messages []*mail.Message
+	for _, p := range recipients(e, selected) {
+		ev := s.toICal(e)
+		for i := range ev.Attendees {
+			if strings.EqualFold(ev.Attendees[i].Email, p.Edges.User.Email) {
+				ev.Attendees[i].RSVP = true
+			}
+		}
+		msg, err := s.message(p.Edges.User, ical.MethodRequest, ev,
+			fmt.Sprintf("%s: %s", subject, ev.Summary),
+			fmt.Sprintf("%s invited you to %s.\n\n%s", organizerName(e), ev.Summary, schedule(ev)))
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, msg)
+	}
+	return messages, nil
+}
+
+// cancels builds a CANCEL for each selected participant
+func (s *Sender) cancels(e *ent.Event, selected func(p *ent.Participant) bool) ([]*mail.Message, error) {
+	var messages []*mail.Message
+	for _, p := range recipients(e, selected) {
+		ev := s.toICal(e)
+		// 取り消しは新しい版として扱われるようにSEQUENCEを進める
+		ev.Sequence++
+		ev.Status = ical.StatusCancelled
+		msg, err := s.message(p.Edges.User, ical.MethodCancel, ev,
+			fmt.Sprintf("Cancelled: %s", ev.Summary),
+			fmt.Sprintf("%s is no longer scheduled for you.\n\n%s", ev.Summary, schedule(ev)))
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, msg)
+	}
+	return messages, nil
+}
+
+// toICal converts the event with Morrow as the ORGANIZER address
+func (s *Sender) toICal(e *ent.Event) ical.Event {
+	ev := calendar.ToICal(e)
+	ev.Organizer = &ical.Person{Name: organizerName(e), Email: s.from.Address}
+	return ev
+}
+
+func (s *Sender) message(to *ent.User, method string, ev ical.Event, subject, text string) (*mail.Message, error) {
+	cal := ical.Calendar{ProdID: calendar.ProdID, Method: method, Events: []ical.Event{ev}}
+	data, err := cal.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return &mail.Message{
+		From:           s.from.String(),
+		To:             (&netmail.Address{Name: to.Name, Address: to.Email}).String(),
+		Subject:        subject,
+		Text:           text,
+		Calendar:       data,
+		CalendarMethod: method,
+	}, nil
+}
+
+// recipients returns the selected participants, leaving out the organizer
+func recipients(e *ent.Event, selected func(p *ent.Participant) bool) []*ent.Participant {
+	var result []*ent.Participant
+	for _, p := range e.Edges.Participants {
+		if p.Edges.User == nil || p.Edges.User.Email == "" {
+			continue
+		}
+		if e.Edges.Creator != nil && p.Edges.User.ID == e.Edges.Creator.ID {
+			continue
+		}
+		if selected(p) {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+func organizerName(e *ent.Event) string {
+	if e.Edges.Creator != nil {
+		return e.Edges.Creator.Name
+	}
+	return "Morrow"
+}
+
+// schedule describes when the event takes place for the text body
+func schedule(ev ical.Event) string {
+	loc := time.UTC
+	if ev.TimeZone != "" {
+		if l, err := ical.LoadTimeZone(ev.TimeZone); err == nil {
+			loc = l
+		}
+	}
+	const layout = "Mon, 02 Jan 2006 15:04 MST"
+	return fmt.Sprintf("%s - %s", ev.Start.In(loc).Format(layout), ev.End.In(loc).Format(layout))
+}
+
+// ApplyReply updates the participation of the attendee who sent reply. Following
+// RFC 6047 section 3.2, only the attendee matching the sender address of the
+// email is applied, so that nobody can answer on behalf of other participants.
+func ApplyReply(ctx context.Context, client *ent.Client, reply *ical.Reply, sender string) (*ent.Participant, error) {
+	attendee, ok := replyAttendee(reply, sender)
+	if !ok {
+		return nil, ErrSenderMismatch
+	}
+
+	eventID, ok := calendar.EventIDFromUID(reply.UID)
+	if !ok {
+		return nil, ErrUnknownEvent
+	}
+	e, err := client.Event.Get(ctx, eventID)
+	if ent.IsNotFound(err) {
+		return nil, ErrUnknownEvent
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	if reply.Sequence < e.Sequence {
+		return nil, ErrStaleReply
+	}
+
+	p, err := client.Participant.Query().
+		Where(
+			participant.HasEventWith(event.IDEQ(eventID)),
+			participant.HasUserWith(user.EmailEqualFold(attendee.Email)),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrUnknownAttendee
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get participant: %w", err)
+	}
+	p, err = p.Update().SetStatus(participantStatus(attendee.PartStat)).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update participant: %w", err)
+	}
+	return p, nil
+}
+
+// replyAttendee returns the attendee of reply whose address is the sender's
+func replyAttendee(reply *ical.Reply, sender string) (ical.Attendee, bool) {
+	for _, a := range reply.Attendees {
+		if sender != "" && strings.EqualFold(a.Email, sender) {
+			return a, true
+		}
+	}
+	return ical.Attendee{}, false
+}
+
+// participantStatus maps a PARTSTAT to a participant status. TENTATIVE and
+// NEEDS-ACTION have no equivalent and leave the participant pending.
+func participantStatus(partStat string) participant.Status {
+	switch partStat {
+	case ical.PartStatAccepted:
+		return participant.StatusAccepted
+	case ical.PartStatDeclined:
+		return participant.StatusDeclined
+	default:
+		return participant.StatusPending
+	}
+}
@@ -0,0 +1,140 @@
+package invitation
+
+import (
+	"context"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/participant"
+	"github.com/matsuokashuhei/morrow-backend/internal/ical"
+	imail "github.com/matsuokashuhei/morrow-backend/internal/mail"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSender() *Sender {
+	return &Sender{from: &mail.Address{Name: "Morrow", Address: "calendar@morrow.example"}}
+}
+
+// testEvent builds an event owned by Olivia with Alice (pending) and Bob (declined)
+func testEvent() *ent.Event {
+	organizer := &ent.User{ID: 1, Name: "Olivia", Email: "olivia@example.com"}
+	alice := &ent.User{ID: 2, Name: "Alice", Email: "alice@example.com"}
+	bob := &ent.User{ID: 3, Name: "Bob", Email: "bob@example.com"}
+
+	e := &ent.Event{
+		ID:         7,
+		Title:      "Hanami",
+		StartTime:  time.Date(2026, 4, 1, 10, 0, 0, 0, time.UTC),
+		EndTime:    time.Date(2026, 4, 1, 12, 0, 0, 0, time.UTC),
+		Visibility: event.VisibilityShared,
+		Sequence:   2,
+	}
+	e.Edges.Creator = organizer
+	e.Edges.Participants = []*ent.Participant{
+		{Role: participant.RoleOwner, Status: participant.StatusAccepted, Edges: ent.ParticipantEdges{User: organizer}},
+		{Role: participant.RoleViewer, Status: participant.StatusPending, Edges: ent.ParticipantEdges{User: alice}},
+		{Role: participant.RoleViewer, Status: participant.StatusDeclined, Edges: ent.ParticipantEdges{User: bob}},
+	}
+	return e
+}
+
+func TestSender_Requests(t *testing.T) {
+	s := testSender()
+	messages, err := s.requests(testEvent(), func(p *ent.Participant) bool { return p.Status != participant.StatusDeclined }, "Updated invitation")
+	require.NoError(t, err)
+
+	// 主催者と辞退した参加者には送らない
+	require.Len(t, messages, 1)
+	msg := messages[0]
+	assert.Equal(t, `"Alice" <alice@example.com>`, msg.To)
+	assert.Equal(t, "Updated invitation: Hanami", msg.Subject)
+	assert.Equal(t, ical.MethodRequest, msg.CalendarMethod)
+
+	cal := strings.ReplaceAll(string(msg.Calendar), "\r\n ", "")
+	assert.Contains(t, cal, "METHOD:REQUEST\r\n")
+	assert.Contains(t, cal, "UID:7@morrow\r\n")
+	assert.Contains(t, cal, "SEQUENCE:2\r\n")
+	assert.Contains(t, cal, "ORGANIZER;CN=\"Olivia\":mailto:calendar@morrow.example\r\n")
+	assert.Contains(t, cal, "PARTSTAT=NEEDS-ACTION;RSVP=TRUE:mailto:alice@example.com")
+	assert.NotContains(t, cal, "RSVP=TRUE:mailto:bob@example.com")
+}
+
+func TestSender_Cancels(t *testing.T) {
+	s := testSender()
+	messages, err := s.cancels(testEvent(), func(p *ent.Participant) bool { return p.Edges.User.ID == 2 })
+	require.NoError(t, err)
+
+	require.Len(t, messages, 1)
+	msg := messages[0]
+	assert.Equal(t, "Cancelled: Hanami", msg.Subject)
+	assert.Equal(t, ical.MethodCancel, msg.CalendarMethod)
+
+	cal := strings.ReplaceAll(string(msg.Calendar), "\r\n ", "")
+	assert.Contains(t, cal, "METHOD:CANCEL\r\n")
+	assert.Contains(t, cal, "STATUS:CANCELLED\r\n")
+	assert.Contains(t, cal, "SEQUENCE:3\r\n")
+}
+
+func TestNilSender(t *testing.T) {
+	var s *Sender
+	ctx := context.Background()
+	// 無効なSenderは何もしない
+	assert.Nil(t, s.Invite(ctx, 1, 2))
+	assert.Nil(t, s.Update(ctx, 1))
+	assert.Nil(t, s.CancelEvent(ctx, 1))
+	assert.Nil(t, s.CancelParticipant(ctx, 1, 2))
+	s.Invite(ctx, 1, 2).Send(ctx)
+	assert.Zero(t, s.Backlog())
+}
+
+// blockingMailer holds every message until release is closed
+type blockingMailer struct {
+	release chan struct{}
+}
+
+func (m blockingMailer) Send(ctx context.Context, _ *imail.Message) error {
+	<-m.release
+	return nil
+}
+
+func TestOutbox_Backlog(t *testing.T) {
+	mailer := blockingMailer{release: make(chan struct{})}
+	s := testSender()
+	s.mailer = mailer
+	messages, err := s.requests(testEvent(), func(*ent.Participant) bool { return true }, "Invitation")
+	require.NoError(t, err)
+
+	(&Outbox{sender: s, messages: messages}).Send(context.Background())
+	assert.Equal(t, int64(len(messages)), s.Backlog())
+
+	close(mailer.release)
+	assert.Eventually(t, func() bool { return s.Backlog() == 0 }, time.Second, time.Millisecond)
+}
+
+func TestParticipantStatus(t *testing.T) {
+	assert.Equal(t, participant.StatusAccepted, participantStatus(ical.PartStatAccepted))
+	assert.Equal(t, participant.StatusDeclined, participantStatus(ical.PartStatDeclined))
+	assert.Equal(t, participant.StatusPending, participantStatus(ical.PartStatTentative))
+	assert.Equal(t, participant.StatusPending, participantStatus(ical.PartStatNeedsAction))
+}
+
+func TestReplyAttendee(t *testing.T) {
+	reply := &ical.Reply{Attendees: []ical.Attendee{
+		{Person: ical.Person{Email: "alice@example.com"}, PartStat: ical.PartStatAccepted},
+		{Person: ical.Person{Email: "bob@example.com"}, PartStat: ical.PartStatDeclined},
+	}}
+
+	a, ok := replyAttendee(reply, "Bob@Example.com")
+	require.True(t, ok)
+	assert.Equal(t, ical.PartStatDeclined, a.PartStat)
+
+	_, ok = replyAttendee(reply, "mallory@example.com")
+	assert.False(t, ok)
+	_, ok = replyAttendee(reply, "")
+	assert.False(t, ok)
+}
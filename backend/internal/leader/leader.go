@@ -0,0 +1,126 @@
+// Package leader elects one server to run the background jobs, so that trash
+// purges, data exports and account closures are not repeated on every replica.
+package leader
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultInterval is how often followers campaign and the leader checks that it still holds the lock
+const DefaultInterval = 15 * time.Second
+
+// Lock is held by at most one server at a time
+type Lock interface {
+	// TryLock takes the lock if no other server holds it and reports whether it did
+	TryLock(ctx context.Context) (bool, error)
+	// Check returns an error when the lock taken by TryLock may have been lost
+	Check(ctx context.Context) error
+	// Unlock releases the lock
+	Unlock(ctx context.Context) error
+}
+
+// Elector campaigns for a lock and runs the work of the leader while it holds it
+type Elector struct {
+	lock     Lock
+	interval time.Duration
+	logger   *logrus.Logger
+	leader   atomic.Bool
+
+	mu  sync.Mutex
+	err error
+}
+
+// New returns an elector campaigning for lock every interval
+func New(lock Lock, interval time.Duration, logger *logrus.Logger) *Elector {
+	return &Elector{
+		lock:     lock,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// IsLeader reports whether this server currently holds the lock
+func (e *Elector) IsLeader() bool {
+	return e.leader.Load()
+}
+
+// Check returns the error of the last campaign or lock check. Followers that
+// could reach the lock pass, since only one server leads at a time.
+func (e *Elector) Check(context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.err
+}
+
+func (e *Elector) setErr(err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.err = err
+}
+
+// Run campaigns until ctx is canceled. While this server leads, lead runs with
+// a context that is canceled when the lock is lost; it must return once the
+// context is done. The lock is released when lead returns.
+func (e *Elector) Run(ctx context.Context, lead func(ctx context.Context)) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	for {
+		ok, err := e.lock.TryLock(ctx)
+		e.setErr(err)
+		if err != nil && ctx.Err() == nil {
+			e.logger.WithError(err).Warn("Failed to campaign for the background job leader")
+		}
+		if ok {
+			e.lead(ctx, ticker, lead)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// lead runs the work of the leader until ctx is canceled, the lock is lost or the work returns
+func (e *Elector) lead(ctx context.Context, ticker *time.Ticker, lead func(ctx context.Context)) {
+	e.logger.Info("Leading the background jobs")
+	e.leader.Store(true)
+	leadCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		lead(leadCtx)
+	}()
+
+	for held := true; held; {
+		select {
+		case <-ctx.Done():
+			held = false
+		case <-done:
+			held = false
+		case <-ticker.C:
+			if err := e.lock.Check(ctx); err != nil {
+				e.setErr(err)
+				if ctx.Err() == nil {
+					e.logger.WithError(err).Warn("Lost the background job leader lock")
+				}
+				held = false
+			}
+		}
+	}
+	cancel()
+	<-done
+	e.leader.Store(false)
+
+	// 他のサーバーがすぐに引き継げるよう、停止中でもロックを解放する
+	if err := e.lock.Unlock(context.WithoutCancel(ctx)); err != nil {
+		e.logger.WithError(err).Debug("Failed to release the background job leader lock")
+	}
+	e.logger.Info("Stopped leading the background jobs")
+}
@@ -0,0 +1,114 @@
+package leader
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLock is a lock shared by the electors of a test, standing in for the database
+type fakeLock struct {
+	mu       sync.Mutex
+	holder   *fakeSession
+	checkErr error
+}
+
+type fakeSession struct {
+	lock *fakeLock
+}
+
+func (s *fakeSession) TryLock(context.Context) (bool, error) {
+	s.lock.mu.Lock()
+	defer s.lock.mu.Unlock()
+	if s.lock.holder == nil {
+		s.lock.holder = s
+	}
+	return s.lock.holder == s, nil
+}
+
+func (s *fakeSession) Check(context.Context) error {
+	s.lock.mu.Lock()
+	defer s.lock.mu.Unlock()
+	return s.lock.checkErr
+}
+
+func (s *fakeSession) Unlock(context.Context) error {
+	s.lock.mu.Lock()
+	defer s.lock.mu.Unlock()
+	if s.lock.holder == s {
+		s.lock.holder = nil
+	}
+	return nil
+}
+
+func TestElector(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	lock := &fakeLock{}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	leading := map[int]bool{}
+	electors := make([]*Elector, 2)
+	for i := range electors {
+		electors[i] = New(&fakeSession{lock: lock}, time.Millisecond, logger)
+		go electors[i].Run(ctx, func(ctx context.Context) {
+			mu.Lock()
+			leading[i] = true
+			mu.Unlock()
+			<-ctx.Done()
+			mu.Lock()
+			leading[i] = false
+			mu.Unlock()
+		})
+	}
+	count := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		n := 0
+		for _, l := range leading {
+			if l {
+				n++
+			}
+		}
+		return n
+	}
+
+	// 常に1台だけがリーダーになる
+	require.Eventually(t, func() bool { return count() == 1 }, time.Second, time.Millisecond)
+	for i := 0; i < 20; i++ {
+		assert.LessOrEqual(t, count(), 1)
+		time.Sleep(time.Millisecond)
+	}
+
+	// ロックを失ったリーダーは仕事を止め、確認できない間はエラーを報告する
+	lock.mu.Lock()
+	lock.checkErr = errors.New("connection lost")
+	lock.mu.Unlock()
+	require.Eventually(t, func() bool {
+		for _, e := range electors {
+			if e.Check(ctx) != nil {
+				return true
+			}
+		}
+		return false
+	}, time.Second, time.Millisecond)
+
+	lock.mu.Lock()
+	lock.checkErr = nil
+	lock.mu.Unlock()
+	require.Eventually(t, func() bool {
+		return count() == 1 && (electors[0].IsLeader() != electors[1].IsLeader())
+	}, time.Second, time.Millisecond)
+
+	cancel()
+	require.Eventually(t, func() bool { return count() == 0 }, time.Second, time.Millisecond)
+}
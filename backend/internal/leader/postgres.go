@@ -0,0 +1,93 @@
+package leader
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// JobsLockKey is the advisory lock key of the background job leader
+const JobsLockKey int64 = 0x6d6f72726f77 // "morrow"
+
+// PostgresLock is a session-level advisory lock held on a dedicated connection.
+// Postgres releases it when the connection closes, so a stopped or disconnected
+// leader is replaced without waiting for a lease to expire.
+type PostgresLock struct {
+	db  *sql.DB
+	key int64
+
+	mu   sync.Mutex
+	conn *sql.Conn
+}
+
+func NewPostgresLock(db *sql.DB, key int64) *PostgresLock {
+	return &PostgresLock{db: db, key: key}
+}
+
+// TryLock implements Lock
+func (l *PostgresLock) TryLock(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.conn != nil {
+		return true, nil
+	}
+
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get a connection for the leader lock: %w", err)
+	}
+	var ok bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", l.key).Scan(&ok); err != nil {
+		discard(conn)
+		return false, fmt.Errorf("failed to take the leader lock: %w", err)
+	}
+	// フォロワーは接続を持ち続けず、プールに返す
+	if !ok {
+		return false, conn.Close()
+	}
+	l.conn = conn
+	return true, nil
+}
+
+// Check implements Lock. The lock belongs to the session, so it is held as long
+// as the connection that took it still answers.
+func (l *PostgresLock) Check(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.conn == nil {
+		return errors.New("the leader lock is not held")
+	}
+	if _, err := l.conn.ExecContext(ctx, "SELECT 1"); err != nil {
+		discard(l.conn)
+		l.conn = nil
+		return fmt.Errorf("lost the connection holding the leader lock: %w", err)
+	}
+	return nil
+}
+
+// Unlock implements Lock
+func (l *PostgresLock) Unlock(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.conn == nil {
+		return nil
+	}
+	if _, err := l.conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", l.key); err != nil {
+		discard(l.conn)
+		l.conn = nil
+		return fmt.Errorf("failed to release the leader lock: %w", err)
+	}
+	err := l.conn.Close()
+	l.conn = nil
+	return err
+}
+
+// discard closes the connection instead of returning it to the pool, which
+// releases the lock if the session still holds it
+func discard(conn *sql.Conn) {
+	_ = conn.Raw(func(any) error { return driver.ErrBadConn })
+	_ = conn.Close()
+}
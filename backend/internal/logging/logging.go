@@ -0,0 +1,46 @@
+// Package logging carries the logger of the HTTP request being served, so that
+// code which only receives a context, such as resolvers and Ent hooks, logs
+// with the request ID of its request.
+package logging
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/sirupsen/logrus"
+)
+
+// fallback logs outside requests, such as in background jobs and tests
+var fallback atomic.Pointer[logrus.Logger]
+
+func init() {
+	fallback.Store(logrus.StandardLogger())
+}
+
+// SetDefault sets the logger returned by FromContext outside requests
+func SetDefault(l *logrus.Logger) {
+	fallback.Store(l)
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying the request-scoped entry
+func NewContext(ctx context.Context, entry *logrus.Entry) context.Context {
+	return context.WithValue(ctx, contextKey{}, entry)
+}
+
+// FromContext returns the request-scoped logger stored in ctx, or an entry of
+// the default logger when ctx does not belong to a request. The entry carries
+// ctx, so that hooks can add the trace ID of the current span.
+func FromContext(ctx context.Context) *logrus.Entry {
+	if entry, ok := ctx.Value(contextKey{}).(*logrus.Entry); ok && entry != nil {
+		return entry.WithContext(ctx)
+	}
+	return fallback.Load().WithContext(ctx)
+}
+
+// WithFields returns a copy of ctx whose logger adds fields, such as the
+// authenticated user, to every entry logged for the rest of the request
+func WithFields(ctx context.Context, fields logrus.Fields) context.Context {
+	return NewContext(ctx, FromContext(ctx).WithFields(fields))
+}
@@ -0,0 +1,41 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func newLogger(buf *bytes.Buffer) *logrus.Logger {
+	logger := logrus.New()
+	logger.SetOutput(buf)
+	logger.SetFormatter(&logrus.JSONFormatter{})
+	return logger
+}
+
+func TestFromContext(t *testing.T) {
+	var fallbackBuf, requestBuf bytes.Buffer
+	prev := fallback.Load()
+	SetDefault(newLogger(&fallbackBuf))
+	t.Cleanup(func() { SetDefault(prev) })
+
+	t.Run("outside requests", func(t *testing.T) {
+		FromContext(context.Background()).Info("job")
+		assert.Contains(t, fallbackBuf.String(), `"msg":"job"`)
+	})
+
+	t.Run("inside a request", func(t *testing.T) {
+		ctx := NewContext(context.Background(), newLogger(&requestBuf).WithField("request_id", "req-1"))
+		ctx = WithFields(ctx, logrus.Fields{"user": "dev_user"})
+
+		entry := FromContext(ctx)
+		entry.Info("resolver")
+		assert.Same(t, ctx, entry.Context)
+		assert.Contains(t, requestBuf.String(), `"request_id":"req-1"`)
+		assert.Contains(t, requestBuf.String(), `"user":"dev_user"`)
+		assert.NotContains(t, fallbackBuf.String(), "resolver")
+	})
+}
@@ -0,0 +1,189 @@
+// Package mail sends notification emails, including iMIP calendar invitations (RFC 6047).
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/internal/config"
+	"github.com/sirupsen/logrus"
+)
+
+// Message is a plain text email with an optional iCalendar part
+type Message struct {
+	From    string
+	To      string
+	Subject string
+	Text    string
+	// Calendar is an iCalendar document sent as text/calendar next to the text body
+	Calendar []byte
+	// CalendarMethod is the iTIP method of Calendar, e.g. REQUEST
+	CalendarMethod string
+}
+
+// Mailer is implemented by the backends that deliver email
+type Mailer interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// New creates the mailer selected by the configuration.
+// It returns nil when no driver is configured, which disables email.
+func New(cfg *config.Config, logger *logrus.Logger) (Mailer, error) {
+	switch cfg.MailDriver {
+	case "":
+		return nil, nil
+	case "log":
+		return NewLogMailer(logger), nil
+	case "smtp":
+		return NewSMTP(SMTPOptions{
+			Host:     cfg.SMTPHost,
+			Port:     cfg.SMTPPort,
+			Username: cfg.SMTPUsername,
+			Password: cfg.SMTPPassword,
+		}), nil
+	default:
+		return nil, fmt.Errorf("unknown mail driver %q", cfg.MailDriver)
+	}
+}
+
+// Bytes renders the message in RFC 5322 format. Messages with a calendar are
+// multipart/mixed with a multipart/alternative body (text/plain and
+// text/calendar) and the calendar attached as invite.ics, the layout most
+// calendar clients recognise as an invitation.
+func (m *Message) Bytes() ([]byte, error) {
+	from, err := mail.ParseAddress(m.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid from address: %w", err)
+	}
+	to, err := mail.ParseAddress(m.To)
+	if err != nil {
+		return nil, fmt.Errorf("invalid to address: %w", err)
+	}
+
+	var buf bytes.Buffer
+	header := func(name, value string) {
+		fmt.Fprintf(&buf, "%s: %s\r\n", name, value)
+	}
+	header("From", from.String())
+	header("To", to.String())
+	header("Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("Message-ID", messageID(from.Address))
+	header("MIME-Version", "1.0")
+
+	if len(m.Calendar) == 0 {
+		header("Content-Type", "text/plain; charset=utf-8")
+		header("Content-Transfer-Encoding", "base64")
+		buf.WriteString("\r\n")
+		writeBase64(&buf, []byte(m.Text))
+		return buf.Bytes(), nil
+	}
+
+	mixed := multipart.NewWriter(&buf)
+	header("Content-Type", `multipart/mixed; boundary="`+mixed.Boundary()+`"`)
+	buf.WriteString("\r\n")
+
+	var alternative bytes.Buffer
+	alt := multipart.NewWriter(&alternative)
+	if err := writePart(alt, "text/plain; charset=utf-8", "", []byte(m.Text)); err != nil {
+		return nil, err
+	}
+	calendarType := "text/calendar; charset=utf-8"
+	if m.CalendarMethod != "" {
+		calendarType += "; method=" + m.CalendarMethod
+	}
+	if err := writePart(alt, calendarType, "", m.Calendar); err != nil {
+		return nil, err
+	}
+	if err := alt.Close(); err != nil {
+		return nil, err
+	}
+
+	body, err := mixed.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {`multipart/alternative; boundary="` + alt.Boundary() + `"`},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := body.Write(alternative.Bytes()); err != nil {
+		return nil, err
+	}
+	if err := writePart(mixed, "application/ics; name=invite.ics", `attachment; filename="invite.ics"`, m.Calendar); err != nil {
+		return nil, err
+	}
+	if err := mixed.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writePart adds a base64 encoded part
+func writePart(w *multipart.Writer, contentType, disposition string, data []byte) error {
+	h := textproto.MIMEHeader{
+		"Content-Type":              {contentType},
+		"Content-Transfer-Encoding": {"base64"},
+	}
+	if disposition != "" {
+		h.Set("Content-Disposition", disposition)
+	}
+	part, err := w.CreatePart(h)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	writeBase64(&buf, data)
+	_, err = part.Write(buf.Bytes())
+	return err
+}
+
+// writeBase64 writes data in base64 wrapped at 76 characters (RFC 2045 section 6.8)
+func writeBase64(buf *bytes.Buffer, data []byte) {
+	encoded := base64.StdEncoding.EncodeToString(data)
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76])
+		buf.WriteString("\r\n")
+		encoded = encoded[76:]
+	}
+	buf.WriteString(encoded)
+	buf.WriteString("\r\n")
+}
+
+// messageID builds a unique Message-ID in the sender's domain
+func messageID(from string) string {
+	b := make([]byte, 12)
+	_, _ = rand.Read(b)
+	domain := "localhost"
+	if _, d, ok := strings.Cut(from, "@"); ok {
+		domain = d
+	}
+	return "<" + hex.EncodeToString(b) + "@" + domain + ">"
+}
+
+// LogMailer writes emails to the log instead of sending them. It is meant for development.
+type LogMailer struct {
+	logger *logrus.Logger
+}
+
+func NewLogMailer(logger *logrus.Logger) *LogMailer {
+	return &LogMailer{logger: logger}
+}
+
+func (m *LogMailer) Send(ctx context.Context, msg *Message) error {
+	m.logger.WithFields(logrus.Fields{
+		"to":       msg.To,
+		"subject":  msg.Subject,
+		"method":   msg.CalendarMethod,
+		"calendar": string(msg.Calendar),
+	}).Info("Email not sent (log mail driver)")
+	return nil
+}
@@ -0,0 +1,127 @@
+package mail
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const calendarBody = "BEGIN:VCALENDAR\r\nMETHOD:REQUEST\r\nEND:VCALENDAR\r\n"
+
+func TestMessage_BytesWithCalendar(t *testing.T) {
+	msg := &Message{
+		From:           "Morrow <calendar@morrow.example>",
+		To:             "Alice <alice@example.com>",
+		Subject:        "招待: 花見",
+		Text:           "You are invited",
+		Calendar:       []byte(calendarBody),
+		CalendarMethod: "REQUEST",
+	}
+	data, err := msg.Bytes()
+	require.NoError(t, err)
+
+	parsed, err := mail.ReadMessage(strings.NewReader(string(data)))
+	require.NoError(t, err)
+	assert.Equal(t, "alice@example.com", mustAddress(t, parsed.Header.Get("To")))
+	assert.True(t, strings.HasSuffix(parsed.Header.Get("Message-ID"), "@morrow.example>"))
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "招待: 花見", subject)
+
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/mixed", mediaType)
+	mixed := multipart.NewReader(parsed.Body, params["boundary"])
+
+	body, err := mixed.NextPart()
+	require.NoError(t, err)
+	mediaType, params, err = mime.ParseMediaType(body.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	alt := multipart.NewReader(body, params["boundary"])
+	text, err := alt.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, "text/plain; charset=utf-8", text.Header.Get("Content-Type"))
+	assert.Equal(t, "You are invited", readPart(t, text))
+
+	cal, err := alt.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, "text/calendar; charset=utf-8; method=REQUEST", cal.Header.Get("Content-Type"))
+	assert.Equal(t, calendarBody, readPart(t, cal))
+
+	attachment, err := mixed.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, "invite.ics", attachment.FileName())
+	assert.Equal(t, calendarBody, readPart(t, attachment))
+
+	_, err = mixed.NextPart()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestMessage_BytesTextOnly(t *testing.T) {
+	msg := &Message{From: "calendar@morrow.example", To: "alice@example.com", Subject: "Hi", Text: "Hello"}
+	data, err := msg.Bytes()
+	require.NoError(t, err)
+
+	parsed, err := mail.ReadMessage(strings.NewReader(string(data)))
+	require.NoError(t, err)
+	assert.Equal(t, "text/plain; charset=utf-8", parsed.Header.Get("Content-Type"))
+}
+
+func TestMessage_BytesRejectsInvalidAddress(t *testing.T) {
+	_, err := (&Message{From: "calendar@morrow.example", To: "not an address"}).Bytes()
+	assert.Error(t, err)
+}
+
+func TestSMTP_Send(t *testing.T) {
+	s := NewSMTP(SMTPOptions{Host: "smtp.example.com", Port: "587", Username: "user", Password: "pass"})
+
+	var gotAddr, gotFrom string
+	var gotTo []string
+	s.send = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		gotAddr, gotFrom, gotTo = addr, from, to
+		assert.NotNil(t, a)
+		return nil
+	}
+
+	err := s.Send(context.Background(), &Message{
+		From: "Morrow <calendar@morrow.example>",
+		To:   "Alice <alice@example.com>",
+		Text: "Hello",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "smtp.example.com:587", gotAddr)
+	assert.Equal(t, "calendar@morrow.example", gotFrom)
+	assert.Equal(t, []string{"alice@example.com"}, gotTo)
+}
+
+func mustAddress(t *testing.T, s string) string {
+	a, err := mail.ParseAddress(s)
+	require.NoError(t, err)
+	return a.Address
+}
+
+// readPart decodes a base64 encoded part
+func readPart(t *testing.T, p *multipart.Part) string {
+	assert.Equal(t, "base64", p.Header.Get("Content-Transfer-Encoding"))
+	raw, err := io.ReadAll(p)
+	require.NoError(t, err)
+	decoded, err := base64Decode(string(raw))
+	require.NoError(t, err)
+	return decoded
+}
+
+func base64Decode(s string) (string, error) {
+	b, err := base64.StdEncoding.DecodeString(strings.NewReplacer("\r", "", "\n", "").Replace(s))
+	return string(b), err
+}
@@ -0,0 +1,55 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+)
+
+// SMTPOptions configures NewSMTP
+type SMTPOptions struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+}
+
+// SMTP delivers email through an SMTP relay, upgrading to TLS with STARTTLS when offered
+type SMTP struct {
+	addr string
+	auth smtp.Auth
+	// send is smtp.SendMail, replaceable in tests
+	send func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func NewSMTP(opts SMTPOptions) *SMTP {
+	s := &SMTP{
+		addr: net.JoinHostPort(opts.Host, opts.Port),
+		send: smtp.SendMail,
+	}
+	if opts.Username != "" {
+		s.auth = smtp.PlainAuth("", opts.Username, opts.Password, opts.Host)
+	}
+	return s
+}
+
+func (s *SMTP) Send(ctx context.Context, msg *Message) error {
+	data, err := msg.Bytes()
+	if err != nil {
+		return err
+	}
+	from, err := mail.ParseAddress(msg.From)
+	if err != nil {
+		return fmt.Errorf("invalid from address: %w", err)
+	}
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return fmt.Errorf("invalid to address: %w", err)
+	}
+	if err := s.send(s.addr, s.auth, from.Address, []string{to.Address}, data); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+	return nil
+}
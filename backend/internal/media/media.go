@@ -0,0 +1,124 @@
+package media
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif" // GIFデコーダーの登録
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // WebPデコーダーの登録
+)
+
+// maxPixels guards against decompression bombs (e.g. a tiny PNG declaring 100k x 100k pixels)
+const maxPixels = 40_000_000
+
+var (
+	// ErrTooLarge is returned when the upload exceeds the size limit
+	ErrTooLarge = errors.New("file is too large")
+	// ErrUnsupportedType is returned when the content is not a supported image
+	ErrUnsupportedType = errors.New("unsupported file type: only JPEG, PNG, GIF and WebP images are allowed")
+)
+
+// extensions maps the supported image MIME types to file extensions
+var extensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// Image is an uploaded image whose type has been verified from its content
+type Image struct {
+	Data        []byte
+	ContentType string
+	Width       int
+	Height      int
+}
+
+// Extension returns the file extension for the image type
+func (img *Image) Extension() string {
+	return extensions[img.ContentType]
+}
+
+// ReadImage reads at most limit bytes from r and verifies that the content is a supported image.
+// The MIME type is sniffed from the bytes; the type declared by the client is ignored.
+func ReadImage(r io.Reader, limit int64) (*Image, error) {
+	data, err := io.ReadAll(io.LimitReader(r, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read upload: %w", err)
+	}
+	if int64(len(data)) > limit {
+		return nil, ErrTooLarge
+	}
+
+	contentType := http.DetectContentType(data)
+	if _, ok := extensions[contentType]; !ok {
+		return nil, ErrUnsupportedType
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedType
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxPixels {
+		return nil, ErrTooLarge
+	}
+
+	return &Image{
+		Data:        data,
+		ContentType: contentType,
+		Width:       cfg.Width,
+		Height:      cfg.Height,
+	}, nil
+}
+
+// Resize scales the image down to fit within maxSize x maxSize, keeping the aspect ratio.
+// Images that already fit are re-encoded unchanged in size. PNG and GIF become PNG to keep
+// transparency; JPEG and WebP become JPEG. Animated GIFs keep only their first frame.
+func Resize(img *Image, maxSize int) (*Image, error) {
+	src, _, err := image.Decode(bytes.NewReader(img.Data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	width, height := fit(img.Width, img.Height, maxSize)
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, src.Bounds(), draw.Over, nil)
+
+	var buf bytes.Buffer
+	contentType := "image/jpeg"
+	switch img.ContentType {
+	case "image/png", "image/gif":
+		contentType = "image/png"
+		err = png.Encode(&buf, dst)
+	default:
+		err = jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 85})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+
+	return &Image{
+		Data:        buf.Bytes(),
+		ContentType: contentType,
+		Width:       width,
+		Height:      height,
+	}, nil
+}
+
+// fit computes the dimensions of a width x height image scaled down to fit in maxSize
+func fit(width, height, maxSize int) (int, int) {
+	if width <= maxSize && height <= maxSize {
+		return width, height
+	}
+	if width >= height {
+		return maxSize, max(1, height*maxSize/width)
+	}
+	return max(1, width*maxSize/height), maxSize
+}
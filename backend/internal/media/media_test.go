@@ -0,0 +1,71 @@
+package media
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodePNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		img.Set(x, 0, color.RGBA{R: 255, A: 255})
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestReadImage(t *testing.T) {
+	data := encodePNG(t, 40, 20)
+
+	img, err := ReadImage(bytes.NewReader(data), 1<<20)
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", img.ContentType)
+	assert.Equal(t, ".png", img.Extension())
+	assert.Equal(t, 40, img.Width)
+	assert.Equal(t, 20, img.Height)
+}
+
+func TestReadImage_Rejects(t *testing.T) {
+	_, err := ReadImage(strings.NewReader("<html><script>alert(1)</script></html>"), 1<<20)
+	assert.ErrorIs(t, err, ErrUnsupportedType)
+
+	_, err = ReadImage(bytes.NewReader(encodePNG(t, 40, 20)), 10)
+	assert.ErrorIs(t, err, ErrTooLarge)
+
+	// PNGのシグネチャだけを持つ壊れたファイル
+	_, err = ReadImage(strings.NewReader("\x89PNG\r\n\x1a\nbroken"), 1<<20)
+	assert.ErrorIs(t, err, ErrUnsupportedType)
+}
+
+func TestResize(t *testing.T) {
+	img, err := ReadImage(bytes.NewReader(encodePNG(t, 400, 200)), 1<<20)
+	require.NoError(t, err)
+
+	thumb, err := Resize(img, 100)
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", thumb.ContentType)
+	assert.Equal(t, 100, thumb.Width)
+	assert.Equal(t, 50, thumb.Height)
+
+	decoded, err := png.Decode(bytes.NewReader(thumb.Data))
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 100, 50), decoded.Bounds())
+}
+
+func TestFit(t *testing.T) {
+	w, h := fit(100, 50, 200)
+	assert.Equal(t, []int{100, 50}, []int{w, h})
+	w, h = fit(1000, 10, 100)
+	assert.Equal(t, []int{100, 1}, []int{w, h})
+	w, h = fit(300, 600, 100)
+	assert.Equal(t, []int{50, 100}, []int{w, h})
+}
@@ -0,0 +1,101 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	dbQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "db_query_duration_seconds",
+		Help:      "Latency of SQL statements sent by Ent, by statement type.",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+	}, []string{"operation"})
+	dbQueryErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "db_query_errors_total",
+		Help:      "SQL statements sent by Ent that failed, by statement type.",
+	}, []string{"operation"})
+)
+
+// Driver wraps an Ent driver to record the duration of every statement,
+// including those run in transactions
+func Driver(drv dialect.Driver) dialect.Driver {
+	return &driver{Driver: drv}
+}
+
+type driver struct {
+	dialect.Driver
+}
+
+func (d *driver) Exec(ctx context.Context, query string, args, v any) error {
+	return observeQuery(query, func() error { return d.Driver.Exec(ctx, query, args, v) })
+}
+
+func (d *driver) Query(ctx context.Context, query string, args, v any) error {
+	return observeQuery(query, func() error { return d.Driver.Query(ctx, query, args, v) })
+}
+
+func (d *driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	t, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &tx{Tx: t}, nil
+}
+
+// BeginTx is called by ent.Client.BeginTx
+func (d *driver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.BeginTx is not supported")
+	}
+	t, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &tx{Tx: t}, nil
+}
+
+type tx struct {
+	dialect.Tx
+}
+
+func (t *tx) Exec(ctx context.Context, query string, args, v any) error {
+	return observeQuery(query, func() error { return t.Tx.Exec(ctx, query, args, v) })
+}
+
+func (t *tx) Query(ctx context.Context, query string, args, v any) error {
+	return observeQuery(query, func() error { return t.Tx.Query(ctx, query, args, v) })
+}
+
+// observeQuery runs a statement and records its duration and failure
+func observeQuery(query string, run func() error) error {
+	op := Operation(query)
+	start := time.Now()
+	err := run()
+	dbQueryDuration.WithLabelValues(op).Observe(time.Since(start).Seconds())
+	if err != nil {
+		dbQueryErrors.WithLabelValues(op).Inc()
+	}
+	return err
+}
+
+// Operation returns the statement type of a query, such as select or insert
+func Operation(query string) string {
+	keyword, _, _ := strings.Cut(strings.TrimSpace(query), " ")
+	switch keyword = strings.ToLower(keyword); keyword {
+	case "select", "insert", "update", "delete", "with":
+		return keyword
+	}
+	return "other"
+}
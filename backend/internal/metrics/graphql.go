@@ -0,0 +1,120 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	graphqlOperations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "graphql_operations_total",
+		Help:      "GraphQL operations by type, name and result (ok or error).",
+	}, []string{"type", "operation", "result"})
+	graphqlDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "graphql_operation_duration_seconds",
+		Help:      "GraphQL query and mutation latency by type and name.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"type", "operation"})
+	resolverDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "graphql_resolver_duration_seconds",
+		Help:      "GraphQL resolver latency by object and field.",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"object", "field"})
+)
+
+// maxOperationNames caps the operation names tracked separately.
+// Clients choose the names, so later names are counted as OtherOperation.
+const maxOperationNames = 200
+
+// OtherOperation labels operations beyond maxOperationNames, and AnonymousOperation unnamed ones
+const (
+	OtherOperation     = "other"
+	AnonymousOperation = "anonymous"
+)
+
+// GraphQL is a gqlgen extension recording the latency of every operation and resolver
+type GraphQL struct {
+	mu    sync.Mutex
+	names map[string]bool
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+	graphql.FieldInterceptor
+} = &GraphQL{}
+
+// ExtensionName implements graphql.HandlerExtension
+func (*GraphQL) ExtensionName() string {
+	return "Metrics"
+}
+
+// Validate implements graphql.HandlerExtension
+func (*GraphQL) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptResponse records each response. Subscriptions respond once per
+// event, so only their events are counted and their duration is not observed.
+func (m *GraphQL) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	resp := next(ctx)
+	if !graphql.HasOperationContext(ctx) {
+		return resp
+	}
+	oc := graphql.GetOperationContext(ctx)
+	if oc.Operation == nil {
+		return resp
+	}
+	kind := string(oc.Operation.Operation)
+	name := m.operationLabel(oc.Operation.Name)
+	result := "ok"
+	if resp != nil && len(resp.Errors) > 0 {
+		result = "error"
+	}
+	graphqlOperations.WithLabelValues(kind, name, result).Inc()
+	if oc.Operation.Operation != "subscription" {
+		graphqlDuration.WithLabelValues(kind, name).Observe(time.Since(oc.Stats.OperationStart).Seconds())
+	}
+	return resp
+}
+
+// InterceptField times the fields backed by a resolver function.
+// Fields read from a loaded struct take no measurable time and are skipped.
+func (*GraphQL) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver || strings.HasPrefix(fc.Object, "__") {
+		return next(ctx)
+	}
+	start := time.Now()
+	res, err := next(ctx)
+	resolverDuration.WithLabelValues(fc.Object, fc.Field.Name).Observe(time.Since(start).Seconds())
+	return res, err
+}
+
+// operationLabel returns the label of an operation name, bounding the number of series
+func (m *GraphQL) operationLabel(name string) string {
+	if name == "" {
+		return AnonymousOperation
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.names == nil {
+		m.names = map[string]bool{}
+	}
+	if m.names[name] {
+		return name
+	}
+	if len(m.names) >= maxOperationNames {
+		return OtherOperation
+	}
+	m.names[name] = true
+	return name
+}
@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	jobRuns = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "job_runs_total",
+		Help:      "Background job runs by job and result (ok or error).",
+	}, []string{"job", "result"})
+	jobDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "job_duration_seconds",
+		Help:      "Background job run duration.",
+		Buckets:   []float64{.01, .05, .1, .5, 1, 5, 10, 30, 60, 300},
+	}, []string{"job"})
+	jobProcessed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "job_processed_total",
+		Help:      "Items completed by background jobs.",
+	}, []string{"job"})
+	jobPending = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "job_pending",
+		Help:      "Items waiting for a background job at its last run.",
+	}, []string{"job"})
+	jobLastSuccess = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "job_last_success_timestamp_seconds",
+		Help:      "Unix time of the last successful run of a background job.",
+	}, []string{"job"})
+)
+
+// ObserveJob records a run of a background job that started at start and
+// completed processed items before failing with err, if any
+func ObserveJob(job string, start time.Time, processed int, err error) {
+	jobDuration.WithLabelValues(job).Observe(time.Since(start).Seconds())
+	jobProcessed.WithLabelValues(job).Add(float64(processed))
+	if err != nil {
+		jobRuns.WithLabelValues(job, "error").Inc()
+		return
+	}
+	jobRuns.WithLabelValues(job, "ok").Inc()
+	jobLastSuccess.WithLabelValues(job).SetToCurrentTime()
+}
+
+// SetJobPending records how many items a background job found waiting
+func SetJobPending(job string, n int) {
+	jobPending.WithLabelValues(job).Set(float64(n))
+}
@@ -0,0 +1,97 @@
+// Package metrics collects Prometheus metrics for HTTP requests, GraphQL
+// operations, database queries and background jobs, and serves them for scraping.
+package metrics
+
+import (
+	"crypto/subtle"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// namespace prefixes every metric name
+const namespace = "morrow"
+
+// Registry holds the collectors served by Handler.
+// A dedicated registry keeps metrics of imported libraries out of the output.
+var Registry = prometheus.NewRegistry()
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests by method, route and status code.",
+	}, []string{"method", "route", "status"})
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency by method, route and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+	httpInFlight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "http_requests_in_flight",
+		Help:      "HTTP requests being served.",
+	})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests, httpDuration, httpInFlight,
+		graphqlOperations, graphqlDuration, resolverDuration,
+		dbQueryDuration, dbQueryErrors,
+		jobRuns, jobDuration, jobProcessed, jobPending, jobLastSuccess,
+	)
+}
+
+// UnmatchedRoute labels requests that did not match a route, so that
+// scanners probing random paths do not create new series
+const UnmatchedRoute = "unmatched"
+
+// StartHTTPRequest counts a request as in flight and returns the function recording it when done.
+// route is the route pattern, such as /api/v1/events/:id, not the request path.
+func StartHTTPRequest() func(method, route string, status int) {
+	start := time.Now()
+	httpInFlight.Inc()
+	return func(method, route string, status int) {
+		httpInFlight.Dec()
+		if route == "" {
+			route = UnmatchedRoute
+		}
+		code := strconv.Itoa(status)
+		httpRequests.WithLabelValues(method, route, code).Inc()
+		httpDuration.WithLabelValues(method, route, code).Observe(time.Since(start).Seconds())
+	}
+}
+
+// RegisterDBStats exports the connection pool statistics of db.
+// It can be called once per process.
+func RegisterDBStats(db *sql.DB) error {
+	return Registry.Register(collectors.NewDBStatsCollector(db, namespace))
+}
+
+// Handler serves the metrics in the Prometheus text format.
+// A non-empty token must be sent as a bearer token.
+func Handler(token string) http.Handler {
+	h := promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+	if token == "" {
+		return h
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="metrics"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
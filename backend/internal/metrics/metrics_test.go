@@ -0,0 +1,87 @@
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler(t *testing.T) {
+	StartHTTPRequest()(http.MethodGet, "/api/v1/events/:id", http.StatusOK)
+
+	t.Run("without a token", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		Handler("").ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `morrow_http_requests_total{method="GET",route="/api/v1/events/:id",status="200"}`)
+		assert.Contains(t, w.Body.String(), "go_goroutines")
+	})
+
+	t.Run("with a token", func(t *testing.T) {
+		for header, code := range map[string]int{
+			"":                    http.StatusUnauthorized,
+			"Bearer wrong":        http.StatusUnauthorized,
+			"Bearer scrape-token": http.StatusOK,
+		} {
+			req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+			if header != "" {
+				req.Header.Set("Authorization", header)
+			}
+			w := httptest.NewRecorder()
+			Handler("scrape-token").ServeHTTP(w, req)
+			assert.Equal(t, code, w.Code, "Authorization: %q", header)
+		}
+	})
+}
+
+func TestStartHTTPRequest(t *testing.T) {
+	before := testutil.ToFloat64(httpRequests.WithLabelValues(http.MethodGet, UnmatchedRoute, "404"))
+	StartHTTPRequest()(http.MethodGet, "", http.StatusNotFound)
+
+	assert.Equal(t, before+1, testutil.ToFloat64(httpRequests.WithLabelValues(http.MethodGet, UnmatchedRoute, "404")))
+	assert.Equal(t, float64(0), testutil.ToFloat64(httpInFlight))
+}
+
+func TestOperation(t *testing.T) {
+	tests := map[string]string{
+		`SELECT "users"."id" FROM "users"`:     "select",
+		`  INSERT INTO "events" ("title") ...`: "insert",
+		`UPDATE "events" SET "title" = $1`:     "update",
+		`DELETE FROM "events" WHERE "id" = $1`: "delete",
+		`SAVEPOINT s1`:                         "other",
+		``:                                     "other",
+	}
+	for query, op := range tests {
+		assert.Equal(t, op, Operation(query), "Query: %s", query)
+	}
+}
+
+func TestGraphQLOperationLabel(t *testing.T) {
+	m := &GraphQL{}
+	assert.Equal(t, AnonymousOperation, m.operationLabel(""))
+	for i := range maxOperationNames {
+		assert.Equal(t, fmt.Sprintf("Op%d", i), m.operationLabel(fmt.Sprintf("Op%d", i)))
+	}
+	// 上限を超えた名前はまとめるが、既知の名前はそのまま
+	assert.Equal(t, OtherOperation, m.operationLabel("Unknown"))
+	assert.Equal(t, "Op0", m.operationLabel("Op0"))
+}
+
+func TestObserveJob(t *testing.T) {
+	ObserveJob("test_job", time.Now(), 3, nil)
+	ObserveJob("test_job", time.Now(), 1, errors.New("boom"))
+	SetJobPending("test_job", 7)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(jobRuns.WithLabelValues("test_job", "ok")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(jobRuns.WithLabelValues("test_job", "error")))
+	assert.Equal(t, float64(4), testutil.ToFloat64(jobProcessed.WithLabelValues("test_job")))
+	assert.Equal(t, float64(7), testutil.ToFloat64(jobPending.WithLabelValues("test_job")))
+	assert.Positive(t, testutil.ToFloat64(jobLastSuccess.WithLabelValues("test_job")))
+}
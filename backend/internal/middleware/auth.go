@@ -5,18 +5,22 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+	"github.com/matsuokashuhei/morrow-backend/internal/logging"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
 	"github.com/sirupsen/logrus"
 )
 
-// AuthMiddleware handles authentication
+// AuthMiddleware handles authentication.
+// developmentToken enables the fixed "development-token" bearer token for local use.
 // TODO: Integrate with Amazon Cognito in Phase 2
-func Auth() gin.HandlerFunc {
-	logger := InitLogger()
-
+func Auth(developmentToken bool) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// Skip authentication for health check endpoints
+		// Skip authentication for health check endpoints, probes and metrics (protected by their own token)
 		if strings.HasPrefix(c.Request.URL.Path, "/health") ||
-			strings.HasPrefix(c.Request.URL.Path, "/ping") {
+			strings.HasPrefix(c.Request.URL.Path, "/ping") ||
+			probePaths[c.Request.URL.Path] ||
+			c.Request.URL.Path == "/metrics" {
 			c.Next()
 			return
 		}
@@ -29,7 +33,7 @@ func Auth() gin.HandlerFunc {
 		if authHeader == "" {
 			// In MVP, we allow unauthenticated access for local usage
 			// Set a default user context for development
-			logger.WithFields(logrus.Fields{
+			logging.FromContext(c.Request.Context()).WithFields(logrus.Fields{
 				"path":   c.Request.URL.Path,
 				"method": c.Request.Method,
 				"ip":     c.ClientIP(),
@@ -48,8 +52,8 @@ func Auth() gin.HandlerFunc {
 			token := strings.TrimPrefix(authHeader, "Bearer ")
 
 			// TODO: Validate token with Cognito
-			if token == "development-token" {
-				logger.WithFields(logrus.Fields{
+			if developmentToken && token == "development-token" {
+				logging.FromContext(c.Request.Context()).WithFields(logrus.Fields{
 					"user_id": "dev_user",
 					"path":    c.Request.URL.Path,
 					"method":  c.Request.Method,
@@ -57,13 +61,16 @@ func Auth() gin.HandlerFunc {
 
 				c.Set("user_id", "dev_user")
 				c.Set("authenticated", true)
+				if !setViewer(c, "dev_user") {
+					return
+				}
 				c.Next()
 				return
 			}
 		}
 
 		// Invalid authentication
-		logger.WithFields(logrus.Fields{
+		logging.FromContext(c.Request.Context()).WithFields(logrus.Fields{
 			"path":        c.Request.URL.Path,
 			"method":      c.Request.Method,
 			"ip":          c.ClientIP(),
@@ -80,12 +87,10 @@ func Auth() gin.HandlerFunc {
 
 // RequireAuth ensures the user is authenticated
 func RequireAuth() gin.HandlerFunc {
-	logger := InitLogger()
-
 	return func(c *gin.Context) {
 		authenticated, exists := c.Get("authenticated")
 		if !exists || !authenticated.(bool) {
-			logger.WithFields(logrus.Fields{
+			logging.FromContext(c.Request.Context()).WithFields(logrus.Fields{
 				"path":   c.Request.URL.Path,
 				"method": c.Request.Method,
 				"ip":     c.ClientIP(),
@@ -110,3 +115,28 @@ func GetUserID(c *gin.Context) (string, bool) {
 	}
 	return userID.(string), true
 }
+
+// setViewer stores the authenticated viewer in the request context so that
+// GraphQL resolvers can look up the caller's participant role.
+// It rejects the request and returns false when an administrator disabled the user.
+func setViewer(c *gin.Context, subject string) bool {
+	v := &viewer.Viewer{Subject: subject}
+	if dbClient, ok := GetDatabaseClient(c); ok && dbClient != nil {
+		if u, err := dbClient.User.Query().Where(user.CognitoIDEQ(subject)).Only(c.Request.Context()); err == nil {
+			if u.DisabledAt != nil {
+				c.JSON(http.StatusForbidden, gin.H{
+					"error":   "account_disabled",
+					"message": "This account has been disabled",
+				})
+				c.Abort()
+				return false
+			}
+			v.UserID = u.ID
+		}
+	}
+	ctx := viewer.NewContext(c.Request.Context(), v)
+	// 以降のログに認証したユーザーを付ける
+	ctx = logging.WithFields(ctx, logrus.Fields{"user_id": subject})
+	c.Request = c.Request.WithContext(ctx)
+	return true
+}
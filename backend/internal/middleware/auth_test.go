@@ -13,7 +13,7 @@ func TestAuth_HealthEndpoints(t *testing.T) {
 	// Setup
 	gin.SetMode(gin.TestMode)
 	router := gin.New()
-	router.Use(Auth())
+	router.Use(Auth(true))
 	router.GET("/health", func(c *gin.Context) {
 		c.JSON(http.StatusOK, gin.H{"status": "ok"})
 	})
@@ -30,7 +30,7 @@ func TestAuth_UnauthenticatedAccess(t *testing.T) {
 	// Setup
 	gin.SetMode(gin.TestMode)
 	router := gin.New()
-	router.Use(Auth())
+	router.Use(Auth(true))
 	router.GET("/test", func(c *gin.Context) {
 		userID, _ := GetUserID(c)
 		authenticated, _ := c.Get("authenticated")
@@ -53,7 +53,7 @@ func TestAuth_DevelopmentToken(t *testing.T) {
 	// Setup
 	gin.SetMode(gin.TestMode)
 	router := gin.New()
-	router.Use(Auth())
+	router.Use(Auth(true))
 	router.GET("/test", func(c *gin.Context) {
 		userID, _ := GetUserID(c)
 		authenticated, _ := c.Get("authenticated")
@@ -1,23 +1,79 @@
 package middleware
 
 import (
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 )
 
-func CORS() gin.HandlerFunc {
+// Origins is an allow list of browser origins. Entries are exact origins such
+// as https://app.example.com or wildcard subdomain patterns such as
+// https://*.example.com, which match every subdomain but not example.com itself.
+type Origins struct {
+	exact    map[string]bool
+	patterns []originPattern
+}
+
+// originPattern is a wildcard entry split into the parts compared with an origin
+type originPattern struct {
+	scheme string
+	// suffix is the host without the leading "*", e.g. ".example.com"
+	suffix string
+	port   string
+}
+
+// NewOrigins builds an allow list. Entries that are not valid origins are ignored;
+// the configuration rejects them before the server starts.
+func NewOrigins(allowed []string) *Origins {
+	o := &Origins{exact: map[string]bool{}}
+	for _, entry := range allowed {
+		u, err := url.Parse(strings.ToLower(entry))
+		if err != nil || u.Host == "" {
+			continue
+		}
+		if strings.HasPrefix(u.Hostname(), "*.") {
+			o.patterns = append(o.patterns, originPattern{
+				scheme: u.Scheme,
+				suffix: strings.TrimPrefix(u.Hostname(), "*"),
+				port:   u.Port(),
+			})
+			continue
+		}
+		o.exact[u.Scheme+"://"+u.Host] = true
+	}
+	return o
+}
+
+// Allowed reports whether a request from origin may use the API
+func (o *Origins) Allowed(origin string) bool {
+	origin = strings.ToLower(origin)
+	if o.exact[origin] {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil || u.Host == "" {
+		return false
+	}
+	host := u.Hostname()
+	for _, p := range o.patterns {
+		if u.Scheme == p.scheme && u.Port() == p.port &&
+			strings.HasSuffix(host, p.suffix) && len(host) > len(p.suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// CORS allows browsers on the allowed origins to call the API with credentials
+func CORS(origins *Origins) gin.HandlerFunc {
 	config := cors.Config{
-		AllowOrigins: []string{
-			"http://localhost:3000",
-			"http://localhost:8081",
-			"http://localhost:19000",
-			"http://localhost:19006",
-		},
+		AllowOriginFunc:  origins.Allowed,
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Length", "Content-Type", "Authorization", "X-Requested-With"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowHeaders:     []string{"Origin", "Content-Length", "Content-Type", "Authorization", "X-Requested-With", "traceparent", "tracestate"},
+		ExposeHeaders:    []string{"Content-Length", "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "Retry-After"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCSRF(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(CSRF("morrow_session", NewOrigins([]string{"https://app.example.com"})))
+	router.Any("/graphql", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	tests := []struct {
+		name    string
+		method  string
+		cookie  bool
+		headers map[string]string
+		code    int
+	}{
+		{"bearer token requests are not checked", http.MethodPost, false, map[string]string{"Origin": "https://evil.example.net"}, http.StatusOK},
+		{"safe methods are not checked", http.MethodGet, true, map[string]string{"Origin": "https://evil.example.net"}, http.StatusOK},
+		{"allowed origins", http.MethodPost, true, map[string]string{"Origin": "https://app.example.com"}, http.StatusOK},
+		{"same origin", http.MethodPost, true, map[string]string{"Origin": "http://example.com"}, http.StatusOK},
+		{"cross-site origins", http.MethodPost, true, map[string]string{"Origin": "https://evil.example.net"}, http.StatusForbidden},
+		{"null origins", http.MethodPost, true, map[string]string{"Origin": "null"}, http.StatusForbidden},
+		{"same-origin fetch metadata", http.MethodPost, true, map[string]string{"Sec-Fetch-Site": "same-origin"}, http.StatusOK},
+		{"cross-site fetch metadata", http.MethodPost, true, map[string]string{"Sec-Fetch-Site": "cross-site"}, http.StatusForbidden},
+		{"allowed referers", http.MethodPost, true, map[string]string{"Referer": "https://app.example.com/events"}, http.StatusOK},
+		{"unknown senders", http.MethodPost, true, nil, http.StatusForbidden},
+		{"cross-site WebSocket upgrades", http.MethodGet, true, map[string]string{
+			"Origin": "https://evil.example.net", "Connection": "Upgrade", "Upgrade": "websocket",
+		}, http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/graphql", nil)
+			if tt.cookie {
+				req.AddCookie(&http.Cookie{Name: "morrow_session", Value: "session"})
+			}
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.code, w.Code)
+		})
+	}
+
+	t.Run("disabled without a session cookie name", func(t *testing.T) {
+		router := gin.New()
+		router.Use(CSRF("", NewOrigins(nil)))
+		router.POST("/graphql", func(c *gin.Context) { c.Status(http.StatusOK) })
+		req := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+		req.AddCookie(&http.Cookie{Name: "morrow_session", Value: "session"})
+		req.Header.Set("Origin", "https://evil.example.net")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
@@ -4,13 +4,12 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/matsuokashuhei/morrow-backend/internal/logging"
 	"github.com/sirupsen/logrus"
 )
 
 // ErrorHandler handles errors and provides consistent error responses
 func ErrorHandler() gin.HandlerFunc {
-	logger := InitLogger()
-
 	return func(c *gin.Context) {
 		c.Next()
 
@@ -35,7 +34,7 @@ func ErrorHandler() gin.HandlerFunc {
 			}
 
 			// Log the error with context
-			logger.WithFields(logrus.Fields{
+			logging.FromContext(c.Request.Context()).WithFields(logrus.Fields{
 				"error_type":   errorType,
 				"status_code":  statusCode,
 				"path":         c.Request.URL.Path,
@@ -83,9 +82,7 @@ func (e *APIError) Error() string {
 
 // RespondWithError sends a consistent error response
 func RespondWithError(c *gin.Context, statusCode int, errorType, message string) {
-	logger := InitLogger()
-
-	logger.WithFields(logrus.Fields{
+	logging.FromContext(c.Request.Context()).WithFields(logrus.Fields{
 		"status_code": statusCode,
 		"error_type":  errorType,
 		"message":     message,
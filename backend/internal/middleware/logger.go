@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/matsuokashuhei/morrow-backend/internal/logging"
+	"github.com/matsuokashuhei/morrow-backend/internal/requestmeta"
 	"github.com/sirupsen/logrus"
 )
 
@@ -38,16 +40,29 @@ func InitLogger() *logrus.Logger {
 				ForceColors:     true,
 			})
 		}
+		// リクエスト外（バックグラウンドジョブなど）のログも同じ設定で出力する
+		logging.SetDefault(globalLogger)
 	})
 
 	return globalLogger
 }
 
-// LoggerMiddleware はGinのリクエストログを構造化ログとして出力する
+// LoggerMiddleware はGinのリクエストログを構造化ログとして出力する。
+// リクエストIDを付けたロガーをコンテキストに格納し、以降のミドルウェアやリゾルバーは
+// logging.FromContext で同じロガーを使う。RequestMeta の後に登録すること。
 func LoggerMiddleware(logger *logrus.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		startTime := time.Now()
 
+		fields := logrus.Fields{
+			"method": c.Request.Method,
+			"path":   c.Request.URL.Path,
+		}
+		if meta, ok := requestmeta.FromContext(c.Request.Context()); ok {
+			fields["request_id"] = meta.RequestID
+		}
+		c.Request = c.Request.WithContext(logging.NewContext(c.Request.Context(), logger.WithFields(fields)))
+
 		// リクエスト処理
 		c.Next()
 
@@ -55,10 +70,8 @@ func LoggerMiddleware(logger *logrus.Logger) gin.HandlerFunc {
 		endTime := time.Now()
 		latency := endTime.Sub(startTime)
 
-		fields := logrus.Fields{
+		fields = logrus.Fields{
 			"status":     c.Writer.Status(),
-			"method":     c.Request.Method,
-			"path":       c.Request.URL.Path,
 			"ip":         c.ClientIP(),
 			"user_agent": c.Request.UserAgent(),
 			"latency":    latency,
@@ -72,15 +85,17 @@ func LoggerMiddleware(logger *logrus.Logger) gin.HandlerFunc {
 
 		// ステータスコードに応じたログレベル
 		status := c.Writer.Status()
+		// 後続の処理で追加されたフィールド（ユーザーなど）とトレースIDも出力する
+		entry := logging.FromContext(c.Request.Context()).WithFields(fields)
 		switch {
 		case status >= 500:
-			logger.WithFields(fields).Error("Server Error")
+			entry.Error("Server Error")
 		case status >= 400:
-			logger.WithFields(fields).Warn("Client Error")
+			entry.Warn("Client Error")
 		case status >= 300:
-			logger.WithFields(fields).Info("Redirection")
+			entry.Info("Redirection")
 		default:
-			logger.WithFields(fields).Info("Success")
+			entry.Info("Success")
 		}
 	}
 }
@@ -91,13 +106,9 @@ func Logger() gin.HandlerFunc {
 	return LoggerMiddleware(InitLogger())
 }
 
-// RequestLogger creates a structured logger for the current request
+// RequestLogger returns the logger of the current request, which carries its ID
 func RequestLogger(c *gin.Context) *logrus.Entry {
-	return InitLogger().WithFields(logrus.Fields{
-		"request_id": c.GetHeader("X-Request-ID"),
-		"path":       c.Request.URL.Path,
-		"method":     c.Request.Method,
-	})
+	return logging.FromContext(c.Request.Context())
 }
 
 // SetGinMode はGinのログ出力を環境に応じて設定する
@@ -1,12 +1,19 @@
 package middleware
 
 import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"sync"
 	"testing"
 
+	"github.com/gin-gonic/gin"
+	"github.com/matsuokashuhei/morrow-backend/internal/logging"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestInitLogger(t *testing.T) {
@@ -63,3 +70,39 @@ func TestSetGinMode(t *testing.T) {
 	SetGinMode()
 	// ginモードの確認は直接的には難しいが、エラーが出ないことを確認
 }
+
+func TestLoggerMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var buf bytes.Buffer
+	logger := logrus.New()
+	logger.SetOutput(&buf)
+	logger.SetFormatter(&logrus.JSONFormatter{})
+
+	router := gin.New()
+	router.Use(RequestMeta())
+	router.Use(LoggerMiddleware(logger))
+	router.Use(Auth(true))
+	router.GET("/api/v1/events", func(c *gin.Context) {
+		// ハンドラーやリゾルバーはコンテキストから同じロガーを取り出す
+		logging.FromContext(c.Request.Context()).Info("resolver log")
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/events", nil)
+	req.Header.Set(RequestIDHeader, "req-123")
+	req.Header.Set("Authorization", "Bearer development-token")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, "req-123", w.Header().Get(RequestIDHeader))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	for _, line := range lines {
+		assert.Contains(t, line, `"request_id":"req-123"`)
+	}
+	// 認証後のログにはユーザーが付く
+	assert.Contains(t, lines[1], `"msg":"resolver log"`)
+	assert.Contains(t, lines[1], `"user_id":"dev_user"`)
+	assert.Contains(t, lines[2], `"msg":"Success"`)
+	assert.Contains(t, lines[2], `"user_id":"dev_user"`)
+}
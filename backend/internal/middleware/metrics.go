@@ -0,0 +1,16 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/matsuokashuhei/morrow-backend/internal/metrics"
+)
+
+// Metrics records the count and latency of requests by route and status
+func Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		done := metrics.StartHTTPRequest()
+		c.Next()
+		// パスではなくルートのパターンで集計し、系列の数を抑える
+		done(c.Request.Method, c.FullPath(), c.Writer.Status())
+	}
+}
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/matsuokashuhei/morrow-backend/internal/logging"
+	"github.com/matsuokashuhei/morrow-backend/internal/ratelimit"
+	"github.com/sirupsen/logrus"
+)
+
+// RateLimit counts the requests of a route group against budget, keyed by the
+// authenticated user or the client IP, and rejects them with 429 once it is spent.
+// It must run after RequestMeta, and after Auth to key requests by user.
+func RateLimit(limiter *ratelimit.Limiter, budget string) gin.HandlerFunc {
+	return RateLimitBy(limiter, budget, func(c *gin.Context) string {
+		return ratelimit.Key(c.Request.Context())
+	})
+}
+
+// RateLimitBy works like RateLimit but keys requests by the value key returns,
+// such as the secret token in the URL of a calendar feed
+func RateLimitBy(limiter *ratelimit.Limiter, budget string, key func(c *gin.Context) string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		res := limiter.Allow(ctx, budget, key(c))
+		ratelimit.SetHeaders(c.Writer.Header(), res)
+		if res.Allowed {
+			c.Next()
+			return
+		}
+		rejectRateLimited(c, budget)
+	}
+}
+
+// RateLimitFailures counts only the requests that failed reports against budget,
+// keyed by the authenticated user or the client IP, and rejects every request of
+// the client once it is spent. Secrets in URLs, such as calendar feed tokens,
+// cannot be guessed then, while clients using valid ones are not limited.
+func RateLimitFailures(limiter *ratelimit.Limiter, budget string, failed func(c *gin.Context) bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		key := ratelimit.Key(ctx)
+		// 使い切った後は正しいトークンかどうかも分からないよう、処理する前に断る
+		if res := limiter.Check(ctx, budget, key); !res.Allowed {
+			ratelimit.SetHeaders(c.Writer.Header(), res)
+			rejectRateLimited(c, budget)
+			return
+		}
+		c.Next()
+		if failed(c) {
+			limiter.Allow(ctx, budget, key)
+		}
+	}
+}
+
+// rejectRateLimited aborts the request with 429
+func rejectRateLimited(c *gin.Context, budget string) {
+	logging.FromContext(c.Request.Context()).WithFields(logrus.Fields{
+		"budget": budget,
+		"ip":     c.ClientIP(),
+	}).Warn("Rate limit exceeded")
+
+	c.JSON(http.StatusTooManyRequests, gin.H{
+		"error":   "rate_limited",
+		"message": "Too many requests, please retry later",
+	})
+	c.Abort()
+}
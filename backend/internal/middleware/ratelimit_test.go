@@ -0,0 +1,126 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/matsuokashuhei/morrow-backend/internal/ratelimit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	limiter := ratelimit.New(ratelimit.NewMemoryStore(), map[string]ratelimit.Limit{
+		ratelimit.BudgetPublic: {Burst: 2, Period: time.Minute},
+	})
+	router := gin.New()
+	router.Use(RequestMeta())
+	router.GET("/status", RateLimit(limiter, ratelimit.BudgetPublic), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	router.GET("/health", RateLimit(limiter, ratelimit.BudgetAuth), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	request := func(path, ip string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = ip + ":1234"
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := request("/status", "192.0.2.1")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "2", w.Header().Get(ratelimit.HeaderLimit))
+	assert.Equal(t, "1", w.Header().Get(ratelimit.HeaderRemaining))
+	assert.Equal(t, "30", w.Header().Get(ratelimit.HeaderReset))
+
+	assert.Equal(t, http.StatusOK, request("/status", "192.0.2.1").Code)
+
+	w = request("/status", "192.0.2.1")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "30", w.Header().Get("Retry-After"))
+	assert.Contains(t, w.Body.String(), `"rate_limited"`)
+
+	// 他のクライアントや予算は別に数える
+	assert.Equal(t, http.StatusOK, request("/status", "192.0.2.2").Code)
+	w = request("/health", "192.0.2.1")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get(ratelimit.HeaderLimit))
+}
+
+func TestRateLimitBy(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	limiter := ratelimit.New(ratelimit.NewMemoryStore(), map[string]ratelimit.Limit{
+		ratelimit.BudgetAuth: {Burst: 1, Period: time.Minute},
+	})
+	router := gin.New()
+	router.Use(RequestMeta())
+	router.GET("/feeds/:token", RateLimitBy(limiter, ratelimit.BudgetAuth, func(c *gin.Context) string {
+		return "feed:" + c.Param("token")
+	}), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	request := func(path string) int {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = "192.0.2.1:1234"
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	// 同じIPからでもトークンごとに数える
+	assert.Equal(t, http.StatusOK, request("/feeds/a"))
+	assert.Equal(t, http.StatusOK, request("/feeds/b"))
+	assert.Equal(t, http.StatusTooManyRequests, request("/feeds/a"))
+}
+
+func TestRateLimitFailures(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	limiter := ratelimit.New(ratelimit.NewMemoryStore(), map[string]ratelimit.Limit{
+		ratelimit.BudgetAuth: {Burst: 3, Period: time.Minute},
+	})
+	router := gin.New()
+	router.Use(RequestMeta())
+	guessLimit := RateLimitFailures(limiter, ratelimit.BudgetAuth, func(c *gin.Context) bool {
+		return c.Writer.Status() == http.StatusNotFound
+	})
+	router.GET("/feeds/:token", guessLimit, func(c *gin.Context) {
+		if c.Param("token") != "valid" {
+			c.Status(http.StatusNotFound)
+			return
+		}
+		c.Status(http.StatusOK)
+	})
+
+	request := func(token, ip string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/feeds/"+token, nil)
+		req.RemoteAddr = ip + ":1234"
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// 正しいトークンは数えない
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, http.StatusOK, request("valid", "192.0.2.1").Code)
+	}
+
+	// 毎回違うトークンを試しても同じIPからの失敗として数える
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, http.StatusNotFound, request("guess-"+strconv.Itoa(i), "192.0.2.1").Code)
+	}
+	w := request("guess-3", "192.0.2.1")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.NotEmpty(t, w.Header().Get("Retry-After"))
+	// 使い切った後は正しいトークンも断り、推測の当たり外れを明かさない
+	assert.Equal(t, http.StatusTooManyRequests, request("valid", "192.0.2.1").Code)
+
+	assert.Equal(t, http.StatusOK, request("valid", "192.0.2.2").Code)
+}
@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/gin-gonic/gin"
+	"github.com/matsuokashuhei/morrow-backend/internal/requestmeta"
+)
+
+// RequestIDHeader carries the ID that correlates a request across services
+const RequestIDHeader = "X-Request-ID"
+
+// RequestMeta stores the request ID and client IP in the request context.
+// The ID is taken from X-Request-ID when the client sent one and echoed in the response.
+func RequestMeta() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		// 不正に長いIDはログや監査記録を汚すため採用しない
+		if id == "" || len(id) > 128 {
+			id = newRequestID()
+		}
+		c.Header(RequestIDHeader, id)
+		c.Request = c.Request.WithContext(requestmeta.NewContext(c.Request.Context(), &requestmeta.Meta{
+			RequestID: id,
+			IP:        c.ClientIP(),
+		}))
+		c.Next()
+	}
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/matsuokashuhei/morrow-backend/internal/requestmeta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestMeta(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var got *requestmeta.Meta
+	router := gin.New()
+	router.Use(RequestMeta())
+	router.GET("/", func(c *gin.Context) {
+		got, _ = requestmeta.FromContext(c.Request.Context())
+		c.Status(http.StatusNoContent)
+	})
+
+	t.Run("keeps the client request ID", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(RequestIDHeader, "abc-123")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.NotNil(t, got)
+		assert.Equal(t, "abc-123", got.RequestID)
+		assert.Equal(t, "192.0.2.1", got.IP)
+		assert.Equal(t, "abc-123", w.Header().Get(RequestIDHeader))
+	})
+
+	t.Run("generates a request ID", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		require.NotNil(t, got)
+		assert.Len(t, got.RequestID, 32)
+		assert.Equal(t, got.RequestID, w.Header().Get(RequestIDHeader))
+	})
+}
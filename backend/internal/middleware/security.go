@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ContentSecurityPolicy is sent with every API response. JSON, iCalendar data
+// and uploaded files must never run scripts or be framed by other sites.
+const ContentSecurityPolicy = "default-src 'none'; frame-ancestors 'none'; base-uri 'none'; form-action 'none'"
+
+// PlaygroundContentSecurityPolicy allows the GraphQL playground page to load
+// GraphiQL from its CDN and to query this API
+const PlaygroundContentSecurityPolicy = "default-src 'none'; " +
+	"script-src 'unsafe-inline' https://cdn.jsdelivr.net; " +
+	"style-src 'unsafe-inline' https://cdn.jsdelivr.net; " +
+	"img-src 'self' data: https://cdn.jsdelivr.net; " +
+	"font-src data: https://cdn.jsdelivr.net; " +
+	"connect-src 'self'; " +
+	"frame-ancestors 'none'; base-uri 'none'; form-action 'none'"
+
+// SecurityHeaders sets the headers that stop browsers from sniffing content
+// types, framing responses and leaking URLs (calendar feed tokens) in the Referer.
+// A positive hstsMaxAge also tells browsers to use HTTPS only; enable it when the
+// API is served over HTTPS, including behind a TLS terminating proxy.
+func SecurityHeaders(hstsMaxAge time.Duration) gin.HandlerFunc {
+	hsts := ""
+	if hstsMaxAge > 0 {
+		hsts = fmt.Sprintf("max-age=%d; includeSubDomains", int64(hstsMaxAge/time.Second))
+	}
+
+	return func(c *gin.Context) {
+		// ハンドラーが上書きできるよう処理の前に設定する
+		header := c.Writer.Header()
+		header.Set("X-Content-Type-Options", "nosniff")
+		header.Set("X-Frame-Options", "DENY")
+		header.Set("Referrer-Policy", "no-referrer")
+		header.Set("Content-Security-Policy", ContentSecurityPolicy)
+		if hsts != "" {
+			header.Set("Strict-Transport-Security", hsts)
+		}
+		c.Next()
+	}
+}
+
+// Embeddable lets the pages of a route group, such as the calendar widget, be
+// framed by the given origins. It must run after SecurityHeaders.
+func Embeddable(frameAncestors []string) gin.HandlerFunc {
+	ancestors := "'none'"
+	if len(frameAncestors) > 0 {
+		ancestors = strings.Join(frameAncestors, " ")
+	}
+	// ウィジェットはスクリプトを使わず、インラインのスタイルだけで表示する
+	policy := "default-src 'none'; style-src 'unsafe-inline'; img-src 'self' data: https:; frame-ancestors " + ancestors + "; base-uri 'none'; form-action 'none'"
+
+	return func(c *gin.Context) {
+		header := c.Writer.Header()
+		// X-Frame-Options は許可するオリジンを列挙できないので CSP だけで制御する
+		header.Del("X-Frame-Options")
+		header.Set("Content-Security-Policy", policy)
+		c.Next()
+	}
+}
@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+)
+
+// probePaths are polled by orchestrators and load balancers without credentials
+var probePaths = map[string]bool{
+	"/livez":    true,
+	"/readyz":   true,
+	"/startupz": true,
+}
+
+// untracedPaths are polled by load balancers and Prometheus and would only add noise
+var untracedPaths = map[string]bool{
+	"/health":  true,
+	"/ping":    true,
+	"/metrics": true,
+}
+
+// Tracing records a server span for every request, continuing the trace of
+// the W3C traceparent header sent by the frontend. The span is stored in the
+// request context, so GraphQL and database spans become its children.
+func Tracing(serviceName string) gin.HandlerFunc {
+	return otelgin.Middleware(serviceName, otelgin.WithFilter(func(r *http.Request) bool {
+		return !untracedPaths[r.URL.Path] && !probePaths[r.URL.Path]
+	}))
+}
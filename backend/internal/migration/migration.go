@@ -0,0 +1,554 @@
+// Package migration applies the versioned migration directory in
+// ent/migrate/migrations. Applied versions are recorded in the
+// schema_migrations table and runs hold a PostgreSQL advisory lock, so
+// several servers or jobs starting at once never apply the same file twice.
+// Every migration runs in its own transaction and can be reverted with the
+// script of the same name under down/.
+package migration
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io/fs"
+	"path"
+	"strings"
+	"time"
+
+	"ariga.io/atlas/sql/migrate"
+)
+
+// RevisionTable records the applied migrations
+const RevisionTable = "schema_migrations"
+
+// Initial is the target version that reverts every migration
+const Initial = "0"
+
+var (
+	// ErrUnknownVersion is returned for versions that are not in the migration directory
+	ErrUnknownVersion = errors.New("unknown migration version")
+	// ErrNoDownScript is returned when reverting a migration without a down script
+	ErrNoDownScript = errors.New("migration has no down script")
+	// ErrDrift is returned when an applied migration was edited after it was applied
+	ErrDrift = errors.New("applied migration differs from the migration directory")
+	// ErrBehind is returned when the database is missing migrations of the directory
+	ErrBehind = errors.New("database schema is behind the migration directory")
+	// ErrNotEmpty is returned when baselining a database that already records migrations
+	ErrNotEmpty = errors.New("database already records applied migrations")
+)
+
+// 同じデータベースを使うすべてのプロセスで共通のロックキー
+var lockKey = func() int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte("morrow:" + RevisionTable))
+	return int64(h.Sum64())
+}()
+
+// Migration is a versioned migration file
+type Migration struct {
+	Version     string
+	Description string
+	Name        string
+	// Hash is the checksum of the up script recorded when it is applied
+	Hash string
+	up   *migrate.LocalFile
+	down *migrate.LocalFile
+}
+
+// Statements returns the statements of the up script
+func (m *Migration) Statements() ([]string, error) {
+	return m.up.Stmts()
+}
+
+// DownStatements returns the statements of the down script
+func (m *Migration) DownStatements() ([]string, error) {
+	if m.down == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNoDownScript, m.Name)
+	}
+	return m.down.Stmts()
+}
+
+// Revision is a migration applied to the database
+type Revision struct {
+	Version       string
+	Description   string
+	Hash          string
+	AppliedAt     time.Time
+	ExecutionTime time.Duration
+}
+
+// Dir is a migration directory verified against its atlas.sum
+type Dir struct {
+	Migrations []*Migration
+}
+
+// Load reads the migrations of fsys and verifies them against atlas.sum.
+// Down scripts are read from down/ with the same file names.
+func Load(fsys fs.FS) (*Dir, error) {
+	names, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list migrations: %w", err)
+	}
+	files := make([]migrate.File, 0, len(names))
+	dir := &Dir{Migrations: make([]*Migration, 0, len(names))}
+	for _, name := range names {
+		b, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", name, err)
+		}
+		up := migrate.NewLocalFile(name, b)
+		files = append(files, up)
+		sum := sha256.Sum256(b)
+		m := &Migration{
+			Version:     up.Version(),
+			Description: up.Desc(),
+			Name:        name,
+			Hash:        base64.StdEncoding.EncodeToString(sum[:]),
+			up:          up,
+		}
+		down, err := fs.ReadFile(fsys, path.Join("down", name))
+		switch {
+		case err == nil:
+			m.down = migrate.NewLocalFile(name, down)
+		case !errors.Is(err, fs.ErrNotExist):
+			return nil, fmt.Errorf("failed to read down migration %s: %w", name, err)
+		}
+		dir.Migrations = append(dir.Migrations, m)
+	}
+
+	if err := verifySum(fsys, files); err != nil {
+		return nil, err
+	}
+	// 対応するマイグレーションがない down スクリプトは名前の間違いとみなす
+	downs, err := fs.Glob(fsys, "down/*.sql")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list down migrations: %w", err)
+	}
+	for _, name := range downs {
+		if _, ok := dir.find(strings.SplitN(path.Base(name), "_", 2)[0]); !ok {
+			return nil, fmt.Errorf("%w: %s has no up migration", ErrUnknownVersion, name)
+		}
+	}
+	return dir, nil
+}
+
+// verifySum compares the files with atlas.sum and names the first file that differs
+func verifySum(fsys fs.FS, files []migrate.File) error {
+	b, err := fs.ReadFile(fsys, migrate.HashFileName)
+	if errors.Is(err, fs.ErrNotExist) {
+		return migrate.ErrChecksumNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", migrate.HashFileName, err)
+	}
+	var expected migrate.HashFile
+	if err := expected.UnmarshalText(b); err != nil {
+		return fmt.Errorf("invalid %s: %w", migrate.HashFileName, err)
+	}
+	actual, err := migrate.NewHashFile(files)
+	if err != nil {
+		return fmt.Errorf("failed to compute checksums: %w", err)
+	}
+
+	for i := 0; i < len(actual) || i < len(expected); i++ {
+		switch {
+		case i >= len(expected):
+			return fmt.Errorf("%w: %s was added without updating %s", migrate.ErrChecksumMismatch, actual[i].N, migrate.HashFileName)
+		case i >= len(actual):
+			return fmt.Errorf("%w: %s was removed", migrate.ErrChecksumMismatch, expected[i].N)
+		case actual[i].N != expected[i].N:
+			return fmt.Errorf("%w: expected %s but found %s", migrate.ErrChecksumMismatch, expected[i].N, actual[i].N)
+		case actual[i].H != expected[i].H:
+			return fmt.Errorf("%w: %s was edited", migrate.ErrChecksumMismatch, actual[i].N)
+		}
+	}
+	return nil
+}
+
+// Latest returns the version of the last migration
+func (d *Dir) Latest() string {
+	if len(d.Migrations) == 0 {
+		return Initial
+	}
+	return d.Migrations[len(d.Migrations)-1].Version
+}
+
+// find returns the migration of a version
+func (d *Dir) find(version string) (*Migration, bool) {
+	for _, m := range d.Migrations {
+		if m.Version == version {
+			return m, true
+		}
+	}
+	return nil, false
+}
+
+// verify checks that every applied migration is in the directory unchanged
+func (d *Dir) verify(applied []Revision) error {
+	for _, r := range applied {
+		m, ok := d.find(r.Version)
+		if !ok {
+			return fmt.Errorf("%w: %s is applied but not in the migration directory", ErrUnknownVersion, r.Version)
+		}
+		if m.Hash != r.Hash {
+			return fmt.Errorf("%w: %s", ErrDrift, m.Name)
+		}
+	}
+	return nil
+}
+
+// pending returns the migrations to apply to reach target, or every unapplied migration when target is empty
+func (d *Dir) pending(applied []Revision, target string) ([]*Migration, error) {
+	if target != "" {
+		if _, ok := d.find(target); !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownVersion, target)
+		}
+	}
+	done := make(map[string]bool, len(applied))
+	for _, r := range applied {
+		done[r.Version] = true
+	}
+	var plan []*Migration
+	for _, m := range d.Migrations {
+		if done[m.Version] || (target != "" && m.Version > target) {
+			continue
+		}
+		plan = append(plan, m)
+	}
+	return plan, nil
+}
+
+// rollback returns the migrations to revert, newest first, so that target is
+// the latest applied version. An empty target reverts the latest migration only.
+func (d *Dir) rollback(applied []Revision, target string) ([]*Migration, error) {
+	if target != "" && target != Initial {
+		if _, ok := d.find(target); !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownVersion, target)
+		}
+	}
+	var plan []*Migration
+	for i := len(applied) - 1; i >= 0; i-- {
+		if target == "" && len(plan) == 1 {
+			break
+		}
+		if target != "" && applied[i].Version <= target {
+			continue
+		}
+		m, ok := d.find(applied[i].Version)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownVersion, applied[i].Version)
+		}
+		if m.down == nil {
+			return nil, fmt.Errorf("%w: %s", ErrNoDownScript, m.Name)
+		}
+		plan = append(plan, m)
+	}
+	return plan, nil
+}
+
+// Status describes the migrations of a database
+type Status struct {
+	// Current is the latest applied version, or Initial when nothing is applied
+	Current string
+	Applied []Revision
+	Pending []*Migration
+	// Drifted lists applied migrations that were edited afterwards
+	Drifted []string
+	// Unknown lists applied versions missing from the directory, e.g. after deploying an older build
+	Unknown []string
+}
+
+// Migrator applies a migration directory to a database
+type Migrator struct {
+	db  *sql.DB
+	dir *Dir
+}
+
+// New returns a migrator applying dir to db
+func New(db *sql.DB, dir *Dir) *Migrator {
+	return &Migrator{db: db, dir: dir}
+}
+
+// Status compares the database with the migration directory without changing it
+func (m *Migrator) Status(ctx context.Context) (*Status, error) {
+	applied, err := appliedRevisions(ctx, m.db)
+	if err != nil {
+		return nil, err
+	}
+	status := &Status{Current: Initial, Applied: applied}
+	if len(applied) > 0 {
+		status.Current = applied[len(applied)-1].Version
+	}
+	for _, r := range applied {
+		mig, ok := m.dir.find(r.Version)
+		switch {
+		case !ok:
+			status.Unknown = append(status.Unknown, r.Version)
+		case mig.Hash != r.Hash:
+			status.Drifted = append(status.Drifted, mig.Name)
+		}
+	}
+	if status.Pending, err = m.dir.pending(applied, ""); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// Check returns ErrBehind when migrations are pending and ErrDrift when applied ones were edited
+func (m *Migrator) Check(ctx context.Context) error {
+	status, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+	if len(status.Drifted) > 0 {
+		return fmt.Errorf("%w: %s", ErrDrift, strings.Join(status.Drifted, ", "))
+	}
+	if len(status.Pending) > 0 {
+		return fmt.Errorf("%w: %d pending, database is at %s and the directory at %s",
+			ErrBehind, len(status.Pending), status.Current, m.dir.Latest())
+	}
+	return nil
+}
+
+// Up applies the pending migrations up to target, or all of them when target
+// is empty, and returns the applied migrations. With dryRun nothing is
+// applied and the migrations that would be applied are returned.
+func (m *Migrator) Up(ctx context.Context, target string, dryRun bool) ([]*Migration, error) {
+	var done []*Migration
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedRevisions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := m.dir.verify(applied); err != nil {
+			return err
+		}
+		plan, err := m.dir.pending(applied, target)
+		if err != nil {
+			return err
+		}
+		if dryRun {
+			done = plan
+			return nil
+		}
+		if err := ensureRevisionTable(ctx, conn); err != nil {
+			return err
+		}
+		for _, mig := range plan {
+			if err := apply(ctx, conn, mig); err != nil {
+				return err
+			}
+			done = append(done, mig)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Down reverts the applied migrations newer than target, newest first, and
+// returns the reverted migrations. An empty target reverts the latest
+// migration and Initial reverts all of them. With dryRun nothing is reverted.
+func (m *Migrator) Down(ctx context.Context, target string, dryRun bool) ([]*Migration, error) {
+	var done []*Migration
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedRevisions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := m.dir.verify(applied); err != nil {
+			return err
+		}
+		plan, err := m.dir.rollback(applied, target)
+		if err != nil {
+			return err
+		}
+		if dryRun {
+			done = plan
+			return nil
+		}
+		for _, mig := range plan {
+			if err := revert(ctx, conn, mig); err != nil {
+				return err
+			}
+			done = append(done, mig)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Baseline records the migrations up to version as applied without running
+// them, for databases whose schema was created before migrations were tracked
+func (m *Migrator) Baseline(ctx context.Context, version string) ([]*Migration, error) {
+	var done []*Migration
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedRevisions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if len(applied) > 0 {
+			return ErrNotEmpty
+		}
+		plan, err := m.dir.pending(nil, version)
+		if err != nil {
+			return err
+		}
+		if err := ensureRevisionTable(ctx, conn); err != nil {
+			return err
+		}
+
+		tx, err := conn.BeginTx(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to start transaction: %w", err)
+		}
+		defer func() { _ = tx.Rollback() }()
+		for _, mig := range plan {
+			if err := recordRevision(ctx, tx, mig, 0); err != nil {
+				return err
+			}
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to commit baseline: %w", err)
+		}
+		done = plan
+		return nil
+	})
+	return done, err
+}
+
+// locked runs fn on a dedicated connection holding the migration advisory lock
+func (m *Migrator) locked(ctx context.Context, fn func(*sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	// セッション単位のロックなので、取得と解放は同じ接続で行う
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockKey); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer func() {
+		_, _ = conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", lockKey)
+	}()
+	return fn(conn)
+}
+
+// queryer is implemented by *sql.DB and *sql.Conn
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// appliedRevisions returns the applied migrations in version order, or none before the revision table exists
+func appliedRevisions(ctx context.Context, q queryer) ([]Revision, error) {
+	var exists bool
+	if err := q.QueryRowContext(ctx, "SELECT to_regclass($1) IS NOT NULL", RevisionTable).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("failed to check revision table: %w", err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	rows, err := q.QueryContext(ctx, `SELECT "version", "description", "hash", "applied_at", "execution_time" FROM "`+RevisionTable+`" ORDER BY "version"`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get applied migrations: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+	var revisions []Revision
+	for rows.Next() {
+		var (
+			r  Revision
+			ms int64
+		)
+		if err := rows.Scan(&r.Version, &r.Description, &r.Hash, &r.AppliedAt, &ms); err != nil {
+			return nil, fmt.Errorf("failed to read applied migration: %w", err)
+		}
+		r.ExecutionTime = time.Duration(ms) * time.Millisecond
+		revisions = append(revisions, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+	return revisions, nil
+}
+
+// ensureRevisionTable creates the revision table on first use
+func ensureRevisionTable(ctx context.Context, conn *sql.Conn) error {
+	if _, err := conn.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS "`+RevisionTable+`" (
+  "version" character varying NOT NULL,
+  "description" character varying NOT NULL,
+  "hash" character varying NOT NULL,
+  "applied_at" timestamptz NOT NULL DEFAULT now(),
+  "execution_time" bigint NOT NULL,
+  PRIMARY KEY ("version")
+)`); err != nil {
+		return fmt.Errorf("failed to create revision table: %w", err)
+	}
+	return nil
+}
+
+// apply runs an up script and records it in one transaction
+func apply(ctx context.Context, conn *sql.Conn, m *Migration) error {
+	stmts, err := m.Statements()
+	if err != nil {
+		return fmt.Errorf("failed to parse %s: %w", m.Name, err)
+	}
+	start := time.Now()
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	for _, stmt := range stmts {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("failed to apply %s: %w", m.Name, err)
+		}
+	}
+	if err := recordRevision(ctx, tx, m, time.Since(start)); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit %s: %w", m.Name, err)
+	}
+	return nil
+}
+
+// revert runs a down script and removes its revision in one transaction
+func revert(ctx context.Context, conn *sql.Conn, m *Migration) error {
+	stmts, err := m.DownStatements()
+	if err != nil {
+		return err
+	}
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	for _, stmt := range stmts {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("failed to revert %s: %w", m.Name, err)
+		}
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM "`+RevisionTable+`" WHERE "version" = $1`, m.Version); err != nil {
+		return fmt.Errorf("failed to remove revision %s: %w", m.Version, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit revert of %s: %w", m.Name, err)
+	}
+	return nil
+}
+
+// recordRevision marks a migration as applied
+func recordRevision(ctx context.Context, tx *sql.Tx, m *Migration, took time.Duration) error {
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO "`+RevisionTable+`" ("version", "description", "hash", "execution_time") VALUES ($1, $2, $3, $4)`,
+		m.Version, m.Description, m.Hash, took.Milliseconds(),
+	); err != nil {
+		return fmt.Errorf("failed to record revision %s: %w", m.Version, err)
+	}
+	return nil
+}
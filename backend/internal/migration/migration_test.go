@@ -0,0 +1,166 @@
+package migration
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"ariga.io/atlas/sql/migrate"
+	"github.com/matsuokashuhei/morrow-backend/ent/migrate/migrations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testDir returns a migration directory with an atlas.sum matching its files
+func testDir(t *testing.T, files map[string]string) fstest.MapFS {
+	t.Helper()
+	fsys := fstest.MapFS{}
+	var ups []migrate.File
+	for _, name := range []string{"1_users.sql", "2_events.sql", "3_messages.sql"} {
+		body, ok := files[name]
+		if !ok {
+			continue
+		}
+		fsys[name] = &fstest.MapFile{Data: []byte(body)}
+		ups = append(ups, migrate.NewLocalFile(name, []byte(body)))
+	}
+	sum, err := migrate.NewHashFile(ups)
+	require.NoError(t, err)
+	b, err := sum.MarshalText()
+	require.NoError(t, err)
+	fsys[migrate.HashFileName] = &fstest.MapFile{Data: b}
+	for name, body := range files {
+		if _, ok := fsys[name]; !ok {
+			fsys[name] = &fstest.MapFile{Data: []byte(body)}
+		}
+	}
+	return fsys
+}
+
+func TestLoad(t *testing.T) {
+	files := map[string]string{
+		"1_users.sql":         "CREATE TABLE users (id int);\n",
+		"2_events.sql":        "CREATE TABLE events (id int);\n",
+		"down/1_users.sql":    "DROP TABLE users;\n",
+		"down/2_events.sql":   "DROP TABLE events;\n",
+		"3_messages.sql":      "CREATE TABLE messages (id int);\nCREATE INDEX messages_id ON messages (id);\n",
+		"down/3_messages.sql": "DROP TABLE messages;\n",
+	}
+
+	t.Run("the embedded directory matches its atlas.sum and can be reverted", func(t *testing.T) {
+		dir, err := Load(migrations.FS)
+		require.NoError(t, err)
+		require.NotEmpty(t, dir.Migrations)
+		for _, m := range dir.Migrations {
+			_, err := m.Statements()
+			assert.NoError(t, err, m.Name)
+			_, err = m.DownStatements()
+			assert.NoError(t, err, m.Name)
+		}
+	})
+
+	t.Run("migrations are ordered by version", func(t *testing.T) {
+		dir, err := Load(testDir(t, files))
+		require.NoError(t, err)
+		require.Len(t, dir.Migrations, 3)
+		assert.Equal(t, "1", dir.Migrations[0].Version)
+		assert.Equal(t, "users", dir.Migrations[0].Description)
+		assert.Equal(t, "3", dir.Latest())
+		stmts, err := dir.Migrations[2].Statements()
+		require.NoError(t, err)
+		assert.Len(t, stmts, 2)
+	})
+
+	t.Run("edited files are rejected", func(t *testing.T) {
+		fsys := testDir(t, files)
+		fsys["2_events.sql"] = &fstest.MapFile{Data: []byte("CREATE TABLE events (id bigint);\n")}
+		_, err := Load(fsys)
+		assert.ErrorIs(t, err, migrate.ErrChecksumMismatch)
+		assert.ErrorContains(t, err, "2_events.sql was edited")
+	})
+
+	t.Run("files added without updating the sum are rejected", func(t *testing.T) {
+		fsys := testDir(t, files)
+		fsys["4_reactions.sql"] = &fstest.MapFile{Data: []byte("CREATE TABLE reactions (id int);\n")}
+		_, err := Load(fsys)
+		assert.ErrorIs(t, err, migrate.ErrChecksumMismatch)
+		assert.ErrorContains(t, err, "4_reactions.sql was added")
+	})
+
+	t.Run("a missing sum is rejected", func(t *testing.T) {
+		fsys := testDir(t, files)
+		delete(fsys, migrate.HashFileName)
+		_, err := Load(fsys)
+		assert.ErrorIs(t, err, migrate.ErrChecksumNotFound)
+	})
+
+	t.Run("down scripts need an up migration", func(t *testing.T) {
+		fsys := testDir(t, files)
+		fsys["down/4_reactions.sql"] = &fstest.MapFile{Data: []byte("DROP TABLE reactions;\n")}
+		_, err := Load(fsys)
+		assert.ErrorIs(t, err, ErrUnknownVersion)
+	})
+}
+
+func TestPlan(t *testing.T) {
+	dir, err := Load(testDir(t, map[string]string{
+		"1_users.sql":         "CREATE TABLE users (id int);\n",
+		"2_events.sql":        "CREATE TABLE events (id int);\n",
+		"3_messages.sql":      "CREATE TABLE messages (id int);\n",
+		"down/2_events.sql":   "DROP TABLE events;\n",
+		"down/3_messages.sql": "DROP TABLE messages;\n",
+	}))
+	require.NoError(t, err)
+	revision := func(version string) Revision {
+		m, ok := dir.find(version)
+		require.True(t, ok)
+		return Revision{Version: version, Hash: m.Hash}
+	}
+	versions := func(plan []*Migration) []string {
+		result := make([]string, 0, len(plan))
+		for _, m := range plan {
+			result = append(result, m.Version)
+		}
+		return result
+	}
+
+	t.Run("pending migrations", func(t *testing.T) {
+		plan, err := dir.pending(nil, "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1", "2", "3"}, versions(plan))
+
+		plan, err = dir.pending([]Revision{revision("1")}, "2")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"2"}, versions(plan))
+
+		_, err = dir.pending(nil, "9")
+		assert.ErrorIs(t, err, ErrUnknownVersion)
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		applied := []Revision{revision("1"), revision("2"), revision("3")}
+
+		plan, err := dir.rollback(applied, "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"3"}, versions(plan))
+
+		plan, err = dir.rollback(applied, "1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"3", "2"}, versions(plan))
+
+		// 1 には down スクリプトがない
+		_, err = dir.rollback(applied, Initial)
+		assert.ErrorIs(t, err, ErrNoDownScript)
+
+		_, err = dir.rollback(applied, "9")
+		assert.ErrorIs(t, err, ErrUnknownVersion)
+	})
+
+	t.Run("applied migrations must match the directory", func(t *testing.T) {
+		assert.NoError(t, dir.verify([]Revision{revision("1"), revision("2")}))
+
+		edited := revision("2")
+		edited.Hash = "edited"
+		assert.ErrorIs(t, dir.verify([]Revision{revision("1"), edited}), ErrDrift)
+		assert.ErrorIs(t, dir.verify([]Revision{{Version: "4"}}), ErrUnknownVersion)
+	})
+}
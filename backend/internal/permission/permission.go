@@ -0,0 +1,66 @@
+package permission
+
+import (
+	"github.com/matsuokashuhei/morrow-backend/ent/participant"
+)
+
+// Permission represents an action a participant can perform on an event
+type Permission string
+
+const (
+	// EditDetails allows changing the event title, description, dates, emoji and visibility
+	EditDetails Permission = "edit_details"
+	// Invite allows adding new participants to the event
+	Invite Permission = "invite"
+	// RemoveParticipants allows removing other participants from the event
+	RemoveParticipants Permission = "remove_participants"
+	// ModerateChat allows editing or deleting other participants' chat messages
+	ModerateChat Permission = "moderate_chat"
+	// ManageRoles allows changing the role of other participants
+	ManageRoles Permission = "manage_roles"
+	// Delete allows deleting the event itself
+	Delete Permission = "delete"
+)
+
+// matrix is the central permission matrix consulted by resolvers and directives.
+// Every role is a superset of the roles ranked below it.
+var matrix = map[participant.Role][]Permission{
+	participant.RoleOwner:     {EditDetails, Invite, RemoveParticipants, ModerateChat, ManageRoles, Delete},
+	participant.RoleEditor:    {EditDetails, Invite, RemoveParticipants, ModerateChat},
+	participant.RoleModerator: {RemoveParticipants, ModerateChat},
+	participant.RoleViewer:    {},
+}
+
+// rank orders roles from the least to the most privileged
+var rank = map[participant.Role]int{
+	participant.RoleViewer:    1,
+	participant.RoleModerator: 2,
+	participant.RoleEditor:    3,
+	participant.RoleOwner:     4,
+}
+
+// Allows reports whether the role grants the permission
+func Allows(role participant.Role, perm Permission) bool {
+	for _, p := range matrix[role] {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
+
+// Permissions returns the permissions granted to the role
+func Permissions(role participant.Role) []Permission {
+	perms := make([]Permission, len(matrix[role]))
+	copy(perms, matrix[role])
+	return perms
+}
+
+// AtLeast reports whether role is at least as privileged as required
+func AtLeast(role, required participant.Role) bool {
+	r, ok := rank[role]
+	if !ok {
+		return false
+	}
+	return r >= rank[required]
+}
@@ -0,0 +1,55 @@
+package permission
+
+import (
+	"testing"
+
+	"github.com/matsuokashuhei/morrow-backend/ent/participant"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllows(t *testing.T) {
+	tests := []struct {
+		role     participant.Role
+		perm     Permission
+		expected bool
+	}{
+		{participant.RoleOwner, Delete, true},
+		{participant.RoleOwner, ManageRoles, true},
+		{participant.RoleEditor, EditDetails, true},
+		{participant.RoleEditor, Invite, true},
+		{participant.RoleEditor, Delete, false},
+		{participant.RoleModerator, ModerateChat, true},
+		{participant.RoleModerator, EditDetails, false},
+		{participant.RoleViewer, ModerateChat, false},
+		{participant.Role("unknown"), EditDetails, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role)+"/"+string(tt.perm), func(t *testing.T) {
+			assert.Equal(t, tt.expected, Allows(tt.role, tt.perm))
+		})
+	}
+}
+
+func TestRolesAreCumulative(t *testing.T) {
+	// 上位のロールは下位のロールの権限をすべて持つ
+	ordered := []participant.Role{
+		participant.RoleViewer,
+		participant.RoleModerator,
+		participant.RoleEditor,
+		participant.RoleOwner,
+	}
+	for i := 1; i < len(ordered); i++ {
+		for _, perm := range Permissions(ordered[i-1]) {
+			assert.True(t, Allows(ordered[i], perm), "%s should include %s", ordered[i], perm)
+		}
+	}
+}
+
+func TestAtLeast(t *testing.T) {
+	assert.True(t, AtLeast(participant.RoleOwner, participant.RoleEditor))
+	assert.True(t, AtLeast(participant.RoleEditor, participant.RoleEditor))
+	assert.False(t, AtLeast(participant.RoleModerator, participant.RoleEditor))
+	assert.False(t, AtLeast(participant.RoleViewer, participant.RoleModerator))
+	assert.False(t, AtLeast(participant.Role("unknown"), participant.RoleViewer))
+}
@@ -0,0 +1,81 @@
+package pubsub
+
+import (
+	"sync"
+)
+
+// subscriberBuffer is the number of undelivered messages kept per subscriber
+// before new messages are dropped for that subscriber
+const subscriberBuffer = 16
+
+// Broker is an in-memory publish/subscribe hub used to fan out GraphQL subscription events.
+// A nil *Broker is valid and silently drops everything published to it.
+type Broker struct {
+	mu     sync.RWMutex
+	topics map[string]map[chan any]struct{}
+}
+
+// NewBroker creates an empty broker
+func NewBroker() *Broker {
+	return &Broker{
+		topics: make(map[string]map[chan any]struct{}),
+	}
+}
+
+// Subscribe registers a subscriber on topic. The returned function must be
+// called to unsubscribe; it closes the channel.
+func (b *Broker) Subscribe(topic string) (<-chan any, func()) {
+	ch := make(chan any, subscriberBuffer)
+	if b == nil {
+		close(ch)
+		return ch, func() {}
+	}
+
+	b.mu.Lock()
+	if b.topics[topic] == nil {
+		b.topics[topic] = make(map[chan any]struct{})
+	}
+	b.topics[topic][ch] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.topics[topic], ch)
+			if len(b.topics[topic]) == 0 {
+				delete(b.topics, topic)
+			}
+			b.mu.Unlock()
+			close(ch)
+		})
+	}
+}
+
+// Publish delivers msg to every subscriber of topic without blocking.
+// Slow subscribers whose buffer is full miss the message.
+func (b *Broker) Publish(topic string, msg any) {
+	if b == nil {
+		return
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for ch := range b.topics[topic] {
+		select {
+		case ch <- msg:
+		default:
+		}
+	}
+}
+
+// Subscribers returns the number of subscribers on topic
+func (b *Broker) Subscribers(topic string) int {
+	if b == nil {
+		return 0
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.topics[topic])
+}
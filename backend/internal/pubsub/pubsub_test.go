@@ -0,0 +1,61 @@
+package pubsub
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBroker_PublishSubscribe(t *testing.T) {
+	b := NewBroker()
+
+	ch, unsubscribe := b.Subscribe("event:1")
+	defer unsubscribe()
+	other, unsubscribeOther := b.Subscribe("event:2")
+	defer unsubscribeOther()
+
+	b.Publish("event:1", "hello")
+
+	assert.Equal(t, "hello", <-ch)
+	assert.Len(t, other, 0)
+}
+
+func TestBroker_Unsubscribe(t *testing.T) {
+	b := NewBroker()
+
+	ch, unsubscribe := b.Subscribe("event:1")
+	assert.Equal(t, 1, b.Subscribers("event:1"))
+
+	unsubscribe()
+	unsubscribe() // 二重呼び出しでもパニックしない
+
+	_, open := <-ch
+	assert.False(t, open)
+	assert.Equal(t, 0, b.Subscribers("event:1"))
+
+	// 購読者がいなくても配信できる
+	b.Publish("event:1", "ignored")
+}
+
+func TestBroker_SlowSubscriberDoesNotBlock(t *testing.T) {
+	b := NewBroker()
+
+	_, unsubscribe := b.Subscribe("event:1")
+	defer unsubscribe()
+
+	for i := 0; i < subscriberBuffer*2; i++ {
+		b.Publish("event:1", i)
+	}
+}
+
+func TestBroker_Nil(t *testing.T) {
+	var b *Broker
+
+	ch, unsubscribe := b.Subscribe("event:1")
+	defer unsubscribe()
+	b.Publish("event:1", "ignored")
+
+	_, open := <-ch
+	assert.False(t, open)
+	assert.Equal(t, 0, b.Subscribers("event:1"))
+}
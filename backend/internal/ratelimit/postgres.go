@@ -0,0 +1,97 @@
+package ratelimit
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// PostgresStore keeps the buckets in the rate_limit_buckets table, so that all
+// servers share them. The clock of the database is used, which servers agree on.
+type PostgresStore struct {
+	db *sql.DB
+
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+func NewPostgresStore(db *sql.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// Take implements Store
+func (s *PostgresStore) Take(ctx context.Context, key string, limit Limit) (Result, error) {
+	s.sweep(ctx)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to begin rate limit transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// 新しいバケットは満タンで作り、既存のバケットは他のサーバーと同時に更新しないよう行をロックする
+	var tokens, elapsed float64
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO rate_limit_buckets (key, tokens, updated_at, full_at)
+		VALUES ($1, $2, now(), now())
+		ON CONFLICT (key) DO UPDATE SET key = EXCLUDED.key
+		RETURNING tokens, GREATEST(EXTRACT(EPOCH FROM now() - updated_at), 0)`,
+		key, float64(limit.Burst),
+	).Scan(&tokens, &elapsed)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to load rate limit bucket: %w", err)
+	}
+
+	tokens, res := limit.take(tokens, time.Duration(elapsed*float64(time.Second)))
+	_, err = tx.ExecContext(ctx, `
+		UPDATE rate_limit_buckets
+		SET tokens = $2, updated_at = now(), full_at = now() + make_interval(secs => $3)
+		WHERE key = $1`,
+		key, tokens, res.Reset.Seconds(),
+	)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to update rate limit bucket: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return Result{}, fmt.Errorf("failed to commit rate limit bucket: %w", err)
+	}
+	return res, nil
+}
+
+// Peek implements Store
+func (s *PostgresStore) Peek(ctx context.Context, key string, limit Limit) (Result, error) {
+	var tokens, elapsed float64
+	err := s.db.QueryRowContext(ctx, `
+		SELECT tokens, GREATEST(EXTRACT(EPOCH FROM now() - updated_at), 0)
+		FROM rate_limit_buckets
+		WHERE key = $1`,
+		key,
+	).Scan(&tokens, &elapsed)
+	// バケットがなければ満タン
+	if errors.Is(err, sql.ErrNoRows) {
+		return limit.peek(float64(limit.Burst), 0), nil
+	}
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to load rate limit bucket: %w", err)
+	}
+	return limit.peek(tokens, time.Duration(elapsed*float64(time.Second))), nil
+}
+
+// sweep removes the buckets that are full again in the background, at most once per sweepInterval
+func (s *PostgresStore) sweep(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if time.Since(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = time.Now()
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		_, _ = s.db.ExecContext(ctx, "DELETE FROM rate_limit_buckets WHERE full_at < now()")
+	}()
+}
@@ -0,0 +1,240 @@
+// Package ratelimit throttles clients with token buckets. Each budget, such as
+// GraphQL mutations or public routes, has its own bucket per client, kept in
+// memory on a single server or in PostgreSQL when several servers share the load.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/internal/logging"
+	"github.com/matsuokashuhei/morrow-backend/internal/requestmeta"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
+)
+
+// Budgets limited separately
+const (
+	// BudgetGraphQL counts every GraphQL query and mutation
+	BudgetGraphQL = "graphql"
+	// BudgetMutation counts GraphQL mutations on top of BudgetGraphQL
+	BudgetMutation = "mutation"
+	// BudgetAuth counts requests authenticated by a secret in the request, such as calendar feed tokens
+	BudgetAuth = "auth"
+	// BudgetPublic counts the routes that need no authentication, including embedded pages
+	BudgetPublic = "public"
+)
+
+// Limit allows Burst requests at once and refills Burst tokens every Period.
+// The zero Limit disables the budget.
+type Limit struct {
+	Burst  int
+	Period time.Duration
+}
+
+// ParseLimit parses limits written as "60/1m" (60 requests per minute).
+// An empty string disables the budget.
+func ParseLimit(s string) (Limit, error) {
+	if s == "" {
+		return Limit{}, nil
+	}
+	count, period, ok := strings.Cut(s, "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("%q is not a limit such as 60/1m", s)
+	}
+	burst, err := strconv.Atoi(count)
+	if err != nil || burst <= 0 {
+		return Limit{}, fmt.Errorf("%q does not start with a positive number of requests", s)
+	}
+	d, err := time.ParseDuration(period)
+	if err != nil || d <= 0 {
+		return Limit{}, fmt.Errorf("%q does not end with a positive duration", s)
+	}
+	return Limit{Burst: burst, Period: d}, nil
+}
+
+// Enabled reports whether the budget is limited
+func (l Limit) Enabled() bool {
+	return l.Burst > 0 && l.Period > 0
+}
+
+// String formats the limit as accepted by ParseLimit
+func (l Limit) String() string {
+	if !l.Enabled() {
+		return ""
+	}
+	return fmt.Sprintf("%d/%s", l.Burst, l.Period)
+}
+
+// refill returns the tokens of a bucket after elapsed, and how long until it is full
+func (l Limit) refill(tokens float64, elapsed time.Duration) (float64, time.Duration) {
+	rate := float64(l.Burst) / l.Period.Seconds()
+	tokens = math.Min(float64(l.Burst), tokens+elapsed.Seconds()*rate)
+	return tokens, time.Duration((float64(l.Burst) - tokens) / rate * float64(time.Second))
+}
+
+// take removes a token from a bucket holding tokens elapsed ago and returns
+// the new number of tokens with the result
+func (l Limit) take(tokens float64, elapsed time.Duration) (float64, Result) {
+	tokens, _ = l.refill(tokens, elapsed)
+	res := Result{Allowed: tokens >= 1, Limit: l.Burst}
+	if res.Allowed {
+		tokens--
+	} else {
+		res.RetryAfter = time.Duration((1 - tokens) / float64(l.Burst) * float64(l.Period))
+	}
+	res.Remaining = int(tokens)
+	_, res.Reset = l.refill(tokens, 0)
+	return tokens, res
+}
+
+// peek returns the state of a bucket holding tokens elapsed ago, without taking a token
+func (l Limit) peek(tokens float64, elapsed time.Duration) Result {
+	tokens, reset := l.refill(tokens, elapsed)
+	res := Result{Allowed: tokens >= 1, Limit: l.Burst, Remaining: int(tokens), Reset: reset}
+	if !res.Allowed {
+		res.RetryAfter = time.Duration((1 - tokens) / float64(l.Burst) * float64(l.Period))
+	}
+	return res
+}
+
+// Result describes the bucket of a client after a request
+type Result struct {
+	Allowed bool
+	// Limit is the size of the bucket. It is zero when the budget is disabled.
+	Limit     int
+	Remaining int
+	// Reset is how long until the bucket is full again
+	Reset time.Duration
+	// RetryAfter is how long until the next request is allowed, when it was not
+	RetryAfter time.Duration
+}
+
+// Store keeps the buckets
+type Store interface {
+	// Take removes a token from the bucket of key, which is created full
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+	// Peek returns the state of the bucket of key without taking a token
+	Peek(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// Limiter applies the limits of the budgets
+type Limiter struct {
+	store  Store
+	limits map[string]Limit
+}
+
+// New creates a limiter. Budgets missing from limits are not limited.
+func New(store Store, limits map[string]Limit) *Limiter {
+	return &Limiter{store: store, limits: limits}
+}
+
+// Allow takes a token of budget for the client key. Requests are allowed when
+// the limiter is nil or the budget is disabled. A failing store is logged and
+// lets requests through rather than taking the API down.
+func (l *Limiter) Allow(ctx context.Context, budget, key string) Result {
+	if l == nil {
+		return Result{Allowed: true}
+	}
+	limit := l.limits[budget]
+	if !limit.Enabled() {
+		return Result{Allowed: true}
+	}
+	res, err := l.store.Take(ctx, budget+":"+key, limit)
+	if err != nil {
+		logging.FromContext(ctx).WithError(err).WithField("budget", budget).Warn("Rate limit store failed")
+		return Result{Allowed: true}
+	}
+	return res
+}
+
+// Check reports whether the client key has a token of budget left, without
+// taking it. It fails open like Allow.
+func (l *Limiter) Check(ctx context.Context, budget, key string) Result {
+	if l == nil {
+		return Result{Allowed: true}
+	}
+	limit := l.limits[budget]
+	if !limit.Enabled() {
+		return Result{Allowed: true}
+	}
+	res, err := l.store.Peek(ctx, budget+":"+key, limit)
+	if err != nil {
+		logging.FromContext(ctx).WithError(err).WithField("budget", budget).Warn("Rate limit store failed")
+		return Result{Allowed: true}
+	}
+	return res
+}
+
+// Key identifies the client of a request: the authenticated user, or else its IP address
+func Key(ctx context.Context) string {
+	if v, ok := viewer.FromContext(ctx); ok && v.Subject != "" {
+		return "user:" + v.Subject
+	}
+	if meta, ok := requestmeta.FromContext(ctx); ok && meta.IP != "" {
+		return "ip:" + meta.IP
+	}
+	return "ip:unknown"
+}
+
+// Response headers, following the IETF RateLimit header fields draft
+const (
+	HeaderLimit     = "RateLimit-Limit"
+	HeaderRemaining = "RateLimit-Remaining"
+	HeaderReset     = "RateLimit-Reset"
+)
+
+// SetHeaders describes res in the RateLimit-* headers, and in Retry-After when
+// the request was rejected. It does nothing for disabled budgets.
+func SetHeaders(h http.Header, res Result) {
+	if res.Limit == 0 {
+		return
+	}
+	h.Set(HeaderLimit, strconv.Itoa(res.Limit))
+	h.Set(HeaderRemaining, strconv.Itoa(res.Remaining))
+	h.Set(HeaderReset, strconv.Itoa(seconds(res.Reset)))
+	if !res.Allowed {
+		h.Set("Retry-After", strconv.Itoa(seconds(res.RetryAfter)))
+	}
+}
+
+// Tighter returns the result that leaves the client fewer requests, to report
+// the budget that will reject it first when a request counts against several
+func Tighter(a, b Result) Result {
+	switch {
+	case a.Limit == 0:
+		return b
+	case b.Limit == 0:
+		return a
+	case !a.Allowed:
+		return a
+	case !b.Allowed:
+		return b
+	case b.Remaining < a.Remaining:
+		return b
+	}
+	return a
+}
+
+// seconds rounds d up, so that clients do not retry too early
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+type headerKey struct{}
+
+// WithResponseHeader lets code that only receives a context, such as GraphQL
+// extensions, set the rate limit headers of the response
+func WithResponseHeader(ctx context.Context, h http.Header) context.Context {
+	return context.WithValue(ctx, headerKey{}, h)
+}
+
+// ResponseHeader returns the headers stored by WithResponseHeader
+func ResponseHeader(ctx context.Context) (http.Header, bool) {
+	h, ok := ctx.Value(headerKey{}).(http.Header)
+	return h, ok
+}
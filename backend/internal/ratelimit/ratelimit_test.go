@@ -0,0 +1,139 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/internal/requestmeta"
+	"github.com/matsuokashuhei/morrow-backend/internal/viewer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLimit(t *testing.T) {
+	limit, err := ParseLimit("60/1m")
+	require.NoError(t, err)
+	assert.Equal(t, Limit{Burst: 60, Period: time.Minute}, limit)
+	assert.True(t, limit.Enabled())
+	assert.Equal(t, "60/1m0s", limit.String())
+
+	limit, err = ParseLimit("")
+	require.NoError(t, err)
+	assert.False(t, limit.Enabled())
+
+	for _, s := range []string{"60", "0/1m", "-1/1m", "a/1m", "60/0s", "60/minute"} {
+		_, err := ParseLimit(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	now := time.Date(2025, 10, 19, 9, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+	limit := Limit{Burst: 2, Period: time.Minute}
+	ctx := context.Background()
+
+	res, err := store.Take(ctx, "ip:192.0.2.1", limit)
+	require.NoError(t, err)
+	assert.Equal(t, Result{Allowed: true, Limit: 2, Remaining: 1, Reset: 30 * time.Second}, res)
+
+	res, _ = store.Take(ctx, "ip:192.0.2.1", limit)
+	assert.True(t, res.Allowed)
+	assert.Equal(t, 0, res.Remaining)
+	assert.Equal(t, time.Minute, res.Reset)
+
+	res, _ = store.Take(ctx, "ip:192.0.2.1", limit)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 30*time.Second, res.RetryAfter)
+
+	// 他のクライアントのバケットは別
+	res, _ = store.Take(ctx, "ip:192.0.2.2", limit)
+	assert.True(t, res.Allowed)
+
+	// 30 秒で 1 トークン補充される
+	now = now.Add(30 * time.Second)
+	res, _ = store.Take(ctx, "ip:192.0.2.1", limit)
+	assert.True(t, res.Allowed)
+	assert.Equal(t, 0, res.Remaining)
+
+	// 確認するだけではトークンを使わない
+	res, _ = store.Peek(ctx, "ip:192.0.2.1", limit)
+	assert.False(t, res.Allowed)
+	res, _ = store.Peek(ctx, "ip:192.0.2.4", limit)
+	assert.Equal(t, Result{Allowed: true, Limit: 2, Remaining: 2}, res)
+
+	// 満タンに戻ったバケットは掃除される
+	now = now.Add(2 * time.Minute)
+	_, _ = store.Take(ctx, "ip:192.0.2.3", limit)
+	assert.Len(t, store.buckets, 1)
+}
+
+type failingStore struct{}
+
+func (failingStore) Take(context.Context, string, Limit) (Result, error) {
+	return Result{}, errors.New("connection refused")
+}
+
+func (failingStore) Peek(context.Context, string, Limit) (Result, error) {
+	return Result{}, errors.New("connection refused")
+}
+
+func TestLimiter(t *testing.T) {
+	ctx := context.Background()
+	limiter := New(NewMemoryStore(), map[string]Limit{BudgetMutation: {Burst: 1, Period: time.Minute}})
+
+	assert.True(t, limiter.Allow(ctx, BudgetMutation, "user:a").Allowed)
+	assert.False(t, limiter.Allow(ctx, BudgetMutation, "user:a").Allowed)
+	assert.True(t, limiter.Allow(ctx, BudgetMutation, "user:b").Allowed)
+	assert.False(t, limiter.Check(ctx, BudgetMutation, "user:a").Allowed)
+	assert.True(t, limiter.Check(ctx, BudgetMutation, "user:c").Allowed)
+
+	t.Run("disabled budgets", func(t *testing.T) {
+		res := limiter.Allow(ctx, BudgetPublic, "user:a")
+		assert.Equal(t, Result{Allowed: true}, res)
+		assert.True(t, (*Limiter)(nil).Allow(ctx, BudgetPublic, "user:a").Allowed)
+	})
+
+	t.Run("failing store", func(t *testing.T) {
+		limiter := New(failingStore{}, map[string]Limit{BudgetPublic: {Burst: 1, Period: time.Minute}})
+		assert.True(t, limiter.Allow(ctx, BudgetPublic, "ip:192.0.2.1").Allowed)
+		assert.True(t, limiter.Check(ctx, BudgetPublic, "ip:192.0.2.1").Allowed)
+	})
+}
+
+func TestKey(t *testing.T) {
+	ctx := requestmeta.NewContext(context.Background(), &requestmeta.Meta{IP: "192.0.2.1"})
+	assert.Equal(t, "ip:192.0.2.1", Key(ctx))
+
+	ctx = viewer.NewContext(ctx, &viewer.Viewer{Subject: "sub-1"})
+	assert.Equal(t, "user:sub-1", Key(ctx))
+
+	assert.Equal(t, "ip:unknown", Key(context.Background()))
+}
+
+func TestSetHeaders(t *testing.T) {
+	h := http.Header{}
+	SetHeaders(h, Result{Allowed: false, Limit: 60, Remaining: 0, Reset: 59500 * time.Millisecond, RetryAfter: 500 * time.Millisecond})
+	assert.Equal(t, "60", h.Get(HeaderLimit))
+	assert.Equal(t, "0", h.Get(HeaderRemaining))
+	assert.Equal(t, "60", h.Get(HeaderReset))
+	assert.Equal(t, "1", h.Get("Retry-After"))
+
+	h = http.Header{}
+	SetHeaders(h, Result{Allowed: true})
+	assert.Empty(t, h)
+}
+
+func TestTighter(t *testing.T) {
+	a := Result{Allowed: true, Limit: 600, Remaining: 500}
+	b := Result{Allowed: true, Limit: 60, Remaining: 10}
+	assert.Equal(t, b, Tighter(a, b))
+	assert.Equal(t, a, Tighter(a, Result{Allowed: true}))
+
+	denied := Result{Allowed: false, Limit: 600}
+	assert.Equal(t, denied, Tighter(b, denied))
+}
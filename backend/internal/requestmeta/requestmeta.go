@@ -0,0 +1,26 @@
+// Package requestmeta carries metadata of the HTTP request, such as its ID and
+// client IP, to code that only receives a context.
+package requestmeta
+
+import "context"
+
+// Meta describes the HTTP request being served
+type Meta struct {
+	// RequestID correlates logs and audit entries of one request
+	RequestID string
+	// IP is the client IP address
+	IP string
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying m
+func NewContext(ctx context.Context, m *Meta) context.Context {
+	return context.WithValue(ctx, contextKey{}, m)
+}
+
+// FromContext returns the metadata stored in ctx, if any
+func FromContext(ctx context.Context) (*Meta, bool) {
+	m, ok := ctx.Value(contextKey{}).(*Meta)
+	return m, ok && m != nil
+}
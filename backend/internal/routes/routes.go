@@ -1,46 +1,102 @@
 package routes
 
 import (
+	"net/http"
+	"strings"
+
 	"github.com/gin-gonic/gin"
 	"github.com/matsuokashuhei/morrow-backend/graph"
+	"github.com/matsuokashuhei/morrow-backend/internal/billing"
 	"github.com/matsuokashuhei/morrow-backend/internal/config"
 	"github.com/matsuokashuhei/morrow-backend/internal/database"
+	"github.com/matsuokashuhei/morrow-backend/internal/feedtoken"
 	"github.com/matsuokashuhei/morrow-backend/internal/handler"
+	"github.com/matsuokashuhei/morrow-backend/internal/health"
+	"github.com/matsuokashuhei/morrow-backend/internal/invitation"
+	"github.com/matsuokashuhei/morrow-backend/internal/metrics"
 	"github.com/matsuokashuhei/morrow-backend/internal/middleware"
+	"github.com/matsuokashuhei/morrow-backend/internal/ratelimit"
+	"github.com/matsuokashuhei/morrow-backend/internal/storage"
 	"github.com/sirupsen/logrus"
 )
 
 // SetupRoutes configures all application routes
-func SetupRoutes(cfg *config.Config, logger *logrus.Logger, dbClient *database.Client) *gin.Engine {
+func SetupRoutes(cfg *config.Config, logger *logrus.Logger, dbClient *database.Client, store storage.Storage, invitations *invitation.Sender, billingService *billing.Service, probes *health.Probes) *gin.Engine {
 	// Create router
 	router := gin.New()
 
 	// Add global middleware
+	origins := middleware.NewOrigins(cfg.CORSAllowedOrigins)
+	router.Use(middleware.Tracing(cfg.TracingServiceName))
+	router.Use(middleware.RequestMeta())
+	router.Use(middleware.Metrics())
 	router.Use(middleware.LoggerMiddleware(logger))
 	router.Use(gin.Recovery())
-	router.Use(middleware.CORS())
+	router.Use(middleware.SecurityHeaders(cfg.HSTSMaxAge))
+	router.Use(middleware.CORS(origins))
+	router.Use(middleware.CSRF(cfg.SessionCookie, origins))
 	router.Use(middleware.DatabaseMiddleware(dbClient)) // データベースクライアント注入
-	router.Use(middleware.Auth())
+	router.Use(middleware.Auth(cfg.DevelopmentAuth))
 	router.Use(middleware.ErrorHandler())
 
 	// Initialize handlers with dependencies
 	healthHandler := handler.NewHealthHandler(dbClient, logger)
+	probeHandler := handler.NewProbeHandler(probes)
 
 	// Public routes (no authentication required)
-	setupPublicRoutes(router, healthHandler, logger)
+	setupPublicRoutes(router, healthHandler, probeHandler, logger)
+
+	// Prometheus metrics, unless they are served on their own port
+	if cfg.MetricsEnabled && cfg.MetricsPort == "" {
+		router.GET("/metrics", gin.WrapH(metrics.Handler(cfg.MetricsToken)))
+	}
+
+	limiter := newRateLimiter(cfg, dbClient, logger)
 
 	// API v1 routes
-	setupAPIV1Routes(router, healthHandler, dbClient, logger)
+	setupAPIV1Routes(router, cfg, healthHandler, dbClient, store, invitations, billingService, limiter, logger)
+
+	// Pages that other sites may embed in an iframe
+	widgetHandler := handler.NewWidgetHandler(dbClient, logger)
+	embed := router.Group("/embed")
+	embed.Use(middleware.Embeddable(cfg.FrameAncestors))
+	embed.Use(middleware.RateLimit(limiter, ratelimit.BudgetPublic))
+	{
+		embed.GET("/events/:id", widgetHandler.Event)
+	}
 
 	return router
 }
 
+// newRateLimiter creates the rate limiter of the configured store, or returns nil
+// when rate limiting is disabled
+func newRateLimiter(cfg *config.Config, dbClient *database.Client, logger *logrus.Logger) *ratelimit.Limiter {
+	limits, err := cfg.RateLimits()
+	if err != nil {
+		// Validate で確認済みなので起動時には発生しない
+		logger.WithError(err).Error("Rate limiting disabled")
+		return nil
+	}
+	switch cfg.RateLimitStore {
+	case "memory":
+		return ratelimit.New(ratelimit.NewMemoryStore(), limits)
+	case "postgres":
+		return ratelimit.New(ratelimit.NewPostgresStore(dbClient.DB()), limits)
+	}
+	return nil
+}
+
 // setupPublicRoutes configures public routes that don't require authentication
-func setupPublicRoutes(router *gin.Engine, healthHandler *handler.HealthHandler, logger *logrus.Logger) {
+func setupPublicRoutes(router *gin.Engine, healthHandler *handler.HealthHandler, probeHandler *handler.ProbeHandler, logger *logrus.Logger) {
 	// Health check endpoints
 	router.GET("/health", healthHandler.Health)
 	router.GET("/ping", healthHandler.Ping)
 
+	// Probes for orchestrators and load balancers
+	router.GET("/livez", probeHandler.Livez)
+	router.GET("/readyz", probeHandler.Readyz)
+	router.GET("/startupz", probeHandler.Startupz)
+
 	logger.Info("Public routes configured")
 
 	// Add other public endpoints here
@@ -48,11 +104,12 @@ func setupPublicRoutes(router *gin.Engine, healthHandler *handler.HealthHandler,
 }
 
 // setupAPIV1Routes configures API v1 routes
-func setupAPIV1Routes(router *gin.Engine, healthHandler *handler.HealthHandler, dbClient *database.Client, logger *logrus.Logger) {
+func setupAPIV1Routes(router *gin.Engine, cfg *config.Config, healthHandler *handler.HealthHandler, dbClient *database.Client, store storage.Storage, invitations *invitation.Sender, billingService *billing.Service, limiter *ratelimit.Limiter, logger *logrus.Logger) {
 	v1 := router.Group("/api/v1")
+	publicLimit := middleware.RateLimit(limiter, ratelimit.BudgetPublic)
 
 	// Public API endpoints
-	v1.GET("/status", healthHandler.Health)
+	v1.GET("/status", publicLimit, healthHandler.Health)
 
 	// Authentication required endpoints
 	authRequired := v1.Group("/")
@@ -64,11 +121,73 @@ func setupAPIV1Routes(router *gin.Engine, healthHandler *handler.HealthHandler,
 		// authRequired.GET("/events", eventHandler.ListEvents)
 	}
 
+	// iCalendar export (calendar apps fetch feeds without an Authorization header)
+	calendarHandler := handler.NewCalendarHandler(dbClient, logger)
+	v1.GET("/events/:id", publicLimit, calendarHandler.Event)
+	// フィードは認証用の予算でトークンごとに制限し、存在しないトークンはIPごとにも数えて総当たりを防ぐ
+	guessLimit := middleware.RateLimitFailures(limiter, ratelimit.BudgetAuth, func(c *gin.Context) bool {
+		return c.Writer.Status() == http.StatusNotFound
+	})
+	feedLimit := middleware.RateLimitBy(limiter, ratelimit.BudgetAuth, func(c *gin.Context) string {
+		token, _ := strings.CutSuffix(c.Param("token"), ".ics")
+		return feedtoken.RateLimitKey(token)
+	})
+	router.GET(feedtoken.FeedPath+":token", guessLimit, feedLimit, calendarHandler.Feed)
+
+	// iTIP replies forwarded by the inbound mail gateway (authenticated by a shared secret)
+	if cfg.IMIPInboundSecret != "" {
+		imipHandler := handler.NewIMIPHandler(dbClient, cfg.IMIPInboundSecret, logger)
+		v1.POST("/imip/reply", imipHandler.Reply)
+	}
+
+	// Payment provider webhooks (authenticated by the provider's signature)
+	if billingService != nil {
+		billingHandler := handler.NewBillingHandler(billingService, logger)
+		v1.POST("/billing/webhook", billingHandler.Webhook)
+	}
+
+	// Signed downloads of the local storage (S3 serves signed URLs itself)
+	if local, ok := store.(*storage.Local); ok {
+		filesHandler := handler.NewFilesHandler(local, logger)
+		router.GET(storage.LocalFilesPath+"*key", publicLimit, filesHandler.Serve)
+	}
+
 	logger.Info("API v1 routes configured")
 
 	// GraphQL endpoints
-	v1.POST("/graphql", graph.GraphQLHandler(dbClient.Client))
-	v1.GET("/graphql", graph.PlaygroundHandler())
+	graphqlHandler := graph.GraphQLHandler(dbClient.Client, graph.HandlerOptions{
+		Storage: store,
+		Limits: graph.UploadLimits{
+			MaxAvatarSize:     cfg.MaxAvatarSize,
+			MaxAttachmentSize: cfg.MaxAttachmentSize,
+		},
+		PublicURL:      cfg.PublicURL,
+		Invitations:    invitations,
+		Billing:        billingService,
+		TrashRetention: cfg.TrashRetention,
+
+		AccountDeletionGracePeriod: cfg.AccountDeletionGracePeriod,
+		AnonymousAccess:            cfg.AnonymousAccess,
+		Introspection:              cfg.GraphQLIntrospection,
+		QueryLimits: graph.QueryLimits{
+			MaxComplexity:          cfg.GraphQLMaxComplexity,
+			MaxDepth:               cfg.GraphQLMaxDepth,
+			AnonymousMaxComplexity: cfg.GraphQLAnonymousMaxComplexity,
+			AnonymousMaxDepth:      cfg.GraphQLAnonymousMaxDepth,
+		},
+		RateLimiter: limiter,
+	})
+	playgroundHandler := graph.PlaygroundHandler()
+	v1.POST("/graphql", graphqlHandler)
+	v1.GET("/graphql", func(c *gin.Context) {
+		// Subscriptions arrive as WebSocket upgrade requests on the same path
+		if c.IsWebsocket() || !cfg.GraphQLPlayground {
+			graphqlHandler(c)
+			return
+		}
+		c.Header("Content-Security-Policy", middleware.PlaygroundContentSecurityPolicy)
+		playgroundHandler(c)
+	})
 
 	logger.Info("GraphQL endpoints configured")
 }
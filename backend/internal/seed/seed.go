@@ -0,0 +1,304 @@
+// Package seed loads fixture sets of users, events, participants and chat
+// messages into the database. Fixtures are written in YAML or JSON and
+// loading the same file again leaves the database unchanged: users are
+// matched by email and events by creator, title and start time.
+package seed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/participant"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+	"github.com/matsuokashuhei/morrow-backend/internal/emoji"
+	"github.com/matsuokashuhei/morrow-backend/internal/ical"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrInvalidFixtures is returned when a fixture set cannot be loaded as written
+var ErrInvalidFixtures = errors.New("invalid fixtures")
+
+// Fixtures is a set of records to load
+type Fixtures struct {
+	Users  []User  `yaml:"users" json:"users"`
+	Events []Event `yaml:"events" json:"events"`
+}
+
+// User is a user fixture
+type User struct {
+	Email string `yaml:"email" json:"email"`
+	Name  string `yaml:"name" json:"name"`
+	Admin bool   `yaml:"admin" json:"admin"`
+}
+
+// Event is an event fixture. Users are referred to by email.
+type Event struct {
+	Title          string        `yaml:"title" json:"title"`
+	Description    string        `yaml:"description" json:"description"`
+	Creator        string        `yaml:"creator" json:"creator"`
+	StartTime      string        `yaml:"start_time" json:"start_time"`
+	EndTime        string        `yaml:"end_time" json:"end_time"`
+	TimeZone       string        `yaml:"time_zone" json:"time_zone"`
+	RecurrenceRule string        `yaml:"recurrence_rule" json:"recurrence_rule"`
+	Emoji          string        `yaml:"emoji" json:"emoji"`
+	Visibility     string        `yaml:"visibility" json:"visibility"`
+	Participants   []Participant `yaml:"participants" json:"participants"`
+	Messages       []Message     `yaml:"messages" json:"messages"`
+}
+
+// Participant is a participation fixture. The creator of an event is its owner and is not listed.
+type Participant struct {
+	User   string `yaml:"user" json:"user"`
+	Role   string `yaml:"role" json:"role"`
+	Status string `yaml:"status" json:"status"`
+}
+
+// Message is a chat message fixture
+type Message struct {
+	Author string `yaml:"author" json:"author"`
+	Body   string `yaml:"body" json:"body"`
+}
+
+// Result counts the records created and the ones that already existed
+type Result struct {
+	UsersCreated   int
+	UsersExisting  int
+	EventsCreated  int
+	EventsExisting int
+	Participants   int
+	Messages       int
+}
+
+// Parse reads fixtures written in YAML or JSON and validates them.
+// Unknown keys are rejected so that typos do not silently drop data.
+func Parse(r io.Reader) (*Fixtures, error) {
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	var f Fixtures
+	// JSONはYAMLとしても読めるので、どちらの形式も同じデコーダーで扱う
+	if err := dec.Decode(&f); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFixtures, err)
+	}
+	if err := f.validate(); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// validate checks references and values before anything is written
+func (f *Fixtures) validate() error {
+	invalid := func(format string, args ...any) error {
+		return fmt.Errorf("%w: %s", ErrInvalidFixtures, fmt.Sprintf(format, args...))
+	}
+	emails := make(map[string]bool, len(f.Users))
+	for i, u := range f.Users {
+		if u.Email == "" || u.Name == "" {
+			return invalid("users[%d] needs an email and a name", i)
+		}
+		if emails[u.Email] {
+			return invalid("user %s is listed twice", u.Email)
+		}
+		emails[u.Email] = true
+	}
+
+	for i, e := range f.Events {
+		if e.Title == "" {
+			return invalid("events[%d] needs a title", i)
+		}
+		if !emails[e.Creator] {
+			return invalid("event %q: creator %q is not a listed user", e.Title, e.Creator)
+		}
+		start, end, err := e.times()
+		if err != nil {
+			return invalid("event %q: %v", e.Title, err)
+		}
+		if !end.After(start) {
+			return invalid("event %q: end_time must be after start_time", e.Title)
+		}
+		if e.TimeZone != "" {
+			if err := ical.ValidateTimeZone(e.TimeZone); err != nil {
+				return invalid("event %q: %v", e.Title, err)
+			}
+		}
+		if e.RecurrenceRule != "" {
+			if err := ical.ValidateRRule(e.RecurrenceRule); err != nil {
+				return invalid("event %q: %v", e.Title, err)
+			}
+		}
+		if err := emoji.ValidateOptional(e.Emoji); err != nil {
+			return invalid("event %q: %v", e.Title, err)
+		}
+		if e.Visibility != "" {
+			if err := event.VisibilityValidator(event.Visibility(e.Visibility)); err != nil {
+				return invalid("event %q: %v", e.Title, err)
+			}
+		}
+		joined := map[string]bool{e.Creator: true}
+		for _, p := range e.Participants {
+			if !emails[p.User] {
+				return invalid("event %q: participant %q is not a listed user", e.Title, p.User)
+			}
+			if joined[p.User] {
+				return invalid("event %q: %s is the creator or listed twice", e.Title, p.User)
+			}
+			joined[p.User] = true
+			// 作成者だけがオーナーになる
+			if p.Role == string(participant.RoleOwner) {
+				return invalid("event %q: only the creator is the owner", e.Title)
+			}
+			if p.Role != "" {
+				if err := participant.RoleValidator(participant.Role(p.Role)); err != nil {
+					return invalid("event %q: %v", e.Title, err)
+				}
+			}
+			if p.Status != "" {
+				if err := participant.StatusValidator(participant.Status(p.Status)); err != nil {
+					return invalid("event %q: %v", e.Title, err)
+				}
+			}
+		}
+		for _, m := range e.Messages {
+			if m.Body == "" {
+				return invalid("event %q: messages need a body", e.Title)
+			}
+			if !joined[m.Author] {
+				return invalid("event %q: message author %q is neither the creator nor a participant", e.Title, m.Author)
+			}
+		}
+	}
+	return nil
+}
+
+// times parses the RFC 3339 start and end times of an event
+func (e Event) times() (time.Time, time.Time, error) {
+	start, err := time.Parse(time.RFC3339, e.StartTime)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid start_time: %w", err)
+	}
+	end, err := time.Parse(time.RFC3339, e.EndTime)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid end_time: %w", err)
+	}
+	return start, end, nil
+}
+
+// optional returns nil for empty fixture values so optional fields stay unset
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// Apply loads the fixtures in one transaction. Existing users and events are
+// kept as they are; participants and messages are only added to the events
+// created by this run.
+func Apply(ctx context.Context, client *ent.Client, f *Fixtures) (*Result, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	result := &Result{}
+	userIDs := make(map[string]int, len(f.Users))
+	for _, u := range f.Users {
+		id, err := tx.User.Query().Where(user.EmailEQ(u.Email)).OnlyID(ctx)
+		switch {
+		case err == nil:
+			result.UsersExisting++
+		case ent.IsNotFound(err):
+			created, err := tx.User.Create().
+				SetEmail(u.Email).
+				SetName(u.Name).
+				SetIsAdmin(u.Admin).
+				Save(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create user %s: %w", u.Email, err)
+			}
+			id = created.ID
+			result.UsersCreated++
+		default:
+			return nil, fmt.Errorf("failed to get user %s: %w", u.Email, err)
+		}
+		userIDs[u.Email] = id
+	}
+
+	for _, e := range f.Events {
+		start, end, err := e.times()
+		if err != nil {
+			return nil, err
+		}
+		creatorID := userIDs[e.Creator]
+		exists, err := tx.Event.Query().
+			Where(
+				event.HasCreatorWith(user.IDEQ(creatorID)),
+				event.TitleEQ(e.Title),
+				event.StartTimeEQ(start),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check event %q: %w", e.Title, err)
+		}
+		if exists {
+			result.EventsExisting++
+			continue
+		}
+
+		visibility := event.VisibilityPrivate
+		if e.Visibility != "" {
+			visibility = event.Visibility(e.Visibility)
+		}
+		created, err := tx.Event.Create().
+			SetTitle(e.Title).
+			SetNillableDescription(optional(e.Description)).
+			SetStartTime(start).
+			SetEndTime(end).
+			SetNillableTimeZone(optional(e.TimeZone)).
+			SetNillableRecurrenceRule(optional(e.RecurrenceRule)).
+			SetNillableEmoji(optional(e.Emoji)).
+			SetVisibility(visibility).
+			SetCreatorID(creatorID).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create event %q: %w", e.Title, err)
+		}
+		result.EventsCreated++
+
+		for _, p := range e.Participants {
+			create := tx.Participant.Create().
+				SetUserID(userIDs[p.User]).
+				SetEventID(created.ID)
+			if p.Role != "" {
+				create.SetRole(participant.Role(p.Role))
+			}
+			if p.Status != "" {
+				create.SetStatus(participant.Status(p.Status))
+			}
+			if err := create.Exec(ctx); err != nil {
+				return nil, fmt.Errorf("failed to add %s to event %q: %w", p.User, e.Title, err)
+			}
+			result.Participants++
+		}
+		for _, m := range e.Messages {
+			if err := tx.Message.Create().
+				SetBody(m.Body).
+				SetEventID(created.ID).
+				SetAuthorID(userIDs[m.Author]).
+				Exec(ctx); err != nil {
+				return nil, fmt.Errorf("failed to post message to event %q: %w", e.Title, err)
+			}
+			result.Messages++
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit fixtures: %w", err)
+	}
+	return result, nil
+}
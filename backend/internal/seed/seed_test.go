@@ -0,0 +1,67 @@
+package seed
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("the development fixtures are valid", func(t *testing.T) {
+		f, err := os.Open("../../fixtures/dev.yaml")
+		require.NoError(t, err)
+		defer f.Close()
+
+		fixtures, err := Parse(f)
+		require.NoError(t, err)
+		assert.Len(t, fixtures.Users, 3)
+		require.NotEmpty(t, fixtures.Events)
+		assert.Equal(t, "alice@example.com", fixtures.Events[0].Creator)
+	})
+
+	t.Run("JSON is accepted", func(t *testing.T) {
+		fixtures, err := Parse(strings.NewReader(`{
+			"users": [{"email": "a@example.com", "name": "A"}],
+			"events": [{"title": "Lunch", "creator": "a@example.com", "start_time": "2030-01-01T12:00:00Z", "end_time": "2030-01-01T13:00:00Z"}]
+		}`))
+		require.NoError(t, err)
+		assert.Equal(t, "Lunch", fixtures.Events[0].Title)
+	})
+
+	t.Run("an empty file has nothing to load", func(t *testing.T) {
+		fixtures, err := Parse(strings.NewReader(""))
+		require.NoError(t, err)
+		assert.Empty(t, fixtures.Users)
+	})
+
+	invalid := map[string]string{
+		"unknown keys":    `users: [{email: a@example.com, name: A, role: admin}]`,
+		"duplicate users": `users: [{email: a@example.com, name: A}, {email: a@example.com, name: B}]`,
+		"unknown creator": `
+users: [{email: a@example.com, name: A}]
+events: [{title: Lunch, creator: b@example.com, start_time: "2030-01-01T12:00:00Z", end_time: "2030-01-01T13:00:00Z"}]`,
+		"end before start": `
+users: [{email: a@example.com, name: A}]
+events: [{title: Lunch, creator: a@example.com, start_time: "2030-01-01T12:00:00Z", end_time: "2030-01-01T11:00:00Z"}]`,
+		"owner participants": `
+users: [{email: a@example.com, name: A}, {email: b@example.com, name: B}]
+events: [{title: Lunch, creator: a@example.com, start_time: "2030-01-01T12:00:00Z", end_time: "2030-01-01T13:00:00Z",
+  participants: [{user: b@example.com, role: owner}]}]`,
+		"authors who did not join": `
+users: [{email: a@example.com, name: A}, {email: b@example.com, name: B}]
+events: [{title: Lunch, creator: a@example.com, start_time: "2030-01-01T12:00:00Z", end_time: "2030-01-01T13:00:00Z",
+  messages: [{author: b@example.com, body: hi}]}]`,
+		"invalid time zones": `
+users: [{email: a@example.com, name: A}]
+events: [{title: Lunch, creator: a@example.com, start_time: "2030-01-01T12:00:00Z", end_time: "2030-01-01T13:00:00Z", time_zone: Mars/Olympus}]`,
+	}
+	for name, body := range invalid {
+		t.Run(name+" are rejected", func(t *testing.T) {
+			_, err := Parse(strings.NewReader(body))
+			assert.ErrorIs(t, err, ErrInvalidFixtures)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package softdelete
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+	"github.com/matsuokashuhei/morrow-backend/internal/metrics"
+	"github.com/sirupsen/logrus"
+)
+
+// Purger permanently deletes trashed rows whose retention window has passed
+type Purger struct {
+	client    *ent.Client
+	retention time.Duration
+	interval  time.Duration
+	logger    *logrus.Logger
+}
+
+// NewPurger returns a purger checking the trash every interval
+func NewPurger(client *ent.Client, retention, interval time.Duration, logger *logrus.Logger) *Purger {
+	return &Purger{
+		client:    client,
+		retention: retention,
+		interval:  interval,
+		logger:    logger,
+	}
+}
+
+// Run purges the trash immediately and then every interval until ctx is canceled
+func (p *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		start := time.Now()
+		n, err := p.Purge(ctx)
+		metrics.ObserveJob("trash_purge", start, n, err)
+		if err != nil {
+			p.logger.WithError(err).Error("Failed to purge trash")
+		} else if n > 0 {
+			p.logger.WithField("count", n).Info("Purged expired trash")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge deletes the events and users trashed before the retention window and returns how many were deleted.
+// The database removes their participants and other dependent rows and detaches their billing events.
+// Rows that fail to delete are logged and retried on the next run.
+func (p *Purger) Purge(ctx context.Context) (int, error) {
+	ctx = Skip(ctx)
+	cutoff := time.Now().Add(-p.retention)
+
+	// ユーザーは作成したイベントより後に削除する
+	eventIDs, err := p.client.Event.Query().
+		Where(event.DeletedAtLT(cutoff)).
+		IDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get expired events: %w", err)
+	}
+	purged := 0
+	for _, id := range eventIDs {
+		if err := p.client.Event.DeleteOneID(id).Exec(ctx); err != nil {
+			p.logger.WithError(err).WithField("event_id", id).Warn("Failed to purge event")
+			continue
+		}
+		purged++
+	}
+
+	// 課金イベントは台帳に残り、データベースが対象ユーザーを空にする
+	userIDs, err := p.client.User.Query().
+		Where(user.DeletedAtLT(cutoff)).
+		IDs(ctx)
+	if err != nil {
+		return purged, fmt.Errorf("failed to get expired users: %w", err)
+	}
+	metrics.SetJobPending("trash_purge", len(eventIDs)+len(userIDs))
+	for _, id := range userIDs {
+		if err := p.client.User.DeleteOneID(id).Exec(ctx); err != nil {
+			p.logger.WithError(err).WithField("user_id", id).Warn("Failed to purge user")
+			continue
+		}
+		purged++
+	}
+	return purged, nil
+}
@@ -0,0 +1,104 @@
+// Package softdelete moves deleted events and users to a trash instead of
+// removing them. Trashed rows are hidden from every query, can be restored
+// within the retention window and are purged permanently afterwards.
+// Participants and messages of a trashed event are hidden with it and are
+// removed by the database when the event is purged.
+package softdelete
+
+import (
+	"context"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/hook"
+	"github.com/matsuokashuhei/morrow-backend/ent/message"
+	"github.com/matsuokashuhei/morrow-backend/ent/participant"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+)
+
+// DefaultRetention is how long trashed rows can be restored when no retention is configured
+const DefaultRetention = 30 * 24 * time.Hour
+
+type skipKey struct{}
+
+// Skip returns a copy of ctx in which queries also return trashed rows
+// and deletes remove rows permanently
+func Skip(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipKey{}, true)
+}
+
+func skipped(ctx context.Context) bool {
+	skip, _ := ctx.Value(skipKey{}).(bool)
+	return skip
+}
+
+// Register installs the soft delete interceptors and hooks on the client.
+// It must be registered before other hooks so that they see deletes as updates of deleted_at.
+func Register(client *ent.Client) {
+	client.Event.Intercept(Interceptor())
+	client.User.Intercept(Interceptor())
+	client.Participant.Intercept(Interceptor())
+	client.Message.Intercept(Interceptor())
+	client.Event.Use(Hook())
+	client.User.Use(Hook())
+}
+
+// Interceptor hides trashed rows from queries, including edge traversals and eager loading
+func Interceptor() ent.Interceptor {
+	return ent.TraverseFunc(func(ctx context.Context, q ent.Query) error {
+		if skipped(ctx) {
+			return nil
+		}
+		switch q := q.(type) {
+		case *ent.EventQuery:
+			q.Where(event.DeletedAtIsNil())
+		case *ent.UserQuery:
+			q.Where(user.DeletedAtIsNil())
+		case *ent.ParticipantQuery:
+			q.Where(participant.HasEventWith(event.DeletedAtIsNil()))
+		case *ent.MessageQuery:
+			q.Where(message.HasEventWith(event.DeletedAtIsNil()))
+		}
+		return nil
+	})
+}
+
+// Hook turns deletes into updates of deleted_at and keeps updates away from trashed rows
+func Hook() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if skipped(ctx) {
+				return next.Mutate(ctx, m)
+			}
+			del := m.Op().Is(ent.OpDelete | ent.OpDeleteOne)
+			switch m := m.(type) {
+			case *ent.EventMutation:
+				m.Where(event.DeletedAtIsNil())
+				if del {
+					m.SetOp(ent.OpUpdate)
+					m.SetDeletedAt(time.Now())
+					return m.Client().Mutate(ctx, m)
+				}
+			case *ent.UserMutation:
+				m.Where(user.DeletedAtIsNil())
+				if del {
+					m.SetOp(ent.OpUpdate)
+					m.SetDeletedAt(time.Now())
+					return m.Client().Mutate(ctx, m)
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	}, ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne)
+}
+
+// Restorable reports whether a row trashed at deletedAt can still be restored
+func Restorable(deletedAt *time.Time, retention time.Duration, now time.Time) bool {
+	return deletedAt != nil && PurgeAt(*deletedAt, retention).After(now)
+}
+
+// PurgeAt returns when a row trashed at deletedAt is deleted permanently
+func PurgeAt(deletedAt time.Time, retention time.Duration) time.Time {
+	return deletedAt.Add(retention)
+}
@@ -0,0 +1,24 @@
+package softdelete
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSkip(t *testing.T) {
+	assert.False(t, skipped(context.Background()))
+	assert.True(t, skipped(Skip(context.Background())))
+}
+
+func TestRestorable(t *testing.T) {
+	now := time.Date(2025, 10, 20, 12, 0, 0, 0, time.UTC)
+	deletedAt := now.Add(-24 * time.Hour)
+
+	assert.True(t, Restorable(&deletedAt, 48*time.Hour, now))
+	assert.False(t, Restorable(&deletedAt, 24*time.Hour, now))
+	assert.False(t, Restorable(nil, 48*time.Hour, now))
+	assert.Equal(t, now.Add(24*time.Hour), PurgeAt(deletedAt, 48*time.Hour))
+}
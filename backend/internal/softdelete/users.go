@@ -0,0 +1,161 @@
+package softdelete
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/ent"
+	"github.com/matsuokashuhei/morrow-backend/ent/event"
+	"github.com/matsuokashuhei/morrow-backend/ent/participant"
+	"github.com/matsuokashuhei/morrow-backend/ent/user"
+)
+
+var (
+	// ErrUserNotFound is returned when the user to delete or restore does not exist
+	ErrUserNotFound = errors.New("user not found")
+	// ErrIdentityInUse is returned when another user took the email or Cognito ID of the user to restore
+	ErrIdentityInUse = errors.New("another user uses the email or Cognito ID of the user")
+	// ErrInvalidTransfer is returned when events are transferred to the deleted user or a missing user
+	ErrInvalidTransfer = errors.New("events can only be transferred to another existing user")
+)
+
+// DeletedUser describes what happened to the events of a deleted user
+type DeletedUser struct {
+	// TransferredEvents were handed over to the new owner
+	TransferredEvents []int
+	// TrashedEvents were moved to the trash together with the user
+	TrashedEvents []int
+}
+
+// DeleteUser moves a user to the trash in one transaction. Their events are
+// handed over to transferTo when it is not zero and trashed with them otherwise,
+// and their participations in events are removed.
+func DeleteUser(ctx context.Context, client *ent.Client, userID, transferTo int) (*DeletedUser, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	deleted, err := DeleteUserTx(ctx, tx, userID, transferTo)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit user deletion: %w", err)
+	}
+	return deleted, nil
+}
+
+// DeleteUserTx works like DeleteUser within a transaction started by the caller
+func DeleteUserTx(ctx context.Context, tx *ent.Tx, userID, transferTo int) (*DeletedUser, error) {
+	if transferTo == userID {
+		return nil, ErrInvalidTransfer
+	}
+
+	exists, err := tx.User.Query().Where(user.IDEQ(userID)).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if !exists {
+		return nil, ErrUserNotFound
+	}
+	if transferTo != 0 {
+		exists, err := tx.User.Query().Where(user.IDEQ(transferTo)).Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get new owner: %w", err)
+		}
+		if !exists {
+			return nil, ErrInvalidTransfer
+		}
+	}
+
+	owned := event.HasCreatorWith(user.IDEQ(userID))
+	eventIDs, err := tx.Event.Query().Where(owned).IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+
+	deleted := &DeletedUser{}
+	// イベントとユーザーに同じ削除日時を記録し、ユーザーの復元時に一緒に戻せるようにする
+	now := time.Now()
+	if len(eventIDs) > 0 {
+		if transferTo != 0 {
+			err = tx.Event.Update().Where(event.IDIn(eventIDs...)).SetCreatorID(transferTo).Exec(ctx)
+			deleted.TransferredEvents = eventIDs
+		} else {
+			err = tx.Event.Update().Where(event.IDIn(eventIDs...)).SetDeletedAt(now).Exec(ctx)
+			deleted.TrashedEvents = eventIDs
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to hand over events: %w", err)
+		}
+	}
+
+	// ゴミ箱にあるイベントへの参加も含めて削除する
+	if _, err := tx.Participant.Delete().
+		Where(participant.HasUserWith(user.IDEQ(userID))).
+		Exec(Skip(ctx)); err != nil {
+		return nil, fmt.Errorf("failed to remove participations: %w", err)
+	}
+
+	if err := tx.User.UpdateOneID(userID).SetDeletedAt(now).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to delete user: %w", err)
+	}
+	return deleted, nil
+}
+
+// RestoreUser moves a user back from the trash in one transaction together
+// with the events that were trashed when the user was deleted. It returns the
+// restored user and the IDs of the restored events. Removed participations are
+// not restored.
+func RestoreUser(ctx context.Context, client *ent.Client, userID int) (*ent.User, []int, error) {
+	ctx = Skip(ctx)
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	u, err := tx.User.Query().
+		Where(user.IDEQ(userID), user.DeletedAtNotNil()).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get deleted user: %w", err)
+	}
+
+	eventIDs, err := tx.Event.Query().
+		Where(event.HasCreatorWith(user.IDEQ(userID)), event.DeletedAtEQ(*u.DeletedAt)).
+		IDs(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get deleted events: %w", err)
+	}
+	if len(eventIDs) > 0 {
+		// 取り消しの通知を受け取ったカレンダーアプリに新しい版として送り直す
+		if err := tx.Event.Update().
+			Where(event.IDIn(eventIDs...)).
+			ClearDeletedAt().
+			AddSequence(1).
+			Exec(ctx); err != nil {
+			return nil, nil, fmt.Errorf("failed to restore events: %w", err)
+		}
+	}
+
+	restored, err := tx.User.UpdateOneID(userID).ClearDeletedAt().Save(ctx)
+	// ゴミ箱にある間に同じメールアドレスで登録したユーザーがいると戻せない
+	if ent.IsConstraintError(err) {
+		return nil, nil, ErrIdentityInUse
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to restore user: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit user restore: %w", err)
+	}
+	return restored, eventIDs, nil
+}
@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocal_PutGetDelete(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocal(t.TempDir(), "http://localhost:8080", []byte("secret"))
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put(ctx, "avatars/1/a.png", strings.NewReader("png-data"), 8, "image/png"))
+
+	r, info, err := store.Get(ctx, "avatars/1/a.png")
+	require.NoError(t, err)
+	body, err := io.ReadAll(r)
+	require.NoError(t, r.Close())
+	require.NoError(t, err)
+	assert.Equal(t, "png-data", string(body))
+	assert.Equal(t, int64(8), info.Size)
+	assert.Equal(t, "image/png", info.ContentType)
+
+	require.NoError(t, store.Delete(ctx, "avatars/1/a.png"))
+	require.NoError(t, store.Delete(ctx, "avatars/1/a.png"))
+	_, _, err = store.Get(ctx, "avatars/1/a.png")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestLocal_RejectsTraversal(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocal(t.TempDir(), "", []byte("secret"))
+	require.NoError(t, err)
+
+	for _, key := range []string{"../etc/passwd", "/abs", "a//b", "a/./b", ""} {
+		assert.Error(t, store.Put(ctx, key, strings.NewReader("x"), 1, ""), key)
+	}
+}
+
+func TestLocal_SignedURL(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocal(t.TempDir(), "http://localhost:8080/", []byte("secret"))
+	require.NoError(t, err)
+
+	signed, err := store.SignedURL(ctx, "attachments/1/photo 1.jpg", time.Minute)
+	require.NoError(t, err)
+
+	u, err := url.Parse(signed)
+	require.NoError(t, err)
+	assert.Equal(t, "/api/v1/files/attachments/1/photo 1.jpg", u.Path)
+
+	q := u.Query()
+	assert.NoError(t, store.Verify("attachments/1/photo 1.jpg", q.Get("expires"), q.Get("signature")))
+	assert.Error(t, store.Verify("attachments/1/other.jpg", q.Get("expires"), q.Get("signature")))
+	assert.Error(t, store.Verify("attachments/1/photo 1.jpg", "1", q.Get("signature")))
+
+	expired, err := store.SignedURL(ctx, "a.jpg", -time.Minute)
+	require.NoError(t, err)
+	u, err = url.Parse(expired)
+	require.NoError(t, err)
+	assert.Error(t, store.Verify("a.jpg", u.Query().Get("expires"), u.Query().Get("signature")))
+}
+
+func TestRef(t *testing.T) {
+	key, ok := KeyFromRef(Ref("avatars/1/a.png"))
+	assert.True(t, ok)
+	assert.Equal(t, "avatars/1/a.png", key)
+
+	_, ok = KeyFromRef("https://example.com/a.png")
+	assert.False(t, ok)
+}
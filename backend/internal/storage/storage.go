@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/matsuokashuhei/morrow-backend/internal/config"
+)
+
+// RefPrefix marks values stored in the database that point to an object in Storage
+// rather than to an external URL (e.g. users.avatar_url = "storage:avatars/1/abc.jpg")
+const RefPrefix = "storage:"
+
+// ErrNotFound is returned when an object does not exist
+var ErrNotFound = errors.New("storage: object not found")
+
+// ObjectInfo describes a stored object
+type ObjectInfo struct {
+	Key         string
+	Size        int64
+	ContentType string
+}
+
+// Storage is implemented by the backends that hold uploaded files
+type Storage interface {
+	// Put stores the content of r under key
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Get opens the object stored under key. The caller must close the reader.
+	Get(ctx context.Context, key string) (io.ReadCloser, *ObjectInfo, error)
+	// Delete removes the object stored under key. Deleting a missing object is not an error.
+	Delete(ctx context.Context, key string) error
+	// SignedURL returns a download URL for key that expires after ttl
+	SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error)
+}
+
+// New creates the storage backend selected by the configuration.
+// It returns nil when no driver is configured, which disables uploads.
+func New(cfg *config.Config) (Storage, error) {
+	switch cfg.StorageDriver {
+	case "":
+		return nil, nil
+	case "local":
+		return NewLocal(cfg.StorageLocalDir, cfg.StorageBaseURL, []byte(cfg.StorageSigningKey))
+	case "s3":
+		return NewS3(S3Options{
+			Endpoint:     cfg.S3Endpoint,
+			Region:       cfg.S3Region,
+			Bucket:       cfg.S3Bucket,
+			AccessKey:    cfg.S3AccessKey,
+			SecretKey:    cfg.S3SecretKey,
+			UsePathStyle: cfg.S3UsePathStyle,
+		})
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", cfg.StorageDriver)
+	}
+}
+
+// pingKey is read by Ping. The object does not need to exist.
+const pingKey = "healthcheck/ping"
+
+// Ping checks that the backend can be reached with the configured credentials
+// by reading an object that normally does not exist
+func Ping(ctx context.Context, s Storage) error {
+	r, _, err := s.Get(ctx, pingKey)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return r.Close()
+}
+
+// Ref converts an object key into the value stored in the database
+func Ref(key string) string {
+	return RefPrefix + key
+}
+
+// KeyFromRef extracts the object key from a value created by Ref
+func KeyFromRef(ref string) (string, bool) {
+	if !strings.HasPrefix(ref, RefPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(ref, RefPrefix), true
+}
+
+// validateKey rejects keys that could escape the storage namespace
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return fmt.Errorf("storage: invalid key %q", key)
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return fmt.Errorf("storage: invalid key %q", key)
+		}
+	}
+	return nil
+}
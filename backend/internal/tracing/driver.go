@@ -0,0 +1,88 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"github.com/matsuokashuhei/morrow-backend/internal/metrics"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Driver wraps an Ent driver to record a span for every statement, including
+// those run in transactions. Spans carry the SQL text but not its arguments.
+func Driver(drv dialect.Driver) dialect.Driver {
+	return &driver{Driver: drv}
+}
+
+type driver struct {
+	dialect.Driver
+}
+
+func (d *driver) Exec(ctx context.Context, query string, args, v any) error {
+	return traceQuery(ctx, query, func(ctx context.Context) error { return d.Driver.Exec(ctx, query, args, v) })
+}
+
+func (d *driver) Query(ctx context.Context, query string, args, v any) error {
+	return traceQuery(ctx, query, func(ctx context.Context) error { return d.Driver.Query(ctx, query, args, v) })
+}
+
+func (d *driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	t, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &tx{Tx: t}, nil
+}
+
+// BeginTx is called by ent.Client.BeginTx
+func (d *driver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.BeginTx is not supported")
+	}
+	t, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &tx{Tx: t}, nil
+}
+
+type tx struct {
+	dialect.Tx
+}
+
+func (t *tx) Exec(ctx context.Context, query string, args, v any) error {
+	return traceQuery(ctx, query, func(ctx context.Context) error { return t.Tx.Exec(ctx, query, args, v) })
+}
+
+func (t *tx) Query(ctx context.Context, query string, args, v any) error {
+	return traceQuery(ctx, query, func(ctx context.Context) error { return t.Tx.Query(ctx, query, args, v) })
+}
+
+// traceQuery runs a statement in a client span named after its type
+func traceQuery(ctx context.Context, query string, run func(context.Context) error) error {
+	op := metrics.Operation(query)
+	ctx, span := tracer().Start(ctx, strings.ToUpper(op),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemNamePostgreSQL,
+			semconv.DBOperationName(op),
+			semconv.DBQueryText(query),
+		),
+	)
+	defer span.End()
+
+	err := run(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
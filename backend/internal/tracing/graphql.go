@@ -0,0 +1,88 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GraphQL is a gqlgen extension recording a span for every operation and,
+// within it, for every field backed by a resolver function
+type GraphQL struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+	graphql.FieldInterceptor
+} = GraphQL{}
+
+// ExtensionName implements graphql.HandlerExtension
+func (GraphQL) ExtensionName() string {
+	return "Tracing"
+}
+
+// Validate implements graphql.HandlerExtension
+func (GraphQL) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptResponse records the operation. Subscriptions get a span per event.
+func (GraphQL) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+	oc := graphql.GetOperationContext(ctx)
+	if oc.Operation == nil {
+		return next(ctx)
+	}
+
+	kind := string(oc.Operation.Operation)
+	name := oc.Operation.Name
+	spanName := kind
+	if name != "" {
+		spanName = kind + " " + name
+	}
+	ctx, span := tracer().Start(ctx, spanName,
+		trace.WithTimestamp(oc.Stats.OperationStart),
+		trace.WithAttributes(
+			attribute.String("graphql.operation.type", kind),
+			attribute.String("graphql.operation.name", name),
+		),
+	)
+	defer span.End()
+
+	resp := next(ctx)
+	if resp != nil && len(resp.Errors) > 0 {
+		span.SetStatus(codes.Error, resp.Errors.Error())
+	}
+	return resp
+}
+
+// InterceptField records the fields backed by a resolver function.
+// Fields read from a loaded struct would only add noise.
+func (GraphQL) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver || strings.HasPrefix(fc.Object, "__") {
+		return next(ctx)
+	}
+
+	ctx, span := tracer().Start(ctx, fmt.Sprintf("%s.%s", fc.Object, fc.Field.Name),
+		trace.WithAttributes(
+			attribute.String("graphql.field.path", fc.Path().String()),
+			attribute.String("graphql.field.type", fc.Field.Definition.Type.String()),
+		),
+	)
+	defer span.End()
+
+	res, err := next(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return res, err
+}
@@ -0,0 +1,107 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// record installs a tracer provider keeping the ended spans in memory
+func record(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+	return recorder
+}
+
+// fakeDriver fails the statement "fail" and runs every other one
+type fakeDriver struct {
+	dialect.Driver
+}
+
+func (fakeDriver) Exec(_ context.Context, query string, _, _ any) error {
+	if query == "fail" {
+		return errors.New("boom")
+	}
+	return nil
+}
+
+func (fakeDriver) Query(context.Context, string, any, any) error {
+	return nil
+}
+
+func TestDriver(t *testing.T) {
+	recorder := record(t)
+	drv := Driver(fakeDriver{})
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "request")
+	require.NoError(t, drv.Query(ctx, `SELECT "users"."id" FROM "users" WHERE "email" = $1`, []any{"a@example.com"}, nil))
+	require.Error(t, drv.Exec(ctx, "fail", nil, nil))
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+
+	query := spans[0]
+	assert.Equal(t, "SELECT", query.Name())
+	assert.Equal(t, parent.SpanContext().SpanID(), query.Parent().SpanID())
+	assert.Contains(t, query.Attributes(), attribute.String("db.query.text", `SELECT "users"."id" FROM "users" WHERE "email" = $1`))
+	assert.Contains(t, query.Attributes(), attribute.String("db.operation.name", "select"))
+	for _, attr := range query.Attributes() {
+		// 引数は個人情報を含むので記録しない
+		assert.NotContains(t, attr.Value.Emit(), "a@example.com")
+	}
+
+	failed := spans[1]
+	assert.Equal(t, codes.Error, failed.Status().Code)
+	assert.Equal(t, "boom", failed.Status().Description)
+}
+
+func TestLogHook(t *testing.T) {
+	record(t)
+	var buf bytes.Buffer
+	logger := logrus.New()
+	logger.SetOutput(&buf)
+	logger.SetFormatter(&logrus.JSONFormatter{})
+	logger.AddHook(LogHook{})
+
+	ctx, span := otel.Tracer("test").Start(context.Background(), "request")
+	defer span.End()
+
+	logger.WithContext(ctx).Info("traced")
+	assert.Contains(t, buf.String(), `"trace_id":"`+span.SpanContext().TraceID().String()+`"`)
+	assert.Contains(t, buf.String(), `"span_id":"`+span.SpanContext().SpanID().String()+`"`)
+
+	buf.Reset()
+	logger.Info("untraced")
+	assert.NotContains(t, buf.String(), "trace_id")
+}
+
+func TestSetup(t *testing.T) {
+	prev := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	shutdown, err := Setup(context.Background(), Options{})
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+
+	shutdown, err = Setup(context.Background(), Options{Exporter: ExporterStdout, ServiceName: "test", SampleRatio: 1})
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+
+	_, err = Setup(context.Background(), Options{Exporter: "jaeger"})
+	assert.Error(t, err)
+}
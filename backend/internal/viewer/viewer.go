@@ -0,0 +1,33 @@
+package viewer
+
+import "context"
+
+// Viewer describes the authenticated caller of the current request
+type Viewer struct {
+	// Subject is the identity provider subject (Cognito sub in Phase 2)
+	Subject string
+	// UserID is the ID of the User whose cognito_id matches Subject, or 0 if none exists yet
+	UserID int
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying the viewer
+func NewContext(ctx context.Context, v *Viewer) context.Context {
+	return context.WithValue(ctx, contextKey{}, v)
+}
+
+// FromContext returns the viewer stored in ctx, if any
+func FromContext(ctx context.Context) (*Viewer, bool) {
+	v, ok := ctx.Value(contextKey{}).(*Viewer)
+	return v, ok && v != nil
+}
+
+// UserID returns the user ID of the viewer stored in ctx, if it is known
+func UserID(ctx context.Context) (int, bool) {
+	v, ok := FromContext(ctx)
+	if !ok || v.UserID == 0 {
+		return 0, false
+	}
+	return v.UserID, true
+}
@@ -0,0 +1,23 @@
+/**
+ * Unit tests for the W3C trace context header sent to the backend
+ */
+import { createTraceparent } from '../traceparent';
+
+describe('createTraceparent', () => {
+  it('should create a valid sampled traceparent header', () => {
+    expect(createTraceparent()).toMatch(/^00-[0-9a-f]{32}-[0-9a-f]{16}-01$/);
+  });
+
+  it('should start a new trace for every request', () => {
+    const [, traceId1, spanId1] = createTraceparent().split('-');
+    const [, traceId2, spanId2] = createTraceparent().split('-');
+
+    expect(traceId1).not.toBe(traceId2);
+    expect(spanId1).not.toBe(spanId2);
+  });
+
+  it('should never use an all-zero trace ID', () => {
+    const [, traceId] = createTraceparent().split('-');
+    expect(traceId).not.toBe('0'.repeat(32));
+  });
+});
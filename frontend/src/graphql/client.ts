@@ -6,6 +6,7 @@ import {
 } from '@apollo/client';
 import { onError } from '@apollo/client/link/error';
 import { getGraphQLEndpoint } from '../utils/environment';
+import { traceparentLink } from './traceparent';
 
 // GraphQL endpoint
 const httpLink = createHttpLink({
@@ -15,9 +16,9 @@ const httpLink = createHttpLink({
 // Error handling link
 const errorLink = onError(({ graphQLErrors, networkError }) => {
   if (graphQLErrors) {
-    graphQLErrors.forEach(({ message, locations, path }) => {
+    graphQLErrors.forEach(({ message, locations, path, extensions }) => {
       console.error(
-        `[GraphQL error]: Message: ${message}, Location: ${locations}, Path: ${path}`
+        `[GraphQL error]: Message: ${message}, Location: ${locations}, Path: ${path}, Request ID: ${extensions?.requestId}`
       );
     });
   }
@@ -34,7 +35,7 @@ const errorLink = onError(({ graphQLErrors, networkError }) => {
 
 // Apollo Client configuration
 export const apolloClient = new ApolloClient({
-  link: from([errorLink, httpLink]),
+  link: from([errorLink, traceparentLink, httpLink]),
   cache: new InMemoryCache({
     typePolicies: {
       Query: {
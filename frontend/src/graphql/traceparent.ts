@@ -0,0 +1,29 @@
+import { setContext } from '@apollo/client/link/context';
+
+const toHex = (bytes: Uint8Array): string =>
+  Array.from(bytes, (b) => b.toString(16).padStart(2, '0')).join('');
+
+const randomHex = (length: number): string => {
+  const bytes = new Uint8Array(length);
+  // すべて0のIDは無効なので作り直す
+  do {
+    crypto.getRandomValues(bytes);
+  } while (bytes.every((b) => b === 0));
+  return toHex(bytes);
+};
+
+/**
+ * Creates a W3C trace context header starting a new sampled trace,
+ * so that the backend spans of one GraphQL request share its trace ID
+ * https://www.w3.org/TR/trace-context/#traceparent-header
+ */
+export const createTraceparent = (): string =>
+  `00-${randomHex(16)}-${randomHex(8)}-01`;
+
+// Sends a traceparent header with every GraphQL request
+export const traceparentLink = setContext((_, { headers }) => ({
+  headers: {
+    ...headers,
+    traceparent: createTraceparent(),
+  },
+}));